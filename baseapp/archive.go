@@ -0,0 +1,36 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ArchiveStore serves the historical state the CommitMultiStore has pruned,
+// from an external versioned store. Archived state carries no proofs.
+type ArchiveStore interface {
+	// HasVersion returns whether the state at the given height is archived
+	HasVersion(height int64) bool
+	// CacheMultiStoreWithVersion branches the archived state at the given height
+	CacheMultiStoreWithVersion(height int64) (sdk.CacheMultiStore, error)
+	// Query serves a store query at an archived height
+	Query(req abci.RequestQuery) abci.ResponseQuery
+}
+
+// versionChecker is implemented by the multi-stores able to tell whether the
+// state at a version has been pruned.
+type versionChecker interface {
+	VersionExists(version int64) bool
+}
+
+// useArchive returns whether a query at the given height must be served from
+// the archive, i.e. the height is archived and pruned from the multi-store.
+// Proofs can only be served by the multi-store.
+func (app *BaseApp) useArchive(height int64, prove bool) bool {
+	if app.archive == nil || prove || !app.archive.HasVersion(height) {
+		return false
+	}
+
+	checker, ok := app.cms.(versionChecker)
+	return ok && !checker.VersionExists(height)
+}
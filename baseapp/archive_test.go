@@ -0,0 +1,131 @@
+package baseapp_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/archive"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestArchiveServesPrunedHeights(t *testing.T) {
+	key := sdk.NewKVStoreKey("archived")
+	heightKey := []byte("height")
+
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil,
+		baseapp.SetPruning(storetypes.NewPruningOptions(1, 0, 1)))
+	app.MountStores(key)
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.KVStore(key).Set(heightKey, []byte(fmt.Sprint(req.Header.Height)))
+		return abci.ResponseBeginBlock{}
+	})
+
+	archiveStore := archive.NewStore(dbm.NewMemDB(), []storetypes.StoreKey{key})
+	archiveService := archive.NewStreamingService(archiveStore, app.CommitMultiStore(), log.NewNopLogger())
+	app.SetStreamingService(archiveService)
+	app.SetArchiveStore(archiveStore)
+	require.NoError(t, app.LoadLatestVersion())
+
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 5; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+	// the state of the first block is imported in the background
+	require.Eventually(t, func() bool { return archiveStore.LatestVersion() == 5 }, time.Second, time.Millisecond)
+	require.Equal(t, int64(1), archiveStore.EarliestVersion())
+
+	query := func(height int64, prove bool) abci.ResponseQuery {
+		return app.Query(abci.RequestQuery{Path: "/store/archived/key", Data: heightKey, Height: height, Prove: prove})
+	}
+
+	// the pruned heights are served by the archive, without proofs
+	for height := int64(1); height <= 5; height++ {
+		res := query(height, false)
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, []byte(fmt.Sprint(height)), res.Value)
+		require.Equal(t, height, res.Height)
+	}
+	require.Empty(t, query(2, true).Value)
+
+	// the heights kept by the multi-store are served with proofs
+	res := query(5, true)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("5"), res.Value)
+	require.NotNil(t, res.ProofOps)
+	require.NoError(t, archiveService.Close())
+}
+
+type archiveTestTx struct{}
+
+func (archiveTestTx) GetMsgs() []sdk.Msg   { return []sdk.Msg{testdata.NewTestMsg()} }
+func (archiveTestTx) ValidateBasic() error { return nil }
+
+func storeContent(store sdk.KVStore) map[string]string {
+	content := make(map[string]string)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		content[string(iter.Key())] = string(iter.Value())
+	}
+
+	return content
+}
+
+func TestArchiveIgnoresCheckTx(t *testing.T) {
+	key := sdk.NewKVStoreKey("archived")
+	heightKey := []byte("height")
+	checkKey := []byte("check")
+
+	txDecoder := func([]byte) (sdk.Tx, error) { return archiveTestTx{}, nil }
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), txDecoder)
+	app.MountStores(key)
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.KVStore(key).Set(heightKey, []byte(fmt.Sprint(req.Header.Height)))
+		return abci.ResponseBeginBlock{}
+	})
+	app.SetAnteHandler(func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		ctx.KVStore(key).Set(checkKey, []byte(fmt.Sprint(ctx.BlockHeight())))
+		return ctx, nil
+	})
+
+	cms := app.CommitMultiStore()
+	archiveStore := archive.NewStore(dbm.NewMemDB(), []storetypes.StoreKey{key})
+	archiveService := archive.NewStreamingService(archiveStore, cms, log.NewNopLogger())
+	app.SetStreamingService(archiveService)
+	require.NoError(t, app.LoadLatestVersion())
+
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+
+		// the writes of CheckTx are never committed
+		require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: []byte(fmt.Sprint(height))}).IsOK())
+	}
+	require.Eventually(t, func() bool { return archiveStore.LatestVersion() == 3 }, time.Second, time.Millisecond)
+
+	for height := int64(1); height <= 3; height++ {
+		archived, err := archiveStore.CacheMultiStoreWithVersion(height)
+		require.NoError(t, err)
+		committed, err := cms.CacheMultiStoreWithVersion(height)
+		require.NoError(t, err)
+
+		expected := storeContent(committed.GetKVStore(key))
+		require.Equal(t, map[string]string{string(heightKey): fmt.Sprint(height)}, expected)
+		require.Equal(t, expected, storeContent(archived.GetKVStore(key)), "height %d", height)
+	}
+	require.NoError(t, archiveService.Close())
+}
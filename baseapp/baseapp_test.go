@@ -831,7 +831,7 @@ func anteHandlerTxTest(t *testing.T, capKey sdk.StoreKey, storeKey []byte) sdk.A
 			counterEvent("ante_handler", txTest.Counter),
 		)
 
-		return ctx, nil
+		return ctx.WithPriority(txTest.Counter), nil
 	}
 }
 
@@ -938,8 +938,14 @@ func TestCheckTx(t *testing.T) {
 		txBytes, err := codec.Marshal(tx)
 		require.NoError(t, err)
 		r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
-		require.Empty(t, r.GetEvents())
 		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+
+		// only the tx priority set by the ante handler is reported
+		events := r.GetEvents()
+		require.Len(t, events, 1)
+		require.Equal(t, sdk.EventTypeTx, events[0].Type)
+		require.Equal(t, []byte(sdk.AttributeKeyPriority), events[0].Attributes[0].Key)
+		require.Equal(t, []byte(fmt.Sprintf("%d", i)), events[0].Attributes[0].Value)
 	}
 
 	checkStateStore := app.checkState.ctx.KVStore(capKey1)
@@ -62,6 +62,23 @@ func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
 }
 
+// parallelCommitter is implemented by the multi-stores able to commit their
+// IAVL stores concurrently.
+type parallelCommitter interface {
+	SetParallelCommit(enabled bool)
+}
+
+// SetParallelCommit provides a BaseApp option function that sets if the IAVL
+// stores are committed concurrently. It has no effect on the multi-stores
+// which always commit their stores sequentially.
+func SetParallelCommit(enabled bool) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if committer, ok := bapp.cms.(parallelCommitter); ok {
+			committer.SetParallelCommit(enabled)
+		}
+	}
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
@@ -242,3 +259,28 @@ func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.grpcQueryRouter.SetInterfaceRegistry(registry)
 	app.msgServiceRouter.SetInterfaceRegistry(registry)
 }
+
+// SetStreamingService is used to set a streaming service into the BaseApp hooks
+// and load the listeners into the multistore.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+	// add the listeners for each StoreKey
+	for key, lis := range s.Listeners() {
+		app.cms.AddListeners(key, lis)
+	}
+	// register the StreamingService within the BaseApp
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock and Commit requests
+	// and responses to the streaming services to update their ABCI context
+	app.abciListeners = append(app.abciListeners, s)
+}
+
+// SetArchiveStore sets the archive serving the queries at the heights pruned
+// from the multi-store.
+func (app *BaseApp) SetArchiveStore(archive ArchiveStore) {
+	if app.sealed {
+		panic("SetArchiveStore() on sealed BaseApp")
+	}
+	app.archive = archive
+}
@@ -0,0 +1,89 @@
+package baseapp
+
+import (
+	"io"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener is the interface used to hook into the ABCI message processing
+// of the BaseApp.
+//
+// The state changes of a phase are observed by the registered store listeners
+// when the branch of the phase is written to the deliver state, before the
+// matching hook is called, so a listener can attribute them to the BeginBlock,
+// the DeliverTx or the EndBlock they originate from. The state written by
+// InitChain is reported with the BeginBlock of the first block. The listeners
+// observe the state changes of the block once more on Commit, when the deliver
+// state is written to the root multi-store. The writes to the check state, made
+// by CheckTx and the simulations, are not observed.
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenDeliverTx updates the streaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenEndBlock updates the streaming service with the latest EndBlock messages
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenCommit updates the streaming service with the latest Commit response,
+	// once the state of the block has been committed
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// StreamingService is the interface for registering WriteListeners with the
+// BaseApp and updating the service with the ABCI messages using the hooks.
+type StreamingService interface {
+	// Stream is the streaming service loop, awaits kv pairs and writes them to
+	// some destination stream or file
+	Stream(wg *sync.WaitGroup) error
+	// Listeners returns the streaming service's listeners for the BaseApp to
+	// register
+	Listeners() map[storetypes.StoreKey][]storetypes.WriteListener
+	// ABCIListener interface for hooking into the ABCI messages from inside the
+	// BaseApp
+	ABCIListener
+	// Closer interface
+	io.Closer
+}
+
+// branchDeliverState branches the deliver state for the ABCI phase about to
+// run when a streaming service is registered, and returns the function writing
+// the branch back to the deliver state once the phase has ended. The store
+// listeners observe the writes of the phase when the branch is written, while
+// the deliver state itself is only written to the root multi-store on Commit.
+func (app *BaseApp) branchDeliverState() (writeBranch func()) {
+	if len(app.abciListeners) == 0 {
+		return func() {}
+	}
+
+	ms := app.deliverState.ms
+	branch := ms.CacheMultiStore()
+	app.deliverState.ms = branch
+	app.deliverState.ctx = app.deliverState.ctx.WithMultiStore(branch)
+
+	return func() {
+		branch.Write()
+		app.deliverState.ms = ms
+		app.deliverState.ctx = app.deliverState.ctx.WithMultiStore(ms)
+	}
+}
+
+// unlistenedBrancher is implemented by the multi-stores able to branch their
+// state without the registered listeners observing the writes to the branch.
+type unlistenedBrancher interface {
+	CacheMultiStoreWithoutListeners() sdk.CacheMultiStore
+}
+
+// branchCheckState branches the multi-store for the check state. The writes
+// of CheckTx and the simulations are never committed, so they are hidden from
+// the store listeners, which would otherwise report them with the next block.
+func (app *BaseApp) branchCheckState() sdk.CacheMultiStore {
+	if brancher, ok := app.cms.(unlistenedBrancher); ok {
+		return brancher.CacheMultiStoreWithoutListeners()
+	}
+
+	return app.cms.CacheMultiStore()
+}
@@ -0,0 +1,161 @@
+package baseapp
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/streaming/collector"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockStreamingService struct {
+	*collector.BlockCollector
+	blocks []*storetypes.BlockMetadata
+}
+
+func newMockStreamingService(keys ...storetypes.StoreKey) *mockStreamingService {
+	s := &mockStreamingService{}
+	s.BlockCollector = collector.NewBlockCollector(keys, func(_ sdk.Context, block *storetypes.BlockMetadata) error {
+		s.blocks = append(s.blocks, block)
+		return nil
+	})
+
+	return s
+}
+
+func (s *mockStreamingService) Stream(_ *sync.WaitGroup) error { return nil }
+func (s *mockStreamingService) Close() error                   { return nil }
+
+func stateChangeKeys(pairs []*storetypes.StoreKVPair) []string {
+	keys := make([]string, len(pairs))
+	for i, pair := range pairs {
+		keys[i] = string(pair.Key)
+	}
+
+	return keys
+}
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	streamingService := newMockStreamingService(capKey1)
+
+	opts := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set([]byte("begin-key"), []byte("begin"))
+			ctx.KVStore(capKey2).Set([]byte("unlistened-key"), []byte("begin"))
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey1).Delete([]byte("begin-key"))
+			return abci.ResponseEndBlock{}
+		})
+		bapp.SetStreamingService(streamingService)
+	}
+
+	app := setupBaseApp(t, opts)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	txBytes, err := codec.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+
+	failingTx := newTxCounter(1, 1)
+	failingTx.FailOnAnte = true
+	failingTxBytes, err := codec.Marshal(failingTx)
+	require.NoError(t, err)
+	require.False(t, app.DeliverTx(abci.RequestDeliverTx{Tx: failingTxBytes}).IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	res := app.Commit()
+
+	require.Len(t, streamingService.blocks, 1)
+	block := streamingService.blocks[0]
+	require.Equal(t, header.Height, block.Height)
+	require.Equal(t, res.Data, block.ResponseCommit.Data)
+
+	// each phase only reports the writes of the listened stores it made
+	require.Equal(t, []string{"begin-key"}, stateChangeKeys(block.BeginBlockStateChanges))
+	require.Len(t, block.DeliverTxs, 2)
+	require.Equal(t, txBytes, block.DeliverTxs[0].Request.Tx)
+	require.Equal(t, []string{"ante-key", "deliver-key"}, stateChangeKeys(block.DeliverTxs[0].StateChanges))
+	require.Empty(t, block.DeliverTxs[1].StateChanges)
+	require.False(t, block.DeliverTxs[1].Response.IsOK())
+	require.Equal(t, []string{"begin-key"}, stateChangeKeys(block.EndBlockStateChanges))
+	require.True(t, block.EndBlockStateChanges[0].Delete)
+
+	// the streamed writes are committed as usual
+	store := app.cms.GetKVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, anteKey))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Nil(t, store.Get([]byte("begin-key")))
+	require.Equal(t, []byte("begin"), app.cms.GetKVStore(capKey2).Get([]byte("unlistened-key")))
+}
+
+func TestStreamingServiceIgnoresCheckState(t *testing.T) {
+	checkKey := []byte("check-key")
+	deliverKey := []byte("deliver-key")
+	streamingService := newMockStreamingService(capKey1)
+
+	opts := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if ctx.IsCheckTx() || simulate {
+				ctx.KVStore(capKey1).Set(checkKey, []byte("check"))
+			}
+			return ctx, nil
+		})
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set([]byte("begin-key"), []byte("begin"))
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetStreamingService(streamingService)
+	}
+
+	app := setupBaseApp(t, opts)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 2; height++ {
+		header := tmproto.Header{Height: height}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+
+		// check and simulate the transaction between the blocks
+		require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+		require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck}).IsOK())
+		_, _, err = app.Simulate(txBytes)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, streamingService.blocks, 2)
+	for _, block := range streamingService.blocks {
+		require.Equal(t, []string{"begin-key"}, stateChangeKeys(block.BeginBlockStateChanges))
+		require.Empty(t, block.DeliverTxs)
+		require.Empty(t, block.EndBlockStateChanges)
+	}
+	store := app.cms.GetKVStore(capKey1)
+	require.Nil(t, store.Get(checkKey))
+	require.Nil(t, store.Get(deliverKey))
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -66,6 +67,9 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// ParallelCommit enables committing the IAVL stores concurrently.
+	ParallelCommit bool `mapstructure:"parallel-commit"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
@@ -159,6 +163,58 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// StreamingConfig defines the state streaming configuration.
+type StreamingConfig struct {
+	// Streamers lists the enabled streaming services, among "file" and "grpc".
+	Streamers []string `mapstructure:"streamers"`
+
+	File FileStreamerConfig `mapstructure:"file"`
+	GRPC GRPCStreamerConfig `mapstructure:"grpc"`
+}
+
+// FileStreamerConfig defines the configuration of the file streaming service.
+type FileStreamerConfig struct {
+	// Keys lists the names of the stores to stream, "*" streams every store.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir is the directory the block files are written to, relative to
+	// the node home directory unless absolute.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// Prefix is prepended to the name of the block files.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// GRPCStreamerConfig defines the configuration of the gRPC streaming service.
+type GRPCStreamerConfig struct {
+	// Keys lists the names of the stores to stream, "*" streams every store.
+	Keys []string `mapstructure:"keys"`
+
+	// Address defines the address of the StreamingSink gRPC service.
+	Address string `mapstructure:"address"`
+
+	// Timeout defines the time allowed for the sink to receive a block.
+	Timeout time.Duration `mapstructure:"timeout"`
+
+	// QueueSize defines the number of blocks queued before block processing
+	// waits on the sink.
+	QueueSize uint `mapstructure:"queue-size"`
+}
+
+// ArchiveConfig defines the configuration of the archive serving the state
+// of the heights pruned from the application store.
+type ArchiveConfig struct {
+	// Enable enables the archive.
+	Enable bool `mapstructure:"enable"`
+
+	// Dir is the directory of the archive database, relative to the node home
+	// directory unless absolute.
+	Dir string `mapstructure:"dir"`
+
+	// Backend is the database backend of the archive.
+	Backend string `mapstructure:"backend"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -170,6 +226,8 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Streaming StreamingConfig  `mapstructure:"streaming"`
+	Archive   ArchiveConfig    `mapstructure:"archive"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -205,6 +263,7 @@ func DefaultConfig() *Config {
 		BaseConfig: BaseConfig{
 			MinGasPrices:      defaultMinGasPrices,
 			InterBlockCache:   true,
+			ParallelCommit:    true,
 			Pruning:           storetypes.PruningOptionDefault,
 			PruningKeepRecent: "0",
 			PruningKeepEvery:  "0",
@@ -245,6 +304,24 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Streaming: StreamingConfig{
+			Streamers: []string{},
+			File: FileStreamerConfig{
+				Keys:     []string{"*"},
+				WriteDir: "data/streaming",
+			},
+			GRPC: GRPCStreamerConfig{
+				Keys:      []string{"*"},
+				Address:   "localhost:9191",
+				Timeout:   5 * time.Second,
+				QueueSize: 64,
+			},
+		},
+		Archive: ArchiveConfig{
+			Enable:  false,
+			Dir:     "data/archive",
+			Backend: "goleveldb",
+		},
 	}
 }
 
@@ -263,6 +340,7 @@ func GetConfig(v *viper.Viper) Config {
 		BaseConfig: BaseConfig{
 			MinGasPrices:      v.GetString("minimum-gas-prices"),
 			InterBlockCache:   v.GetBool("inter-block-cache"),
+			ParallelCommit:    v.GetBool("parallel-commit"),
 			Pruning:           v.GetString("pruning"),
 			PruningKeepRecent: v.GetString("pruning-keep-recent"),
 			PruningKeepEvery:  v.GetString("pruning-keep-every"),
@@ -313,6 +391,25 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Streaming: StreamingConfig{
+			Streamers: v.GetStringSlice("streaming.streamers"),
+			File: FileStreamerConfig{
+				Keys:     v.GetStringSlice("streaming.file.keys"),
+				WriteDir: v.GetString("streaming.file.write-dir"),
+				Prefix:   v.GetString("streaming.file.prefix"),
+			},
+			GRPC: GRPCStreamerConfig{
+				Keys:      v.GetStringSlice("streaming.grpc.keys"),
+				Address:   v.GetString("streaming.grpc.address"),
+				Timeout:   v.GetDuration("streaming.grpc.timeout"),
+				QueueSize: v.GetUint("streaming.grpc.queue-size"),
+			},
+		},
+		Archive: ArchiveConfig{
+			Enable:  v.GetBool("archive.enable"),
+			Dir:     v.GetString("archive.dir"),
+			Backend: v.GetString("archive.backend"),
+		},
 	}
 }
 
@@ -63,6 +63,10 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# ParallelCommit commits the IAVL stores concurrently, with at most one worker
+# per CPU, rather than one after another.
+parallel-commit = {{ .BaseConfig.ParallelCommit }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs Tendermint what to index. If empty, all events will be indexed.
 #
@@ -205,6 +209,66 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                        State Streaming                                  ###
+###############################################################################
+
+# Streaming exposes the ABCI requests and responses of each committed block,
+# together with the state changes written by each of its phases, to external
+# consumers such as indexers.
+[streaming]
+
+# streamers lists the enabled streaming services, among "file" and "grpc".
+streamers = [{{ range .Streaming.Streamers }}{{ printf "%q, " . }}{{end}}]
+
+[streaming.file]
+
+# keys lists the names of the stores to stream, "*" streams every store.
+keys = [{{ range .Streaming.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# write-dir is the directory each block is written to as a file holding a
+# length-prefixed protobuf BlockMetadata. It is relative to the node home
+# directory unless absolute.
+write-dir = "{{ .Streaming.File.WriteDir }}"
+
+# prefix is prepended to the name of the block files.
+prefix = "{{ .Streaming.File.Prefix }}"
+
+[streaming.grpc]
+
+# keys lists the names of the stores to stream, "*" streams every store.
+keys = [{{ range .Streaming.GRPC.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# address is the address of the local StreamingSink gRPC service blocks are pushed to.
+address = "{{ .Streaming.GRPC.Address }}"
+
+# timeout is the time allowed for the sink to receive a block before it is dropped.
+timeout = "{{ .Streaming.GRPC.Timeout }}"
+
+# queue-size is the number of blocks queued for the sink before block
+# processing waits on it.
+queue-size = {{ .Streaming.GRPC.QueueSize }}
+
+###############################################################################
+###                        Archive Configuration                            ###
+###############################################################################
+
+# The archive keeps the state changes of every height in a separate database,
+# starting from the first block committed once it is enabled. Queries at the
+# heights the application store has pruned are served from it, without proofs,
+# so that a node can run with an aggressive pruning strategy.
+[archive]
+
+# enable defines if the archive should be enabled.
+enable = {{ .Archive.Enable }}
+
+# dir is the directory of the archive database, relative to the node home
+# directory unless absolute.
+dir = "{{ .Archive.Dir }}"
+
+# backend is the database backend of the archive.
+backend = "{{ .Archive.Backend }}"
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,478 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/light"
+	"github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/statesync"
+	tmstore "github.com/tendermint/tendermint/store"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+const (
+	FlagOutput              = "output"
+	FlagBootstrapTendermint = "bootstrap-tendermint"
+
+	// snapshotMetadataEntry is the name of the tarball entry holding the snapshot metadata,
+	// all other entries are chunks named by their index.
+	snapshotMetadataEntry = "metadata"
+)
+
+// SnapshotCmd returns the snapshots command group, which manages the local
+// state-sync snapshot store offline.
+func SnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state-sync snapshots",
+		Long: `Manage the local state-sync snapshot store without running the node.
+
+Snapshots can be packaged into a single tarball with dump, shipped to another
+machine (e.g. through object storage) and registered there with load. A node
+can then restore its app state from the snapshot with import, instead of
+fetching it from peers through state sync.`,
+	}
+	cmd.AddCommand(
+		ExportSnapshotCmd(appCreator),
+		ImportSnapshotCmd(appCreator),
+		ListSnapshotsCmd(),
+		DeleteSnapshotCmd(),
+		DumpSnapshotCmd(),
+		LoadSnapshotCmd(),
+	)
+	return cmd
+}
+
+// ExportSnapshotCmd creates a snapshot of the app state at a committed height.
+func ExportSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export app state to the local snapshot store",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+
+			db, err := openDB(ctx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 {
+				height = app.LastCommitID().Version
+			}
+			if height <= 0 {
+				return errors.New("no committed height to export")
+			}
+
+			snapshot, err := app.SnapshotManager().Create(uint64(height))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "Height to export, defaults to the latest committed height")
+	return cmd
+}
+
+// ImportSnapshotCmd restores app state from a snapshot in the local snapshot store.
+func ImportSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <height> <format>",
+		Short: "Restore app state from a snapshot in the local snapshot store",
+		Long: `Restore app state from a snapshot in the local snapshot store. The node's
+application database must be empty.
+
+Tendermint also needs its state and the commit at the snapshot height before
+the node can start from it. With --bootstrap-tendermint they are fetched from
+the RPC servers in the [statesync] section of config.toml, verified with the
+light client against the configured trust height and hash, and written to the
+empty Tendermint databases. Otherwise the restored height and app hash are
+printed, and Tendermint has to be bootstrapped separately.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(ctx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			if latest := app.LastCommitID().Version; latest != 0 {
+				return fmt.Errorf("application state is not empty, found height %d", latest)
+			}
+			if err := app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+				return err
+			}
+			commitID := app.LastCommitID()
+			fmt.Printf("Restored app state to height %d and hash %X\n", commitID.Version, commitID.Hash)
+
+			bootstrap, _ := cmd.Flags().GetBool(FlagBootstrapTendermint)
+			if !bootstrap {
+				return nil
+			}
+			if err := bootstrapTendermintState(cmd.Context(), ctx.Config, ctx.Logger, height, commitID.Hash); err != nil {
+				return fmt.Errorf("failed to bootstrap tendermint state: %w", err)
+			}
+			fmt.Printf("Bootstrapped tendermint state at height %d\n", height)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(FlagBootstrapTendermint, false, "Bootstrap Tendermint state at the snapshot height from the [statesync] RPC servers")
+	return cmd
+}
+
+// ListSnapshotsCmd lists the snapshots in the local snapshot store.
+func ListSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List snapshots in the local snapshot store",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+			list, err := store.List()
+			if err != nil {
+				return err
+			}
+			for _, s := range list {
+				fmt.Printf("height: %d format: %d chunks: %d hash: %X\n", s.Height, s.Format, s.Chunks, s.Hash)
+			}
+			return nil
+		},
+	}
+}
+
+// DeleteSnapshotCmd deletes a snapshot from the local snapshot store.
+func DeleteSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <height> <format>",
+		Short: "Delete a snapshot from the local snapshot store",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			store, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+			return store.Delete(height, format)
+		},
+	}
+}
+
+// DumpSnapshotCmd packages a snapshot from the local snapshot store into a tarball.
+func DumpSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump a snapshot from the local snapshot store into a tarball",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			store, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(FlagOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			if err := DumpSnapshot(store, height, format, f); err != nil {
+				_ = os.Remove(output)
+				return err
+			}
+			return f.Close()
+		},
+	}
+
+	cmd.Flags().StringP(FlagOutput, "o", "", "Output file, defaults to <height>-<format>.tar.gz")
+	return cmd
+}
+
+// LoadSnapshotCmd registers a snapshot tarball produced by dump in the local snapshot store.
+func LoadSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load <archive-file>",
+		Short: "Load a snapshot tarball into the local snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := GetSnapshotStore(GetServerContextFromCmd(cmd).Viper)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			snapshot, err := LoadSnapshot(store, f)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Snapshot loaded at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+}
+
+// DumpSnapshot writes a snapshot from the store as a gzipped tarball, holding the
+// snapshot metadata followed by its chunks in order.
+func DumpSnapshot(store *snapshots.Store, height uint64, format uint32, w io.Writer) error {
+	snapshot, chunks, err := store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d format %d not found", height, format)
+	}
+	defer snapshots.DrainChunks(chunks)
+
+	metadata, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	if err := writeTarEntry(tw, snapshotMetadataEntry, metadata); err != nil {
+		return err
+	}
+	index := 0
+	for chunk := range chunks {
+		body, err := ioutil.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+		if err := writeTarEntry(tw, strconv.Itoa(index), body); err != nil {
+			return err
+		}
+		index++
+	}
+	if uint32(index) != snapshot.Chunks {
+		return fmt.Errorf("snapshot has %d chunks, but %d were found", snapshot.Chunks, index)
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
+
+// LoadSnapshot saves a snapshot tarball written by DumpSnapshot into the store. The
+// saved snapshot is removed again if its hash does not match the dumped metadata.
+func LoadSnapshot(store *snapshots.Store, r io.Reader) (*snapshottypes.Snapshot, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gzr.Close()
+	tr := tar.NewReader(gzr)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot metadata: %w", err)
+	}
+	if hdr.Name != snapshotMetadataEntry {
+		return nil, fmt.Errorf("expected snapshot metadata entry, got %q", hdr.Name)
+	}
+	metadata, err := ioutil.ReadAll(tr)
+	if err != nil {
+		return nil, err
+	}
+	var expected snapshottypes.Snapshot
+	if err := proto.Unmarshal(metadata, &expected); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot metadata: %w", err)
+	}
+
+	// Chunks are read fully before being handed to the store, since the tar reader
+	// only gives access to one entry at a time.
+	chunks := make(chan io.ReadCloser)
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(chunks)
+		for index := 0; ; index++ {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				errCh <- err
+				return
+			}
+			if hdr.Name != strconv.Itoa(index) {
+				errCh <- fmt.Errorf("expected snapshot chunk %d, got %q", index, hdr.Name)
+				return
+			}
+			body, err := ioutil.ReadAll(tr)
+			if err != nil {
+				errCh <- err
+				return
+			}
+			chunks <- ioutil.NopCloser(bytes.NewReader(body))
+		}
+	}()
+
+	snapshot, err := store.Save(expected.Height, expected.Format, chunks)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case err = <-errCh:
+	default:
+		if !bytes.Equal(snapshot.Hash, expected.Hash) || snapshot.Chunks != expected.Chunks {
+			err = fmt.Errorf("snapshot hash mismatch, expected %X with %d chunks, got %X with %d chunks",
+				expected.Hash, expected.Chunks, snapshot.Hash, snapshot.Chunks)
+		}
+	}
+	if err != nil {
+		if delErr := store.Delete(snapshot.Height, snapshot.Format); delErr != nil {
+			return nil, fmt.Errorf("%v; failed to delete invalid snapshot: %w", err, delErr)
+		}
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// bootstrapTendermintState fetches the Tendermint state and commit at height through the
+// state sync light client, checks them against the restored app hash and saves them into
+// the node's empty state and block stores.
+func bootstrapTendermintState(ctx context.Context, cfg *tmcfg.Config, logger log.Logger, height uint64, appHash []byte) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	genState, err := sm.MakeGenesisStateFromFile(cfg.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB)
+	state, err := stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if !state.IsEmpty() {
+		return fmt.Errorf("tendermint state is not empty, found height %d", state.LastBlockHeight)
+	}
+
+	blockDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer blockDB.Close()
+	blockStore := tmstore.NewBlockStore(blockDB)
+	if blockStore.Height() != 0 {
+		return fmt.Errorf("tendermint block store is not empty, found height %d", blockStore.Height())
+	}
+
+	provider, err := statesync.NewLightClientStateProvider(
+		ctx,
+		genState.ChainID, genState.Version, genState.InitialHeight,
+		cfg.StateSync.RPCServers,
+		light.TrustOptions{
+			Period: cfg.StateSync.TrustPeriod,
+			Height: cfg.StateSync.TrustHeight,
+			Hash:   cfg.StateSync.TrustHashBytes(),
+		},
+		logger.With("module", "light"),
+	)
+	if err != nil {
+		return err
+	}
+
+	trustedAppHash, err := provider.AppHash(ctx, height)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(trustedAppHash, appHash) {
+		return fmt.Errorf("restored app hash %X does not match trusted app hash %X", appHash, trustedAppHash)
+	}
+	state, err = provider.State(ctx, height)
+	if err != nil {
+		return err
+	}
+	commit, err := provider.Commit(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	if err := stateStore.Bootstrap(state); err != nil {
+		return err
+	}
+	return blockStore.SaveSeenCommit(state.LastBlockHeight, commit)
+}
+
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height %q: %w", args[0], err)
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid format %q: %w", args[1], err)
+	}
+	return height, uint32(format), nil
+}
+
+func writeTarEntry(tw *tar.Writer, name string, body []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(body)),
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(body)
+	return err
+}
@@ -0,0 +1,155 @@
+package server_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+func newSnapshotStore(t *testing.T) *snapshots.Store {
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func snapshotChunks(chunks ...[]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- ioutil.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func TestDumpLoadSnapshot(t *testing.T) {
+	source := newSnapshotStore(t)
+	expected, err := source.Save(3, 1, snapshotChunks([]byte{3, 1, 0}, []byte{3, 1, 1}, []byte{3, 1, 2}))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.Error(t, server.DumpSnapshot(source, 4, 1, &buf))
+	require.NoError(t, server.DumpSnapshot(source, 3, 1, &buf))
+
+	target := newSnapshotStore(t)
+	loaded, err := server.LoadSnapshot(target, bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, expected, loaded)
+
+	_, chunks, err := target.Load(3, 1)
+	require.NoError(t, err)
+	var bodies [][]byte
+	for chunk := range chunks {
+		body, err := ioutil.ReadAll(chunk)
+		require.NoError(t, err)
+		bodies = append(bodies, body)
+	}
+	require.Equal(t, [][]byte{{3, 1, 0}, {3, 1, 1}, {3, 1, 2}}, bodies)
+
+	// loading the same snapshot twice conflicts with the existing one
+	_, err = server.LoadSnapshot(target, bytes.NewReader(buf.Bytes()))
+	require.Error(t, err)
+}
+
+func TestLoadSnapshotRejectsTamperedChunks(t *testing.T) {
+	source := newSnapshotStore(t)
+	snapshot, err := source.Save(3, 1, snapshotChunks([]byte{3, 1, 0}, []byte{3, 1, 1}))
+	require.NoError(t, err)
+	metadata, err := proto.Marshal(snapshot)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for _, entry := range []struct {
+		name string
+		body []byte
+	}{
+		{"metadata", metadata},
+		{"0", []byte{3, 1, 0}},
+		{"1", []byte{9, 9, 9}},
+	} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: entry.name, Mode: 0644, Size: int64(len(entry.body))}))
+		_, err := tw.Write(entry.body)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	target := newSnapshotStore(t)
+	_, err = server.LoadSnapshot(target, &buf)
+	require.Error(t, err)
+
+	loaded, err := target.Get(3, 1)
+	require.NoError(t, err)
+	require.Nil(t, loaded)
+}
+
+func TestSnapshotCmd_ExportImport(t *testing.T) {
+	tempDir := t.TempDir()
+	require.NoError(t, createConfigFolder(tempDir))
+	snapshotStore := newSnapshotStore(t)
+
+	logger := log.NewNopLogger()
+	encCfg := simapp.MakeTestEncodingConfig()
+	newApp := func(db dbm.DB) *simapp.SimApp {
+		return simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, tempDir, 0, encCfg,
+			simapp.EmptyAppOptions{}, baseapp.SetSnapshotStore(snapshotStore))
+	}
+
+	sourceDB := dbm.NewMemDB()
+	source := newApp(sourceDB)
+	genDoc := newDefaultGenesisDoc(encCfg.Marshaler)
+	source.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   genDoc.AppState,
+	})
+	source.Commit()
+	for i := int64(2); i <= 3; i++ {
+		source.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: i}})
+		source.Commit()
+	}
+	expected := source.LastCommitID()
+
+	targetDB := dbm.NewMemDB()
+	execute := func(db dbm.DB, args ...string) error {
+		serverCtx := server.NewDefaultContext()
+		serverCtx.Config.RootDir = tempDir
+		ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+		cmd := server.SnapshotCmd(func(log.Logger, dbm.DB, io.Writer, types.AppOptions) types.Application {
+			return newApp(db)
+		})
+		cmd.SetArgs(args)
+		return cmd.ExecuteContext(ctx)
+	}
+
+	require.NoError(t, execute(sourceDB, "export"))
+	snapshot, err := snapshotStore.GetLatest()
+	require.NoError(t, err)
+	require.Equal(t, uint64(expected.Version), snapshot.Height)
+
+	// importing into a non-empty application fails
+	require.Error(t, execute(sourceDB, "import", "3", fmt.Sprint(snapshot.Format)))
+
+	require.NoError(t, execute(targetDB, "import", "3", fmt.Sprint(snapshot.Format)))
+	require.Equal(t, expected, newApp(targetDB).LastCommitID())
+}
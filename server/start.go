@@ -4,6 +4,7 @@ package server
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"runtime/pprof"
@@ -43,6 +44,7 @@ const (
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
+	FlagParallelCommit     = "parallel-commit"
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
@@ -144,6 +146,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Bool(FlagParallelCommit, true, "Commit the IAVL stores concurrently")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
@@ -202,6 +205,8 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		closeApp(ctx, app)
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -398,6 +403,8 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			_ = tmNode.Stop()
 		}
 
+		closeApp(ctx, app)
+
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
@@ -419,3 +426,13 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	// wait for signal capture and gracefully return
 	return WaitForQuitSignals()
 }
+
+// closeApp closes the application once it no longer receives ABCI requests,
+// when it holds resources to release on shutdown.
+func closeApp(ctx *Context, app types.Application) {
+	if closer, ok := app.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			ctx.Logger.Error("failed to close the application", "err", err)
+		}
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/mint"
@@ -124,13 +125,13 @@ func TestRunMigrations(t *testing.T) {
 		},
 		{
 			"can register and run migration handler for x/bank",
-			"bank", 1,
+			"bank", 2,
 			false, "", false, "", 1,
 		},
 		{
 			"cannot register migration handler for same module & forVersion",
-			"bank", 1,
-			true, "another migration for module bank and version 1 already exists: internal logic error", false, "", 0,
+			"bank", 2,
+			true, "another migration for module bank and version 2 already exists: internal logic error", false, "", 0,
 		},
 	}
 
@@ -161,12 +162,12 @@ func TestRunMigrations(t *testing.T) {
 			require.NoError(t, err)
 
 			// Run migrations only for bank. That's why we put the initial
-			// version for bank as 1, and for all other modules, we put as
-			// their latest ConsensusVersion.
+			// version for bank as the one before its latest ConsensusVersion,
+			// and for all other modules, we put as their latest ConsensusVersion.
 			_, err = app.mm.RunMigrations(
 				app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()}), app.configurator,
 				module.VersionMap{
-					"bank":         1,
+					"bank":         bank.AppModule{}.ConsensusVersion() - 1,
 					"auth":         auth.AppModule{}.ConsensusVersion(),
 					"authz":        authzmodule.AppModule{}.ConsensusVersion(),
 					"staking":      staking.AppModule{}.ConsensusVersion(),
@@ -182,6 +183,7 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
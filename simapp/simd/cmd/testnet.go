@@ -214,6 +214,8 @@ func InitTestnet(
 			sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
 			stakingtypes.NewDescription(nodeDirName, "", "", "", ""),
 			stakingtypes.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+			stakingtypes.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), 0, nil),
+			addr,
 			sdk.OneInt(),
 		)
 		if err != nil {
@@ -117,7 +117,7 @@ func SetupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs
 			Commission:        stakingtypes.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
 			ReallocatedCommissionRule: stakingtypes.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), uint32(0), []stakingtypes.RecommanderClassRate{}),
 			MinSelfDelegation: sdk.ZeroInt(),
-			IncentiveTeamAddress: val.Address.String(),
+			IncentiveTeamRecipients: stakingtypes.NewSingleIncentiveTeam(sdk.AccAddress(val.Address)),
 		}
 		validators = append(validators, validator)
 		delegations = append(delegations, stakingtypes.NewDelegation(genAccs[0].GetAddress(), val.Address.Bytes(), sdk.AccAddress(val.Address), sdk.OneDec()))
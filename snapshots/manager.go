@@ -284,6 +284,76 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// RestoreLocalSnapshot restores app state from a snapshot held in the local snapshot store,
+// verifying each chunk against the snapshot metadata. It is used to restore a node offline,
+// without going through ABCI state sync.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "no snapshot store configured")
+	}
+	snapshot, chChunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chChunks)
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			uint32(len(snapshot.Metadata.ChunkHashes)),
+			snapshot.Chunks)
+	}
+	if snapshot.Format != types.CurrentFormat {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	err = m.beginLocked(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.endLocked()
+
+	// Verify chunks as they are read, so a corrupted store is caught before its chunk is applied.
+	chVerified := make(chan io.ReadCloser, chunkBufferSize)
+	chErr := make(chan error, 1)
+	go func() {
+		defer close(chVerified)
+		index := 0
+		for chunk := range chChunks {
+			body, err := ioutil.ReadAll(chunk)
+			chunk.Close()
+			if err != nil {
+				chErr <- sdkerrors.Wrapf(err, "failed to read snapshot chunk %v", index)
+				return
+			}
+			if index >= len(snapshot.Metadata.ChunkHashes) {
+				chErr <- sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has more than %v chunks", snapshot.Chunks)
+				return
+			}
+			hash := sha256.Sum256(body)
+			if !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[index]) {
+				chErr <- sdkerrors.Wrapf(types.ErrChunkHashMismatch, "expected %x, got %x",
+					snapshot.Metadata.ChunkHashes[index], hash)
+				return
+			}
+			chVerified <- ioutil.NopCloser(bytes.NewReader(body))
+			index++
+		}
+	}()
+
+	err = m.restoreSnapshot(*snapshot, chVerified)
+	DrainChunks(chVerified)
+	select {
+	case verifyErr := <-chErr:
+		return verifyErr
+	default:
+	}
+	return err
+}
+
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
 	streamReader, err := NewStreamReader(chChunks)
@@ -2,10 +2,14 @@ package snapshots_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
@@ -210,3 +214,46 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(tempdir) })
+
+	store, err := snapshots.NewStore(db.NewMemDB(), tempdir)
+	require.NoError(t, err)
+
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	source := &mockSnapshotter{items: items}
+	snapshot, err := snapshots.NewManager(store, source).Create(5)
+	require.NoError(t, err)
+
+	// nil manager should return error
+	err = (*snapshots.Manager)(nil).RestoreLocalSnapshot(5, snapshot.Format)
+	require.Error(t, err)
+
+	// restoring a missing snapshot should error
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+	err = manager.RestoreLocalSnapshot(4, snapshot.Format)
+	require.Error(t, err)
+	require.Nil(t, target.items)
+
+	// restoring an existing snapshot should apply its items
+	err = manager.RestoreLocalSnapshot(5, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, items, target.items)
+
+	// a corrupted chunk should be rejected before it is applied
+	chunkPath := filepath.Join(tempdir, "5", "1", "0")
+	require.NoError(t, ioutil.WriteFile(chunkPath, []byte{9, 9, 9}, 0644))
+	target = &mockSnapshotter{}
+	err = snapshots.NewManager(store, target).RestoreLocalSnapshot(5, snapshot.Format)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrChunkHashMismatch))
+	require.Nil(t, target.items)
+}
@@ -0,0 +1,141 @@
+package archive
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// The archive keeps every version of a key as a separate entry, keyed by
+// entryPrefix | escaped(store name) | escaped(key) | big endian height, so that
+// the entries of a store are sorted by key first and by height second. The
+// escaping preserves the ordering of the keys while making each of them
+// prefix free.
+//
+// An entry value starts with a byte telling whether the key was set or
+// deleted at that height, followed by the value of the key when it was set.
+//
+// A gap of the archive is keyed by gapPrefix | big endian first height, its
+// value is the big endian last height of the gap.
+var (
+	entryPrefix        = []byte{0x00}
+	earliestVersionKey = []byte{0x01}
+	latestVersionKey   = []byte{0x02}
+	gapPrefix          = []byte{0x03}
+)
+
+const (
+	heightLen = 8
+
+	entrySet    byte = 0x00
+	entryDelete byte = 0x01
+
+	escapeByte     byte = 0x00
+	escapedZero    byte = 0x01
+	terminatorByte byte = 0x00
+)
+
+// escape appends bz to dst, replacing each 0x00 byte with 0x00 0x01.
+func escape(dst, bz []byte) []byte {
+	for _, b := range bz {
+		if b == escapeByte {
+			dst = append(dst, escapeByte, escapedZero)
+		} else {
+			dst = append(dst, b)
+		}
+	}
+
+	return dst
+}
+
+// escapeTerminated appends the escaped bz followed by the 0x00 0x00
+// terminator to dst.
+func escapeTerminated(dst, bz []byte) []byte {
+	return append(escape(dst, bz), escapeByte, terminatorByte)
+}
+
+// unescapeTerminated decodes an escaped and terminated byte slice, which must
+// span the whole of bz.
+func unescapeTerminated(bz []byte) ([]byte, error) {
+	out := make([]byte, 0, len(bz))
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != escapeByte {
+			out = append(out, bz[i])
+			continue
+		}
+		if i+1 >= len(bz) {
+			return nil, fmt.Errorf("invalid escaped key %X", bz)
+		}
+		i++
+		switch bz[i] {
+		case escapedZero:
+			out = append(out, escapeByte)
+		case terminatorByte:
+			if i != len(bz)-1 {
+				return nil, fmt.Errorf("invalid escaped key %X", bz)
+			}
+			return out, nil
+		default:
+			return nil, fmt.Errorf("invalid escaped key %X", bz)
+		}
+	}
+
+	return nil, fmt.Errorf("unterminated escaped key %X", bz)
+}
+
+// storePrefix returns the prefix of the entries of a store.
+func storePrefix(storeName string) []byte {
+	return escapeTerminated(append([]byte{}, entryPrefix...), []byte(storeName))
+}
+
+// keyPrefix returns the prefix of the entries of a key of a store.
+func keyPrefix(storeName string, key []byte) []byte {
+	return escapeTerminated(storePrefix(storeName), key)
+}
+
+// entryKey returns the key of the entry of a key at the given height.
+func entryKey(storeName string, key []byte, height int64) []byte {
+	return appendHeight(keyPrefix(storeName, key), height)
+}
+
+func appendHeight(bz []byte, height int64) []byte {
+	return append(bz, heightBytes(height)...)
+}
+
+func heightBytes(height int64) []byte {
+	bz := make([]byte, heightLen)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+
+	return bz
+}
+
+// gapKey returns the key of the gap starting at the given height.
+func gapKey(height int64) []byte {
+	return appendHeight(append([]byte{}, gapPrefix...), height)
+}
+
+// splitEntryKey returns the key prefix and the height of an entry key.
+func splitEntryKey(entry []byte) ([]byte, int64) {
+	split := len(entry) - heightLen
+	return entry[:split], int64(binary.BigEndian.Uint64(entry[split:]))
+}
+
+// entryValue encodes a state change as an entry value.
+func entryValue(pair *types.StoreKVPair) []byte {
+	if pair.Delete {
+		return []byte{entryDelete}
+	}
+
+	return append([]byte{entrySet}, pair.Value...)
+}
+
+// decodeEntryValue returns the value of an entry, which is nil when the key
+// was deleted.
+func decodeEntryValue(bz []byte) []byte {
+	if len(bz) == 0 || bz[0] == entryDelete {
+		return nil
+	}
+
+	return bz[1:]
+}
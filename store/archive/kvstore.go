@@ -0,0 +1,227 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = (*kvStore)(nil)
+
+// kvStore is a read-only view of an archived store at a given height.
+type kvStore struct {
+	db     dbm.DB
+	prefix []byte // the entry prefix of the store
+	height int64
+}
+
+func newKVStore(db dbm.DB, storeName string, height int64) *kvStore {
+	return &kvStore{db: db, prefix: storePrefix(storeName), height: height}
+}
+
+// Get returns the value of the key at the height of the view, reading the
+// latest entry of the key up to that height.
+func (s *kvStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	prefix := escapeTerminated(append([]byte{}, s.prefix...), key)
+	iter, err := s.db.ReverseIterator(appendHeight(prefix, 0), appendHeight(append([]byte{}, prefix...), s.height+1))
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+
+	return decodeEntryValue(iter.Value())
+}
+
+// Has returns whether the key is set at the height of the view.
+func (s *kvStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set panics, archived stores are read-only.
+func (s *kvStore) Set(_, _ []byte) {
+	panic("archived stores are read-only")
+}
+
+// Delete panics, archived stores are read-only.
+func (s *kvStore) Delete(_ []byte) {
+	panic("archived stores are read-only")
+}
+
+// Iterator returns an iterator over the keys set at the height of the view.
+func (s *kvStore) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+// ReverseIterator returns a reverse iterator over the keys set at the height
+// of the view.
+func (s *kvStore) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+func (s *kvStore) iterator(start, end []byte, reverse bool) types.Iterator {
+	// the escaped bounds without terminator sort before every entry of the
+	// keys greater than or equal to them
+	low := escape(append([]byte{}, s.prefix...), start)
+	var high []byte
+	if end != nil {
+		high = escape(append([]byte{}, s.prefix...), end)
+	} else {
+		high = types.PrefixEndBytes(s.prefix)
+	}
+
+	var (
+		source dbm.Iterator
+		err    error
+	)
+	if reverse {
+		source, err = s.db.ReverseIterator(low, high)
+	} else {
+		source, err = s.db.Iterator(low, high)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	iter := &iterator{
+		source:    source,
+		prefixLen: len(s.prefix),
+		height:    s.height,
+		reverse:   reverse,
+		start:     start,
+		end:       end,
+	}
+	iter.advance()
+
+	return iter
+}
+
+// GetStoreType returns the type of the store.
+func (s *kvStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap branches the underlying store.
+func (s *kvStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements KVStore.
+func (s *kvStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface.
+func (s *kvStore) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+// iterator merges the entries of each key into the value of the key at the
+// height of the view, skipping the keys unset at that height.
+type iterator struct {
+	source    dbm.Iterator
+	prefixLen int
+	height    int64
+	reverse   bool
+	start     []byte
+	end       []byte
+
+	key   []byte
+	value []byte
+	valid bool
+}
+
+// advance moves to the next key set at the height of the view. In ascending
+// order the last entry up to the height holds the value of a key, in
+// descending order it is the first one.
+func (it *iterator) advance() {
+	it.valid = false
+	for it.source.Valid() {
+		current, _ := splitEntryKey(it.source.Key())
+		current = append([]byte{}, current...)
+
+		var (
+			value []byte
+			found bool
+		)
+		for ; it.source.Valid(); it.source.Next() {
+			prefix, height := splitEntryKey(it.source.Key())
+			if !bytes.Equal(prefix, current) {
+				break
+			}
+			if height > it.height || (it.reverse && found) {
+				continue
+			}
+			value, found = decodeEntryValue(it.source.Value()), true
+		}
+
+		if !found || value == nil {
+			continue
+		}
+
+		key, err := unescapeTerminated(current[it.prefixLen:])
+		if err != nil {
+			panic(err)
+		}
+		it.key, it.value, it.valid = key, value, true
+
+		return
+	}
+}
+
+// Domain implements Iterator.
+func (it *iterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+// Valid implements Iterator.
+func (it *iterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements Iterator.
+func (it *iterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	it.advance()
+}
+
+// Key implements Iterator.
+func (it *iterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+// Value implements Iterator.
+func (it *iterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+// Error implements Iterator.
+func (it *iterator) Error() error {
+	return it.source.Error()
+}
+
+// Close implements Iterator.
+func (it *iterator) Close() error {
+	return it.source.Close()
+}
@@ -0,0 +1,200 @@
+package archive
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/spf13/cast"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/collector"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	OptArchiveEnable  = "archive.enable"
+	OptArchiveDir     = "archive.dir"
+	OptArchiveBackend = "archive.backend"
+
+	defaultArchiveDir = "data/archive"
+)
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// versionRetainer is implemented by the multi-stores able to keep a version
+// from being pruned while it is imported.
+type versionRetainer interface {
+	RetainVersion(version int64)
+	ReleaseVersion(version int64)
+}
+
+// StreamingService is the baseapp.StreamingService filling an archive Store
+// with the state changes of each committed block.
+//
+// The full state of the stores is imported into the archive at the first block
+// committed after it is enabled, and again at the first block following a gap
+// in the archived heights, e.g. after the node ran without the archive. The
+// import runs in the background, the blocks committed meanwhile are archived
+// once it completes. The imported version is kept from being pruned until then
+// when the multi-store supports it.
+type StreamingService struct {
+	*collector.BlockCollector
+
+	archive *Store
+	cms     types.CommitMultiStore
+	logger  log.Logger
+
+	mtx       sync.Mutex
+	importing bool
+	pending   []*types.BlockMetadata // blocks committed during the import
+	wg        sync.WaitGroup
+}
+
+// NewStreamingService creates a StreamingService archiving the state of the
+// given multi-store into archive.
+func NewStreamingService(archive *Store, cms types.CommitMultiStore, logger log.Logger) *StreamingService {
+	s := &StreamingService{
+		archive: archive,
+		cms:     cms,
+		logger:  logger.With("module", "archive"),
+	}
+	s.BlockCollector = collector.NewBlockCollector(archive.StoreKeys(), s.archiveBlock)
+
+	return s
+}
+
+// Stream satisfies the baseapp.StreamingService interface. The blocks are
+// archived synchronously on Commit, the imports run in their own goroutine.
+func (s *StreamingService) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close waits for a running import and closes the archive.
+func (s *StreamingService) Close() error {
+	s.wg.Wait()
+	return s.archive.Close()
+}
+
+func (s *StreamingService) archiveBlock(_ sdk.Context, block *types.BlockMetadata) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.importing {
+		s.pending = append(s.pending, block)
+		return nil
+	}
+
+	if latest := s.archive.LatestVersion(); latest == 0 || latest != block.Height-1 {
+		s.logger.Info("importing the state into the archive", "height", block.Height, "latest", latest)
+		if retainer, ok := s.cms.(versionRetainer); ok {
+			retainer.RetainVersion(block.Height)
+		}
+		s.importing = true
+		s.wg.Add(1)
+		go s.importHeight(block.Height)
+
+		return nil
+	}
+
+	return s.archive.WriteChangeSet(block.Height, changeSet(block))
+}
+
+// importHeight imports the state of the stores at the given height into the
+// archive, then archives the blocks committed in the meantime. On failure,
+// the import is retried at the next committed block.
+func (s *StreamingService) importHeight(height int64) {
+	defer s.wg.Done()
+
+	err := s.importVersion(height)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	pending := s.pending
+	s.importing, s.pending = false, nil
+	if err != nil {
+		s.logger.Error("failed to import the state into the archive", "height", height, "err", err)
+		return
+	}
+	s.logger.Info("imported the state into the archive", "height", height)
+
+	for _, block := range pending {
+		if err := s.archive.WriteChangeSet(block.Height, changeSet(block)); err != nil {
+			s.logger.Error("failed to archive block", "height", block.Height, "err", err)
+			return
+		}
+	}
+}
+
+func (s *StreamingService) importVersion(height int64) error {
+	if retainer, ok := s.cms.(versionRetainer); ok {
+		defer retainer.ReleaseVersion(height)
+	}
+
+	ms, err := s.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return err
+	}
+
+	return s.archive.Import(height, ms)
+}
+
+// changeSet returns the state changes of a block in the order they were
+// written.
+func changeSet(block *types.BlockMetadata) []*types.StoreKVPair {
+	changeSet := block.BeginBlockStateChanges
+	for _, tx := range block.DeliverTxs {
+		changeSet = append(changeSet, tx.StateChanges...)
+	}
+
+	return append(changeSet, block.EndBlockStateChanges...)
+}
+
+// LoadArchive opens the archive configured in the AppOptions, if enabled,
+// and registers it with the BaseApp, both to be filled as a streaming service
+// and to serve the queries at the heights the multi-store has pruned. The
+// archive keeps the state of all of the given stores. The returned streaming
+// service must be closed on shutdown, which closes the archive.
+func LoadArchive(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, keys map[string]*types.KVStoreKey) (*StreamingService, error) {
+	if !cast.ToBool(appOpts.Get(OptArchiveEnable)) {
+		return nil, nil
+	}
+
+	dir := cast.ToString(appOpts.Get(OptArchiveDir))
+	if dir == "" {
+		dir = defaultArchiveDir
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	backend := dbm.GoLevelDBBackend
+	if name := cast.ToString(appOpts.Get(OptArchiveBackend)); name != "" {
+		backend = dbm.BackendType(name)
+	}
+	db, err := dbm.NewDB("archive", backend, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	storeKeys := make([]types.StoreKey, 0, len(keys))
+	for _, key := range keys {
+		storeKeys = append(storeKeys, key)
+	}
+
+	archive := NewStore(db, storeKeys)
+	service := NewStreamingService(archive, bApp.CommitMultiStore(), bApp.Logger())
+	bApp.SetStreamingService(service)
+	bApp.SetArchiveStore(archive)
+
+	return service, nil
+}
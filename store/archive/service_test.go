@@ -0,0 +1,85 @@
+package archive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStreamingServiceImportsGaps(t *testing.T) {
+	cms := rootmulti.NewStore(dbm.NewMemDB())
+	cms.SetPruning(types.NewPruningOptions(0, 0, 1))
+	cms.MountStoreWithDB(storeKey1, types.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	archive := NewStore(dbm.NewMemDB(), []types.StoreKey{storeKey1})
+	s := NewStreamingService(archive, cms, log.NewNopLogger())
+
+	ctx := sdk.Context{}
+	commit := func(height int64, streamed bool, key, value string) {
+		cms.GetKVStore(storeKey1).Set([]byte(key), []byte(value))
+		if streamed {
+			require.NoError(t, s.OnWrite(storeKey1, []byte(key), []byte(value), false))
+			require.NoError(t, s.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+			require.NoError(t, s.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+		}
+		require.Equal(t, height, cms.Commit().Version)
+		if streamed {
+			require.NoError(t, s.ListenCommit(ctx, abci.ResponseCommit{}))
+		}
+	}
+	waitLatest := func(height int64) {
+		require.Eventually(t, func() bool {
+			s.mtx.Lock()
+			defer s.mtx.Unlock()
+			return !s.importing && archive.LatestVersion() == height
+		}, time.Second, time.Millisecond)
+	}
+
+	// the state of the first block is imported, the following ones are
+	// archived after it
+	commit(1, true, "a", "1")
+	commit(2, true, "b", "2")
+	commit(3, true, "a", "3")
+	waitLatest(3)
+	require.Equal(t, int64(1), archive.EarliestVersion())
+
+	// the blocks committed without the archive leave a gap, the state of the
+	// next archived block is imported
+	commit(4, false, "b", "4")
+	commit(5, false, "c", "5")
+	commit(6, true, "a", "6")
+	waitLatest(6)
+	commit(7, true, "c", "7")
+	waitLatest(7)
+
+	require.True(t, archive.HasVersion(3))
+	require.False(t, archive.HasVersion(4))
+	require.False(t, archive.HasVersion(5))
+	require.True(t, archive.HasVersion(6))
+
+	for height, expected := range map[int64][]string{
+		1: {"a=1"},
+		2: {"a=1", "b=2"},
+		3: {"a=3", "b=2"},
+		6: {"a=6", "b=4", "c=5"},
+		7: {"a=6", "b=4", "c=7"},
+	} {
+		ms, err := archive.CacheMultiStoreWithVersion(height)
+		require.NoError(t, err)
+		require.Equal(t, expected, collect(ms.GetKVStore(storeKey1).Iterator(nil, nil)), "height %d", height)
+	}
+
+	// the imported versions have been released and pruned
+	require.False(t, cms.VersionExists(6))
+	require.NoError(t, s.Close())
+}
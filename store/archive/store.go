@@ -0,0 +1,293 @@
+// Package archive implements an external versioned store keeping the state
+// changes of each height, which serves the historical state the root
+// multi-store has pruned.
+package archive
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// importBatchSize is the number of entries written per batch when importing
+// the full state of the stores.
+const importBatchSize = 10000
+
+// Store is a versioned store of the state of a set of KVStores, filled with
+// the state changes of each committed height. It serves the state at any
+// height from the first one it archived, the earliest version, to the last
+// one, the latest version.
+type Store struct {
+	db   dbm.DB
+	keys map[string]types.StoreKey
+}
+
+// NewStore creates an archive Store of the given stores on top of db.
+func NewStore(db dbm.DB, storeKeys []types.StoreKey) *Store {
+	keys := make(map[string]types.StoreKey, len(storeKeys))
+	for _, key := range storeKeys {
+		keys[key.Name()] = key
+	}
+
+	return &Store{db: db, keys: keys}
+}
+
+// StoreKeys returns the keys of the archived stores.
+func (s *Store) StoreKeys() []types.StoreKey {
+	storeKeys := make([]types.StoreKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		storeKeys = append(storeKeys, key)
+	}
+
+	return storeKeys
+}
+
+// EarliestVersion returns the first archived height, 0 if the archive is empty.
+func (s *Store) EarliestVersion() int64 {
+	return s.getVersion(earliestVersionKey)
+}
+
+// LatestVersion returns the last archived height, 0 if the archive is empty.
+func (s *Store) LatestVersion() int64 {
+	return s.getVersion(latestVersionKey)
+}
+
+// HasVersion returns whether the state at the given height is archived.
+func (s *Store) HasVersion(height int64) bool {
+	earliest := s.EarliestVersion()
+	if earliest == 0 || height < earliest || height > s.LatestVersion() {
+		return false
+	}
+
+	return !s.inGap(height)
+}
+
+// inGap returns whether the given height falls in a gap of the archive, i.e.
+// it was skipped before the state of a later height was imported.
+func (s *Store) inGap(height int64) bool {
+	iter, err := s.db.ReverseIterator(gapPrefix, gapKey(height+1))
+	if err != nil {
+		panic(err)
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return false
+	}
+
+	return height <= int64(binary.BigEndian.Uint64(iter.Value()))
+}
+
+// WriteChangeSet archives the state changes of the given height, which must
+// follow the latest archived height.
+func (s *Store) WriteChangeSet(height int64, changeSet []*types.StoreKVPair) error {
+	latest := s.LatestVersion()
+	if latest == 0 {
+		return fmt.Errorf("cannot archive the changes of height %d: the archive is empty", height)
+	}
+	if height <= latest {
+		return fmt.Errorf("cannot archive the changes of height %d: the archive is at height %d", height, latest)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, pair := range changeSet {
+		if _, ok := s.keys[pair.StoreKey]; !ok {
+			continue
+		}
+		if err := batch.Set(entryKey(pair.StoreKey, pair.Key, height), entryValue(pair)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(latestVersionKey, heightBytes(height)); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// Import archives the full state of the given stores at the given height,
+// which must follow the latest archived height. It becomes the earliest
+// version of an empty archive, otherwise the heights between the latest
+// archived height and the imported one are recorded as a gap of the archive.
+// Only the keys whose value differs from the archived state are written.
+func (s *Store) Import(height int64, multiStore types.MultiStore) error {
+	latest := s.LatestVersion()
+	if height <= latest {
+		return fmt.Errorf("cannot import the state of height %d: the archive is at height %d", height, latest)
+	}
+
+	batch := s.db.NewBatch()
+	defer func() { batch.Close() }()
+
+	size := 0
+	write := func(name string, pair *types.StoreKVPair) error {
+		if err := batch.Set(entryKey(name, pair.Key, height), entryValue(pair)); err != nil {
+			return err
+		}
+
+		size++
+		if size%importBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = s.db.NewBatch()
+		}
+
+		return nil
+	}
+
+	for name, key := range s.keys {
+		// the archived view includes the entries an interrupted import may
+		// have left after the latest archived height
+		store, archived := multiStore.GetKVStore(key), newKVStore(s.db, name, height-1)
+
+		if err := importIterate(store, func(k, v []byte) error {
+			if bytes.Equal(archived.Get(k), v) {
+				return nil
+			}
+			return write(name, &types.StoreKVPair{Key: k, Value: v})
+		}); err != nil {
+			return err
+		}
+
+		// the deleted keys are collected first, the batches cannot be written
+		// while iterating over the archive
+		var deleted [][]byte
+		if err := importIterate(archived, func(k, _ []byte) error {
+			if !store.Has(k) {
+				deleted = append(deleted, k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range deleted {
+			if err := write(name, &types.StoreKVPair{Delete: true, Key: k}); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the versions are written last, so that an interrupted import leaves the
+	// archive at its latest height
+	if latest == 0 {
+		if err := batch.Set(earliestVersionKey, heightBytes(height)); err != nil {
+			return err
+		}
+	} else if height > latest+1 {
+		if err := batch.Set(gapKey(latest+1), heightBytes(height-1)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(latestVersionKey, heightBytes(height)); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// importIterate calls fn with each key and value of the store.
+func importIterate(store types.KVStore, fn func(key, value []byte) error) error {
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if err := fn(iter.Key(), iter.Value()); err != nil {
+			iter.Close()
+			return err
+		}
+	}
+
+	return iter.Close()
+}
+
+// CacheMultiStoreWithVersion branches the archived state at the given height.
+func (s *Store) CacheMultiStoreWithVersion(height int64) (types.CacheMultiStore, error) {
+	if !s.HasVersion(height) {
+		return nil, fmt.Errorf("height %d is not archived (earliest: %d, latest: %d)", height, s.EarliestVersion(), s.LatestVersion())
+	}
+
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(s.keys))
+	for name, key := range s.keys {
+		stores[key] = newKVStore(s.db, name, height)
+	}
+
+	return cachemulti.NewStore(s.db, stores, s.keys, nil, nil, nil), nil
+}
+
+// Query serves the "/<store>/key" and "/<store>/subspace" store queries at
+// an archived height. Archived state carries no proofs.
+func (s *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
+	if req.Prove {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "archived state cannot be queried with proofs"))
+	}
+	if !s.HasVersion(req.Height) {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "height %d is not archived", req.Height))
+	}
+
+	path := strings.SplitN(strings.TrimPrefix(req.Path, "/"), "/", 2)
+	if len(path) != 2 {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid path: %s", req.Path))
+	}
+	if _, ok := s.keys[path[0]]; !ok {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no such store: %s", path[0]))
+	}
+
+	store := newKVStore(s.db, path[0], req.Height)
+	res := abci.ResponseQuery{Key: req.Data, Height: req.Height}
+
+	switch "/" + path[1] {
+	case "/key":
+		res.Value = store.Get(req.Data)
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		iterator := types.KVStorePrefixIterator(store, req.Data)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
+	}
+
+	return res
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func (s *Store) getVersion(key []byte) int64 {
+	bz, err := s.db.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(binary.BigEndian.Uint64(bz))
+}
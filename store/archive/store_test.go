@@ -0,0 +1,186 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	storeKey1 = types.NewKVStoreKey("store1")
+	storeKey2 = types.NewKVStoreKey("store2")
+)
+
+func set(storeKey types.StoreKey, key, value string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeKey.Name(), Key: []byte(key), Value: []byte(value)}
+}
+
+func del(storeKey types.StoreKey, key string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: storeKey.Name(), Delete: true, Key: []byte(key)}
+}
+
+// newTestArchive returns an archive imported at height 1 with a and b set in
+// the first store, then updated at heights 2 and 3.
+func newTestArchive(t *testing.T) *Store {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("a"), []byte("a1"))
+	parent.Set([]byte("b"), []byte("b1"))
+	multiStore := cachemulti.NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		storeKey1: parent,
+		storeKey2: dbadapter.Store{DB: dbm.NewMemDB()},
+	}, nil, nil, nil, nil)
+
+	archive := NewStore(dbm.NewMemDB(), []types.StoreKey{storeKey1, storeKey2})
+	require.NoError(t, archive.Import(1, multiStore))
+	require.NoError(t, archive.WriteChangeSet(2, []*types.StoreKVPair{
+		set(storeKey1, "a", "a2"), del(storeKey1, "b"), set(storeKey1, "a\x00c", "c2"), set(storeKey2, "a", "other"),
+	}))
+	require.NoError(t, archive.WriteChangeSet(3, []*types.StoreKVPair{
+		set(storeKey1, "b", "b3"), set(storeKey1, "d", "d3-1"), set(storeKey1, "d", "d3"),
+	}))
+
+	return archive
+}
+
+func collect(iter types.Iterator) []string {
+	defer iter.Close()
+
+	var pairs []string
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, string(iter.Key())+"="+string(iter.Value()))
+	}
+
+	return pairs
+}
+
+func TestArchiveVersions(t *testing.T) {
+	archive := NewStore(dbm.NewMemDB(), []types.StoreKey{storeKey1})
+	require.False(t, archive.HasVersion(1))
+	require.Error(t, archive.WriteChangeSet(1, nil))
+
+	archive = newTestArchive(t)
+	require.Equal(t, int64(1), archive.EarliestVersion())
+	require.Equal(t, int64(3), archive.LatestVersion())
+	require.False(t, archive.HasVersion(0))
+	require.True(t, archive.HasVersion(2))
+	require.False(t, archive.HasVersion(4))
+
+	require.Error(t, archive.WriteChangeSet(3, nil))
+	require.Error(t, archive.Import(3, nil))
+	_, err := archive.CacheMultiStoreWithVersion(4)
+	require.Error(t, err)
+}
+
+func TestArchiveImportGap(t *testing.T) {
+	archive := newTestArchive(t)
+
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("a"), []byte("a2"))
+	parent.Set([]byte("b"), []byte("b6"))
+	parent.Set([]byte("e"), []byte("e6"))
+	multiStore := cachemulti.NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{
+		storeKey1: parent,
+		storeKey2: dbadapter.Store{DB: dbm.NewMemDB()},
+	}, nil, nil, nil, nil)
+	require.NoError(t, archive.Import(6, multiStore))
+
+	// the skipped heights are not archived
+	require.Equal(t, int64(1), archive.EarliestVersion())
+	require.Equal(t, int64(6), archive.LatestVersion())
+	require.True(t, archive.HasVersion(3))
+	require.False(t, archive.HasVersion(4))
+	require.False(t, archive.HasVersion(5))
+	require.True(t, archive.HasVersion(6))
+
+	// the imported state replaces the archived one
+	require.Equal(t, []string{"a=a2", "a\x00c=c2", "b=b3", "d=d3"}, collect(newKVStore(archive.db, storeKey1.Name(), 3).Iterator(nil, nil)))
+	require.Equal(t, []string{"a=a2", "b=b6", "e=e6"}, collect(newKVStore(archive.db, storeKey1.Name(), 6).Iterator(nil, nil)))
+	require.Nil(t, newKVStore(archive.db, storeKey2.Name(), 6).Get([]byte("a")))
+
+	require.NoError(t, archive.WriteChangeSet(7, []*types.StoreKVPair{set(storeKey1, "a", "a7")}))
+	require.True(t, archive.HasVersion(7))
+	require.Equal(t, []byte("a7"), newKVStore(archive.db, storeKey1.Name(), 7).Get([]byte("a")))
+}
+
+func TestArchiveGet(t *testing.T) {
+	archive := newTestArchive(t)
+
+	for height, expected := range map[int64]map[string]string{
+		1: {"a": "a1", "b": "b1", "a\x00c": "", "d": ""},
+		2: {"a": "a2", "b": "", "a\x00c": "c2", "d": ""},
+		3: {"a": "a2", "b": "b3", "a\x00c": "c2", "d": "d3"},
+	} {
+		store := newKVStore(archive.db, storeKey1.Name(), height)
+		for key, value := range expected {
+			if value == "" {
+				require.Nil(t, store.Get([]byte(key)), "height %d key %q", height, key)
+				require.False(t, store.Has([]byte(key)))
+			} else {
+				require.Equal(t, []byte(value), store.Get([]byte(key)), "height %d key %q", height, key)
+			}
+		}
+	}
+
+	require.Nil(t, newKVStore(archive.db, storeKey2.Name(), 1).Get([]byte("a")))
+	require.Equal(t, []byte("other"), newKVStore(archive.db, storeKey2.Name(), 3).Get([]byte("a")))
+	require.Panics(t, func() { newKVStore(archive.db, storeKey1.Name(), 3).Set([]byte("a"), []byte("a")) })
+}
+
+func TestArchiveIterators(t *testing.T) {
+	archive := newTestArchive(t)
+
+	store := newKVStore(archive.db, storeKey1.Name(), 1)
+	require.Equal(t, []string{"a=a1", "b=b1"}, collect(store.Iterator(nil, nil)))
+
+	store = newKVStore(archive.db, storeKey1.Name(), 2)
+	require.Equal(t, []string{"a=a2", "a\x00c=c2"}, collect(store.Iterator(nil, nil)))
+	require.Equal(t, []string{"a\x00c=c2", "a=a2"}, collect(store.ReverseIterator(nil, nil)))
+
+	store = newKVStore(archive.db, storeKey1.Name(), 3)
+	require.Equal(t, []string{"a=a2", "a\x00c=c2", "b=b3", "d=d3"}, collect(store.Iterator(nil, nil)))
+	require.Equal(t, []string{"d=d3", "b=b3", "a\x00c=c2", "a=a2"}, collect(store.ReverseIterator(nil, nil)))
+	require.Equal(t, []string{"a\x00c=c2", "b=b3"}, collect(store.Iterator([]byte("a\x00"), []byte("d"))))
+	require.Equal(t, []string{"b=b3", "a\x00c=c2"}, collect(store.ReverseIterator([]byte("a\x00"), []byte("d"))))
+	require.Equal(t, []string{"a=a2", "a\x00c=c2"}, collect(types.KVStorePrefixIterator(store, []byte("a"))))
+}
+
+func TestArchiveCacheMultiStore(t *testing.T) {
+	archive := newTestArchive(t)
+
+	cms, err := archive.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+
+	// writes only go to the branch
+	store := cms.GetKVStore(storeKey1)
+	require.Equal(t, []byte("a2"), store.Get([]byte("a")))
+	store.Set([]byte("a"), []byte("branch"))
+	require.Equal(t, []byte("branch"), store.Get([]byte("a")))
+	require.Equal(t, []byte("a2"), newKVStore(archive.db, storeKey1.Name(), 2).Get([]byte("a")))
+	require.Equal(t, []byte("other"), cms.GetKVStore(storeKey2).Get([]byte("a")))
+}
+
+func TestArchiveQuery(t *testing.T) {
+	archive := newTestArchive(t)
+
+	res := archive.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("b"), Height: 3})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("b3"), res.Value)
+
+	res = archive.Query(abci.RequestQuery{Path: "/store1/subspace", Data: []byte("a"), Height: 2})
+	require.True(t, res.IsOK(), res.Log)
+	var pairs kv.Pairs
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Equal(t, []kv.Pair{{Key: []byte("a"), Value: []byte("a2")}, {Key: []byte("a\x00c"), Value: []byte("c2")}}, pairs.Pairs)
+
+	require.False(t, archive.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("b"), Height: 3, Prove: true}).IsOK())
+	require.False(t, archive.Query(abci.RequestQuery{Path: "/store1/key", Data: []byte("b"), Height: 4}).IsOK())
+	require.False(t, archive.Query(abci.RequestQuery{Path: "/unknown/key", Data: []byte("b"), Height: 3}).IsOK())
+	require.False(t, archive.Query(abci.RequestQuery{Path: "/store1/unknown", Data: []byte("b"), Height: 3}).IsOK())
+}
@@ -27,6 +27,8 @@ type Store struct {
 	traceContext types.TraceContext
 
 	listeners map[types.StoreKey][]types.WriteListener
+	// branches of the parent stores the listened stores write to
+	listenedParents map[types.StoreKey]types.CacheWrap
 }
 
 var _ types.CacheMultiStore = Store{}
@@ -46,6 +48,8 @@ func NewFromKVStore(
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
 		listeners:    listeners,
+
+		listenedParents: make(map[types.StoreKey]types.CacheWrap),
 	}
 
 	for key, store := range stores {
@@ -57,6 +61,7 @@ func NewFromKVStore(
 		}
 		if cms.ListeningEnabled(key) {
 			cms.stores[key] = cacheWrapped.CacheWrapWithListeners(key, cms.listeners[key])
+			cms.listenedParents[key] = cacheWrapped
 		} else {
 			cms.stores[key] = cacheWrapped
 		}
@@ -134,11 +139,15 @@ func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
 }
 
-// Write calls Write on each underlying store.
+// Write calls Write on each underlying store. A listened store is written to
+// the branch of its parent store, which is then written as well.
 func (cms Store) Write() {
 	cms.db.Write()
-	for _, store := range cms.stores {
+	for key, store := range cms.stores {
 		store.Write()
+		if parent, ok := cms.listenedParents[key]; ok {
+			parent.Write()
+		}
 	}
 }
 
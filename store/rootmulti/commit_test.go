@@ -0,0 +1,121 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// commitTestWorkers bounds the parallel commit worker pool independently of
+// the number of CPUs, so that the parallel path is exercised everywhere.
+const commitTestWorkers = 4
+
+func newCommitTestKeys(numStores int) []types.StoreKey {
+	keys := make([]types.StoreKey, numStores)
+	for i := range keys {
+		keys[i] = types.NewKVStoreKey(fmt.Sprintf("store%02d", i))
+	}
+
+	return keys
+}
+
+// newCommitTestStore returns a loaded multi-store with an IAVL store for each
+// of the given keys, plus a transient store.
+func newCommitTestStore(t testing.TB, db dbm.DB, keys []types.StoreKey, parallel bool) *Store {
+	store := NewStore(db)
+	store.SetParallelCommit(parallel)
+	store.commitWorkers = commitTestWorkers
+
+	for _, key := range keys {
+		store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	store.MountStoreWithDB(types.NewTransientStoreKey("transient"), types.StoreTypeTransient, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	return store
+}
+
+// writeBlock writes writesPerStore keys to each store for the given height.
+func writeBlock(store *Store, keys []types.StoreKey, height, writesPerStore int) {
+	for _, key := range keys {
+		kvStore := store.GetKVStore(key)
+		for i := 0; i < writesPerStore; i++ {
+			kvStore.Set([]byte(fmt.Sprintf("key%06d", i)), []byte(fmt.Sprintf("value%d-%d", height, i)))
+		}
+	}
+}
+
+func TestParallelCommitMatchesSequential(t *testing.T) {
+	keys := newCommitTestKeys(8)
+	sequential := newCommitTestStore(t, dbm.NewMemDB(), keys, false)
+	parallel := newCommitTestStore(t, dbm.NewMemDB(), keys, true)
+
+	for height := 1; height <= 3; height++ {
+		writeBlock(sequential, keys, height, 50)
+		writeBlock(parallel, keys, height, 50)
+
+		require.Equal(t, sequential.Commit(), parallel.Commit())
+		require.Equal(t, sequential.lastCommitInfo, parallel.lastCommitInfo)
+	}
+
+	// the store infos are sorted by name, without the transient store
+	storeInfos := parallel.lastCommitInfo.StoreInfos
+	require.Len(t, storeInfos, len(keys))
+	for i, key := range keys {
+		require.Equal(t, key.Name(), storeInfos[i].Name)
+	}
+}
+
+type panickingCommitStore struct {
+	types.CommitKVStore
+}
+
+func (panickingCommitStore) Commit() types.CommitID {
+	panic("commit failed")
+}
+
+func TestParallelCommitPropagatesPanics(t *testing.T) {
+	keys := newCommitTestKeys(4)
+	store := newCommitTestStore(t, dbm.NewMemDB(), keys, true)
+	store.stores[keys[2]] = panickingCommitStore{store.stores[keys[2]]}
+
+	require.PanicsWithValue(t, "commit failed", func() { store.Commit() })
+}
+
+func benchmarkCommit(b *testing.B, parallel bool, numStores, writesPerStore int) {
+	db, err := dbm.NewGoLevelDB("commit", b.TempDir())
+	require.NoError(b, err)
+	defer db.Close()
+
+	keys := newCommitTestKeys(numStores)
+	store := newCommitTestStore(b, db, keys, parallel)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		writeBlock(store, keys, i, writesPerStore)
+		b.StartTimer()
+
+		store.Commit()
+	}
+}
+
+// BenchmarkCommit compares the sequential and the parallel commit of the IAVL
+// stores, with a pool of commitTestWorkers workers.
+func BenchmarkCommit(b *testing.B) {
+	for _, numStores := range []int{4, 16} {
+		for _, writesPerStore := range []int{100, 1000} {
+			for _, parallel := range []bool{false, true} {
+				name := fmt.Sprintf("stores=%d/writes=%d/parallel=%t", numStores, writesPerStore, parallel)
+				b.Run(name, func(b *testing.B) {
+					benchmarkCommit(b, parallel, numStores, writesPerStore)
+				})
+			}
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"runtime"
 	"sort"
 	"strings"
 	"sync"
@@ -48,6 +49,8 @@ type Store struct {
 	lazyLoading    bool
 	pruneHeights   []int64
 	initialVersion int64
+	parallelCommit bool
+	commitWorkers  int // bound of the parallel commit worker pool, 0 for one per CPU
 
 	traceWriter       io.Writer
 	traceContext      types.TraceContext
@@ -56,6 +59,9 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	listeners map[types.StoreKey][]types.WriteListener
+
+	retainedVersions map[int64]int // versions kept from pruning while in use
+	retainMtx        sync.Mutex
 }
 
 var (
@@ -69,14 +75,17 @@ var (
 // LoadVersion must be called.
 func NewStore(db dbm.DB) *Store {
 	return &Store{
-		db:            db,
-		pruningOpts:   types.PruneNothing,
-		iavlCacheSize: iavl.DefaultIAVLCacheSize,
-		storesParams:  make(map[types.StoreKey]storeParams),
-		stores:        make(map[types.StoreKey]types.CommitKVStore),
-		keysByName:    make(map[string]types.StoreKey),
-		pruneHeights:  make([]int64, 0),
-		listeners:     make(map[types.StoreKey][]types.WriteListener),
+		db:             db,
+		pruningOpts:    types.PruneNothing,
+		iavlCacheSize:  iavl.DefaultIAVLCacheSize,
+		storesParams:   make(map[types.StoreKey]storeParams),
+		stores:         make(map[types.StoreKey]types.CommitKVStore),
+		keysByName:     make(map[string]types.StoreKey),
+		pruneHeights:   make([]int64, 0),
+		parallelCommit: true,
+		listeners:      make(map[types.StoreKey][]types.WriteListener),
+
+		retainedVersions: make(map[int64]int),
 	}
 }
 
@@ -96,6 +105,12 @@ func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
 
+// SetParallelCommit sets if the IAVL stores should be committed concurrently
+// or one after another. It is enabled by default.
+func (rs *Store) SetParallelCommit(enabled bool) {
+	rs.parallelCommit = enabled
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -371,6 +386,22 @@ func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
 	return false
 }
 
+// VersionExists returns whether the state at the given version can be loaded
+// from the IAVL stores, i.e. it was committed and has not been pruned. Stores
+// added after the version are ignored.
+func (rs *Store) VersionExists(version int64) bool {
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		if rs.GetCommitKVStore(key).(*iavl.Store).VersionExists(version) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
@@ -400,7 +431,7 @@ func (rs *Store) Commit() types.CommitID {
 		version = previousHeight + 1
 	}
 
-	rs.lastCommitInfo = commitStores(version, rs.stores)
+	rs.lastCommitInfo = commitStores(version, rs.stores, rs.maxCommitWorkers())
 
 	// Determine if pruneHeight height needs to be added to the list of heights to
 	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
@@ -429,28 +460,66 @@ func (rs *Store) Commit() types.CommitID {
 	}
 }
 
+// RetainVersion keeps the given version from being pruned until it is
+// released, so that it can be read in the background, e.g. to be imported
+// into an external store.
+func (rs *Store) RetainVersion(version int64) {
+	rs.retainMtx.Lock()
+	defer rs.retainMtx.Unlock()
+
+	rs.retainedVersions[version]++
+}
+
+// ReleaseVersion releases a version retained with RetainVersion, which is
+// pruned again with the following heights to prune.
+func (rs *Store) ReleaseVersion(version int64) {
+	rs.retainMtx.Lock()
+	defer rs.retainMtx.Unlock()
+
+	if rs.retainedVersions[version] <= 1 {
+		delete(rs.retainedVersions, version)
+	} else {
+		rs.retainedVersions[version]--
+	}
+}
+
 // pruneStores will batch delete a list of heights from each mounted sub-store.
-// Afterwards, pruneHeights is reset.
+// Afterwards, pruneHeights is reset to the retained heights, which are pruned
+// once released.
 func (rs *Store) pruneStores() {
 	if len(rs.pruneHeights) == 0 {
 		return
 	}
 
+	pruneHeights, retained := make([]int64, 0, len(rs.pruneHeights)), make([]int64, 0)
+	rs.retainMtx.Lock()
+	for _, height := range rs.pruneHeights {
+		if rs.retainedVersions[height] > 0 {
+			retained = append(retained, height)
+		} else {
+			pruneHeights = append(pruneHeights, height)
+		}
+	}
+	rs.retainMtx.Unlock()
+
+	rs.pruneHeights = retained
+	if len(pruneHeights) == 0 {
+		return
+	}
+
 	for key, store := range rs.stores {
 		if store.GetStoreType() == types.StoreTypeIAVL {
 			// If the store is wrapped with an inter-block cache, we must first unwrap
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
 
-			if err := store.(*iavl.Store).DeleteVersions(rs.pruneHeights...); err != nil {
+			if err := store.(*iavl.Store).DeleteVersions(pruneHeights...); err != nil {
 				if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
 					panic(err)
 				}
 			}
 		}
 	}
-
-	rs.pruneHeights = make([]int64, 0)
 }
 
 // CacheWrap implements CacheWrapper/Store/CommitStore.
@@ -478,10 +547,22 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners)
 }
 
+// CacheMultiStoreWithoutListeners is analogous to CacheMultiStore except that
+// the writes to the branch are not observed by the registered listeners. It
+// should be used for the branches whose writes are never committed.
+func (rs *Store) CacheMultiStoreWithoutListeners() types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper)
+	for k, v := range rs.stores {
+		stores[k] = v
+	}
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), nil)
+}
+
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// iterating at past heights, so the writes to the branch are not observed by
+// the registered listeners.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
@@ -505,7 +586,7 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 		}
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners), nil
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), nil), nil
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -942,19 +1023,43 @@ func getLatestVersion(db dbm.DB) int64 {
 }
 
 // Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore) *types.CommitInfo {
-	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
+// commitStores commits all the given stores at the given version and returns
+// the resulting CommitInfo, whose StoreInfos are sorted by store name. When
+// more than one worker is allowed, the IAVL stores, which are independent
+// trees, are committed concurrently by a pool of at most that many workers,
+// while the other stores are committed by the calling goroutine.
+func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, workers int) *types.CommitInfo {
+	keys := make([]types.StoreKey, 0, len(storeMap))
+	for key := range storeMap {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	commitIDs := make([]types.CommitID, len(keys))
+	concurrent := make([]int, 0, len(keys))
+	for i, key := range keys {
+		store := storeMap[key]
+		if workers > 1 && store.GetStoreType() == types.StoreTypeIAVL {
+			concurrent = append(concurrent, i)
+			continue
+		}
 
-	for key, store := range storeMap {
-		commitID := store.Commit()
+		commitIDs[i] = store.Commit()
+	}
 
-		if store.GetStoreType() == types.StoreTypeTransient {
+	commitConcurrently(workers, concurrent, func(i int) {
+		commitIDs[i] = storeMap[keys[i]].Commit()
+	})
+
+	storeInfos := make([]types.StoreInfo, 0, len(keys))
+	for i, key := range keys {
+		if storeMap[key].GetStoreType() == types.StoreTypeTransient {
 			continue
 		}
 
 		si := types.StoreInfo{}
 		si.Name = key.Name()
-		si.CommitId = commitID
+		si.CommitId = commitIDs[i]
 		storeInfos = append(storeInfos, si)
 	}
 
@@ -964,6 +1069,65 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 	}
 }
 
+// commitConcurrently calls commit with each of the given indexes from a pool
+// of at most workers goroutines, and returns once all of them are done. A
+// panic raised by a commit is propagated to the caller once the other
+// commits are done.
+func commitConcurrently(workers int, indexes []int, commit func(i int)) {
+	if len(indexes) == 0 {
+		return
+	}
+	if workers > len(indexes) {
+		workers = len(indexes)
+	}
+
+	var (
+		wg        sync.WaitGroup
+		panicOnce sync.Once
+		panicErr  interface{}
+	)
+
+	jobs := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				func() {
+					defer func() {
+						if r := recover(); r != nil {
+							panicOnce.Do(func() { panicErr = r })
+						}
+					}()
+					commit(i)
+				}()
+			}
+		}()
+	}
+
+	for _, i := range indexes {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if panicErr != nil {
+		panic(panicErr)
+	}
+}
+
+// maxCommitWorkers returns the number of workers committing the IAVL stores.
+func (rs *Store) maxCommitWorkers() int {
+	switch {
+	case !rs.parallelCommit:
+		return 1
+	case rs.commitWorkers > 0:
+		return rs.commitWorkers
+	default:
+		return runtime.GOMAXPROCS(0)
+	}
+}
+
 // Gets commitInfo from disk.
 func getCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
 	cInfoKey := fmt.Sprintf(commitInfoKeyFmt, ver)
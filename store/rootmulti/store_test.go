@@ -544,6 +544,27 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruningRetainedVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(0, 0, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.Commit()
+	ms.RetainVersion(1)
+	ms.Commit()
+	ms.Commit()
+
+	// the retained version is kept until it is released
+	require.True(t, ms.VersionExists(1))
+	require.False(t, ms.VersionExists(2))
+	require.Equal(t, []int64{1}, ms.pruneHeights)
+
+	ms.ReleaseVersion(1)
+	ms.Commit()
+	require.False(t, ms.VersionExists(1))
+	require.Empty(t, ms.pruneHeights)
+}
+
 func TestSetInitialVersion(t *testing.T) {
 	db := dbm.NewMemDB()
 	multi := newMultiStoreWithMounts(db, types.PruneNothing)
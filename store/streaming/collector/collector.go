@@ -0,0 +1,148 @@
+// Package collector implements the assembly of the ABCI messages and state
+// changes of a block into a BlockMetadata, which is shared by the built-in
+// streaming services.
+package collector
+
+import (
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmitFn is called with the assembled metadata of a block once it has been
+// committed.
+type EmitFn func(ctx sdk.Context, block *types.BlockMetadata) error
+
+var _ types.WriteListener = (*BlockCollector)(nil)
+
+// BlockCollector listens to the state changes of the stores it is registered
+// for and assigns them to the ABCI phase that wrote them. Once the block is
+// committed, the assembled BlockMetadata is passed to the emit function.
+//
+// The writes are observed when the branch of a phase is written to the deliver
+// state, so a key written by nested branches is observed more than once; only
+// its last write of the phase is kept. The writes observed on Commit, when the
+// deliver state is written to the root multi-store, were already reported by
+// their phase and are dropped.
+type BlockCollector struct {
+	storeKeys []types.StoreKey
+	emit      EmitFn
+
+	mtx          sync.Mutex
+	stateChanges []*types.StoreKVPair // state changes written since the last ABCI phase
+	block        *types.BlockMetadata
+}
+
+// NewBlockCollector creates a BlockCollector listening to the given stores.
+func NewBlockCollector(storeKeys []types.StoreKey, emit EmitFn) *BlockCollector {
+	return &BlockCollector{
+		storeKeys: storeKeys,
+		emit:      emit,
+		block:     &types.BlockMetadata{},
+	}
+}
+
+// Listeners returns the collector as the write listener of each store it
+// listens to.
+func (c *BlockCollector) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(c.storeKeys))
+	for _, key := range c.storeKeys {
+		listeners[key] = []types.WriteListener{c}
+	}
+
+	return listeners
+}
+
+// OnWrite satisfies the WriteListener interface by buffering the state change
+// until the end of the current ABCI phase.
+func (c *BlockCollector) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.stateChanges = append(c.stateChanges, &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+
+	return nil
+}
+
+// ListenBeginBlock records the BeginBlock messages and the state changes
+// written by the BeginBlocker.
+func (c *BlockCollector) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.block = &types.BlockMetadata{
+		Height:                 req.Header.Height,
+		RequestBeginBlock:      &req,
+		ResponseBeginBlock:     &res,
+		BeginBlockStateChanges: c.drainStateChanges(),
+	}
+
+	return nil
+}
+
+// ListenDeliverTx records the DeliverTx messages and the state changes
+// written by the transaction.
+func (c *BlockCollector) ListenDeliverTx(_ sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.block.DeliverTxs = append(c.block.DeliverTxs, &types.BlockMetadata_DeliverTx{
+		Request:      &req,
+		Response:     &res,
+		StateChanges: c.drainStateChanges(),
+	})
+
+	return nil
+}
+
+// ListenEndBlock records the EndBlock messages and the state changes written
+// by the EndBlocker.
+func (c *BlockCollector) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.block.RequestEndBlock = &req
+	c.block.ResponseEndBlock = &res
+	c.block.EndBlockStateChanges = c.drainStateChanges()
+
+	return nil
+}
+
+// ListenCommit completes the block with the Commit response and emits it.
+func (c *BlockCollector) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	c.mtx.Lock()
+	block := c.block
+	block.ResponseCommit = &res
+	c.block = &types.BlockMetadata{}
+	c.stateChanges = nil
+	c.mtx.Unlock()
+
+	return c.emit(ctx, block)
+}
+
+// drainStateChanges returns the state changes written since the last ABCI
+// phase, keeping the last write of each key in the order they were written.
+func (c *BlockCollector) drainStateChanges() []*types.StoreKVPair {
+	last := make(map[string]int, len(c.stateChanges))
+	for i, pair := range c.stateChanges {
+		last[pair.StoreKey+"/"+string(pair.Key)] = i
+	}
+
+	var stateChanges []*types.StoreKVPair
+	for i, pair := range c.stateChanges {
+		if last[pair.StoreKey+"/"+string(pair.Key)] == i {
+			stateChanges = append(stateChanges, pair)
+		}
+	}
+	c.stateChanges = nil
+
+	return stateChanges
+}
@@ -0,0 +1,212 @@
+package streaming
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// ServiceConstructor is used to construct a streaming service
+type ServiceConstructor func(bApp *baseapp.BaseApp, opts serverTypes.AppOptions, codec codec.BinaryCodec, keys []types.StoreKey) (baseapp.StreamingService, error)
+
+// ServiceType enum for specifying the type of StreamingService
+type ServiceType int
+
+const (
+	Unknown ServiceType = iota
+	File
+	GRPC
+	// add more in the future
+)
+
+const (
+	OptStreamingStreamers   = "streaming.streamers"
+	OptStreamingFileKeys    = "streaming.file.keys"
+	OptStreamingFileDir     = "streaming.file.write-dir"
+	OptStreamingFilePrefix  = "streaming.file.prefix"
+	OptStreamingGRPCKeys    = "streaming.grpc.keys"
+	OptStreamingGRPCAddress = "streaming.grpc.address"
+	OptStreamingGRPCTimeout = "streaming.grpc.timeout"
+	OptStreamingGRPCQueue   = "streaming.grpc.queue-size"
+
+	// AllStoreKeys is the store key pattern used to listen to every store.
+	AllStoreKeys = "*"
+
+	defaultFileDir   = "data/streaming"
+	defaultGRPCQueue = 64
+)
+
+// ServiceTypeFromString returns the streaming.ServiceType corresponding to the
+// provided name
+func ServiceTypeFromString(name string) ServiceType {
+	switch strings.ToLower(name) {
+	case "file", "f":
+		return File
+	case "grpc":
+		return GRPC
+	default:
+		return Unknown
+	}
+}
+
+// String returns the string name of a streaming.ServiceType
+func (sst ServiceType) String() string {
+	switch sst {
+	case File:
+		return "file"
+	case GRPC:
+		return "grpc"
+	default:
+		return "unknown"
+	}
+}
+
+// ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to
+// streaming.ServiceConstructors
+var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
+	File: NewFileStreamingService,
+	GRPC: NewGRPCStreamingService,
+}
+
+// NewServiceConstructor returns the streaming.ServiceConstructor corresponding
+// to the provided name
+func NewServiceConstructor(name string) (ServiceConstructor, error) {
+	ssType := ServiceTypeFromString(name)
+	if ssType == Unknown {
+		return nil, fmt.Errorf("unrecognized streaming service name %s", name)
+	}
+	if constructor, ok := ServiceConstructorLookupTable[ssType]; ok && constructor != nil {
+		return constructor, nil
+	}
+	return nil, fmt.Errorf("streaming service constructor of type %s not found", ssType.String())
+}
+
+// NewFileStreamingService is the streaming.ServiceConstructor function for
+// creating a FileStreamingService
+func NewFileStreamingService(_ *baseapp.BaseApp, opts serverTypes.AppOptions, codec codec.BinaryCodec, keys []types.StoreKey) (baseapp.StreamingService, error) {
+	filePrefix := cast.ToString(opts.Get(OptStreamingFilePrefix))
+	fileDir := cast.ToString(opts.Get(OptStreamingFileDir))
+	if fileDir == "" {
+		fileDir = defaultFileDir
+	}
+	if !filepath.IsAbs(fileDir) {
+		fileDir = filepath.Join(cast.ToString(opts.Get(flags.FlagHome)), fileDir)
+	}
+	if err := os.MkdirAll(fileDir, 0755); err != nil {
+		return nil, err
+	}
+
+	return file.NewStreamingService(fileDir, filePrefix, keys, codec)
+}
+
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for
+// creating a GRPCStreamingService
+func NewGRPCStreamingService(bApp *baseapp.BaseApp, opts serverTypes.AppOptions, _ codec.BinaryCodec, keys []types.StoreKey) (baseapp.StreamingService, error) {
+	address := cast.ToString(opts.Get(OptStreamingGRPCAddress))
+	if address == "" {
+		return nil, fmt.Errorf("%s must be set to use the grpc streaming service", OptStreamingGRPCAddress)
+	}
+	timeout, err := cast.ToDurationE(opts.Get(OptStreamingGRPCTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", OptStreamingGRPCTimeout, err)
+	}
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	queueSize := cast.ToInt(opts.Get(OptStreamingGRPCQueue))
+	if queueSize <= 0 {
+		queueSize = defaultGRPCQueue
+	}
+
+	return grpc.NewStreamingService(address, timeout, queueSize, keys, bApp.Logger())
+}
+
+// LoadStreamingServices is a function for loading StreamingServices onto the
+// BaseApp using the provided AppOptions, codec, and keys. It returns the
+// StreamingServices loaded and a sync.WaitGroup that the caller can use to
+// wait for the services to finish after closing them.
+func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
+	// waitgroup for the streaming services to signal when they are done
+	wg := new(sync.WaitGroup)
+	// configure state listening capabilities using AppOptions
+	streamers := cast.ToStringSlice(appOpts.Get(OptStreamingStreamers))
+	activeStreamers := make([]baseapp.StreamingService, 0, len(streamers))
+	for _, streamerName := range streamers {
+		// get the store keys allowed to be exposed for this streaming service
+		exposeKeyStrs := cast.ToStringSlice(appOpts.Get(fmt.Sprintf("streaming.%s.keys", streamerName)))
+		exposeStoreKeys := exposedStoreKeys(exposeKeyStrs, keys)
+
+		constructor, err := NewServiceConstructor(streamerName)
+		if err != nil {
+			closeStreamingServices(activeStreamers)
+			return nil, nil, err
+		}
+		// generate the streaming service using the constructor, appOptions,
+		// and the StoreKeys we want to expose
+		streamingService, err := constructor(bApp, appOpts, appCodec, exposeStoreKeys)
+		if err != nil {
+			closeStreamingServices(activeStreamers)
+			return nil, nil, err
+		}
+		// register the streaming service with the BaseApp
+		bApp.SetStreamingService(streamingService)
+		// kick off the background streaming service loop
+		if err := streamingService.Stream(wg); err != nil {
+			closeStreamingServices(append(activeStreamers, streamingService))
+			return nil, nil, err
+		}
+		// add to the list of active streamers
+		activeStreamers = append(activeStreamers, streamingService)
+	}
+	// if there are no active streamers, activeStreamers is empty (len == 0)
+	// and the waitGroup is not waiting on anything
+	return activeStreamers, wg, nil
+}
+
+// exposedStoreKeys returns the keys of the stores matching the given names,
+// in a deterministic order. The AllStoreKeys pattern matches every store.
+func exposedStoreKeys(names []string, keys map[string]*types.KVStoreKey) []types.StoreKey {
+	exposeAll := false
+	for _, name := range names {
+		if name == AllStoreKeys {
+			exposeAll = true
+			break
+		}
+	}
+
+	var exposed []types.StoreKey
+	if exposeAll {
+		names = make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	for _, name := range names {
+		if key, ok := keys[name]; ok {
+			exposed = append(exposed, key)
+		}
+	}
+
+	return exposed
+}
+
+func closeStreamingServices(services []baseapp.StreamingService) {
+	for _, s := range services {
+		s.Close()
+	}
+}
@@ -0,0 +1,99 @@
+package file
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/streaming/collector"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// StreamingService is a concrete implementation of baseapp.StreamingService
+// that writes the data of each committed block to its own file.
+//
+// A file holds a single length-prefixed protobuf encoded BlockMetadata, made
+// of the ABCI requests and responses of the block and of the state changes of
+// the listened stores, grouped by the phase that wrote them. The files are
+// named {prefix}-block-{height}, or block-{height} without a prefix, and are
+// moved into the write directory once complete.
+type StreamingService struct {
+	*collector.BlockCollector
+
+	writeDir   string            // directory to write files into
+	filePrefix string            // optional prefix for each of the generated files
+	codec      codec.BinaryCodec // binary marshaller used for re-encoding the data
+}
+
+// NewStreamingService creates a new StreamingService for the provided
+// writeDir, (optional) filePrefix, and storeKeys.
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
+	// sanity check that the dir is writable
+	if err := isDirWriteable(writeDir); err != nil {
+		return nil, err
+	}
+
+	s := &StreamingService{
+		writeDir:   writeDir,
+		filePrefix: filePrefix,
+		codec:      c,
+	}
+	s.BlockCollector = collector.NewBlockCollector(storeKeys, s.writeBlock)
+
+	return s, nil
+}
+
+// Stream satisfies the baseapp.StreamingService interface. The files are
+// written synchronously on Commit, so there is no background work.
+func (fss *StreamingService) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close satisfies the io.Closer interface.
+func (fss *StreamingService) Close() error {
+	return nil
+}
+
+// FileName returns the name of the file holding the data of the block at the
+// given height.
+func (fss *StreamingService) FileName(height int64) string {
+	if fss.filePrefix == "" {
+		return fmt.Sprintf("block-%d", height)
+	}
+
+	return fmt.Sprintf("%s-block-%d", fss.filePrefix, height)
+}
+
+// writeBlock writes the block metadata into a temporary file which is then
+// renamed, so that readers of the write directory never see a partial file.
+func (fss *StreamingService) writeBlock(_ sdk.Context, block *types.BlockMetadata) error {
+	bz, err := fss.codec.MarshalLengthPrefixed(block)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(fss.writeDir, fss.FileName(block.Height))
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, bz, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// isDirWriteable checks if dir is writable by writing and removing a file
+// to dir. It returns nil if dir is writable.
+func isDirWriteable(dir string) error {
+	f := filepath.Join(dir, ".touch")
+	if err := os.WriteFile(f, []byte(""), 0600); err != nil {
+		return err
+	}
+
+	return os.Remove(f)
+}
@@ -0,0 +1,65 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+
+	mockStoreKey1 = types.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = types.NewKVStoreKey("mockStore2")
+)
+
+func TestFileStreamingService(t *testing.T) {
+	writeDir := t.TempDir()
+	fss, err := NewStreamingService(writeDir, "test", []types.StoreKey{mockStoreKey1, mockStoreKey2}, testMarshaller)
+	require.NoError(t, err)
+	require.Len(t, fss.Listeners(), 2)
+
+	ctx := sdk.Context{}
+	beginReq := abci.RequestBeginBlock{Header: tmproto.Header{Height: 3}}
+	deliverReq := abci.RequestDeliverTx{Tx: []byte("tx")}
+	deliverRes := abci.ResponseDeliverTx{Log: "ok"}
+	endReq := abci.RequestEndBlock{Height: 3}
+
+	require.NoError(t, fss.OnWrite(mockStoreKey1, []byte("begin"), []byte("1"), false))
+	require.NoError(t, fss.ListenBeginBlock(ctx, beginReq, abci.ResponseBeginBlock{}))
+	require.NoError(t, fss.OnWrite(mockStoreKey2, []byte("tx"), []byte("2"), false))
+	require.NoError(t, fss.ListenDeliverTx(ctx, deliverReq, deliverRes))
+	require.NoError(t, fss.OnWrite(mockStoreKey1, []byte("begin"), nil, true))
+	require.NoError(t, fss.ListenEndBlock(ctx, endReq, abci.ResponseEndBlock{}))
+	require.NoError(t, fss.ListenCommit(ctx, abci.ResponseCommit{Data: []byte("hash")}))
+
+	bz, err := os.ReadFile(filepath.Join(writeDir, "test-block-3"))
+	require.NoError(t, err)
+
+	var block types.BlockMetadata
+	require.NoError(t, testMarshaller.UnmarshalLengthPrefixed(bz, &block))
+	require.Equal(t, int64(3), block.Height)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: "mockStore1", Key: []byte("begin"), Value: []byte("1")}}, block.BeginBlockStateChanges)
+	require.Len(t, block.DeliverTxs, 1)
+	require.Equal(t, deliverReq.Tx, block.DeliverTxs[0].Request.Tx)
+	require.Equal(t, deliverRes.Log, block.DeliverTxs[0].Response.Log)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: "mockStore2", Key: []byte("tx"), Value: []byte("2")}}, block.DeliverTxs[0].StateChanges)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: "mockStore1", Delete: true, Key: []byte("begin")}}, block.EndBlockStateChanges)
+	require.Equal(t, []byte("hash"), block.ResponseCommit.Data)
+
+	// the next block starts from a clean state
+	require.NoError(t, fss.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 4}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, fss.ListenCommit(ctx, abci.ResponseCommit{}))
+	require.FileExists(t, filepath.Join(writeDir, "test-block-4"))
+	require.Equal(t, "block-4", (&StreamingService{}).FileName(4))
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/streaming/collector"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = (*StreamingService)(nil)
+
+// StreamingService is a concrete implementation of baseapp.StreamingService
+// that pushes the data of each committed block to a local StreamingSink gRPC
+// service.
+//
+// The blocks are queued on Commit and sent in order by the Stream loop, so a
+// slow sink only stalls block processing once the queue is full. A block the
+// sink fails to receive within the timeout is logged and dropped.
+type StreamingService struct {
+	*collector.BlockCollector
+
+	conn    *grpc.ClientConn
+	client  types.StreamingSinkClient
+	timeout time.Duration
+	logger  log.Logger
+
+	queue     chan *types.BlockMetadata
+	done      chan struct{} // closed once the Stream loop has sent the queued blocks
+	closeOnce sync.Once
+}
+
+// NewStreamingService creates a new StreamingService pushing the state changes
+// of the given stores to the sink listening on address.
+func NewStreamingService(
+	address string, timeout time.Duration, queueSize int, storeKeys []types.StoreKey, logger log.Logger,
+) (*StreamingService, error) {
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	s := &StreamingService{
+		conn:    conn,
+		client:  types.NewStreamingSinkClient(conn),
+		timeout: timeout,
+		logger:  logger.With("module", "streaming", "streamer", "grpc"),
+		queue:   make(chan *types.BlockMetadata, queueSize),
+	}
+	s.BlockCollector = collector.NewBlockCollector(storeKeys, s.enqueueBlock)
+
+	return s, nil
+}
+
+// Stream spins up a goroutine sending the queued blocks to the sink until the
+// service is closed.
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	gss.done = make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(gss.done)
+		for block := range gss.queue {
+			if err := gss.sendBlock(block); err != nil {
+				gss.logger.Error("failed to stream block", "height", block.Height, "err", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Close stops the Stream loop once the queued blocks are sent and closes the
+// connection to the sink.
+func (gss *StreamingService) Close() error {
+	var err error
+	gss.closeOnce.Do(func() {
+		close(gss.queue)
+		if gss.done != nil {
+			<-gss.done
+		}
+		err = gss.conn.Close()
+	})
+
+	return err
+}
+
+func (gss *StreamingService) enqueueBlock(_ sdk.Context, block *types.BlockMetadata) error {
+	gss.queue <- block
+	return nil
+}
+
+func (gss *StreamingService) sendBlock(block *types.BlockMetadata) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gss.timeout)
+	defer cancel()
+
+	_, err := gss.client.ListenBlock(ctx, &types.ListenBlockRequest{Block: block})
+	return err
+}
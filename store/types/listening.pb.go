@@ -4,8 +4,14 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/tendermint/tendermint/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -95,8 +101,268 @@ func (m *StoreKVPair) GetValue() []byte {
 	return nil
 }
 
+// BlockMetadata contains the ABCI requests and responses of a block together
+// with the state changes written by each of its phases.
+//
+// Since: cosmos-sdk 0.45
+type BlockMetadata struct {
+	Height                 int64                      `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	RequestBeginBlock      *types.RequestBeginBlock   `protobuf:"bytes,2,opt,name=request_begin_block,json=requestBeginBlock,proto3" json:"request_begin_block,omitempty"`
+	ResponseBeginBlock     *types.ResponseBeginBlock  `protobuf:"bytes,3,opt,name=response_begin_block,json=responseBeginBlock,proto3" json:"response_begin_block,omitempty"`
+	BeginBlockStateChanges []*StoreKVPair             `protobuf:"bytes,4,rep,name=begin_block_state_changes,json=beginBlockStateChanges,proto3" json:"begin_block_state_changes,omitempty"`
+	DeliverTxs             []*BlockMetadata_DeliverTx `protobuf:"bytes,5,rep,name=deliver_txs,json=deliverTxs,proto3" json:"deliver_txs,omitempty"`
+	RequestEndBlock        *types.RequestEndBlock     `protobuf:"bytes,6,opt,name=request_end_block,json=requestEndBlock,proto3" json:"request_end_block,omitempty"`
+	ResponseEndBlock       *types.ResponseEndBlock    `protobuf:"bytes,7,opt,name=response_end_block,json=responseEndBlock,proto3" json:"response_end_block,omitempty"`
+	EndBlockStateChanges   []*StoreKVPair             `protobuf:"bytes,8,rep,name=end_block_state_changes,json=endBlockStateChanges,proto3" json:"end_block_state_changes,omitempty"`
+	ResponseCommit         *types.ResponseCommit      `protobuf:"bytes,9,opt,name=response_commit,json=responseCommit,proto3" json:"response_commit,omitempty"`
+}
+
+func (m *BlockMetadata) Reset()         { *m = BlockMetadata{} }
+func (m *BlockMetadata) String() string { return proto.CompactTextString(m) }
+func (*BlockMetadata) ProtoMessage()    {}
+func (*BlockMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5d350879fe4fecd, []int{1}
+}
+func (m *BlockMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockMetadata.Merge(m, src)
+}
+func (m *BlockMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockMetadata proto.InternalMessageInfo
+
+func (m *BlockMetadata) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockMetadata) GetRequestBeginBlock() *types.RequestBeginBlock {
+	if m != nil {
+		return m.RequestBeginBlock
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetResponseBeginBlock() *types.ResponseBeginBlock {
+	if m != nil {
+		return m.ResponseBeginBlock
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetBeginBlockStateChanges() []*StoreKVPair {
+	if m != nil {
+		return m.BeginBlockStateChanges
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetDeliverTxs() []*BlockMetadata_DeliverTx {
+	if m != nil {
+		return m.DeliverTxs
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetRequestEndBlock() *types.RequestEndBlock {
+	if m != nil {
+		return m.RequestEndBlock
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetResponseEndBlock() *types.ResponseEndBlock {
+	if m != nil {
+		return m.ResponseEndBlock
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetEndBlockStateChanges() []*StoreKVPair {
+	if m != nil {
+		return m.EndBlockStateChanges
+	}
+	return nil
+}
+
+func (m *BlockMetadata) GetResponseCommit() *types.ResponseCommit {
+	if m != nil {
+		return m.ResponseCommit
+	}
+	return nil
+}
+
+// DeliverTx encapsulates a transaction of the block and the state changes it
+// wrote. Failed transactions have no state changes.
+type BlockMetadata_DeliverTx struct {
+	Request      *types.RequestDeliverTx  `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response     *types.ResponseDeliverTx `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	StateChanges []*StoreKVPair           `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *BlockMetadata_DeliverTx) Reset()         { *m = BlockMetadata_DeliverTx{} }
+func (m *BlockMetadata_DeliverTx) String() string { return proto.CompactTextString(m) }
+func (*BlockMetadata_DeliverTx) ProtoMessage()    {}
+func (*BlockMetadata_DeliverTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5d350879fe4fecd, []int{1, 0}
+}
+func (m *BlockMetadata_DeliverTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockMetadata_DeliverTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockMetadata_DeliverTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockMetadata_DeliverTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockMetadata_DeliverTx.Merge(m, src)
+}
+func (m *BlockMetadata_DeliverTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockMetadata_DeliverTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockMetadata_DeliverTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockMetadata_DeliverTx proto.InternalMessageInfo
+
+func (m *BlockMetadata_DeliverTx) GetRequest() *types.RequestDeliverTx {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *BlockMetadata_DeliverTx) GetResponse() *types.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *BlockMetadata_DeliverTx) GetStateChanges() []*StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+// ListenBlockRequest is the request type for the StreamingSink/ListenBlock RPC method.
+type ListenBlockRequest struct {
+	Block *BlockMetadata `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *ListenBlockRequest) Reset()         { *m = ListenBlockRequest{} }
+func (m *ListenBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ListenBlockRequest) ProtoMessage()    {}
+func (*ListenBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5d350879fe4fecd, []int{2}
+}
+func (m *ListenBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenBlockRequest.Merge(m, src)
+}
+func (m *ListenBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenBlockRequest proto.InternalMessageInfo
+
+func (m *ListenBlockRequest) GetBlock() *BlockMetadata {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+// ListenBlockResponse is the response type for the StreamingSink/ListenBlock RPC method.
+type ListenBlockResponse struct {
+}
+
+func (m *ListenBlockResponse) Reset()         { *m = ListenBlockResponse{} }
+func (m *ListenBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*ListenBlockResponse) ProtoMessage()    {}
+func (*ListenBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5d350879fe4fecd, []int{3}
+}
+func (m *ListenBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListenBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListenBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListenBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListenBlockResponse.Merge(m, src)
+}
+func (m *ListenBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListenBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListenBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListenBlockResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StoreKVPair)(nil), "cosmos.base.store.v1beta1.StoreKVPair")
+	proto.RegisterType((*BlockMetadata)(nil), "cosmos.base.store.v1beta1.BlockMetadata")
+	proto.RegisterType((*BlockMetadata_DeliverTx)(nil), "cosmos.base.store.v1beta1.BlockMetadata.DeliverTx")
+	proto.RegisterType((*ListenBlockRequest)(nil), "cosmos.base.store.v1beta1.ListenBlockRequest")
+	proto.RegisterType((*ListenBlockResponse)(nil), "cosmos.base.store.v1beta1.ListenBlockResponse")
 }
 
 func init() {
@@ -104,21 +370,128 @@ func init() {
 }
 
 var fileDescriptor_a5d350879fe4fecd = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0xd5, 0x2f, 0x33,
-	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0xcf, 0xc9, 0x2c, 0x2e, 0x49, 0xcd, 0xcb, 0xcc, 0x4b, 0xd7,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84, 0x28, 0xd5, 0x03, 0x29, 0xd5, 0x03, 0x2b, 0xd5,
-	0x83, 0x2a, 0x55, 0xca, 0xe2, 0xe2, 0x0e, 0x06, 0x09, 0x78, 0x87, 0x05, 0x24, 0x66, 0x16, 0x09,
-	0x49, 0x73, 0x71, 0x82, 0xe5, 0xe3, 0xb3, 0x53, 0x2b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83,
-	0x38, 0xc0, 0x02, 0xde, 0xa9, 0x95, 0x42, 0x62, 0x5c, 0x6c, 0x29, 0xa9, 0x39, 0xa9, 0x25, 0xa9,
-	0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x1c, 0x41, 0x50, 0x9e, 0x90, 0x00, 0x17, 0x33, 0x48, 0x39, 0xb3,
-	0x02, 0xa3, 0x06, 0x4f, 0x10, 0x88, 0x29, 0x24, 0xc2, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x2a,
-	0xc1, 0x02, 0x16, 0x83, 0x70, 0x9c, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x23, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x2d,
-	0x08, 0xa5, 0x5b, 0x9c, 0x92, 0x0d, 0xf5, 0x5c, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x47, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xe0, 0xb3, 0x51, 0xfe, 0x00, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0x59, 0xcb, 0x9f, 0xf6, 0x2d, 0x08, 0x0e, 0x88, 0x0b, 0x24, 0xb5, 0xd6, 0xc4, 0xd4,
+	0x03, 0xb3, 0xa1, 0x1e, 0x4d, 0x3c, 0x80, 0x26, 0x26, 0x60, 0x34, 0x5b, 0xf4, 0x60, 0x62, 0x36,
+	0xb3, 0xdd, 0x37, 0xed, 0xd8, 0xee, 0x2c, 0xce, 0x0c, 0x04, 0x0e, 0x7e, 0x07, 0x3f, 0x87, 0x9f,
+	0xc4, 0x23, 0x17, 0x13, 0x8f, 0x06, 0xbe, 0x88, 0xd9, 0x99, 0xed, 0xb2, 0x0b, 0xb6, 0x81, 0x53,
+	0x77, 0xde, 0x3e, 0xcf, 0x33, 0xef, 0xfc, 0x66, 0xf7, 0x85, 0xe7, 0xbd, 0x44, 0xc5, 0x89, 0xf2,
+	0x42, 0xa6, 0xd0, 0x53, 0x3a, 0x91, 0xe8, 0x9d, 0xec, 0x84, 0xa8, 0xd9, 0x8e, 0x37, 0xe2, 0x4a,
+	0xa3, 0xe0, 0xa2, 0x4f, 0x8f, 0x64, 0xa2, 0x13, 0xb2, 0x61, 0xa5, 0x34, 0x95, 0x52, 0x23, 0xa5,
+	0x99, 0x74, 0x73, 0x4b, 0xa3, 0x88, 0x50, 0xc6, 0x5c, 0x68, 0x8f, 0x85, 0x3d, 0xee, 0xe9, 0xb3,
+	0x23, 0x54, 0xd6, 0xd7, 0xfa, 0x0a, 0xf5, 0x6e, 0xaa, 0xde, 0xff, 0xf4, 0x81, 0x71, 0x49, 0xb6,
+	0xa0, 0x66, 0xcc, 0xc1, 0x10, 0xcf, 0x5c, 0xa7, 0xe9, 0xb4, 0x6b, 0x7e, 0xd5, 0x14, 0xf6, 0xf1,
+	0x8c, 0xac, 0xc3, 0x7c, 0x84, 0x23, 0xd4, 0xe8, 0xde, 0x6b, 0x3a, 0xed, 0xaa, 0x9f, 0xad, 0xc8,
+	0x0a, 0x54, 0x52, 0x79, 0xa5, 0xe9, 0xb4, 0x17, 0xfd, 0xf4, 0x91, 0xac, 0xc1, 0xdc, 0x09, 0x1b,
+	0x1d, 0xa3, 0x3b, 0x6b, 0x6a, 0x76, 0xd1, 0xfa, 0xb9, 0x00, 0x4b, 0xbb, 0xa3, 0xa4, 0x37, 0x7c,
+	0x87, 0x9a, 0x45, 0x4c, 0xb3, 0x34, 0x71, 0x80, 0xbc, 0x3f, 0xd0, 0x66, 0xaf, 0x8a, 0x9f, 0xad,
+	0x88, 0x0f, 0xab, 0x12, 0xbf, 0x1d, 0xa3, 0xd2, 0x41, 0x88, 0x7d, 0x2e, 0x82, 0x30, 0xb5, 0x99,
+	0x6d, 0xeb, 0x9d, 0x16, 0xbd, 0x3a, 0x10, 0x4d, 0x0f, 0x44, 0x7d, 0xab, 0xdd, 0x4d, 0xa5, 0x66,
+	0x03, 0xff, 0x81, 0xbc, 0x5e, 0x22, 0x1f, 0x61, 0x4d, 0xa2, 0x3a, 0x4a, 0x84, 0xc2, 0x52, 0x68,
+	0xc5, 0x84, 0x3e, 0xfd, 0x4f, 0xa8, 0x15, 0x17, 0x52, 0x89, 0xbc, 0x51, 0x23, 0x0c, 0x36, 0x0a,
+	0x69, 0x81, 0xd2, 0x4c, 0x63, 0xd0, 0x1b, 0x30, 0xd1, 0x47, 0xe5, 0xce, 0x36, 0x2b, 0xed, 0x7a,
+	0xe7, 0x19, 0x9d, 0x78, 0x39, 0xb4, 0x00, 0xdf, 0x5f, 0x0f, 0xf3, 0xd8, 0x6e, 0x1a, 0xb3, 0x67,
+	0x53, 0x48, 0x17, 0xea, 0x11, 0x8e, 0xf8, 0x09, 0xca, 0x40, 0x9f, 0x2a, 0x77, 0xce, 0x84, 0x76,
+	0xa6, 0x84, 0x96, 0x20, 0xd3, 0xd7, 0xd6, 0x7b, 0x78, 0xea, 0x43, 0x34, 0x7e, 0x54, 0xe4, 0x00,
+	0xc6, 0x8c, 0x02, 0x14, 0x51, 0xc6, 0x62, 0xde, 0xb0, 0x68, 0x4e, 0x02, 0xfc, 0x46, 0x44, 0x16,
+	0xc4, 0xb2, 0x2c, 0x17, 0xc8, 0x7b, 0xc8, 0xd9, 0x14, 0xe2, 0x16, 0x4c, 0xdc, 0x93, 0x89, 0x68,
+	0xf3, 0xbc, 0x15, 0x79, 0xad, 0x42, 0xbe, 0xc0, 0xa3, 0x3c, 0xe7, 0x1a, 0xd4, 0xea, 0x9d, 0xa0,
+	0xae, 0xa1, 0x88, 0x6e, 0x22, 0x7d, 0x0b, 0xcb, 0x79, 0xbf, 0xbd, 0x24, 0x8e, 0xb9, 0x76, 0x6b,
+	0xa6, 0xd9, 0xc7, 0x13, 0x9b, 0xdd, 0x33, 0x32, 0xff, 0xbe, 0x2c, 0xad, 0x37, 0x7f, 0x3b, 0x50,
+	0xcb, 0x09, 0x93, 0x97, 0xb0, 0x90, 0xa1, 0x71, 0x9d, 0x89, 0x87, 0x37, 0xff, 0x5f, 0xdd, 0xca,
+	0xd8, 0x41, 0x5e, 0x41, 0x75, 0x1c, 0x3e, 0xe5, 0x55, 0xb7, 0x82, 0x2b, 0x7b, 0xee, 0x21, 0xfb,
+	0xb0, 0x54, 0x26, 0x55, 0xb9, 0x13, 0xa9, 0x45, 0x55, 0x20, 0xd4, 0x3a, 0x04, 0x72, 0x60, 0x66,
+	0x8c, 0xbd, 0xa1, 0xbc, 0xc5, 0x39, 0x7b, 0xb5, 0xf6, 0x74, 0xed, 0xdb, 0xbe, 0x84, 0xbe, 0xb5,
+	0xb5, 0x1e, 0xc2, 0x6a, 0x29, 0xd5, 0x76, 0xde, 0xf9, 0x0e, 0x4b, 0x5d, 0x2d, 0x91, 0xc5, 0x5c,
+	0xf4, 0xbb, 0x5c, 0x0c, 0xc9, 0x08, 0xea, 0x05, 0x1d, 0xd9, 0x9e, 0xb2, 0xcf, 0xcd, 0x2e, 0x37,
+	0xe9, 0x6d, 0xe5, 0xd9, 0xd7, 0xbc, 0xfb, 0xeb, 0xa2, 0xe1, 0x9c, 0x5f, 0x34, 0x9c, 0xbf, 0x17,
+	0x0d, 0xe7, 0xc7, 0x65, 0x63, 0xe6, 0xfc, 0xb2, 0x31, 0xf3, 0xe7, 0xb2, 0x31, 0xf3, 0xb9, 0xdd,
+	0xe7, 0x7a, 0x70, 0x1c, 0xd2, 0x5e, 0x12, 0x7b, 0xd9, 0x30, 0xb6, 0x3f, 0xdb, 0x2a, 0x1a, 0x66,
+	0x23, 0xd9, 0x8c, 0xd3, 0x70, 0xde, 0xcc, 0xd3, 0x17, 0xff, 0x06, 0x00, 0x82, 0xf5, 0x43, 0xb2,
+	0xb4, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamingSinkClient is the client API for StreamingSink service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamingSinkClient interface {
+	// ListenBlock receives the metadata and state changes of a committed block.
+	ListenBlock(ctx context.Context, in *ListenBlockRequest, opts ...grpc.CallOption) (*ListenBlockResponse, error)
+}
+
+type streamingSinkClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamingSinkClient(cc grpc1.ClientConn) StreamingSinkClient {
+	return &streamingSinkClient{cc}
+}
+
+func (c *streamingSinkClient) ListenBlock(ctx context.Context, in *ListenBlockRequest, opts ...grpc.CallOption) (*ListenBlockResponse, error) {
+	out := new(ListenBlockResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.store.v1beta1.StreamingSink/ListenBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StreamingSinkServer is the server API for StreamingSink service.
+type StreamingSinkServer interface {
+	// ListenBlock receives the metadata and state changes of a committed block.
+	ListenBlock(context.Context, *ListenBlockRequest) (*ListenBlockResponse, error)
+}
+
+// UnimplementedStreamingSinkServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamingSinkServer struct {
+}
+
+func (*UnimplementedStreamingSinkServer) ListenBlock(ctx context.Context, req *ListenBlockRequest) (*ListenBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListenBlock not implemented")
+}
+
+func RegisterStreamingSinkServer(s grpc1.Server, srv StreamingSinkServer) {
+	s.RegisterService(&_StreamingSink_serviceDesc, srv)
+}
+
+func _StreamingSink_ListenBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListenBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingSinkServer).ListenBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.store.v1beta1.StreamingSink/ListenBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingSinkServer).ListenBlock(ctx, req.(*ListenBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StreamingSink_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.store.v1beta1.StreamingSink",
+	HandlerType: (*StreamingSinkServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListenBlock",
+			Handler:    _StreamingSink_ListenBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/store/v1beta1/listening.proto",
 }
 
 func (m *StoreKVPair) Marshal() (dAtA []byte, err error) {
@@ -162,61 +535,926 @@ func (m *StoreKVPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.StoreKey) > 0 {
-		i -= len(m.StoreKey)
-		copy(dAtA[i:], m.StoreKey)
-		i = encodeVarintListening(dAtA, i, uint64(len(m.StoreKey)))
-		i--
-		dAtA[i] = 0xa
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintListening(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseCommit != nil {
+		{
+			size, err := m.ResponseCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.EndBlockStateChanges) > 0 {
+		for iNdEx := len(m.EndBlockStateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndBlockStateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintListening(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ResponseEndBlock != nil {
+		{
+			size, err := m.ResponseEndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RequestEndBlock != nil {
+		{
+			size, err := m.RequestEndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DeliverTxs) > 0 {
+		for iNdEx := len(m.DeliverTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeliverTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintListening(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BeginBlockStateChanges) > 0 {
+		for iNdEx := len(m.BeginBlockStateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeginBlockStateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintListening(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ResponseBeginBlock != nil {
+		{
+			size, err := m.ResponseBeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RequestBeginBlock != nil {
+		{
+			size, err := m.RequestBeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintListening(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockMetadata_DeliverTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockMetadata_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockMetadata_DeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintListening(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListening(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListenBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListenBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListenBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintListening(dAtA []byte, offset int, v uint64) int {
+	offset -= sovListening(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StoreKVPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovListening(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovListening(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovListening(uint64(m.Height))
+	}
+	if m.RequestBeginBlock != nil {
+		l = m.RequestBeginBlock.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if m.ResponseBeginBlock != nil {
+		l = m.ResponseBeginBlock.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if len(m.BeginBlockStateChanges) > 0 {
+		for _, e := range m.BeginBlockStateChanges {
+			l = e.Size()
+			n += 1 + l + sovListening(uint64(l))
+		}
+	}
+	if len(m.DeliverTxs) > 0 {
+		for _, e := range m.DeliverTxs {
+			l = e.Size()
+			n += 1 + l + sovListening(uint64(l))
+		}
+	}
+	if m.RequestEndBlock != nil {
+		l = m.RequestEndBlock.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if m.ResponseEndBlock != nil {
+		l = m.ResponseEndBlock.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if len(m.EndBlockStateChanges) > 0 {
+		for _, e := range m.EndBlockStateChanges {
+			l = e.Size()
+			n += 1 + l + sovListening(uint64(l))
+		}
+	}
+	if m.ResponseCommit != nil {
+		l = m.ResponseCommit.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockMetadata_DeliverTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovListening(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListenBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovListening(uint64(l))
+	}
+	return n
+}
+
+func (m *ListenBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovListening(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozListening(x uint64) (n int) {
+	return sovListening(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreKVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreKVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestBeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestBeginBlock == nil {
+				m.RequestBeginBlock = &types.RequestBeginBlock{}
+			}
+			if err := m.RequestBeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseBeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseBeginBlock == nil {
+				m.ResponseBeginBlock = &types.ResponseBeginBlock{}
+			}
+			if err := m.ResponseBeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlockStateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeginBlockStateChanges = append(m.BeginBlockStateChanges, &StoreKVPair{})
+			if err := m.BeginBlockStateChanges[len(m.BeginBlockStateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliverTxs = append(m.DeliverTxs, &BlockMetadata_DeliverTx{})
+			if err := m.DeliverTxs[len(m.DeliverTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestEndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestEndBlock == nil {
+				m.RequestEndBlock = &types.RequestEndBlock{}
+			}
+			if err := m.RequestEndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseEndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseEndBlock == nil {
+				m.ResponseEndBlock = &types.ResponseEndBlock{}
+			}
+			if err := m.ResponseEndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockStateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBlockStateChanges = append(m.EndBlockStateChanges, &StoreKVPair{})
+			if err := m.EndBlockStateChanges[len(m.EndBlockStateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListening
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseCommit == nil {
+				m.ResponseCommit = &types.ResponseCommit{}
+			}
+			if err := m.ResponseCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintListening(dAtA []byte, offset int, v uint64) int {
-	offset -= sovListening(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *StoreKVPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StoreKey)
-	if l > 0 {
-		n += 1 + l + sovListening(uint64(l))
-	}
-	if m.Delete {
-		n += 2
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovListening(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovListening(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovListening(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozListening(x uint64) (n int) {
-	return sovListening(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
+func (m *BlockMetadata_DeliverTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -239,17 +1477,17 @@ func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreKVPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeliverTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreKVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowListening
@@ -259,29 +1497,33 @@ func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthListening
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthListening
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			if m.Request == nil {
+				m.Request = &types.RequestDeliverTx{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowListening
@@ -291,17 +1533,33 @@ func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Delete = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthListening
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListening
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.ResponseDeliverTx{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowListening
@@ -311,31 +1569,81 @@ func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthListening
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthListening
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			m.StateChanges = append(m.StateChanges, &StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListenBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowListening
@@ -345,24 +1653,26 @@ func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthListening
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthListening
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.Block == nil {
+				m.Block = &BlockMetadata{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -386,6 +1696,56 @@ func (m *StoreKVPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListenBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListening
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListenBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListenBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListening(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListening
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipListening(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -317,6 +317,8 @@ func New(t *testing.T, cfg Config) *Network {
 			sdk.NewCoin(cfg.BondDenom, cfg.BondedTokens),
 			stakingtypes.NewDescription(nodeDirName, "", "", "", ""),
 			stakingtypes.NewCommissionRates(commission, sdk.OneDec(), sdk.OneDec()),
+			stakingtypes.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), 0, nil),
+			addr,
 			sdk.OneInt(),
 		)
 		require.NoError(t, err)
@@ -38,6 +38,7 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	priority      int64 // The tx priority, only relevant in CheckTx
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -58,6 +59,7 @@ func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Priority() int64             { return c.priority }
 
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
@@ -202,6 +204,12 @@ func (c Context) WithMinGasPrices(gasPrices DecCoins) Context {
 	return c
 }
 
+// WithPriority returns a Context with an updated tx priority
+func (c Context) WithPriority(p int64) Context {
+	c.priority = p
+	return c
+}
+
 // WithConsensusParams returns a Context with an updated consensus params
 func (c Context) WithConsensusParams(params *abci.ConsensusParams) Context {
 	c.consParams = params
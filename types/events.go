@@ -228,6 +228,7 @@ var (
 	AttributeKeyAccountSequence = "acc_seq"
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
+	AttributeKeyPriority        = "priority"
 
 	EventTypeMessage = "message"
 
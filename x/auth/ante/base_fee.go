@@ -0,0 +1,64 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type baseFeesContextKey struct{}
+
+// BaseFeeDecorator rejects transactions whose fee does not cover the consensus
+// base fee per gas of the fee market, in CheckTx as well as in DeliverTx. The
+// base fee part of the fee is recorded on the context, for DeductFeeDecorator
+// to send it to the burnt fee collector.
+// If the fee market keeper is nil or the fee market is disabled, the decorator
+// does nothing.
+// CONTRACT: Tx must implement FeeTx to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+}
+
+func NewBaseFeeDecorator(fmk FeeMarketKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		feeMarketKeeper: fmk,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if bfd.feeMarketKeeper == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	baseFee, enabled := bfd.feeMarketKeeper.BaseFeePerGas(ctx)
+	if !enabled || baseFee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// fee = ceil(baseFee * gasLimit), as for the minimum gas prices
+	gasLimit := sdk.NewDecFromInt(sdk.NewIntFromUint64(feeTx.GetGas()))
+	required := sdk.NewCoin(baseFee.Denom, baseFee.Amount.Mul(gasLimit).Ceil().RoundInt())
+
+	paid := feeTx.GetFee().AmountOf(baseFee.Denom)
+	if paid.LT(required.Amount) {
+		// simulations usually carry no fee, so only the paid part is deducted
+		if !simulate {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required base fee: %s", feeTx.GetFee(), required)
+		}
+		required.Amount = paid
+	}
+
+	newCtx = ctx.WithValue(baseFeesContextKey{}, sdk.NewCoins(required))
+	return next(newCtx, tx, simulate)
+}
+
+// BaseFeesFromContext returns the base fee part of the transaction fee, as
+// recorded by BaseFeeDecorator. It is empty if the fee market is disabled.
+func BaseFeesFromContext(ctx sdk.Context) sdk.Coins {
+	baseFees, _ := ctx.Value(baseFeesContextKey{}).(sdk.Coins)
+	return baseFees
+}
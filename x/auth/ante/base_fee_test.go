@@ -0,0 +1,77 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *AnteTestSuite) TestBaseFeeDecorator() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, feeAmount)
+	suite.Require().NoError(err)
+
+	bfd := ante.NewBaseFeeDecorator(suite.app.FeeMarketKeeper)
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, nil)
+	antehandler := sdk.ChainAnteDecorators(bfd, dfd)
+
+	// the fee market is disabled by default
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err = antehandler(cacheCtx, tx, false)
+	suite.Require().NoError(err)
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.Enabled = true
+	params.Denom = "atom"
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+
+	// 200000gas * 0.001atom = 200atom required, in CheckTx and DeliverTx
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, sdk.NewDecWithPrec(1, 3))
+	for _, checkTx := range []bool{true, false} {
+		_, err = antehandler(suite.ctx.WithIsCheckTx(checkTx), tx, false)
+		suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	}
+
+	// simulations only pay what the fee covers
+	cacheCtx, _ = suite.ctx.CacheContext()
+	_, err = antehandler(cacheCtx, tx, true)
+	suite.Require().NoError(err)
+
+	// 200000gas * 0.0005atom = 100atom of base fee, the rest goes to the fee collector
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, sdk.NewDecWithPrec(5, 4))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	burntFeeCollector := suite.app.AccountKeeper.GetModuleAddress(types.BurntFeeCollectorName)
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 100), suite.app.BankKeeper.GetBalance(suite.ctx, burntFeeCollector, "atom"))
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 50), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, "atom"))
+
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "atom")
+	suite.app.BankKeeper.BurnCollectedFees(suite.ctx)
+	suite.Require().Equal(supply.SubAmount(sdk.NewInt(100)), suite.app.BankKeeper.GetSupply(suite.ctx, "atom"))
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 100), suite.app.BankKeeper.GetDeflationBySource(suite.ctx, types.BurntFeeCollectorName, "atom"))
+}
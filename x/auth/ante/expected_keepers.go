@@ -18,3 +18,9 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// FeeMarketKeeper defines the expected fee market keeper.
+type FeeMarketKeeper interface {
+	// BaseFeePerGas returns the base fee per gas, and false if the fee market is disabled.
+	BaseFeePerGas(ctx sdk.Context) (sdk.DecCoin, bool)
+}
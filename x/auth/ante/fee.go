@@ -2,7 +2,6 @@ package ante
 
 import (
 	"fmt"
-	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,6 +14,9 @@ import (
 // Note this only applies when ctx.CheckTx = true
 // If fee is high enough or not CheckTx, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
+//
+// Deprecated: the minimum gas prices are checked by the TxFeeChecker of the
+// DeductFeeDecorator, which also computes the tx priority.
 type MempoolFeeDecorator struct{}
 
 func NewMempoolFeeDecorator() MempoolFeeDecorator {
@@ -55,21 +57,35 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// TxFeeChecker checks if the provided fee is enough and returns the effective
+// fee and the tx priority, the effective fee should be deducted later, and the
+// priority should be returned in the abci response.
+type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
+// The fees are checked, and the tx priority is computed, by the TxFeeChecker,
+// which defaults to checking the fees against the validator's minimum gas
+// prices in CheckTx and using the gas price as priority.
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
 	ak             AccountKeeper
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
+	txFeeChecker   TxFeeChecker
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
+	if tfc == nil {
+		tfc = checkTxFeeWithValidatorMinGasPrices
+	}
+
 	return DeductFeeDecorator{
 		ak:             ak,
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
+		txFeeChecker:   tfc,
 	}
 }
 
@@ -84,6 +100,14 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	}
 
 	fee := feeTx.GetFee()
+	var priority int64
+	if !simulate {
+		fee, priority, err = dfd.txFeeChecker(ctx, tx)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 
@@ -111,40 +135,47 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	}
 
 	// deduct the fees
-	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, feeTx.GetFee())
+	if !fee.IsZero() {
+		err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
 		if err != nil {
 			return ctx, err
 		}
 	}
 
 	events := sdk.Events{sdk.NewEvent(sdk.EventTypeTx,
-		sdk.NewAttribute(sdk.AttributeKeyFee, feeTx.GetFee().String()),
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
 	)}
 	ctx.EventManager().EmitEvents(events)
 
-	return next(ctx, tx, simulate)
+	newCtx = ctx.WithPriority(priority)
+
+	return next(newCtx, tx, simulate)
 }
 
-// DeductFees deducts fees from the given account.
+// DeductFees deducts fees from the given account. The base fee part of the
+// fees, recorded on the context by BaseFeeDecorator, and the part of the
+// remaining fees which is burnt, as defined by the bank params, are sent to the
+// burnt fee collector and the rest to the fee collector.
 func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, fees sdk.Coins) error {
 	if !fees.IsValid() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
 	}
 
-	burntFeeDenom := bankKeeper.GetBurntFeeDenom(ctx)
-	if strings.TrimSpace(burntFeeDenom) != "" {
-		fees, err := burnFees(bankKeeper, ctx, acc, fees, burntFeeDenom)
-		if err != nil {
-			return err
-		}
+	fees, err := deductBaseFees(bankKeeper, ctx, acc, fees)
+	if err != nil {
+		return err
+	}
 
-		if fees.IsZero() {
-			return nil
-		}
+	fees, err = burnFees(bankKeeper, ctx, acc, fees)
+	if err != nil {
+		return err
+	}
+
+	if fees.IsZero() {
+		return nil
 	}
 
-	err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
+	err = bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
@@ -152,17 +183,37 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI
 	return nil
 }
 
-func burnFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, fees sdk.Coins, burntFeeDenom string) (sdk.Coins, error) {
-	amount := fees.AmountOf(burntFeeDenom)
-	if amount.IsPositive() {
-		feesL := sdk.NewCoins(sdk.NewCoin(burntFeeDenom, amount))
+// burnFees sends the part of the fees which is burnt to the burnt fee collector
+// and returns the remaining fees.
+func burnFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, fees sdk.Coins) (sdk.Coins, error) {
+	burntFees := bankKeeper.CalculateBurntFees(ctx, fees)
+	if burntFees.IsZero() {
+		return fees, nil
+	}
 
-		if err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.BurntFeeCollectorName, feesL); err != nil {
-			return fees, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
-		}
+	if err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.BurntFeeCollectorName, burntFees); err != nil {
+		return fees, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	return fees.Sub(burntFees), nil
+}
+
+// deductBaseFees sends the base fee part of the fees to the burnt fee collector
+// and returns the remaining fees.
+func deductBaseFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI, fees sdk.Coins) (sdk.Coins, error) {
+	baseFees := BaseFeesFromContext(ctx)
+	if baseFees.IsZero() {
+		return fees, nil
+	}
+
+	remaining, hasNeg := fees.SafeSub(baseFees)
+	if hasNeg {
+		return fees, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required base fee: %s", fees, baseFees)
+	}
 
-		fees = fees.Sub(feesL)
+	if err := bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.BurntFeeCollectorName, baseFees); err != nil {
+		return fees, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
 
-	return fees, nil
+	return remaining, nil
 }
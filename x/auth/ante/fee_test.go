@@ -5,7 +5,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
@@ -86,7 +89,7 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, coins)
 	suite.Require().NoError(err)
 
-	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err = antehandler(suite.ctx, tx, false)
@@ -102,3 +105,106 @@ func (suite *AnteTestSuite) TestDeductFees() {
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
 }
+
+func (suite *AnteTestSuite) TestDeductFeesBurntFees() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// burn a quarter of the fees paid in atom
+	bankParams := suite.app.BankKeeper.GetParams(suite.ctx)
+	bankParams.BurntFees = []banktypes.BurntFee{banktypes.NewBurntFee("atom", sdk.NewDecWithPrec(25, 2))}
+	suite.app.BankKeeper.SetParams(suite.ctx, bankParams)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, feeAmount)
+	suite.Require().NoError(err)
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// 150atom * 0.25 is truncated
+	burntFeeCollector := suite.app.AccountKeeper.GetModuleAddress(types.BurntFeeCollectorName)
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 37), suite.app.BankKeeper.GetBalance(suite.ctx, burntFeeCollector, "atom"))
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 113), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, "atom"))
+
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "atom")
+	suite.app.BankKeeper.BurnCollectedFees(suite.ctx)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, burntFeeCollector, "atom").IsZero())
+	suite.Require().Equal(supply.SubAmount(sdk.NewInt(37)), suite.app.BankKeeper.GetSupply(suite.ctx, "atom"))
+	suite.Require().Equal(sdk.NewInt64Coin("atom", 37), suite.app.BankKeeper.GetDeflationBySource(suite.ctx, types.BurntFeeCollectorName, "atom"))
+}
+
+func (suite *AnteTestSuite) TestDeductFeesTxPriority() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin("atom", 2000000), sdk.NewInt64Coin("stake", 600000))
+	gasLimit := testdata.NewTestGasLimit()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(feeAmount)
+	suite.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, feeAmount.Add(feeAmount...).Add(feeAmount...))
+	suite.Require().NoError(err)
+
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	// the minimum gas prices are checked by the default fee checker
+	highGasPrice := []sdk.DecCoin{sdk.NewDecCoin("atom", sdk.NewInt(20))}
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true).WithMinGasPrices(highGasPrice), tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// they are not checked in DeliverTx
+	_, err = antehandler(suite.ctx.WithMinGasPrices(highGasPrice), tx, false)
+	suite.Require().NoError(err)
+
+	// the priority is the lowest gas price of the fee coins: 600000stake / 200000gas
+	newCtx, err := antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(3), newCtx.Priority())
+
+	// a custom fee checker sets the fee and the priority
+	checker := func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		return sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), 42, nil
+	}
+	dfd = ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, checker)
+	antehandler = sdk.ChainAnteDecorators(dfd)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, addr1, "atom")
+	newCtx, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(42), newCtx.Priority())
+	suite.Require().Equal(balance.SubAmount(sdk.NewInt(100)), suite.app.BankKeeper.GetBalance(suite.ctx, addr1, "atom"))
+}
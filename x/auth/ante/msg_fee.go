@@ -0,0 +1,91 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MsgFeeDecorator enforces the msg fees param of the auth module: for each
+// message of the tx whose type has a msg fee, it consumes the gas surcharge of
+// the type and requires the tx fee to include the minimum fee of the type.
+// Unlike the minimum gas prices of the validator, the msg fees are enforced in
+// both CheckTx and DeliverTx. The minimum fee is not checked in simulate mode,
+// so that the gas of a tx can be estimated before its fee is known.
+//
+// The messages wrapped by other messages, e.g. by authz MsgExec or by a gov
+// MsgSubmitProposal with a MessagesProposal content, are charged like the top
+// level messages of the tx.
+//
+// CONTRACT: Tx must implement FeeTx interface to use MsgFeeDecorator
+type MsgFeeDecorator struct {
+	ak AccountKeeper
+}
+
+func NewMsgFeeDecorator(ak AccountKeeper) MsgFeeDecorator {
+	return MsgFeeDecorator{
+		ak: ak,
+	}
+}
+
+func (mfd MsgFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the params are read without gas, so that txs with no msg fee cost as much
+	// gas as before the msg fees were introduced
+	params := mfd.ak.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	if len(params.MsgFees) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	requiredFees, err := consumeMsgFees(ctx, params, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+
+	if !simulate && !feeTx.GetFee().IsAllGTE(requiredFees) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the tx messages; got: %s required: %s", feeTx.GetFee(), requiredFees)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// nestedMsgs is implemented by the messages wrapping other messages.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// consumeMsgFees consumes the gas surcharges of the given messages, and of the
+// messages they wrap, and returns the sum of their minimum fees.
+func consumeMsgFees(ctx sdk.Context, params types.Params, msgs []sdk.Msg) (sdk.Coins, error) {
+	requiredFees := sdk.NewCoins()
+	for _, msg := range msgs {
+		if msgFee, found := params.MsgFee(sdk.MsgTypeURL(msg)); found {
+			if msgFee.GasSurcharge > 0 {
+				ctx.GasMeter().ConsumeGas(msgFee.GasSurcharge, "msg gas surcharge")
+			}
+			requiredFees = requiredFees.Add(msgFee.MinFee...)
+		}
+
+		nested, ok := msg.(nestedMsgs)
+		if !ok {
+			continue
+		}
+
+		wrapped, err := nested.GetMessages()
+		if err != nil {
+			return nil, err
+		}
+
+		nestedFees, err := consumeMsgFees(ctx, params, wrapped)
+		if err != nil {
+			return nil, err
+		}
+		requiredFees = requiredFees.Add(nestedFees...)
+	}
+
+	return requiredFees, nil
+}
@@ -0,0 +1,90 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (suite *AnteTestSuite) TestMsgFeeDecorator() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewMsgFeeDecorator(suite.app.AccountKeeper)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+
+	createTx := func(fee sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(fee)
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		return tx
+	}
+
+	// no msg fee is set for the message type
+	ctx := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err := antehandler(ctx, createTx(nil, msg), false)
+	suite.Require().NoError(err)
+	suite.Require().Zero(ctx.GasMeter().GasConsumed())
+
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	params.MsgFees = []types.MsgFee{
+		types.NewMsgFee(sdk.MsgTypeURL(msg), sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), 10000),
+	}
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	// the wrapped messages are charged like the top level ones
+	execMsg := authz.NewMsgExec(addr1, []sdk.Msg{msg})
+	content, err := govtypes.NewMessagesProposal("title", "description", []sdk.Msg{msg, msg})
+	suite.Require().NoError(err)
+	proposalMsg, err := govtypes.NewMsgSubmitProposal(content, nil, addr1)
+	suite.Require().NoError(err)
+	textProposalMsg, err := govtypes.NewMsgSubmitProposal(govtypes.NewTextProposal("title", "description"), nil, addr1)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		desc     string
+		fee      sdk.Coins
+		msgs     []sdk.Msg
+		simulate bool
+		expGas   uint64
+		expErr   error
+	}{
+		{"insufficient fee", sdk.NewCoins(sdk.NewInt64Coin("atom", 99)), []sdk.Msg{msg}, false, 10000, sdkerrors.ErrInsufficientFee},
+		{"fee in another denom", sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), []sdk.Msg{msg}, false, 10000, sdkerrors.ErrInsufficientFee},
+		{"sufficient fee", sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), []sdk.Msg{msg}, false, 10000, nil},
+		{"min fee of each message", sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), []sdk.Msg{msg, msg}, false, 20000, sdkerrors.ErrInsufficientFee},
+		{"sufficient fee for each message", sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), []sdk.Msg{msg, msg}, false, 20000, nil},
+		{"min fee not checked in simulate", nil, []sdk.Msg{msg}, true, 10000, nil},
+		{"min fee of the messages wrapped by authz", sdk.NewCoins(sdk.NewInt64Coin("atom", 99)), []sdk.Msg{&execMsg}, false, 10000, sdkerrors.ErrInsufficientFee},
+		{"sufficient fee for the messages wrapped by authz", sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), []sdk.Msg{&execMsg}, false, 10000, nil},
+		{"min fee of the proposal messages", sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), []sdk.Msg{proposalMsg}, false, 20000, sdkerrors.ErrInsufficientFee},
+		{"sufficient fee for the proposal messages", sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), []sdk.Msg{proposalMsg}, false, 20000, nil},
+		{"no msg fee for a proposal without messages", nil, []sdk.Msg{textProposalMsg}, false, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.desc, func() {
+			ctx := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			_, err := antehandler(ctx, createTx(tc.fee, tc.msgs...), tc.simulate)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(tc.expGas, ctx.GasMeter().GasConsumed())
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package ante
+
+import (
+	"bytes"
+	"math"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// PendingTxRetentionBlocks is the number of blocks for which a pending tx is
+// tracked by the TxReplacementDecorator.
+const PendingTxRetentionBlocks = 100
+
+// MinReplacementPriorityBump is the minimum increase, in percent of the pending
+// tx priority, of the priority of a replacement tx.
+const MinReplacementPriorityBump = 10
+
+// TxReplacementDecorator implements the replace-by-fee rule of the mempool: a
+// tx from the same single signer, with the same sequence and a priority higher
+// by at least MinReplacementPriorityBump percent, replaces the signer's latest
+// pending tx.
+//
+// In CheckTx the replacement is accepted by resetting the signer's sequence in
+// the check state, so that the signature verification and sequence increment
+// decorators run as if the replaced tx had never been checked. The replaced tx
+// is then rejected, and evicted from the mempool, when it is rechecked.
+//
+// NOTE: Tendermint's mempool keeps no index by signer, so the replaced tx stays
+// in the mempool until the next recheck and may still be included in a block
+// proposed before it, in which case the replacement fails its sequence check.
+// The fees of the replaced tx are also deducted from the check state until the
+// next commit, so the signer must be able to pay for both txs.
+//
+// CONTRACT: must be run after DeductFeeDecorator, which sets the tx priority,
+// and before SetPubKeyDecorator and all the signature verification decorators.
+type TxReplacementDecorator struct {
+	ak      AccountKeeper
+	pending *pendingTxs
+}
+
+func NewTxReplacementDecorator(ak AccountKeeper) TxReplacementDecorator {
+	return TxReplacementDecorator{
+		ak:      ak,
+		pending: newPendingTxs(),
+	}
+}
+
+func (trd TxReplacementDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	// only txs with a single signer can be replaced
+	signers := sigTx.GetSigners()
+	if len(signers) != 1 || len(sigs) != 1 {
+		return next(ctx, tx, simulate)
+	}
+
+	signer, sequence := signers[0].String(), sigs[0].Sequence
+	txHash := tmhash.Sum(ctx.TxBytes())
+
+	if !ctx.IsCheckTx() {
+		newCtx, err := next(ctx, tx, simulate)
+		if err == nil {
+			// the tx is no longer pending once it is included in a block
+			trd.pending.remove(signer, sequence)
+		}
+
+		return newCtx, err
+	}
+
+	if ctx.IsReCheckTx() {
+		if entry, ok := trd.pending.get(signer, sequence); ok && !bytes.Equal(entry.hash, txHash) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrWrongSequence,
+				"tx with sequence %d of %s has been replaced by tx %X", sequence, signer, entry.hash)
+		}
+
+		return next(ctx, tx, simulate)
+	}
+
+	acc := trd.ak.GetAccount(ctx, signers[0])
+	if acc != nil && acc.GetSequence() > 0 && sequence == acc.GetSequence()-1 {
+		entry, ok := trd.pending.get(signer, sequence)
+		if ok && !bytes.Equal(entry.hash, txHash) {
+			if minPriority := minReplacementPriority(entry.priority); ctx.Priority() < minPriority {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee,
+					"replacement tx priority %d must be at least %d, %d%% above the pending tx priority %d",
+					ctx.Priority(), minPriority, MinReplacementPriorityBump, entry.priority)
+			}
+
+			if err := acc.SetSequence(sequence); err != nil {
+				panic(err)
+			}
+
+			trd.ak.SetAccount(ctx, acc)
+		}
+	}
+
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+
+	trd.pending.set(signer, sequence, pendingTx{
+		hash:     txHash,
+		priority: ctx.Priority(),
+		height:   ctx.BlockHeight(),
+	})
+
+	return newCtx, nil
+}
+
+// minReplacementPriority returns the minimum priority of a tx replacing a
+// pending tx with the given priority, it is always greater than the pending tx
+// priority.
+func minReplacementPriority(priority int64) int64 {
+	bump := sdk.NewInt(priority).MulRaw(MinReplacementPriorityBump).ToDec().QuoInt64(100).Ceil().TruncateInt()
+	if !bump.IsPositive() {
+		bump = sdk.OneInt()
+	}
+
+	minPriority := bump.AddRaw(priority)
+	if !minPriority.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return minPriority.Int64()
+}
+
+// pendingTx is the latest tx accepted in CheckTx for a signer and sequence.
+type pendingTx struct {
+	hash     []byte
+	priority int64
+	height   int64
+}
+
+// pendingTxs tracks the pending txs by signer and sequence, it is shared by
+// CheckTx and DeliverTx, which run concurrently.
+type pendingTxs struct {
+	mtx            sync.Mutex
+	txs            map[string]map[uint64]pendingTx
+	prunedAtHeight int64
+}
+
+func newPendingTxs() *pendingTxs {
+	return &pendingTxs{
+		txs: make(map[string]map[uint64]pendingTx),
+	}
+}
+
+func (p *pendingTxs) get(signer string, sequence uint64) (pendingTx, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	entry, ok := p.txs[signer][sequence]
+	return entry, ok
+}
+
+func (p *pendingTxs) set(signer string, sequence uint64, entry pendingTx) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.pruneLocked(entry.height)
+
+	if p.txs[signer] == nil {
+		p.txs[signer] = make(map[uint64]pendingTx)
+	}
+	p.txs[signer][sequence] = entry
+}
+
+func (p *pendingTxs) remove(signer string, sequence uint64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	delete(p.txs[signer], sequence)
+	if len(p.txs[signer]) == 0 {
+		delete(p.txs, signer)
+	}
+}
+
+// pruneLocked removes the txs tracked for more than PendingTxRetentionBlocks,
+// at most once per block height.
+func (p *pendingTxs) pruneLocked(height int64) {
+	if height <= p.prunedAtHeight {
+		return
+	}
+	p.prunedAtHeight = height
+
+	for signer, txs := range p.txs {
+		for sequence, entry := range txs {
+			if entry.height+PendingTxRetentionBlocks < height {
+				delete(txs, sequence)
+			}
+		}
+		if len(txs) == 0 {
+			delete(p.txs, signer)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (suite *AnteTestSuite) TestTxReplacement() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(1)
+	addr := accounts[0].acc.GetAddress()
+	privs, accNums := []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}
+
+	// createTx returns a tx with the given sequence and gas price, and its bytes.
+	createTx := func(sequence uint64, gasPrice int64) (sdk.Tx, []byte) {
+		gasLimit := uint64(100000)
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", gasPrice*int64(gasLimit))))
+		suite.txBuilder.SetGasLimit(gasLimit)
+
+		tx, err := suite.CreateTestTx(privs, accNums, []uint64{sequence}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		return tx, txBytes
+	}
+
+	// checkTx writes the state changes of the AnteHandler only if it succeeds,
+	// as baseapp does
+	checkTx := func(ctx sdk.Context, tx sdk.Tx, txBytes []byte) error {
+		cacheCtx, write := ctx.CacheContext()
+		_, err := suite.anteHandler(cacheCtx.WithIsCheckTx(true).WithTxBytes(txBytes), tx, false)
+		if err == nil {
+			write()
+		}
+		return err
+	}
+
+	sequence := func(ctx sdk.Context) uint64 {
+		return suite.app.AccountKeeper.GetAccount(ctx, addr).GetSequence()
+	}
+
+	// the check state is a branch of the committed state
+	checkCtx, _ := suite.ctx.CacheContext()
+
+	original, originalBytes := createTx(0, 20)
+	suite.Require().NoError(checkTx(checkCtx, original, originalBytes))
+	suite.Require().Equal(uint64(1), sequence(checkCtx))
+
+	// a tx with the same sequence and priority does not replace the pending tx
+	sameFee, sameFeeBytes := createTx(0, 20)
+	sameFeeBytes = append(sameFeeBytes, 0) // make the tx hash differ
+	suite.Require().ErrorIs(checkTx(checkCtx, sameFee, sameFeeBytes), sdkerrors.ErrInsufficientFee)
+
+	// the priority of the replacement must be at least 10% higher
+	smallBump, smallBumpBytes := createTx(0, 21)
+	suite.Require().ErrorIs(checkTx(checkCtx, smallBump, smallBumpBytes), sdkerrors.ErrInsufficientFee)
+	suite.Require().Equal(uint64(1), sequence(checkCtx))
+
+	// a tx with the same sequence and a high enough priority replaces the pending tx
+	replacement, replacementBytes := createTx(0, 22)
+	suite.Require().NoError(checkTx(checkCtx, replacement, replacementBytes))
+	suite.Require().Equal(uint64(1), sequence(checkCtx))
+
+	// only the latest pending tx of the signer can be replaced
+	next, nextBytes := createTx(1, 20)
+	suite.Require().NoError(checkTx(checkCtx, next, nextBytes))
+	replacement2, replacement2Bytes := createTx(0, 30)
+	suite.Require().ErrorIs(checkTx(checkCtx, replacement2, replacement2Bytes), sdkerrors.ErrWrongSequence)
+
+	// once a block is committed, the replaced tx fails the recheck
+	recheckCtx, _ := suite.ctx.WithIsReCheckTx(true).CacheContext()
+	suite.Require().ErrorIs(checkTx(recheckCtx, original, originalBytes), sdkerrors.ErrWrongSequence)
+	suite.Require().NoError(checkTx(recheckCtx, replacement, replacementBytes))
+
+	// a tx is no longer replaceable once it is delivered
+	_, err := suite.anteHandler(suite.ctx.WithTxBytes(replacementBytes), replacement, false)
+	suite.Require().NoError(err)
+
+	checkCtx, _ = suite.ctx.CacheContext()
+	replacement3, replacement3Bytes := createTx(0, 40)
+	suite.Require().ErrorIs(checkTx(checkCtx, replacement3, replacement3Bytes), sdkerrors.ErrWrongSequence)
+}
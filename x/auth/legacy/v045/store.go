@@ -0,0 +1,25 @@
+package v045
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// migrateParams sets the params which did not exist before:
+//
+// - the msg fees param, to its default value
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	if !paramSpace.Has(ctx, types.KeyMsgFees) {
+		paramSpace.Set(ctx, types.KeyMsgFees, types.DefaultParams().MsgFees)
+	}
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Setting the msg fees param
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	migrateParams(ctx, paramSpace)
+	return nil
+}
@@ -0,0 +1,32 @@
+package v045_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v045auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, tParamsKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	require.False(t, paramSpace.Has(ctx, types.KeyMsgFees))
+
+	require.NoError(t, v045auth.MigrateStore(ctx, paramSpace))
+
+	var msgFees []types.MsgFee
+	paramSpace.Get(ctx, types.KeyMsgFees, &msgFees)
+	require.True(t, paramSpace.Has(ctx, types.KeyMsgFees))
+	require.Empty(t, msgFees)
+}
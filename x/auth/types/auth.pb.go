@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -113,6 +115,8 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	// msg_fees defines the minimum fee and the gas surcharge of message types.
+	MsgFees []MsgFee `protobuf:"bytes,6,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees" yaml:"msg_fees,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,59 +186,143 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetMsgFees() []MsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+// MsgFee defines the minimum fee and the fixed gas surcharge of each message of
+// a type in a tx.
+type MsgFee struct {
+	// msg_type_url is the type URL of the message, as returned by sdk.MsgTypeURL.
+	MsgTypeURL string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// min_fee is the minimum fee to pay for each message of the type.
+	MinFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=min_fee,json=minFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee" yaml:"min_fee"`
+	// gas_surcharge is the gas consumed for each message of the type, in addition
+	// to the gas consumed by its execution.
+	GasSurcharge uint64 `protobuf:"varint,3,opt,name=gas_surcharge,json=gasSurcharge,proto3" json:"gas_surcharge,omitempty" yaml:"gas_surcharge"`
+}
+
+func (m *MsgFee) Reset()      { *m = MsgFee{} }
+func (*MsgFee) ProtoMessage() {}
+func (*MsgFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *MsgFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFee.Merge(m, src)
+}
+func (m *MsgFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFee proto.InternalMessageInfo
+
+func (m *MsgFee) GetMsgTypeURL() string {
+	if m != nil {
+		return m.MsgTypeURL
+	}
+	return ""
+}
+
+func (m *MsgFee) GetMinFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFee
+	}
+	return nil
+}
+
+func (m *MsgFee) GetGasSurcharge() uint64 {
+	if m != nil {
+		return m.GasSurcharge
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*MsgFee)(nil), "cosmos.auth.v1beta1.MsgFee")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x5f, 0xf2, 0xf8, 0x98, 0x00, 0x12, 0x26, 0x80, 0x93, 0xf7, 0x64, 0x5b, 0x5e, 0xe5,
-	0x49, 0x2f, 0x8e, 0x92, 0x8a, 0x4a, 0x64, 0x51, 0x15, 0xd3, 0x2e, 0x50, 0x0b, 0x42, 0x46, 0xea,
-	0xa2, 0xaa, 0xe4, 0x8e, 0x9d, 0xc1, 0x58, 0x64, 0x32, 0xc6, 0x33, 0x46, 0x31, 0xbf, 0xa0, 0xcb,
-	0x2e, 0xbb, 0xe4, 0x47, 0xf0, 0x0f, 0xba, 0xe9, 0x12, 0xb1, 0xea, 0xca, 0xad, 0xc2, 0xa6, 0xea,
-	0x32, 0xfb, 0x4a, 0x95, 0x67, 0x9c, 0x90, 0xa0, 0x74, 0x95, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0x9d,
-	0x7b, 0xe3, 0x01, 0xaa, 0x47, 0x28, 0x26, 0xb4, 0x09, 0x63, 0x76, 0xd6, 0xbc, 0x6c, 0xb9, 0x88,
-	0xc1, 0x16, 0x0f, 0xcc, 0x30, 0x22, 0x8c, 0xc8, 0x1b, 0x82, 0x37, 0x39, 0x94, 0xf3, 0xb5, 0xaa,
-	0x00, 0x1d, 0x2e, 0x69, 0xe6, 0x0a, 0x1e, 0xd4, 0x2a, 0x3e, 0xf1, 0x89, 0xc0, 0xb3, 0x53, 0x8e,
-	0x56, 0x7d, 0x42, 0xfc, 0x1e, 0x6a, 0xf2, 0xc8, 0x8d, 0x4f, 0x9b, 0xb0, 0x9f, 0x08, 0xca, 0xf8,
-	0x25, 0x81, 0xb2, 0x05, 0x29, 0xda, 0xf3, 0x3c, 0x12, 0xf7, 0x99, 0xac, 0x80, 0x45, 0xd8, 0xed,
-	0x46, 0x88, 0x52, 0x45, 0xd2, 0xa5, 0xfa, 0xb2, 0x3d, 0x0e, 0xe5, 0x77, 0x60, 0x31, 0x8c, 0x5d,
-	0xe7, 0x1c, 0x25, 0xca, 0x5f, 0xba, 0x54, 0x2f, 0xb7, 0x2b, 0xa6, 0xb0, 0x35, 0xc7, 0xb6, 0xe6,
-	0x5e, 0x3f, 0xb1, 0x1a, 0x3f, 0x53, 0xad, 0x12, 0xc6, 0x6e, 0x2f, 0xf0, 0x32, 0xed, 0xff, 0x04,
-	0x07, 0x0c, 0xe1, 0x90, 0x25, 0xa3, 0x54, 0x5b, 0x4f, 0x20, 0xee, 0x75, 0x8c, 0x07, 0xd6, 0xb0,
-	0x17, 0xc2, 0xd8, 0x7d, 0x85, 0x12, 0xf9, 0x39, 0x58, 0x83, 0xa2, 0x05, 0xa7, 0x1f, 0x63, 0x17,
-	0x45, 0x4a, 0x51, 0x97, 0xea, 0x25, 0xab, 0x3a, 0x4a, 0xb5, 0x4d, 0x91, 0x36, 0xcb, 0x1b, 0xf6,
-	0x6a, 0x0e, 0x1c, 0xf1, 0x58, 0xae, 0x81, 0x25, 0x8a, 0x2e, 0x62, 0xd4, 0xf7, 0x90, 0x52, 0xca,
-	0x72, 0xed, 0x49, 0xdc, 0x51, 0x3e, 0x5c, 0x6b, 0x85, 0x4f, 0xd7, 0x5a, 0xe1, 0xc7, 0xb5, 0x56,
-	0xb8, 0xbb, 0x69, 0x2c, 0xe5, 0xd7, 0x3d, 0x30, 0x3e, 0x4b, 0x60, 0xf5, 0x90, 0x74, 0xe3, 0xde,
-	0x64, 0x02, 0xef, 0xc1, 0x8a, 0x0b, 0x29, 0x72, 0x72, 0x77, 0x3e, 0x86, 0x72, 0x5b, 0x37, 0xe7,
-	0x6c, 0xc2, 0x9c, 0x9a, 0x9c, 0xf5, 0xcf, 0x6d, 0xaa, 0x49, 0xa3, 0x54, 0xdb, 0x10, 0xdd, 0x4e,
-	0x7b, 0x18, 0x76, 0xd9, 0x9d, 0x9a, 0xb1, 0x0c, 0x4a, 0x7d, 0x88, 0x11, 0x1f, 0xe3, 0xb2, 0xcd,
-	0xcf, 0xb2, 0x0e, 0xca, 0x21, 0x8a, 0x70, 0x40, 0x69, 0x40, 0xfa, 0x54, 0x29, 0xea, 0xc5, 0xfa,
-	0xb2, 0x3d, 0x0d, 0x75, 0x6a, 0xe3, 0x3b, 0xdc, 0xdd, 0x34, 0xd6, 0x66, 0x5a, 0x3e, 0x30, 0xbe,
-	0x15, 0xc1, 0xc2, 0x31, 0x8c, 0x20, 0xa6, 0xf2, 0x11, 0xd8, 0xc0, 0x70, 0xe0, 0x60, 0x84, 0x89,
-	0xe3, 0x9d, 0xc1, 0x08, 0x7a, 0x0c, 0x45, 0x62, 0x99, 0x25, 0x4b, 0x1d, 0xa5, 0x5a, 0x4d, 0xf4,
-	0x37, 0x47, 0x64, 0xd8, 0xeb, 0x18, 0x0e, 0x0e, 0x11, 0x26, 0xfb, 0x13, 0x4c, 0xde, 0x05, 0x2b,
-	0x6c, 0xe0, 0xd0, 0xc0, 0x77, 0x7a, 0x01, 0x0e, 0x18, 0x6f, 0xba, 0x64, 0x6d, 0x3f, 0x5c, 0x74,
-	0x9a, 0x35, 0x6c, 0xc0, 0x06, 0x27, 0x81, 0xff, 0x3a, 0x0b, 0x64, 0x1b, 0x6c, 0x72, 0xf2, 0x0a,
-	0x39, 0x1e, 0xa1, 0xcc, 0x09, 0x51, 0xe4, 0xb8, 0x09, 0x43, 0xf9, 0x6a, 0xf5, 0x51, 0xaa, 0xfd,
-	0x3b, 0xe5, 0xf1, 0x58, 0x66, 0xd8, 0xeb, 0x99, 0xd9, 0x15, 0xda, 0x27, 0x94, 0x1d, 0xa3, 0xc8,
-	0x4a, 0x18, 0x92, 0x2f, 0xc0, 0x76, 0x56, 0xed, 0x12, 0x45, 0xc1, 0x69, 0x22, 0xf4, 0xa8, 0xdb,
-	0xde, 0xd9, 0x69, 0xed, 0x8a, 0xa5, 0x5b, 0x9d, 0x61, 0xaa, 0x55, 0x4e, 0x02, 0xff, 0x0d, 0x57,
-	0x64, 0xa9, 0x2f, 0x5f, 0x70, 0x7e, 0x94, 0x6a, 0xaa, 0xa8, 0xf6, 0x07, 0x03, 0xc3, 0xae, 0xd0,
-	0x99, 0x3c, 0x01, 0xcb, 0x09, 0xa8, 0x3e, 0xce, 0xa0, 0xc8, 0x0b, 0xdb, 0x3b, 0x4f, 0xcf, 0x5b,
-	0xca, 0xdf, 0xbc, 0xe8, 0xb3, 0x61, 0xaa, 0x6d, 0xcd, 0x14, 0x3d, 0x19, 0x2b, 0x46, 0xa9, 0xa6,
-	0xcf, 0x2f, 0x3b, 0x31, 0x31, 0xec, 0x2d, 0x3a, 0x37, 0xb7, 0xb3, 0x94, 0xff, 0x67, 0x25, 0x6b,
-	0xff, 0xcb, 0x50, 0x95, 0x6e, 0x87, 0xaa, 0xf4, 0x7d, 0xa8, 0x4a, 0x1f, 0xef, 0xd5, 0xc2, 0xed,
-	0xbd, 0x5a, 0xf8, 0x7a, 0xaf, 0x16, 0xde, 0xfe, 0xe7, 0x07, 0xec, 0x2c, 0x76, 0x4d, 0x8f, 0xe0,
-	0xfc, 0x2d, 0xc8, 0x7f, 0x1a, 0xb4, 0x7b, 0xde, 0x1c, 0x88, 0xa7, 0x85, 0x25, 0x21, 0xa2, 0xee,
-	0x02, 0xff, 0x52, 0x9f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x49, 0x90, 0x16, 0xd9, 0x76, 0x04,
-	0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xdb, 0x90, 0xb6, 0x93, 0xb6, 0x52, 0xdd, 0xec, 0xae, 0x93, 0x45, 0x1e, 0x63, 0x09,
+	0x29, 0x48, 0x34, 0x51, 0x83, 0x8a, 0xb4, 0x91, 0x40, 0xac, 0xcb, 0x0f, 0xad, 0xd8, 0xae, 0x56,
+	0x13, 0x40, 0x02, 0x21, 0x99, 0xb1, 0x33, 0x75, 0xad, 0x66, 0x3c, 0x5e, 0x8f, 0x5d, 0xc5, 0xfb,
+	0x17, 0x70, 0x44, 0x9c, 0x38, 0xf6, 0xcc, 0x79, 0x4f, 0x5c, 0xb9, 0xec, 0xb1, 0xda, 0x13, 0x27,
+	0x83, 0xd2, 0x0b, 0xe2, 0xe8, 0x3b, 0x12, 0xf2, 0x8c, 0x9d, 0x1f, 0xab, 0xb0, 0xa7, 0xe4, 0xbd,
+	0xef, 0x7d, 0xdf, 0xfb, 0xe6, 0x3d, 0xcf, 0x00, 0xdd, 0x65, 0x9c, 0x32, 0xde, 0xc7, 0x49, 0x7c,
+	0xd1, 0xbf, 0x3a, 0x76, 0x48, 0x8c, 0x8f, 0x45, 0xd0, 0x0b, 0x23, 0x16, 0x33, 0xf5, 0x50, 0xe2,
+	0x3d, 0x91, 0x2a, 0xf1, 0x4e, 0x45, 0x72, 0x30, 0x27, 0x73, 0x92, 0xcb, 0xfc, 0x40, 0x92, 0x3a,
+	0x6d, 0x89, 0xdb, 0x22, 0xea, 0x97, 0x0a, 0x12, 0x6a, 0x79, 0xcc, 0x63, 0x32, 0x5f, 0xfc, 0xab,
+	0x08, 0x1e, 0x63, 0xde, 0x84, 0xf4, 0x45, 0xe4, 0x24, 0xe7, 0x7d, 0x1c, 0xa4, 0x12, 0x32, 0xff,
+	0x55, 0x40, 0xd3, 0xc2, 0x9c, 0x3c, 0x74, 0x5d, 0x96, 0x04, 0xb1, 0xaa, 0x81, 0x2d, 0x3c, 0x1e,
+	0x47, 0x84, 0x73, 0x4d, 0x31, 0x94, 0xee, 0x0e, 0xaa, 0x42, 0xf5, 0x7b, 0xb0, 0x15, 0x26, 0x8e,
+	0x7d, 0x49, 0x52, 0x6d, 0xc3, 0x50, 0xba, 0xcd, 0x41, 0xab, 0x27, 0x65, 0x7b, 0x95, 0x6c, 0xef,
+	0x61, 0x90, 0x5a, 0x47, 0xff, 0x64, 0xb0, 0x15, 0x26, 0xce, 0xc4, 0x77, 0x8b, 0xda, 0xf7, 0x19,
+	0xf5, 0x63, 0x42, 0xc3, 0x38, 0xcd, 0x33, 0x78, 0x90, 0x62, 0x3a, 0x19, 0x9a, 0x0b, 0xd4, 0x44,
+	0x8d, 0x30, 0x71, 0xbe, 0x24, 0xa9, 0xfa, 0x09, 0xd8, 0xc7, 0xd2, 0x82, 0x1d, 0x24, 0xd4, 0x21,
+	0x91, 0xb6, 0x69, 0x28, 0xdd, 0xba, 0xd5, 0xce, 0x33, 0x78, 0x47, 0xd2, 0x56, 0x71, 0x13, 0xed,
+	0x95, 0x89, 0x27, 0x22, 0x56, 0x3b, 0x60, 0x9b, 0x93, 0x67, 0x09, 0x09, 0x5c, 0xa2, 0xd5, 0x0b,
+	0x2e, 0x9a, 0xc7, 0x43, 0xed, 0xc7, 0x6b, 0x58, 0xfb, 0xe5, 0x1a, 0xd6, 0xfe, 0xbe, 0x86, 0xb5,
+	0x57, 0x2f, 0x8e, 0xb6, 0xcb, 0xe3, 0x3e, 0x32, 0x7f, 0x57, 0xc0, 0xde, 0x19, 0x1b, 0x27, 0x93,
+	0xf9, 0x04, 0x7e, 0x00, 0xbb, 0xc5, 0xe0, 0xed, 0x52, 0x5d, 0x8c, 0xa1, 0x39, 0x30, 0x7a, 0x6b,
+	0x36, 0xd5, 0x5b, 0x9a, 0x9c, 0x75, 0xff, 0x26, 0x83, 0x4a, 0x9e, 0xc1, 0x43, 0xe9, 0x76, 0x59,
+	0xc3, 0x44, 0x4d, 0x67, 0x69, 0xc6, 0x2a, 0xa8, 0x07, 0x98, 0x12, 0x31, 0xc6, 0x1d, 0x24, 0xfe,
+	0xab, 0x06, 0x68, 0x86, 0x24, 0xa2, 0x3e, 0xe7, 0x3e, 0x0b, 0xb8, 0xb6, 0x69, 0x6c, 0x76, 0x77,
+	0xd0, 0x72, 0x6a, 0xd8, 0xa9, 0xce, 0xf0, 0xea, 0xc5, 0xd1, 0xfe, 0x8a, 0xe5, 0x47, 0xe6, 0x6f,
+	0x75, 0xd0, 0x78, 0x8a, 0x23, 0x4c, 0xb9, 0xfa, 0x04, 0x1c, 0x52, 0x3c, 0xb5, 0x29, 0xa1, 0xcc,
+	0x76, 0x2f, 0x70, 0x84, 0xdd, 0x98, 0x44, 0x72, 0x99, 0x75, 0x4b, 0xcf, 0x33, 0xd8, 0x91, 0xfe,
+	0xd6, 0x14, 0x99, 0xe8, 0x80, 0xe2, 0xe9, 0x19, 0xa1, 0xec, 0x74, 0x9e, 0x53, 0x1f, 0x80, 0xdd,
+	0x78, 0x6a, 0x73, 0xdf, 0xb3, 0x27, 0x3e, 0xf5, 0x63, 0x61, 0xba, 0x6e, 0xdd, 0x5b, 0x1c, 0x74,
+	0x19, 0x35, 0x11, 0x88, 0xa7, 0x23, 0xdf, 0x7b, 0x5c, 0x04, 0x2a, 0x02, 0x77, 0x04, 0xf8, 0x9c,
+	0xd8, 0x2e, 0xe3, 0xb1, 0x1d, 0x92, 0xc8, 0x76, 0xd2, 0x98, 0x94, 0xab, 0x35, 0xf2, 0x0c, 0xbe,
+	0xbd, 0xa4, 0xf1, 0x7a, 0x99, 0x89, 0x0e, 0x0a, 0xb1, 0xe7, 0xe4, 0x94, 0xf1, 0xf8, 0x29, 0x89,
+	0xac, 0x34, 0x26, 0xea, 0x33, 0x70, 0xaf, 0xe8, 0x76, 0x45, 0x22, 0xff, 0x3c, 0x95, 0xf5, 0x64,
+	0x3c, 0x38, 0x39, 0x39, 0x7e, 0x20, 0x97, 0x6e, 0x0d, 0x67, 0x19, 0x6c, 0x8d, 0x7c, 0xef, 0x1b,
+	0x51, 0x51, 0x50, 0x3f, 0xfb, 0x54, 0xe0, 0x79, 0x06, 0x75, 0xd9, 0xed, 0x7f, 0x04, 0x4c, 0xd4,
+	0xe2, 0x2b, 0x3c, 0x99, 0x56, 0x53, 0xd0, 0x7e, 0x9d, 0xc1, 0x89, 0x1b, 0x0e, 0x4e, 0x3e, 0xbc,
+	0x3c, 0xd6, 0xde, 0x12, 0x4d, 0x3f, 0x9e, 0x65, 0xf0, 0xee, 0x4a, 0xd3, 0x51, 0x55, 0x91, 0x67,
+	0xd0, 0x58, 0xdf, 0x76, 0x2e, 0x62, 0xa2, 0xbb, 0x7c, 0x2d, 0x57, 0xfd, 0x16, 0x6c, 0x53, 0xee,
+	0xd9, 0xe7, 0x84, 0x70, 0xad, 0x61, 0x6c, 0x76, 0x9b, 0x83, 0xfb, 0x6b, 0xbf, 0xc3, 0x33, 0xee,
+	0x7d, 0x4e, 0x88, 0xf5, 0xce, 0xcb, 0x0c, 0xd6, 0xf2, 0x0c, 0xb6, 0xcb, 0x15, 0x97, 0xd4, 0xc5,
+	0x1d, 0x34, 0xd1, 0x16, 0x15, 0xa5, 0x7c, 0xb8, 0x5d, 0x5e, 0x07, 0xc5, 0xfc, 0x79, 0x03, 0x34,
+	0xa4, 0x80, 0xfa, 0x05, 0xd8, 0x2d, 0x48, 0x71, 0x1a, 0x12, 0x3b, 0x89, 0x26, 0xf2, 0x09, 0xb0,
+	0xde, 0x9d, 0x65, 0x10, 0x9c, 0x71, 0xef, 0xab, 0x34, 0x24, 0x5f, 0xa3, 0xc7, 0x8b, 0xd5, 0x2f,
+	0xd7, 0x9a, 0x08, 0xd0, 0xb2, 0x24, 0x9a, 0xa8, 0x57, 0x60, 0x8b, 0xfa, 0x41, 0xd1, 0x5d, 0xdb,
+	0x10, 0xbe, 0xdb, 0x95, 0xef, 0xe2, 0x22, 0xcc, 0x7d, 0x9f, 0x32, 0x3f, 0xb0, 0xac, 0xd2, 0xf5,
+	0x7e, 0x29, 0x2a, 0x79, 0xe6, 0xaf, 0x7f, 0xc2, 0xae, 0xe7, 0xc7, 0x17, 0x89, 0xd3, 0x73, 0x19,
+	0x2d, 0x9f, 0xb9, 0xf2, 0xe7, 0x88, 0x8f, 0x2f, 0xfb, 0x45, 0x67, 0x2e, 0x24, 0x38, 0x6a, 0x50,
+	0x3f, 0x28, 0x0e, 0xf0, 0x11, 0xd8, 0xf3, 0x30, 0xb7, 0x79, 0x12, 0x15, 0x9f, 0xb5, 0x57, 0x7d,
+	0x6a, 0x5a, 0x9e, 0xc1, 0x96, 0x94, 0x5f, 0x81, 0x4d, 0xb4, 0xeb, 0x61, 0x3e, 0xaa, 0xc2, 0xc5,
+	0x50, 0xac, 0xd3, 0x97, 0x33, 0x5d, 0xb9, 0x99, 0xe9, 0xca, 0x5f, 0x33, 0x5d, 0xf9, 0xe9, 0x56,
+	0xaf, 0xdd, 0xdc, 0xea, 0xb5, 0x3f, 0x6e, 0xf5, 0xda, 0x77, 0xef, 0xbd, 0xd1, 0xd4, 0x54, 0x3e,
+	0xf5, 0xc2, 0x9b, 0xd3, 0x10, 0x2f, 0xe3, 0x07, 0xff, 0x0d, 0x00, 0xf1, 0x3d, 0xaf, 0x2c, 0x06,
+	0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -271,6 +359,49 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if len(this.MsgFees) != len(that1.MsgFees) {
+		return false
+	}
+	for i := range this.MsgFees {
+		if !this.MsgFees[i].Equal(&that1.MsgFees[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFee)
+	if !ok {
+		that2, ok := that.(MsgFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeURL != that1.MsgTypeURL {
+		return false
+	}
+	if len(this.MinFee) != len(that1.MinFee) {
+		return false
+	}
+	for i := range this.MinFee {
+		if !this.MinFee[i].Equal(&that1.MinFee[i]) {
+			return false
+		}
+	}
+	if this.GasSurcharge != that1.GasSurcharge {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -396,6 +527,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -424,6 +569,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasSurcharge != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.GasSurcharge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MinFee) > 0 {
+		for iNdEx := len(m.MinFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeURL) > 0 {
+		i -= len(m.MsgTypeURL)
+		copy(dAtA[i:], m.MsgTypeURL)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypeURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -502,6 +696,34 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeURL)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.MinFee) > 0 {
+		for _, e := range m.MinFee {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.GasSurcharge != 0 {
+		n += 1 + sovAuth(uint64(m.GasSurcharge))
+	}
 	return n
 }
 
@@ -941,6 +1163,175 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFee = append(m.MinFee, types1.Coin{})
+			if err := m.MinFee[len(m.MinFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSurcharge", wireType)
+			}
+			m.GasSurcharge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasSurcharge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -6,6 +6,6 @@ import (
 
 // BankKeeper defines the contract needed for supply related APIs (noalias)
 type BankKeeper interface {
-	GetBurntFeeDenom(ctx sdk.Context) string
+	CalculateBurntFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -24,13 +26,14 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyMsgFees                = []byte("MsgFees")
 )
 
 var _ paramtypes.ParamSet = &Params{}
 
 // NewParams creates a new Params object
 func NewParams(
-	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
+	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64, msgFees []MsgFee,
 ) Params {
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -38,6 +41,7 @@ func NewParams(
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		MsgFees:                msgFees,
 	}
 }
 
@@ -55,6 +59,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyMsgFees, &p.MsgFees, validateMsgFees),
 	}
 }
 
@@ -85,6 +90,65 @@ func (p Params) String() string {
 	return string(out)
 }
 
+// MsgFee returns the minimum fee and gas surcharge of the given message type
+// URL, and false if the message type has none.
+func (p Params) MsgFee(msgTypeURL string) (MsgFee, bool) {
+	for _, mf := range p.MsgFees {
+		if mf.MsgTypeURL == msgTypeURL {
+			return mf, true
+		}
+	}
+
+	return MsgFee{}, false
+}
+
+// NewMsgFee creates a new MsgFee object
+func NewMsgFee(msgTypeURL string, minFee sdk.Coins, gasSurcharge uint64) MsgFee {
+	return MsgFee{
+		MsgTypeURL:   msgTypeURL,
+		MinFee:       minFee,
+		GasSurcharge: gasSurcharge,
+	}
+}
+
+// String implements the stringer interface.
+func (mf MsgFee) String() string {
+	out, _ := yaml.Marshal(mf)
+	return string(out)
+}
+
+func validateMsgFees(i interface{}) error {
+	msgFees, ok := i.([]MsgFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// ensure each message type is only registered one time.
+	registered := make(map[string]bool)
+	for _, mf := range msgFees {
+		if registered[mf.MsgTypeURL] {
+			return fmt.Errorf("duplicate msg fee parameter found: '%s'", mf.MsgTypeURL)
+		}
+		if err := validateMsgFee(mf); err != nil {
+			return err
+		}
+		registered[mf.MsgTypeURL] = true
+	}
+
+	return nil
+}
+
+func validateMsgFee(mf MsgFee) error {
+	if !strings.HasPrefix(mf.MsgTypeURL, "/") || len(strings.TrimSpace(mf.MsgTypeURL)) < 2 {
+		return fmt.Errorf("invalid msg type url: '%s'", mf.MsgTypeURL)
+	}
+	if err := mf.MinFee.Validate(); err != nil {
+		return fmt.Errorf("invalid min fee of %s: %w", mf.MsgTypeURL, err)
+	}
+
+	return nil
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -167,6 +231,9 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateMsgFees(p.MsgFees); err != nil {
+		return err
+	}
 
 	return nil
 }
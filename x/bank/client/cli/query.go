@@ -14,7 +14,10 @@ import (
 )
 
 const (
-	FlagDenom = "denom"
+	FlagDenom       = "denom"
+	FlagSource      = "source"
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
 )
 
 // GetQueryCmd returns the parent command for all x/bank CLi query commands. The
@@ -34,6 +37,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 		GetCmdQueryTotalDeflation(),
+		GetCmdQueryDeflationBySource(),
 	)
 
 	return cmd
@@ -271,4 +275,62 @@ To query for the total deflation of a specific coin denomination use:
 	return cmd
 }
 
+func GetCmdQueryDeflationBySource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deflation-by-source",
+		Short: "Query the deflation of coins by source module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deflation of coins by the module account they were burnt from.
+
+Example:
+  $ %s query %s deflation-by-source
+
+To query for the deflation of a specific module account use:
+  $ %s query %s deflation-by-source --source=[module-name]
 
+To query for the deflation over a range of heights of the deflation history use:
+  $ %s query %s deflation-by-source --start-height=[height] --end-height=[height]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			source, err := cmd.Flags().GetString(FlagSource)
+			if err != nil {
+				return err
+			}
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeflationBySource(cmd.Context(), &types.QueryDeflationBySourceRequest{
+				Source:      source,
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSource, "", "The module account the coins were burnt from")
+	cmd.Flags().Int64(FlagStartHeight, 0, "The first height of the deflation history to query")
+	cmd.Flags().Int64(FlagEndHeight, 0, "The last height of the deflation history to query, defaults to the latest height")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// CalculateBurntFees returns the part of the fees which is burnt, as defined by
+// the burn ratio of each denom. The burnt amounts are truncated.
+func (k BaseKeeper) CalculateBurntFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	params := k.GetParams(ctx)
+
+	burntFees := sdk.NewCoins()
+	for _, fee := range fees {
+		amount := fee.Amount.ToDec().MulTruncate(params.BurnRatioOf(fee.Denom)).TruncateInt()
+		burntFees = burntFees.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+
+	return burntFees
+}
+
+// BurnCollectedFees burns the fees collected by the burnt fee collector, one
+// denom at a time so that a burn event is emitted for each denom.
+func (k BaseKeeper) BurnCollectedFees(ctx sdk.Context) {
+	burntFeeCollector := k.ak.GetModuleAccount(ctx, authtypes.BurntFeeCollectorName)
+	if burntFeeCollector == nil {
+		return
+	}
+
+	for _, fee := range k.GetAllBalances(ctx, burntFeeCollector.GetAddress()) {
+		if err := k.BurnCoins(ctx, authtypes.BurntFeeCollectorName, sdk.NewCoins(fee)); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetDeflationBySource retrieves the deflation of a denom burnt from a source
+// module account.
+func (k BaseKeeper) GetDeflationBySource(ctx sdk.Context, source, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.DeflationBySourceKey(source, denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	return sdk.NewCoin(denom, unmarshalDeflationAmount(bz))
+}
+
+// GetDeflationOfSource retrieves the deflation of all the denoms burnt from a
+// source module account.
+func (k BaseKeeper) GetDeflationOfSource(ctx sdk.Context, source string) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	sourceStore := prefix.NewStore(store, types.CreateDeflationBySourcePrefix(source))
+
+	iterator := sourceStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	deflation := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		deflation = deflation.Add(sdk.NewCoin(string(iterator.Key()), unmarshalDeflationAmount(iterator.Value())))
+	}
+
+	return deflation
+}
+
+// IterateDeflationBySource iterates over the deflation of all the source module
+// accounts and denoms, and performs a callback function.
+func (k BaseKeeper) IterateDeflationBySource(ctx sdk.Context, cb func(source string, coin sdk.Coin) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	deflationStore := prefix.NewStore(store, types.DeflationBySourcePrefix)
+
+	iterator := deflationStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		source, denom := types.SplitDeflationBySourceKey(iterator.Key())
+		if cb(source, sdk.NewCoin(denom, unmarshalDeflationAmount(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+// GetAllDeflationBySource returns the deflation of all the source module
+// accounts.
+func (k BaseKeeper) GetAllDeflationBySource(ctx sdk.Context) []types.DeflationSource {
+	var deflation []types.DeflationSource
+	k.IterateDeflationBySource(ctx, func(source string, coin sdk.Coin) bool {
+		deflation = addDeflationSource(deflation, source, coin)
+		return false
+	})
+
+	return deflation
+}
+
+// GetDeflationHistory returns the deflation of the source module accounts
+// between the startHeight and endHeight inclusive, as recorded in the
+// deflation history. Only the given source is returned if not empty.
+func (k BaseKeeper) GetDeflationHistory(ctx sdk.Context, source string, startHeight, endHeight int64) []types.DeflationSource {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.DeflationHistoryHeightPrefix(startHeight), types.DeflationHistoryHeightPrefix(endHeight+1))
+	defer iterator.Close()
+
+	var deflation []types.DeflationSource
+	for ; iterator.Valid(); iterator.Next() {
+		_, entrySource, denom := types.SplitDeflationHistoryKey(iterator.Key()[len(types.DeflationHistoryPrefix):])
+		if source != "" && entrySource != source {
+			continue
+		}
+
+		deflation = addDeflationSource(deflation, entrySource, sdk.NewCoin(denom, unmarshalDeflationAmount(iterator.Value())))
+	}
+
+	return deflation
+}
+
+// GetDeflationHistoryStartHeight returns the first height kept in the deflation
+// history at the current height. The history is disabled if the number of
+// blocks kept is zero, in which case the current height plus one is returned.
+func (k BaseKeeper) GetDeflationHistoryStartHeight(ctx sdk.Context) int64 {
+	historyBlocks := int64(k.GetParams(ctx).DeflationHistoryBlocks)
+
+	startHeight := ctx.BlockHeight() - historyBlocks + 1
+	if startHeight < 0 {
+		startHeight = 0
+	}
+
+	return startHeight
+}
+
+// PruneDeflationHistory deletes the deflation history entries which are older
+// than the number of blocks to keep.
+func (k BaseKeeper) PruneDeflationHistory(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.DeflationHistoryPrefix, types.DeflationHistoryHeightPrefix(k.GetDeflationHistoryStartHeight(ctx)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// trackDeflation adds the coins burnt from a source module account to the total
+// deflation, to the deflation of the source and to the deflation history.
+func (k BaseKeeper) trackDeflation(ctx sdk.Context, source string, amounts sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	keepHistory := k.GetParams(ctx).DeflationHistoryBlocks > 0
+
+	for _, amount := range amounts {
+		deflation := k.GetDeflation(ctx, amount.GetDenom())
+		deflation = deflation.Add(amount)
+		k.setDeflation(ctx, deflation)
+
+		sourceDeflation := k.GetDeflationBySource(ctx, source, amount.GetDenom())
+		sourceDeflation = sourceDeflation.Add(amount)
+		k.setDeflationBySource(ctx, source, sourceDeflation)
+
+		if keepHistory {
+			historyKey := types.DeflationHistoryKey(ctx.BlockHeight(), source, amount.GetDenom())
+
+			burnt := amount.Amount
+			if bz := store.Get(historyKey); bz != nil {
+				burnt = burnt.Add(unmarshalDeflationAmount(bz))
+			}
+			store.Set(historyKey, marshalDeflationAmount(burnt))
+		}
+	}
+}
+
+// setDeflationBySource sets the deflation of a source module account for the
+// given coin.
+func (k BaseKeeper) setDeflationBySource(ctx sdk.Context, source string, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.DeflationBySourceKey(source, coin.GetDenom())
+
+	if coin.IsZero() {
+		store.Delete(key)
+	} else {
+		store.Set(key, marshalDeflationAmount(coin.Amount))
+	}
+}
+
+// addDeflationSource adds a coin to the deflation of a source, the sources keep
+// the order in which they are first added.
+func addDeflationSource(deflation []types.DeflationSource, source string, coin sdk.Coin) []types.DeflationSource {
+	for i := range deflation {
+		if deflation[i].Source == source {
+			deflation[i].Amount = deflation[i].Amount.Add(coin)
+			return deflation
+		}
+	}
+
+	return append(deflation, types.NewDeflationSource(source, sdk.NewCoins(coin)))
+}
+
+func marshalDeflationAmount(amount sdk.Int) []byte {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal amount value %v", err))
+	}
+	return bz
+}
+
+func unmarshalDeflationAmount(bz []byte) sdk.Int {
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("unable to unmarshal deflation value %v", err))
+	}
+	return amount
+}
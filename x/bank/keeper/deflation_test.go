@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *IntegrationTestSuite) TestDeflationBySource() {
+	ctx := suite.ctx.WithBlockHeight(10)
+	require := suite.Require()
+
+	authKeeper, keeper := suite.initKeepersWithmAccPerms(make(map[string]bool))
+	authKeeper.SetModuleAccount(ctx, burnerAcc)
+	authKeeper.SetModuleAccount(ctx, multiPermAcc)
+
+	burnt := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, burnt.Add(burnt...)))
+	require.NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, authtypes.Burner, burnt))
+	require.NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, multiPerm, burnt))
+
+	// burn twice at height 10 from the burner and once at height 12 from the
+	// multiple permissions account
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.BurnCoins(ctx, authtypes.Burner, sdk.NewCoins(newFooCoin(30))))
+	require.NoError(keeper.BurnCoins(ctx, authtypes.Burner, sdk.NewCoins(newFooCoin(20), newBarCoin(50))))
+
+	event := ctx.EventManager().Events()[len(ctx.EventManager().Events())-1]
+	require.Equal(types.EventTypeCoinBurn, event.Type)
+	require.Contains(event.Attributes, sdk.NewAttribute(types.AttributeKeySource, authtypes.Burner).ToKVPair())
+
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(keeper.BurnCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(40))))
+
+	require.Equal(newFooCoin(90), keeper.GetDeflation(ctx, fooDenom))
+	require.Equal(newFooCoin(50), keeper.GetDeflationBySource(ctx, authtypes.Burner, fooDenom))
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(50)), keeper.GetDeflationOfSource(ctx, authtypes.Burner))
+	require.Equal(sdk.NewCoins(newFooCoin(40)), keeper.GetDeflationOfSource(ctx, multiPerm))
+
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// total deflation by source
+	res, err := keeper.DeflationBySource(goCtx, &types.QueryDeflationBySourceRequest{})
+	require.NoError(err)
+	require.ElementsMatch([]types.DeflationSource{
+		types.NewDeflationSource(authtypes.Burner, sdk.NewCoins(newFooCoin(50), newBarCoin(50))),
+		types.NewDeflationSource(multiPerm, sdk.NewCoins(newFooCoin(40))),
+	}, res.Deflation)
+
+	res, err = keeper.DeflationBySource(goCtx, &types.QueryDeflationBySourceRequest{Source: multiPerm})
+	require.NoError(err)
+	require.Equal([]types.DeflationSource{types.NewDeflationSource(multiPerm, sdk.NewCoins(newFooCoin(40)))}, res.Deflation)
+
+	// deflation history
+	res, err = keeper.DeflationBySource(goCtx, &types.QueryDeflationBySourceRequest{StartHeight: 10, EndHeight: 11})
+	require.NoError(err)
+	require.Equal([]types.DeflationSource{
+		types.NewDeflationSource(authtypes.Burner, sdk.NewCoins(newFooCoin(50), newBarCoin(50))),
+	}, res.Deflation)
+
+	res, err = keeper.DeflationBySource(goCtx, &types.QueryDeflationBySourceRequest{StartHeight: 11})
+	require.NoError(err)
+	require.Equal([]types.DeflationSource{types.NewDeflationSource(multiPerm, sdk.NewCoins(newFooCoin(40)))}, res.Deflation)
+
+	res, err = keeper.DeflationBySource(goCtx, &types.QueryDeflationBySourceRequest{Source: authtypes.Burner, StartHeight: 11})
+	require.NoError(err)
+	require.Empty(res.Deflation)
+
+	_, err = keeper.DeflationBySource(goCtx, &types.QueryDeflationBySourceRequest{StartHeight: 12, EndHeight: 10})
+	require.Error(err)
+
+	// the history older than the kept blocks is pruned
+	params := keeper.GetParams(ctx)
+	params.DeflationHistoryBlocks = 5
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(15)
+	keeper.PruneDeflationHistory(ctx)
+	require.Equal([]types.DeflationSource{types.NewDeflationSource(multiPerm, sdk.NewCoins(newFooCoin(40)))}, keeper.GetDeflationHistory(ctx, "", 0, 15))
+
+	_, err = keeper.DeflationBySource(sdk.WrapSDKContext(ctx), &types.QueryDeflationBySourceRequest{StartHeight: 10})
+	require.Error(err)
+
+	// the totals are kept
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(50)), keeper.GetDeflationOfSource(ctx, authtypes.Burner))
+	deflation := keeper.GetAllDeflationBySource(ctx)
+	require.Len(deflation, 2)
+	require.NoError(types.ValidateDeflationBySource(deflation))
+}
+
+func (suite *IntegrationTestSuite) TestBurntFeeCollectorMigration() {
+	app, ctx := suite.app, suite.ctx
+	require := suite.Require()
+
+	// the burnt fee collector of a chain created before the burner permission
+	burntFeeCollector := app.AccountKeeper.GetModuleAccount(ctx, authtypes.BurntFeeCollectorName).(*authtypes.ModuleAccount)
+	burntFeeCollector.Permissions = nil
+	app.AccountKeeper.SetModuleAccount(ctx, burntFeeCollector)
+
+	fees := sdk.NewCoins(newFooCoin(100))
+	require.NoError(simapp.FundModuleAccount(app.BankKeeper, ctx, authtypes.BurntFeeCollectorName, fees))
+	require.Panics(func() { app.BankKeeper.BurnCollectedFees(ctx) })
+
+	require.NoError(keeper.NewMigrator(app.BankKeeper.(keeper.BaseKeeper)).Migrate2to3(ctx))
+
+	app.BankKeeper.BurnCollectedFees(ctx)
+	require.True(app.BankKeeper.GetAllBalances(ctx, burntFeeCollector.GetAddress()).IsZero())
+	require.Equal(newFooCoin(100), app.BankKeeper.GetDeflationBySource(ctx, authtypes.BurntFeeCollectorName, fooDenom))
+}
@@ -40,6 +40,12 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, deflation := range genState.Deflation {
 		k.setDeflation(ctx, deflation)
 	}
+
+	for _, deflation := range genState.DeflationBySource {
+		for _, coin := range deflation.Amount {
+			k.setDeflationBySource(ctx, deflation.Source, coin)
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -53,11 +59,14 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total deflation %v", err))
 	}
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 		totalDeflation,
 	)
+	genState.DeflationBySource = k.GetAllDeflationBySource(ctx)
+
+	return genState
 }
@@ -228,3 +228,40 @@ func (k BaseKeeper) DeflationOf(c context.Context, req *types.QueryDeflationOfRe
 
 	return &types.QueryDeflationOfResponse{Amount: sdk.NewCoin(req.Denom, deflation.Amount)}, nil
 }
+
+// DeflationBySource implements the Query/DeflationBySource gRPC method
+func (k BaseKeeper) DeflationBySource(c context.Context, req *types.QueryDeflationBySourceRequest) (*types.QueryDeflationBySourceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// total deflation by source
+	if req.StartHeight == 0 && req.EndHeight == 0 {
+		if req.Source != "" {
+			deflation := []types.DeflationSource{}
+			if amount := k.GetDeflationOfSource(ctx, req.Source); !amount.IsZero() {
+				deflation = append(deflation, types.NewDeflationSource(req.Source, amount))
+			}
+			return &types.QueryDeflationBySourceResponse{Deflation: deflation}, nil
+		}
+
+		return &types.QueryDeflationBySourceResponse{Deflation: k.GetAllDeflationBySource(ctx)}, nil
+	}
+
+	endHeight := req.EndHeight
+	if endHeight == 0 {
+		endHeight = ctx.BlockHeight()
+	}
+
+	if req.StartHeight < 0 || req.StartHeight > endHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range %d-%d", req.StartHeight, endHeight)
+	}
+
+	if startHeight := k.GetDeflationHistoryStartHeight(ctx); req.StartHeight < startHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "deflation history is only kept from height %d", startHeight)
+	}
+
+	return &types.QueryDeflationBySourceResponse{Deflation: k.GetDeflationHistory(ctx, req.Source, req.StartHeight, endHeight)}, nil
+}
@@ -24,9 +24,15 @@ type Keeper interface {
 	InitGenesis(sdk.Context, *types.GenesisState)
 	ExportGenesis(sdk.Context) *types.GenesisState
 
-	GetBurntFeeDenom(ctx sdk.Context) string
+	CalculateBurntFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins
+	BurnCollectedFees(ctx sdk.Context)
 	GetDeflation(ctx sdk.Context, denom string) sdk.Coin
 	GetPaginatedTotalDeflation(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	GetDeflationBySource(ctx sdk.Context, source, denom string) sdk.Coin
+	GetDeflationOfSource(ctx sdk.Context, source string) sdk.Coins
+	IterateDeflationBySource(ctx sdk.Context, cb func(source string, coin sdk.Coin) (stop bool))
+	GetDeflationHistory(ctx sdk.Context, source string, startHeight, endHeight int64) []types.DeflationSource
+	PruneDeflationHistory(ctx sdk.Context)
 	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	HasSupply(ctx sdk.Context, denom string) bool
 	GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
@@ -505,19 +511,17 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
-		deflation := k.GetDeflation(ctx, amount.GetDenom())
 		supply = supply.Sub(amount)
-		deflation = deflation.Add(amount)
 		k.setSupply(ctx, supply)
-		k.setDeflation(ctx, deflation)
 	}
+	k.trackDeflation(ctx, moduleName, amounts)
 
 	logger := k.Logger(ctx)
 	logger.Info("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
 
 	// emit burn event
 	ctx.EventManager().EmitEvent(
-		types.NewCoinBurnEvent(acc.GetAddress(), amounts),
+		types.NewCoinBurnEvent(acc.GetAddress(), moduleName, amounts),
 	)
 
 	return nil
@@ -620,7 +624,3 @@ func (k BaseViewKeeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bo
 		}
 	}
 }
-
-func (k BaseKeeper) GetBurntFeeDenom(ctx sdk.Context) string {
-	return k.GetParams(ctx).BurntFeeDenom
-}
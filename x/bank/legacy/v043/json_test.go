@@ -72,9 +72,13 @@ func TestMigrateJSON(t *testing.T) {
 			]
 		}
 	],
+	"deflation": [],
+	"deflation_by_source": [],
 	"denom_metadata": [],
 	"params": {
+		"burnt_fees": [],
 		"default_send_enabled": false,
+		"deflation_history_blocks": "0",
 		"send_enabled": []
 	},
 	"supply": [
@@ -0,0 +1,74 @@
+package v045
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// KeyBurntFeeDenom is the store's key of the BurntFeeDenom param, replaced by
+// the BurntFees param.
+var KeyBurntFeeDenom = []byte("BurntFeeDenom")
+
+// migrateParams sets the params which did not exist before:
+//
+// - the deflation history param, to its default value
+// - the burnt fees param, burning the whole fees of the former burnt fee denom
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.KeyDeflationHistoryBlocks) {
+		paramSpace.Set(ctx, types.KeyDeflationHistoryBlocks, types.DefaultDeflationHistoryBlocks)
+	}
+
+	if !paramSpace.Has(ctx, types.KeyBurntFees) {
+		burntFees := []types.BurntFee{}
+
+		if bz := paramSpace.GetRaw(ctx, KeyBurntFeeDenom); bz != nil {
+			var burntFeeDenom string
+			if err := json.Unmarshal(bz, &burntFeeDenom); err != nil {
+				return err
+			}
+
+			if strings.TrimSpace(burntFeeDenom) != "" {
+				burntFees = append(burntFees, types.NewBurntFee(burntFeeDenom, sdk.OneDec()))
+			}
+		}
+
+		paramSpace.Set(ctx, types.KeyBurntFees, burntFees)
+	}
+
+	return nil
+}
+
+// migrateBurntFeeCollector sets the permissions of the stored burnt fee
+// collector module account to the ones it is registered with, as it was
+// created without the burner permission it needs to burn the fees.
+func migrateBurntFeeCollector(ctx sdk.Context, ak types.AccountKeeper) {
+	addr, perms := ak.GetModuleAddressAndPermissions(authtypes.BurntFeeCollectorName)
+	if addr == nil {
+		return
+	}
+
+	macc, ok := ak.GetAccount(ctx, addr).(*authtypes.ModuleAccount)
+	if !ok {
+		return
+	}
+
+	macc.Permissions = perms
+	ak.SetModuleAccount(ctx, macc)
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Setting the deflation history and burnt fees params
+// - Granting the burner permission to the burnt fee collector module account
+//
+// The deflation burnt before the migration is not attributed to any source.
+func MigrateStore(ctx sdk.Context, ak types.AccountKeeper, paramSpace paramtypes.Subspace) error {
+	migrateBurntFeeCollector(ctx, ak)
+	return migrateParams(ctx, paramSpace)
+}
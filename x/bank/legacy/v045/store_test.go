@@ -0,0 +1,71 @@
+package v045_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	v045bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	authKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, db)
+	cms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	ak := authkeeper.NewAccountKeeper(
+		encCfg.Marshaler, authKey,
+		paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, authtypes.ModuleName),
+		authtypes.ProtoBaseAccount, map[string][]string{authtypes.BurntFeeCollectorName: {authtypes.Burner}},
+	)
+
+	// set the burnt fee denom param replaced by the burnt fees param
+	legacyParamSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(paramtypes.NewKeyTable(
+			paramtypes.NewParamSetPair(v045bank.KeyBurntFeeDenom, new(string), func(interface{}) error { return nil }),
+		))
+	legacyParamSpace.Set(ctx, v045bank.KeyBurntFeeDenom, sdk.DefaultBondDenom)
+
+	// the burnt fee collector was created without permissions
+	burntFeeCollector := ak.NewAccount(ctx, authtypes.NewEmptyModuleAccount(authtypes.BurntFeeCollectorName))
+	ak.SetModuleAccount(ctx, burntFeeCollector.(authtypes.ModuleAccountI))
+	require.False(t, ak.GetModuleAccount(ctx, authtypes.BurntFeeCollectorName).HasPermission(authtypes.Burner))
+
+	require.False(t, paramSpace.Has(ctx, types.KeyDeflationHistoryBlocks))
+	require.False(t, paramSpace.Has(ctx, types.KeyBurntFees))
+
+	require.NoError(t, v045bank.MigrateStore(ctx, ak, paramSpace))
+
+	var historyBlocks uint64
+	paramSpace.Get(ctx, types.KeyDeflationHistoryBlocks, &historyBlocks)
+	require.Equal(t, types.DefaultDeflationHistoryBlocks, historyBlocks)
+
+	var burntFees []types.BurntFee
+	paramSpace.Get(ctx, types.KeyBurntFees, &burntFees)
+	require.Equal(t, []types.BurntFee{types.NewBurntFee(sdk.DefaultBondDenom, sdk.OneDec())}, burntFees)
+
+	migrated := ak.GetModuleAccount(ctx, authtypes.BurntFeeCollectorName)
+	require.True(t, migrated.HasPermission(authtypes.Burner))
+	require.Equal(t, burntFeeCollector.GetAccountNumber(), migrated.GetAccountNumber())
+}
@@ -30,7 +30,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty" yaml:"send_enabled,omitempty"`
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty" yaml:"default_send_enabled,omitempty"`
-	BurntFeeDenom      string         `protobuf:"bytes,3,opt,name=burnt_fee_denom,json=burntFeeDenom,proto3" json:"burnt_fee_denom,omitempty" yaml:"burnt_fee_denom,omitempty"`
+	// deflation_history_blocks is the number of blocks for which the burns are
+	// kept in the deflation history, zero disables the history.
+	DeflationHistoryBlocks uint64 `protobuf:"varint,4,opt,name=deflation_history_blocks,json=deflationHistoryBlocks,proto3" json:"deflation_history_blocks,omitempty" yaml:"deflation_history_blocks,omitempty"`
+	// burnt_fees defines the fraction of the fees of each denom which is burnt.
+	BurntFees []BurntFee `protobuf:"bytes,5,rep,name=burnt_fees,json=burntFees,proto3" json:"burnt_fees" yaml:"burnt_fees,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,9 +83,62 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
-func (m *Params) GetBurntFeeDenom() string {
+func (m *Params) GetDeflationHistoryBlocks() uint64 {
 	if m != nil {
-		return m.BurntFeeDenom
+		return m.DeflationHistoryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetBurntFees() []BurntFee {
+	if m != nil {
+		return m.BurntFees
+	}
+	return nil
+}
+
+// BurntFee defines the fraction of the fees paid in a coin denom which is
+// burnt, the remainder is sent to the fee collector.
+type BurntFee struct {
+	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=burn_ratio,json=burnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_ratio" yaml:"burn_ratio"`
+}
+
+func (m *BurntFee) Reset()      { *m = BurntFee{} }
+func (*BurntFee) ProtoMessage() {}
+func (*BurntFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{1}
+}
+func (m *BurntFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurntFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurntFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurntFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurntFee.Merge(m, src)
+}
+func (m *BurntFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurntFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurntFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurntFee proto.InternalMessageInfo
+
+func (m *BurntFee) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
 	return ""
 }
@@ -96,7 +153,7 @@ type SendEnabled struct {
 func (m *SendEnabled) Reset()      { *m = SendEnabled{} }
 func (*SendEnabled) ProtoMessage() {}
 func (*SendEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{1}
+	return fileDescriptor_dd052eee12edf988, []int{2}
 }
 func (m *SendEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,7 +206,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{2}
+	return fileDescriptor_dd052eee12edf988, []int{3}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +245,7 @@ func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{3}
+	return fileDescriptor_dd052eee12edf988, []int{4}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,7 +287,7 @@ func (m *Supply) Reset()         { *m = Supply{} }
 func (m *Supply) String() string { return proto.CompactTextString(m) }
 func (*Supply) ProtoMessage()    {}
 func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{4}
+	return fileDescriptor_dd052eee12edf988, []int{5}
 }
 func (m *Supply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,7 +335,7 @@ func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
 func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
 func (*DenomUnit) ProtoMessage()    {}
 func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{5}
+	return fileDescriptor_dd052eee12edf988, []int{6}
 }
 func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +411,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{6}
+	return fileDescriptor_dd052eee12edf988, []int{7}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,62 +482,141 @@ func (m *Metadata) GetSymbol() string {
 	return ""
 }
 
+// DeflationSource defines the coins burnt from a module account.
+type DeflationSource struct {
+	// source is the name of the module account the coins were burnt from.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// amount is the amount of coins burnt.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *DeflationSource) Reset()         { *m = DeflationSource{} }
+func (m *DeflationSource) String() string { return proto.CompactTextString(m) }
+func (*DeflationSource) ProtoMessage()    {}
+func (*DeflationSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *DeflationSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeflationSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeflationSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeflationSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeflationSource.Merge(m, src)
+}
+func (m *DeflationSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeflationSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeflationSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeflationSource proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
+	proto.RegisterType((*BurntFee)(nil), "cosmos.bank.v1beta1.BurntFee")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
 	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
 	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*DeflationSource)(nil), "cosmos.bank.v1beta1.DeflationSource")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x35, 0x4d, 0x48, 0x2f, 0x54, 0x48, 0x47, 0x85, 0xdc, 0x4a, 0xd8, 0xc6, 0x02, 0x29,
-	0x45, 0x34, 0x69, 0x81, 0x01, 0x65, 0x41, 0x4a, 0xa1, 0xa8, 0x12, 0x08, 0xe4, 0x0a, 0x21, 0xc1,
-	0x60, 0x9d, 0x73, 0xd7, 0x60, 0xd5, 0xbe, 0xb3, 0x72, 0xe7, 0xaa, 0xfe, 0x07, 0x4c, 0xc0, 0xc8,
-	0x58, 0x56, 0x56, 0xf8, 0x0f, 0x74, 0xac, 0x98, 0x98, 0x02, 0x6a, 0x17, 0xe6, 0xfe, 0x02, 0x74,
-	0x77, 0x4e, 0xea, 0xa2, 0x80, 0x18, 0x18, 0x98, 0x72, 0xdf, 0x7d, 0xdf, 0xfb, 0xde, 0xcb, 0x7b,
-	0xef, 0x0c, 0xed, 0x3e, 0x17, 0x09, 0x17, 0x9d, 0x10, 0xb3, 0x9d, 0xce, 0xee, 0x5a, 0x48, 0x25,
-	0x5e, 0xd3, 0xa0, 0x9d, 0x0e, 0xb9, 0xe4, 0xe8, 0xa2, 0xe1, 0xdb, 0xfa, 0xaa, 0xe0, 0x97, 0x16,
-	0x06, 0x7c, 0xc0, 0x35, 0xdf, 0x51, 0x27, 0x23, 0x5d, 0x5a, 0x34, 0xd2, 0xc0, 0x10, 0x45, 0x9c,
-	0xa1, 0x4e, 0xb3, 0x08, 0x3a, 0xc9, 0xd2, 0xe7, 0x11, 0x33, 0xbc, 0xf7, 0x7e, 0x06, 0xd6, 0x9f,
-	0xe0, 0x21, 0x4e, 0x04, 0xda, 0x86, 0xe7, 0x05, 0x65, 0x24, 0xa0, 0x0c, 0x87, 0x31, 0x25, 0x16,
-	0x70, 0xab, 0xad, 0xe6, 0x4d, 0xb7, 0x3d, 0xa5, 0x8e, 0xf6, 0x16, 0x65, 0xe4, 0xbe, 0xd1, 0xf5,
-	0xae, 0x9c, 0x8c, 0x9c, 0xcb, 0x39, 0x4e, 0xe2, 0xae, 0x57, 0x8e, 0xbf, 0xc1, 0x93, 0x48, 0xd2,
-	0x24, 0x95, 0xb9, 0xe7, 0x37, 0xc5, 0xa9, 0x1e, 0xbd, 0x80, 0x0b, 0x84, 0x6e, 0xe3, 0x2c, 0x96,
-	0xc1, 0x99, 0x7c, 0x33, 0x2e, 0x68, 0x35, 0x7a, 0xcb, 0x27, 0x23, 0xe7, 0x9a, 0x71, 0x9b, 0xa6,
-	0x2a, 0xbb, 0xa2, 0x42, 0x50, 0x2a, 0x06, 0x3d, 0x84, 0x17, 0xc2, 0x6c, 0xc8, 0x64, 0xb0, 0x4d,
-	0x69, 0x40, 0x28, 0xe3, 0x89, 0x55, 0x75, 0x41, 0x6b, 0xae, 0x77, 0xf5, 0x64, 0xe4, 0xb8, 0xc6,
-	0xf7, 0x17, 0x41, 0xd9, 0x72, 0x5e, 0x73, 0x1b, 0x94, 0xde, 0x53, 0x4c, 0x77, 0xf6, 0xdd, 0xbe,
-	0x53, 0xf1, 0x1e, 0xc0, 0x66, 0x39, 0xc5, 0x02, 0xac, 0x19, 0x63, 0xa0, 0x8c, 0x7d, 0x03, 0x90,
-	0x05, 0xcf, 0x9d, 0xf9, 0x23, 0xfe, 0x18, 0x76, 0x1b, 0xca, 0xe4, 0xc7, 0xbe, 0x03, 0xbc, 0xd7,
-	0x00, 0xd6, 0x36, 0x59, 0x9a, 0x49, 0xa5, 0xc6, 0x84, 0x0c, 0xa9, 0x10, 0x85, 0xcb, 0x18, 0x22,
-	0x0c, 0x6b, 0x6a, 0x3c, 0xc2, 0x9a, 0xd1, 0xed, 0x5f, 0x3c, 0x6d, 0xbf, 0xa0, 0x93, 0xf6, 0xaf,
-	0xf3, 0x88, 0xf5, 0x56, 0x0f, 0x46, 0x4e, 0xe5, 0xc3, 0x37, 0xa7, 0x35, 0x88, 0xe4, 0xcb, 0x2c,
-	0x6c, 0xf7, 0x79, 0x52, 0xcc, 0xbe, 0xf8, 0x59, 0x11, 0x64, 0xa7, 0x23, 0xf3, 0x94, 0x0a, 0x1d,
-	0x20, 0x7c, 0xe3, 0xdc, 0x6d, 0xbc, 0x32, 0x05, 0x55, 0xbc, 0x37, 0x00, 0xd6, 0x1f, 0x67, 0xf2,
-	0x3f, 0xaa, 0xe8, 0x23, 0x80, 0xf5, 0xad, 0x2c, 0x4d, 0xe3, 0x5c, 0xe5, 0x95, 0x5c, 0xe2, 0xb8,
-	0x58, 0xc4, 0x7f, 0x9b, 0x57, 0x3b, 0x77, 0x37, 0x8a, 0xbc, 0xe0, 0xcb, 0xa7, 0x95, 0x3b, 0xd7,
-	0xff, 0x18, 0xbd, 0x67, 0x1e, 0x6a, 0x4c, 0x07, 0xb8, 0x9f, 0x77, 0x76, 0x57, 0x6f, 0xaf, 0xb6,
-	0x4d, 0x9d, 0x9b, 0x16, 0xf0, 0x9e, 0xc1, 0x39, 0xbd, 0x30, 0x4f, 0x59, 0x24, 0x7f, 0xb3, 0x1f,
-	0x4b, 0xb0, 0x41, 0xf7, 0x52, 0xce, 0x28, 0x93, 0x7a, 0x41, 0xe6, 0xfd, 0x09, 0xd6, 0xbd, 0x8f,
-	0x23, 0x2c, 0xa8, 0xb0, 0xaa, 0x6e, 0x55, 0xf7, 0xde, 0x40, 0xef, 0x33, 0x80, 0x8d, 0x47, 0x54,
-	0x62, 0x82, 0x25, 0x46, 0x2e, 0x6c, 0x12, 0x2a, 0xfa, 0xc3, 0x28, 0x95, 0x11, 0x67, 0x85, 0x7d,
-	0xf9, 0x0a, 0xdd, 0x55, 0x0a, 0xc6, 0x93, 0x20, 0x63, 0x91, 0x1c, 0x0f, 0xcc, 0x9e, 0xfa, 0x82,
-	0x27, 0xf5, 0xfa, 0x90, 0x8c, 0x8f, 0x02, 0x21, 0x38, 0xab, 0xda, 0x6b, 0xde, 0x8c, 0xaf, 0xcf,
-	0xaa, 0x3a, 0x12, 0x89, 0x34, 0xc6, 0xb9, 0x35, 0x6b, 0x36, 0xa3, 0x80, 0x4a, 0xcd, 0x70, 0x42,
-	0xad, 0x9a, 0x51, 0xab, 0x33, 0xba, 0x04, 0xeb, 0x22, 0x4f, 0x42, 0x1e, 0x5b, 0x75, 0x7d, 0x5b,
-	0xa0, 0xde, 0xfa, 0xc1, 0x91, 0x0d, 0x0e, 0x8f, 0x6c, 0xf0, 0xfd, 0xc8, 0x06, 0x6f, 0x8f, 0xed,
-	0xca, 0xe1, 0xb1, 0x5d, 0xf9, 0x7a, 0x6c, 0x57, 0x9e, 0x2f, 0xff, 0x4d, 0xdf, 0xf5, 0xf0, 0xc2,
-	0xba, 0xfe, 0x68, 0xdd, 0xfa, 0x19, 0x00, 0x00, 0xff, 0xff, 0x86, 0x30, 0x9a, 0xa6, 0x3c, 0x05,
-	0x00, 0x00,
-}
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6f, 0xdb, 0x36,
+	0x14, 0x36, 0xe3, 0x1f, 0xb3, 0xe9, 0x0d, 0xd9, 0xb4, 0x20, 0x50, 0x32, 0x44, 0xf2, 0x34, 0x6c,
+	0x70, 0x86, 0xc5, 0x4e, 0xb6, 0x1d, 0x06, 0x5f, 0x06, 0x28, 0x59, 0xb6, 0x0c, 0x18, 0x36, 0x28,
+	0x18, 0x06, 0x6c, 0x07, 0x81, 0x92, 0x68, 0x47, 0x88, 0x44, 0x0a, 0x22, 0x15, 0x44, 0xff, 0xc1,
+	0x4e, 0x69, 0x8f, 0x39, 0xe6, 0xdc, 0x6b, 0x8b, 0xfe, 0x0b, 0xcd, 0x31, 0xe8, 0xa9, 0xe8, 0xc1,
+	0x2d, 0x92, 0x4b, 0xcf, 0xfe, 0x0b, 0x0a, 0x92, 0xb2, 0xad, 0x00, 0x6e, 0x91, 0x43, 0x0b, 0xf4,
+	0xe4, 0xf7, 0xf8, 0x3e, 0x7e, 0xdf, 0xc7, 0xc7, 0x27, 0x1a, 0x1a, 0x3e, 0x65, 0x31, 0x65, 0x7d,
+	0x0f, 0x91, 0xe3, 0xfe, 0xc9, 0x8e, 0x87, 0x39, 0xda, 0x91, 0x49, 0x2f, 0x49, 0x29, 0xa7, 0xda,
+	0xe7, 0xaa, 0xde, 0x93, 0x4b, 0x45, 0x7d, 0x7d, 0x65, 0x44, 0x47, 0x54, 0xd6, 0xfb, 0x22, 0x52,
+	0xd0, 0xf5, 0x35, 0x05, 0x75, 0x55, 0xa1, 0xd8, 0xa7, 0x4a, 0x73, 0x15, 0x86, 0x67, 0x2a, 0x3e,
+	0x0d, 0x89, 0xaa, 0x5b, 0x8f, 0xab, 0xb0, 0xf1, 0x17, 0x4a, 0x51, 0xcc, 0xb4, 0x21, 0xfc, 0x98,
+	0x61, 0x12, 0xb8, 0x98, 0x20, 0x2f, 0xc2, 0x81, 0x0e, 0x3a, 0xd5, 0x6e, 0xfb, 0xfb, 0x4e, 0x6f,
+	0x81, 0x8f, 0xde, 0x21, 0x26, 0xc1, 0x2f, 0x0a, 0x67, 0x7f, 0x39, 0x19, 0x9b, 0x1b, 0x39, 0x8a,
+	0xa3, 0x81, 0x55, 0xde, 0xff, 0x1d, 0x8d, 0x43, 0x8e, 0xe3, 0x84, 0xe7, 0x96, 0xd3, 0x66, 0x73,
+	0xbc, 0xf6, 0x1f, 0x5c, 0x09, 0xf0, 0x10, 0x65, 0x11, 0x77, 0x6f, 0xe9, 0x2d, 0x75, 0x40, 0xb7,
+	0x69, 0x6f, 0x4e, 0xc6, 0xe6, 0xd7, 0x8a, 0x6d, 0x11, 0xaa, 0xcc, 0xaa, 0x15, 0x80, 0x92, 0x19,
+	0x6d, 0x04, 0xf5, 0x00, 0x0f, 0x23, 0xc4, 0x43, 0x4a, 0xdc, 0xa3, 0x90, 0x71, 0x9a, 0xe6, 0xae,
+	0x17, 0x51, 0xff, 0x98, 0xe9, 0xb5, 0x0e, 0xe8, 0xd6, 0xec, 0xad, 0xc9, 0xd8, 0xdc, 0x9c, 0x09,
+	0x2c, 0x44, 0x96, 0x45, 0x56, 0x67, 0xa0, 0xdf, 0x14, 0xc6, 0x96, 0x10, 0x0d, 0x41, 0xe8, 0x65,
+	0x29, 0xe1, 0xee, 0x10, 0x63, 0xa6, 0xd7, 0x65, 0xaf, 0x36, 0x16, 0xf6, 0xca, 0x16, 0xb0, 0x7d,
+	0x8c, 0xed, 0xaf, 0x2e, 0xc7, 0x66, 0x65, 0x32, 0x36, 0xbf, 0x50, 0xea, 0xf3, 0xed, 0x65, 0xbd,
+	0x96, 0x57, 0xc0, 0xd9, 0xa0, 0x76, 0x7e, 0x61, 0x56, 0x7e, 0xaf, 0x35, 0xab, 0x9f, 0xd6, 0x9c,
+	0xe5, 0x19, 0xda, 0x0d, 0x30, 0xa1, 0xb1, 0x75, 0x06, 0x60, 0x73, 0xca, 0xac, 0xad, 0xc0, 0xba,
+	0x5c, 0xd5, 0x41, 0x07, 0x74, 0x5b, 0x8e, 0x4a, 0x34, 0x4f, 0x59, 0x74, 0x53, 0x61, 0x5f, 0xb6,
+	0xb7, 0x65, 0xef, 0x0a, 0x0f, 0xcf, 0xc7, 0xe6, 0x37, 0xa3, 0x90, 0x1f, 0x65, 0x5e, 0xcf, 0xa7,
+	0x71, 0x31, 0x30, 0xc5, 0xcf, 0x16, 0x0b, 0x8e, 0xfb, 0x3c, 0x4f, 0x30, 0xeb, 0xed, 0x61, 0x7f,
+	0x32, 0x36, 0x3f, 0x9b, 0xbb, 0x55, 0x4c, 0x85, 0x47, 0x47, 0xc4, 0x83, 0xa6, 0xf0, 0xf8, 0xea,
+	0xc2, 0x04, 0xd6, 0xaf, 0xb0, 0x5d, 0xbe, 0x88, 0xc5, 0x96, 0x74, 0xf8, 0xd1, 0xad, 0xeb, 0x76,
+	0xa6, 0x69, 0x89, 0xe8, 0x0c, 0xc0, 0xfa, 0x01, 0x49, 0x32, 0x2e, 0xd0, 0x28, 0x08, 0x52, 0xcc,
+	0x58, 0xc1, 0x32, 0x4d, 0x35, 0x04, 0xeb, 0x62, 0x88, 0x99, 0xbe, 0x24, 0x1b, 0xbf, 0x36, 0x6f,
+	0x3c, 0xc3, 0xb3, 0xc6, 0xef, 0xd2, 0x90, 0xd8, 0xdb, 0xe2, 0xc0, 0x0f, 0x5e, 0x98, 0xdd, 0x3b,
+	0x1c, 0x58, 0x6c, 0x60, 0x8e, 0x62, 0x1e, 0x34, 0xff, 0x57, 0x86, 0x2a, 0xd6, 0x3d, 0x00, 0x1b,
+	0x7f, 0x66, 0xfc, 0x03, 0x72, 0xf4, 0x10, 0xc0, 0xc6, 0x61, 0x96, 0x24, 0x51, 0x2e, 0x74, 0x39,
+	0xe5, 0x28, 0xd2, 0xc1, 0x7b, 0xd0, 0x95, 0xcc, 0x83, 0xfd, 0x42, 0x17, 0x3c, 0x7d, 0xb4, 0xf5,
+	0xd3, 0xb7, 0x6f, 0xdd, 0x7d, 0xaa, 0x9e, 0xb3, 0x08, 0x8f, 0x90, 0x9f, 0xf7, 0x4f, 0xb6, 0x7f,
+	0xdc, 0xee, 0x29, 0x9f, 0x07, 0x3a, 0xb0, 0xfe, 0x81, 0xad, 0x3d, 0x31, 0x05, 0x7f, 0x93, 0x90,
+	0xbf, 0x61, 0x3e, 0xd6, 0x61, 0x13, 0x9f, 0x26, 0x94, 0x60, 0xc2, 0xe5, 0x80, 0x7c, 0xe2, 0xcc,
+	0x72, 0xd9, 0xfb, 0x28, 0x44, 0x0c, 0x33, 0xbd, 0xda, 0xa9, 0xca, 0xde, 0xab, 0xd4, 0x7a, 0x02,
+	0x60, 0xf3, 0x0f, 0xcc, 0x51, 0x80, 0x38, 0xd2, 0x3a, 0xb0, 0x1d, 0x60, 0xe6, 0xa7, 0x61, 0x22,
+	0x3e, 0xda, 0x82, 0xbe, 0xbc, 0xa4, 0xfd, 0x2c, 0x10, 0x84, 0xc6, 0x6e, 0x46, 0x42, 0x3e, 0xbd,
+	0x30, 0x63, 0xe1, 0xb7, 0x3b, 0xf3, 0xeb, 0xc0, 0x60, 0x1a, 0x32, 0x4d, 0x83, 0x35, 0xd1, 0x5e,
+	0xbd, 0x2a, 0xb9, 0x65, 0x2c, 0xdc, 0x05, 0x21, 0x4b, 0x22, 0x94, 0xcb, 0x77, 0xa6, 0xe5, 0x4c,
+	0x53, 0x81, 0x26, 0x28, 0xc6, 0x7a, 0x5d, 0xa1, 0x45, 0xac, 0xad, 0xc2, 0x06, 0xcb, 0x63, 0x8f,
+	0x46, 0x7a, 0x43, 0xae, 0x16, 0x99, 0x75, 0x0e, 0xe0, 0xf2, 0xde, 0xf4, 0xc1, 0x39, 0xa4, 0x59,
+	0xea, 0x2b, 0xac, 0x8c, 0x8a, 0xb3, 0x14, 0x99, 0xe6, 0xc3, 0x06, 0x8a, 0x69, 0x46, 0x78, 0x71,
+	0x82, 0x77, 0x7a, 0xf5, 0x05, 0xf5, 0x7c, 0xe6, 0xec, 0xdd, 0xcb, 0x6b, 0x03, 0x5c, 0x5d, 0x1b,
+	0xe0, 0xe5, 0xb5, 0x01, 0xee, 0xdf, 0x18, 0x95, 0xab, 0x1b, 0xa3, 0xf2, 0xec, 0xc6, 0xa8, 0xfc,
+	0xbb, 0x79, 0x97, 0x91, 0x90, 0xe4, 0x5e, 0x43, 0xfe, 0xeb, 0xfc, 0xf0, 0x7a, 0x00, 0x78, 0xa8,
+	0x1e, 0x8a, 0xfd, 0x06, 0x00, 0x00,
+}
+
+func (this *BurntFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*BurntFee)
+	if !ok {
+		that2, ok := that.(BurntFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.BurnRatio.Equal(that1.BurnRatio) {
+		return false
+	}
+	return true
+}
 func (this *SendEnabled) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -557,12 +693,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.BurntFeeDenom) > 0 {
-		i -= len(m.BurntFeeDenom)
-		copy(dAtA[i:], m.BurntFeeDenom)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.BurntFeeDenom)))
+	if len(m.BurntFees) > 0 {
+		for iNdEx := len(m.BurntFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurntFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DeflationHistoryBlocks != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.DeflationHistoryBlocks))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
 	if m.DefaultSendEnabled {
 		i--
@@ -591,6 +739,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BurntFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurntFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurntFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SendEnabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -872,6 +1060,50 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeflationSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeflationSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeflationSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -898,10 +1130,30 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
-	l = len(m.BurntFeeDenom)
+	if m.DeflationHistoryBlocks != 0 {
+		n += 1 + sovBank(uint64(m.DeflationHistoryBlocks))
+	}
+	if len(m.BurntFees) > 0 {
+		for _, e := range m.BurntFees {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BurntFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovBank(uint64(l))
 	}
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovBank(uint64(l))
 	return n
 }
 
@@ -1031,6 +1283,25 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *DeflationSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1120,9 +1391,112 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeflationHistoryBlocks", wireType)
+			}
+			m.DeflationHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeflationHistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurntFeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurntFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurntFees = append(m.BurntFees, BurntFee{})
+			if err := m.BurntFees[len(m.BurntFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurntFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurntFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurntFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1150,7 +1524,41 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurntFeeDenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1968,6 +2376,122 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeflationSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeflationSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeflationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDeflationSource creates a new DeflationSource instance
+func NewDeflationSource(source string, amount sdk.Coins) DeflationSource {
+	return DeflationSource{
+		Source: source,
+		Amount: amount,
+	}
+}
+
+// ValidateDeflationBySource validates the deflation of the source module
+// accounts, each source may appear only once.
+func ValidateDeflationBySource(deflation []DeflationSource) error {
+	seenSources := make(map[string]bool)
+	for _, d := range deflation {
+		if d.Source == "" {
+			return fmt.Errorf("deflation source cannot be empty")
+		}
+		if seenSources[d.Source] {
+			return fmt.Errorf("duplicate deflation source %s", d.Source)
+		}
+		if err := d.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid deflation of source %s: %w", d.Source, err)
+		}
+
+		seenSources[d.Source] = true
+	}
+
+	return nil
+}
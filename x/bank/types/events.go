@@ -23,6 +23,7 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+	AttributeKeySource   = "source"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
@@ -55,12 +56,14 @@ func NewCoinMintEvent(minter sdk.AccAddress, amount sdk.Coins) sdk.Event {
 	)
 }
 
-// NewCoinBurnEvent constructs a new coin burned sdk.Event
+// NewCoinBurnEvent constructs a new coin burned sdk.Event, the source is the
+// name of the module account the coins are burnt from.
 // nolint: interfacer
-func NewCoinBurnEvent(burner sdk.AccAddress, amount sdk.Coins) sdk.Event {
+func NewCoinBurnEvent(burner sdk.AccAddress, source string, amount sdk.Coins) sdk.Event {
 	return sdk.NewEvent(
 		EventTypeCoinBurn,
 		sdk.NewAttribute(AttributeKeyBurner, burner.String()),
 		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(AttributeKeySource, source),
 	)
 }
@@ -66,7 +66,7 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	return ValidateDeflationBySource(gs.DeflationBySource)
 }
 
 // NewGenesisState creates a new genesis state.
@@ -38,6 +38,8 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata" yaml:"denom_metadata"`
 	// deflation represents the total deflation.
 	Deflation github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=deflation,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deflation"`
+	// deflation_by_source represents the deflation by source module account.
+	DeflationBySource []DeflationSource `protobuf:"bytes,6,rep,name=deflation_by_source,json=deflationBySource,proto3" json:"deflation_by_source" yaml:"deflation_by_source"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +110,13 @@ func (m *GenesisState) GetDeflation() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *GenesisState) GetDeflationBySource() []DeflationSource {
+	if m != nil {
+		return m.DeflationBySource
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -158,33 +167,35 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x53, 0xe2, 0x40,
-	0x18, 0x87, 0x93, 0xe3, 0xff, 0x72, 0x77, 0xc5, 0xde, 0xdd, 0x4c, 0x8e, 0x3b, 0x12, 0x2e, 0x15,
-	0x57, 0x98, 0x08, 0x56, 0x52, 0x58, 0x84, 0xc2, 0xca, 0x19, 0x27, 0x76, 0x36, 0xce, 0x26, 0x59,
-	0x63, 0x86, 0x24, 0x9b, 0x61, 0x17, 0x47, 0xbe, 0x81, 0x25, 0x1f, 0x81, 0xda, 0x0f, 0x61, 0x4d,
-	0x49, 0x69, 0x85, 0x0e, 0x34, 0xd6, 0x7e, 0x02, 0x27, 0xbb, 0x21, 0xe8, 0xc8, 0x58, 0x51, 0x41,
-	0xf2, 0xfe, 0x9e, 0xe7, 0x7d, 0x37, 0xfb, 0x82, 0x7f, 0x2e, 0xa1, 0x11, 0xa1, 0xa6, 0x83, 0xe2,
-	0x81, 0x79, 0xdd, 0x71, 0x30, 0x43, 0x1d, 0xd3, 0xc7, 0x31, 0xa6, 0x01, 0x35, 0x92, 0x21, 0x61,
-	0x04, 0xfe, 0x10, 0x11, 0x23, 0x8d, 0x18, 0x59, 0xa4, 0xf1, 0xd3, 0x27, 0x3e, 0xe1, 0x75, 0x33,
-	0xfd, 0x27, 0xa2, 0x0d, 0x35, 0xb7, 0x51, 0x9c, 0xdb, 0x5c, 0x12, 0xc4, 0x1f, 0xea, 0x6f, 0xba,
-	0x71, 0x2f, 0xaf, 0xeb, 0xf7, 0x05, 0xf0, 0xf5, 0x58, 0x34, 0x3f, 0x63, 0x88, 0x61, 0x78, 0x08,
-	0xca, 0x09, 0x1a, 0xa2, 0x88, 0x2a, 0x72, 0x4b, 0x6e, 0xd7, 0xbb, 0x7f, 0x8c, 0x2d, 0xc3, 0x18,
-	0xa7, 0x3c, 0x62, 0x15, 0x67, 0x0b, 0x4d, 0xb2, 0x33, 0x00, 0x1e, 0x81, 0xaa, 0x83, 0x42, 0x14,
-	0xbb, 0x98, 0x2a, 0x5f, 0x5a, 0x85, 0x76, 0xbd, 0xfb, 0x77, 0x2b, 0x6c, 0x89, 0x50, 0x46, 0xe7,
-	0x0c, 0x74, 0x41, 0x99, 0x8e, 0x92, 0x24, 0x1c, 0x2b, 0x05, 0x4e, 0xff, 0xde, 0xd0, 0x14, 0xe7,
-	0x74, 0x9f, 0x04, 0xb1, 0xb5, 0x9f, 0xa2, 0x77, 0x8f, 0x5a, 0xdb, 0x0f, 0xd8, 0xd5, 0xc8, 0x31,
-	0x5c, 0x12, 0x99, 0xd9, 0x49, 0xc5, 0xcf, 0x1e, 0xf5, 0x06, 0x26, 0x1b, 0x27, 0x98, 0x72, 0x80,
-	0xda, 0x99, 0x1a, 0xba, 0xe0, 0xbb, 0x87, 0x63, 0x12, 0x5d, 0x44, 0x98, 0x21, 0x0f, 0x31, 0xa4,
-	0x14, 0x79, 0xb3, 0xe6, 0xd6, 0x51, 0x4f, 0xb2, 0x90, 0xd5, 0x4c, 0x1b, 0xbe, 0x2c, 0xb4, 0x5f,
-	0x63, 0x14, 0x85, 0x3d, 0xfd, 0xbd, 0x42, 0xb7, 0xbf, 0xf1, 0x17, 0xeb, 0x34, 0x0c, 0x40, 0xcd,
-	0xc3, 0x97, 0x21, 0x62, 0x01, 0x89, 0x95, 0xd2, 0xee, 0x0f, 0xb3, 0xb1, 0xeb, 0x13, 0x19, 0x54,
-	0xb2, 0x0f, 0x0a, 0x15, 0x50, 0x41, 0x9e, 0x37, 0xc4, 0x54, 0x5c, 0x5e, 0xcd, 0x5e, 0x3f, 0x42,
-	0x04, 0x4a, 0xe9, 0x52, 0xac, 0xef, 0x65, 0xa7, 0xc3, 0x08, 0x73, 0xaf, 0x7a, 0x3b, 0xd5, 0xa4,
-	0xe7, 0xa9, 0x26, 0x59, 0xfd, 0xd9, 0x52, 0x95, 0xe7, 0x4b, 0x55, 0x7e, 0x5a, 0xaa, 0xf2, 0x64,
-	0xa5, 0x4a, 0xf3, 0x95, 0x2a, 0x3d, 0xac, 0x54, 0xe9, 0xfc, 0xff, 0xa7, 0xd2, 0x1b, 0xb1, 0xa5,
-	0xdc, 0xed, 0x94, 0xf9, 0x7e, 0x1e, 0xbc, 0x06, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x4b, 0x74, 0x75,
-	0x2f, 0x03, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xb1, 0x8e, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0xae, 0x97, 0xbb, 0xf3, 0x01, 0x12, 0x3e, 0x90, 0x42, 0xe1, 0x92, 0x23, 0x62,
+	0x28, 0x03, 0x09, 0x2d, 0x13, 0x1d, 0x18, 0x52, 0x24, 0x26, 0x24, 0x94, 0x6e, 0x2c, 0x95, 0x93,
+	0x98, 0x10, 0x35, 0x89, 0xa3, 0xd8, 0x45, 0xcd, 0x1b, 0x30, 0xf6, 0x11, 0x3a, 0xf3, 0x04, 0x3c,
+	0x42, 0xc7, 0x8e, 0x4c, 0x05, 0xb5, 0x0b, 0x33, 0x4f, 0x80, 0x62, 0x3b, 0x29, 0xa8, 0x11, 0x53,
+	0xa7, 0xc4, 0xfe, 0xfe, 0xff, 0xdf, 0xe7, 0x7f, 0xf2, 0x19, 0x3c, 0x09, 0x08, 0x4d, 0x09, 0x75,
+	0x7c, 0x94, 0x4d, 0x9d, 0xcf, 0x7d, 0x1f, 0x33, 0xd4, 0x77, 0x22, 0x9c, 0x61, 0x1a, 0x53, 0x3b,
+	0x2f, 0x08, 0x23, 0xf0, 0x4a, 0x48, 0xec, 0x4a, 0x62, 0x4b, 0x49, 0xf7, 0x7e, 0x44, 0x22, 0xc2,
+	0xeb, 0x4e, 0xf5, 0x26, 0xa4, 0x5d, 0xa3, 0xa1, 0x51, 0xdc, 0xd0, 0x02, 0x12, 0x67, 0x07, 0xf5,
+	0xbf, 0xba, 0x71, 0x2e, 0xaf, 0x5b, 0xdf, 0x3a, 0xe0, 0xf6, 0x5b, 0xd1, 0x7c, 0xcc, 0x10, 0xc3,
+	0xf0, 0x15, 0xd0, 0x72, 0x54, 0xa0, 0x94, 0xea, 0xea, 0x8d, 0xda, 0xbb, 0x1c, 0x3c, 0xb2, 0x5b,
+	0x0e, 0x63, 0xbf, 0xe7, 0x12, 0xb7, 0xb3, 0xda, 0x98, 0x8a, 0x27, 0x0d, 0xf0, 0x35, 0x38, 0xf7,
+	0x51, 0x82, 0xb2, 0x00, 0x53, 0xfd, 0xd6, 0xcd, 0x49, 0xef, 0x72, 0xf0, 0xb8, 0xd5, 0xec, 0x0a,
+	0x91, 0x74, 0x37, 0x1e, 0x18, 0x00, 0x8d, 0xce, 0xf2, 0x3c, 0x29, 0xf5, 0x13, 0xee, 0x7e, 0xb8,
+	0x77, 0x53, 0xdc, 0xb8, 0x47, 0x24, 0xce, 0xdc, 0x17, 0x95, 0xf5, 0xeb, 0x0f, 0xb3, 0x17, 0xc5,
+	0xec, 0xd3, 0xcc, 0xb7, 0x03, 0x92, 0x3a, 0x32, 0xa9, 0x78, 0x3c, 0xa7, 0xe1, 0xd4, 0x61, 0x65,
+	0x8e, 0x29, 0x37, 0x50, 0x4f, 0xa2, 0x61, 0x00, 0xee, 0x86, 0x38, 0x23, 0xe9, 0x24, 0xc5, 0x0c,
+	0x85, 0x88, 0x21, 0xbd, 0xc3, 0x9b, 0x5d, 0xb7, 0x1e, 0xf5, 0x9d, 0x14, 0xb9, 0xd7, 0x55, 0xc3,
+	0xdf, 0x1b, 0xf3, 0x41, 0x89, 0xd2, 0x64, 0x68, 0xfd, 0x8b, 0xb0, 0xbc, 0x3b, 0x7c, 0xa3, 0x56,
+	0xc3, 0x18, 0x5c, 0x84, 0xf8, 0x63, 0x82, 0x58, 0x4c, 0x32, 0xfd, 0xf4, 0xf8, 0x61, 0xf6, 0x74,
+	0x38, 0x07, 0x57, 0xcd, 0x62, 0xe2, 0x97, 0x13, 0x4a, 0x66, 0x45, 0x80, 0x75, 0x8d, 0x37, 0x7d,
+	0xda, 0x1a, 0xea, 0x4d, 0xad, 0x1f, 0x73, 0xad, 0x6b, 0xc9, 0x6c, 0xdd, 0x3a, 0xdb, 0x01, 0xce,
+	0xf2, 0xee, 0x35, 0xbb, 0x6e, 0x29, 0x6c, 0xd6, 0x42, 0x05, 0x67, 0xf2, 0x57, 0x42, 0x1d, 0x9c,
+	0xa1, 0x30, 0x2c, 0x30, 0x15, 0x63, 0x73, 0xe1, 0xd5, 0x4b, 0x88, 0xc0, 0x69, 0x35, 0x8e, 0xf5,
+	0x44, 0x1c, 0xf5, 0x33, 0x08, 0xf2, 0xf0, 0xfc, 0xcb, 0xd2, 0x54, 0x7e, 0x2d, 0x4d, 0xc5, 0x1d,
+	0xad, 0xb6, 0x86, 0xba, 0xde, 0x1a, 0xea, 0xcf, 0xad, 0xa1, 0x2e, 0x76, 0x86, 0xb2, 0xde, 0x19,
+	0xca, 0xf7, 0x9d, 0xa1, 0x7c, 0x78, 0xf6, 0x5f, 0xe8, 0x5c, 0xdc, 0x0f, 0xce, 0xf6, 0x35, 0x7e,
+	0x33, 0x5e, 0xfe, 0x19, 0x00, 0xd4, 0x1c, 0xf9, 0xbf, 0xa9, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +218,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeflationBySource) > 0 {
+		for iNdEx := len(m.DeflationBySource) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeflationBySource[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Deflation) > 0 {
 		for iNdEx := len(m.Deflation) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -363,6 +388,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DeflationBySource) > 0 {
+		for _, e := range m.DeflationBySource {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -589,6 +620,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeflationBySource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeflationBySource = append(m.DeflationBySource, DeflationSource{})
+			if err := m.DeflationBySource[len(m.DeflationBySource)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
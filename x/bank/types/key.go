@@ -28,6 +28,9 @@ var (
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
 	DeflationKey        = []byte{0x04}
+
+	DeflationBySourcePrefix = []byte{0x05}
+	DeflationHistoryPrefix  = []byte{0x06}
 )
 
 // DenomMetadataKey returns the denomination metadata key.
@@ -58,3 +61,46 @@ func AddressFromBalancesStore(key []byte) (sdk.AccAddress, error) {
 func CreateAccountBalancesPrefix(addr []byte) []byte {
 	return append(BalancesPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// CreateDeflationBySourcePrefix creates the prefix for the deflation of a
+// source module account.
+func CreateDeflationBySourcePrefix(source string) []byte {
+	return append(DeflationBySourcePrefix, address.MustLengthPrefix([]byte(source))...)
+}
+
+// DeflationBySourceKey returns the key of the deflation of a denom for a
+// source module account.
+func DeflationBySourceKey(source, denom string) []byte {
+	return append(CreateDeflationBySourcePrefix(source), []byte(denom)...)
+}
+
+// DeflationHistoryHeightPrefix returns the prefix of the deflation history
+// entries of a height.
+func DeflationHistoryHeightPrefix(height int64) []byte {
+	return append(DeflationHistoryPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// DeflationHistoryKey returns the key of the deflation history entry of a denom
+// burnt from a source module account at a height.
+func DeflationHistoryKey(height int64, source, denom string) []byte {
+	key := append(DeflationHistoryHeightPrefix(height), address.MustLengthPrefix([]byte(source))...)
+	return append(key, []byte(denom)...)
+}
+
+// SplitDeflationBySourceKey returns the source and the denom from a deflation
+// by source key, without the DeflationBySourcePrefix.
+func SplitDeflationBySourceKey(key []byte) (source, denom string) {
+	kv.AssertKeyAtLeastLength(key, 1)
+	sourceLen := int(key[0])
+	kv.AssertKeyAtLeastLength(key, 1+sourceLen)
+	return string(key[1 : 1+sourceLen]), string(key[1+sourceLen:])
+}
+
+// SplitDeflationHistoryKey returns the height, the source and the denom from a
+// deflation history key, without the DeflationHistoryPrefix.
+func SplitDeflationHistoryKey(key []byte) (height int64, source, denom string) {
+	kv.AssertKeyAtLeastLength(key, 8)
+	height = int64(sdk.BigEndianToUint64(key[:8]))
+	source, denom = SplitDeflationBySourceKey(key[8:])
+	return height, source, denom
+}
@@ -2,7 +2,6 @@ package types
 
 import (
 	"fmt"
-	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
@@ -13,6 +12,9 @@ import (
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
+	// DefaultDeflationHistoryBlocks keeps about a week of deflation history
+	// with 6 seconds blocks
+	DefaultDeflationHistoryBlocks uint64 = 100800
 )
 
 var (
@@ -20,8 +22,10 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
-	// KeyBurntFeeDenom is store's key for BurntFeeDenom Params
-	KeyBurntFeeDenom = []byte("BurntFeeDenom")
+	// KeyBurntFees is store's key for BurntFees Params
+	KeyBurntFees = []byte("BurntFees")
+	// KeyDeflationHistoryBlocks is store's key for DeflationHistoryBlocks Params
+	KeyDeflationHistoryBlocks = []byte("DeflationHistoryBlocks")
 )
 
 // ParamKeyTable for bank module.
@@ -30,11 +34,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the bank module
-func NewParams(defaultSendEnabled bool, sendEnabledParams SendEnabledParams, burntFeeDenom string) Params {
+func NewParams(defaultSendEnabled bool, sendEnabledParams SendEnabledParams, burntFees []BurntFee) Params {
 	return Params{
 		SendEnabled:        sendEnabledParams,
 		DefaultSendEnabled: defaultSendEnabled,
-		BurntFeeDenom:      burntFeeDenom,
+		BurntFees:          burntFees,
 	}
 }
 
@@ -44,7 +48,9 @@ func DefaultParams() Params {
 		SendEnabled: SendEnabledParams{},
 		// The default send enabled value allows send transfers for all coin denoms
 		DefaultSendEnabled: true,
-		BurntFeeDenom: sdk.DefaultBondDenom,
+		// The whole fees paid in the bond denom are burnt by default
+		BurntFees: []BurntFee{NewBurntFee(sdk.DefaultBondDenom, sdk.OneDec())},
+		DeflationHistoryBlocks: DefaultDeflationHistoryBlocks,
 	}
 }
 
@@ -53,7 +59,10 @@ func (p Params) Validate() error {
 	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
 		return err
 	}
-	if err := validateBurntFeeDenom(p.BurntFeeDenom); err != nil {
+	if err := validateBurntFees(p.BurntFees); err != nil {
+		return err
+	}
+	if err := validateDeflationHistoryBlocks(p.DeflationHistoryBlocks); err != nil {
 		return err
 	}
 	return validateIsBool(p.DefaultSendEnabled)
@@ -85,7 +94,9 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 		}
 	}
 	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams, p.BurntFeeDenom)
+	params := NewParams(p.DefaultSendEnabled, sendParams, p.BurntFees)
+	params.DeflationHistoryBlocks = p.DeflationHistoryBlocks
+	return params
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -93,7 +104,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
-		paramtypes.NewParamSetPair(KeyBurntFeeDenom, &p.BurntFeeDenom, validateBurntFeeDenom),
+		paramtypes.NewParamSetPair(KeyBurntFees, &p.BurntFees, validateBurntFees),
+		paramtypes.NewParamSetPair(KeyDeflationHistoryBlocks, &p.DeflationHistoryBlocks, validateDeflationHistoryBlocks),
 	}
 }
 
@@ -150,17 +162,67 @@ func validateIsBool(i interface{}) error {
 	return nil
 }
 
-func validateBurntFeeDenom(i interface{}) error {
-	v, ok := i.(string)
+// BurnRatioOf returns the fraction of the fees paid in the given denom which
+// is burnt, zero if the denom is not burnt.
+func (p Params) BurnRatioOf(denom string) sdk.Dec {
+	for _, bf := range p.BurntFees {
+		if bf.Denom == denom {
+			return bf.BurnRatio
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+// NewBurntFee creates a new BurntFee object
+func NewBurntFee(denom string, burnRatio sdk.Dec) BurntFee {
+	return BurntFee{
+		Denom:     denom,
+		BurnRatio: burnRatio,
+	}
+}
+
+// String implements stringer insterface
+func (bf BurntFee) String() string {
+	out, _ := yaml.Marshal(bf)
+	return string(out)
+}
+
+func validateBurntFees(i interface{}) error {
+	burntFees, ok := i.([]BurntFee)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	
-	if strings.TrimSpace(v) != "" {
-		if err := sdk.ValidateDenom(v); err != nil {
+	// ensure each denom is only registered one time.
+	registered := make(map[string]bool)
+	for _, bf := range burntFees {
+		if registered[bf.Denom] {
+			return fmt.Errorf("duplicate burnt fee parameter found: '%s'", bf.Denom)
+		}
+		if err := validateBurntFee(bf); err != nil {
 			return err
 		}
+		registered[bf.Denom] = true
+	}
+	return nil
+}
+
+func validateBurntFee(bf BurntFee) error {
+	if err := sdk.ValidateDenom(bf.Denom); err != nil {
+		return err
 	}
+	if bf.BurnRatio.IsNil() {
+		return fmt.Errorf("burn ratio of %s must be not nil", bf.Denom)
+	}
+	if bf.BurnRatio.IsNegative() || bf.BurnRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("burn ratio of %s must be between 0 and 1: %s", bf.Denom, bf.BurnRatio)
+	}
+	return nil
+}
 
+func validateDeflationHistoryBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
 	return nil
 }
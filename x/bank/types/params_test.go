@@ -97,6 +97,10 @@ func Test_validateParams(t *testing.T) {
 - denom: foodenom2
   enabled: false
 default_send_enabled: true
+deflation_history_blocks: 100800
+burnt_fees:
+- denom: stake
+  burn_ratio: "1.000000000000000000"
 `
 	require.Equal(t, paramYaml, params.String())
 
@@ -107,13 +111,17 @@ default_send_enabled: true
   enabled: false
 - denom: foodenom2
   enabled: false
+deflation_history_blocks: 100800
+burnt_fees:
+- denom: stake
+  burn_ratio: "1.000000000000000000"
 `
 	require.Equal(t, paramYaml, params.String())
 
 	params = NewParams(true, SendEnabledParams{
 		NewSendEnabled("foodenom", false),
 		NewSendEnabled("foodenom", true), // this is not allowed
-	})
+	}, nil)
 
 	// fails due to duplicate entries.
 	require.Error(t, params.Validate())
@@ -123,3 +131,34 @@ default_send_enabled: true
 
 	require.Error(t, validateSendEnabledParams(SendEnabledParams{NewSendEnabled("INVALIDDENOM", true)}))
 }
+
+func Test_validateBurntFees(t *testing.T) {
+	tests := []struct {
+		name      string
+		burntFees []BurntFee
+		wantErr   bool
+	}{
+		{"empty", []BurntFee{}, false},
+		{"whole fee", []BurntFee{NewBurntFee("foo", sdk.OneDec())}, false},
+		{"multiple denoms", []BurntFee{NewBurntFee("foo", sdk.NewDecWithPrec(5, 1)), NewBurntFee("bar", sdk.ZeroDec())}, false},
+		{"duplicate denom", []BurntFee{NewBurntFee("foo", sdk.NewDecWithPrec(5, 1)), NewBurntFee("foo", sdk.OneDec())}, true},
+		{"invalid denom", []BurntFee{NewBurntFee("1foo", sdk.OneDec())}, true},
+		{"nil ratio", []BurntFee{{Denom: "foo"}}, true},
+		{"negative ratio", []BurntFee{NewBurntFee("foo", sdk.NewDec(-1))}, true},
+		{"ratio above one", []BurntFee{NewBurntFee("foo", sdk.NewDecWithPrec(11, 1))}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateBurntFees(tt.burntFees) != nil)
+		})
+	}
+
+	require.Error(t, validateBurntFees(NewBurntFee("foo", sdk.OneDec())))
+}
+
+func TestParams_BurnRatioOf(t *testing.T) {
+	params := NewParams(true, SendEnabledParams{}, []BurntFee{NewBurntFee("foo", sdk.NewDecWithPrec(5, 1))})
+
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), params.BurnRatioOf("foo"))
+	require.Equal(t, sdk.ZeroDec(), params.BurnRatioOf("bar"))
+}
@@ -975,6 +975,100 @@ func (m *QueryDeflationOfResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// QueryDeflationBySourceRequest is the request type for the Query/DeflationBySource
+// RPC method.
+type QueryDeflationBySourceRequest struct {
+	// source is the (optional) name of the module account to query the deflation for.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// start_height is the first height of the (optional) range of the deflation
+	// history to query. The total deflation is returned if neither start_height
+	// nor end_height are set.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last height of the range of the deflation history to
+	// query, it defaults to the current height.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryDeflationBySourceRequest) Reset()         { *m = QueryDeflationBySourceRequest{} }
+func (m *QueryDeflationBySourceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeflationBySourceRequest) ProtoMessage()    {}
+func (*QueryDeflationBySourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{20}
+}
+func (m *QueryDeflationBySourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeflationBySourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeflationBySourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeflationBySourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeflationBySourceRequest.Merge(m, src)
+}
+func (m *QueryDeflationBySourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeflationBySourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeflationBySourceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeflationBySourceRequest proto.InternalMessageInfo
+
+// QueryDeflationBySourceResponse is the response type for the Query/DeflationBySource
+// RPC method.
+type QueryDeflationBySourceResponse struct {
+	// deflation is the deflation of the coins by source module account.
+	Deflation []DeflationSource `protobuf:"bytes,1,rep,name=deflation,proto3" json:"deflation"`
+}
+
+func (m *QueryDeflationBySourceResponse) Reset()         { *m = QueryDeflationBySourceResponse{} }
+func (m *QueryDeflationBySourceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeflationBySourceResponse) ProtoMessage()    {}
+func (*QueryDeflationBySourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{21}
+}
+func (m *QueryDeflationBySourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeflationBySourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeflationBySourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeflationBySourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeflationBySourceResponse.Merge(m, src)
+}
+func (m *QueryDeflationBySourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeflationBySourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeflationBySourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeflationBySourceResponse proto.InternalMessageInfo
+
+func (m *QueryDeflationBySourceResponse) GetDeflation() []DeflationSource {
+	if m != nil {
+		return m.Deflation
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -996,75 +1090,84 @@ func init() {
 	proto.RegisterType((*QueryTotalDeflationResponse)(nil), "cosmos.bank.v1beta1.QueryTotalDeflationResponse")
 	proto.RegisterType((*QueryDeflationOfRequest)(nil), "cosmos.bank.v1beta1.QueryDeflationOfRequest")
 	proto.RegisterType((*QueryDeflationOfResponse)(nil), "cosmos.bank.v1beta1.QueryDeflationOfResponse")
+	proto.RegisterType((*QueryDeflationBySourceRequest)(nil), "cosmos.bank.v1beta1.QueryDeflationBySourceRequest")
+	proto.RegisterType((*QueryDeflationBySourceResponse)(nil), "cosmos.bank.v1beta1.QueryDeflationBySourceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x85, 0x3a, 0xc9, 0x33, 0x54, 0x62, 0x1a, 0x44, 0xba, 0x6d, 0xd7, 0xb0, 0x2a,
-	0xb5, 0x13, 0x25, 0x3b, 0x89, 0x03, 0x42, 0xed, 0x05, 0x35, 0xad, 0xe0, 0x80, 0x50, 0x83, 0xc3,
-	0x09, 0x09, 0x45, 0x63, 0x7b, 0x6a, 0x56, 0x59, 0xef, 0x6c, 0x33, 0x6b, 0x44, 0xa8, 0x7a, 0x00,
-	0x09, 0x09, 0x89, 0x03, 0x95, 0x38, 0x21, 0x38, 0x54, 0x5c, 0x10, 0x1c, 0x39, 0xf1, 0x27, 0xe4,
-	0xc0, 0xa1, 0xc0, 0x85, 0x13, 0xa0, 0x84, 0x03, 0x7f, 0x06, 0xf2, 0xfc, 0xd8, 0xec, 0xda, 0xbb,
-	0xeb, 0x45, 0x38, 0x42, 0x3d, 0xc5, 0x1e, 0xbf, 0x1f, 0x9f, 0xf7, 0x66, 0xe6, 0x7d, 0x27, 0x50,
-	0xef, 0x72, 0x31, 0xe0, 0x82, 0x74, 0x68, 0xb0, 0x47, 0xde, 0xdf, 0xe8, 0xb0, 0x88, 0x6e, 0x90,
-	0xbb, 0x43, 0xb6, 0x7f, 0xe0, 0x86, 0xfb, 0x3c, 0xe2, 0xf8, 0xbc, 0x32, 0x70, 0x47, 0x06, 0xae,
-	0x36, 0xb0, 0x56, 0x62, 0x2f, 0xc1, 0x94, 0x75, 0xec, 0x1b, 0xd2, 0xbe, 0x17, 0xd0, 0xc8, 0xe3,
-	0x81, 0x0a, 0x60, 0x2d, 0xf6, 0x79, 0x9f, 0xcb, 0x8f, 0x64, 0xf4, 0x49, 0xaf, 0x5e, 0xea, 0x73,
-	0xde, 0xf7, 0x19, 0xa1, 0xa1, 0x47, 0x68, 0x10, 0xf0, 0x48, 0xba, 0x08, 0xfd, 0xab, 0x9d, 0x8c,
-	0x6f, 0x22, 0x77, 0xb9, 0x17, 0x4c, 0xfc, 0x9e, 0xa0, 0x96, 0x84, 0xf2, 0x77, 0xe7, 0x36, 0x9c,
-	0x7f, 0x6b, 0x44, 0xb5, 0x45, 0x7d, 0x1a, 0x74, 0x59, 0x9b, 0xdd, 0x1d, 0x32, 0x11, 0xe1, 0x25,
-	0x98, 0xa3, 0xbd, 0xde, 0x3e, 0x13, 0x62, 0x09, 0x3d, 0x8f, 0x9a, 0x0b, 0x6d, 0xf3, 0x15, 0x2f,
-	0xc2, 0xd9, 0x1e, 0x0b, 0xf8, 0x60, 0xe9, 0x8c, 0x5c, 0x57, 0x5f, 0xae, 0xcf, 0x7f, 0xfa, 0xb0,
-	0x5e, 0xf9, 0xfb, 0x61, 0xbd, 0xe2, 0xbc, 0x01, 0x8b, 0xe9, 0x80, 0x22, 0xe4, 0x81, 0x60, 0x78,
-	0x13, 0xe6, 0x3a, 0x6a, 0x49, 0x46, 0xac, 0xb5, 0x2e, 0xb8, 0x71, 0xbf, 0x04, 0x33, 0xfd, 0x72,
-	0x6f, 0x72, 0x2f, 0x68, 0x1b, 0x4b, 0xe7, 0x13, 0x04, 0xcf, 0xc9, 0x68, 0x37, 0x7c, 0x5f, 0x07,
-	0x14, 0xd3, 0x11, 0x5f, 0x03, 0x38, 0xe9, 0xad, 0xe4, 0xac, 0xb5, 0xae, 0xa6, 0xb2, 0xa9, 0x6d,
-	0x33, 0x39, 0xb7, 0x69, 0xdf, 0x14, 0xde, 0x4e, 0x78, 0x26, 0x8a, 0xfa, 0x09, 0xc1, 0xd2, 0x24,
-	0x87, 0xae, 0xac, 0x0f, 0xf3, 0x9a, 0x77, 0x44, 0xf2, 0x44, 0x61, 0x69, 0x5b, 0xeb, 0x87, 0xbf,
-	0xd7, 0x2b, 0xdf, 0xff, 0x51, 0x6f, 0xf6, 0xbd, 0xe8, 0xbd, 0x61, 0xc7, 0xed, 0xf2, 0x01, 0xd1,
-	0x5b, 0xa4, 0xfe, 0xac, 0x89, 0xde, 0x1e, 0x89, 0x0e, 0x42, 0x26, 0xa4, 0x83, 0x68, 0xc7, 0xc1,
-	0xf1, 0xeb, 0x19, 0x75, 0x35, 0xa6, 0xd6, 0xa5, 0x28, 0x93, 0x85, 0x39, 0x9f, 0x21, 0xb8, 0x2c,
-	0xcb, 0xd9, 0x09, 0x59, 0xd0, 0xa3, 0x1d, 0x9f, 0xfd, 0x9f, 0xcd, 0xfd, 0x05, 0x81, 0x9d, 0x47,
-	0xf3, 0xd8, 0xb6, 0x78, 0x4f, 0x1f, 0xdc, 0xb7, 0x79, 0x44, 0xfd, 0x9d, 0x61, 0x18, 0xfa, 0x07,
-	0xa6, 0xb7, 0xe9, 0x0e, 0xa2, 0x19, 0x74, 0xf0, 0xd0, 0x1c, 0xcf, 0x54, 0x36, 0xdd, 0xbb, 0x2e,
-	0x54, 0x85, 0x5c, 0x39, 0x8d, 0xce, 0xe9, 0xd0, 0xb3, 0xeb, 0xdb, 0xaa, 0x1e, 0x1f, 0xaa, 0x88,
-	0xdb, 0x77, 0x4c, 0xd3, 0xe2, 0xb1, 0x83, 0x12, 0x63, 0xc7, 0xd9, 0x86, 0x67, 0xc7, 0xac, 0x75,
-	0xd1, 0xaf, 0x40, 0x95, 0x0e, 0xf8, 0x30, 0x88, 0xa6, 0x0e, 0x9b, 0xad, 0x27, 0x47, 0x45, 0xb7,
-	0xb5, 0xb9, 0xb3, 0x08, 0x58, 0x46, 0xdc, 0xa6, 0xfb, 0x74, 0x60, 0xae, 0x83, 0xb3, 0xad, 0xa7,
-	0xa4, 0x59, 0xd5, 0x59, 0xae, 0x41, 0x35, 0x94, 0x2b, 0x3a, 0xcb, 0x45, 0x37, 0x43, 0x02, 0x5c,
-	0xe5, 0x64, 0xf2, 0x28, 0x07, 0xa7, 0x07, 0x96, 0x8c, 0x78, 0x6b, 0x54, 0x87, 0x78, 0x93, 0x45,
-	0xb4, 0x47, 0x23, 0x3a, 0xe3, 0x23, 0xe2, 0x7c, 0x87, 0xe0, 0x62, 0x66, 0x1a, 0x5d, 0xc0, 0x0d,
-	0x58, 0x18, 0xe8, 0x35, 0x73, 0xb1, 0x2e, 0x67, 0xd6, 0x60, 0x3c, 0x75, 0x15, 0x27, 0x5e, 0xb3,
-	0xdb, 0xf9, 0x0d, 0xb8, 0x70, 0x82, 0x3a, 0xde, 0x90, 0xec, 0xed, 0x7f, 0x37, 0xd9, 0xc4, 0x89,
-	0xe2, 0x5e, 0x85, 0x79, 0x83, 0xa9, 0x5b, 0x58, 0xaa, 0xb6, 0xd8, 0xc9, 0x09, 0x74, 0x78, 0x79,
-	0xab, 0x6e, 0xb1, 0x3b, 0xbe, 0x04, 0x3d, 0xbd, 0x6b, 0xfc, 0xb3, 0xd9, 0xad, 0xf1, 0x84, 0xba,
-	0x20, 0x0f, 0x16, 0x7a, 0x66, 0xf1, 0x34, 0x2e, 0xf3, 0x49, 0xf4, 0xd9, 0xed, 0x2a, 0xd1, 0x73,
-	0x30, 0xae, 0x66, 0xda, 0x95, 0xde, 0xd1, 0xa3, 0x2c, 0xe5, 0xf0, 0x1f, 0x6f, 0x75, 0xeb, 0x87,
-	0xa7, 0xe0, 0xac, 0x8c, 0x8a, 0xbf, 0x44, 0x30, 0xa7, 0xe5, 0x05, 0x37, 0x33, 0x8f, 0x43, 0xc6,
-	0x73, 0xc8, 0x5a, 0x2e, 0x61, 0xa9, 0x18, 0x9d, 0x6b, 0x1f, 0xff, 0xfa, 0xd7, 0x17, 0x67, 0x36,
-	0xf1, 0x06, 0xf1, 0xba, 0xa1, 0x4f, 0x3f, 0xa4, 0xe3, 0x4f, 0x2f, 0xa5, 0x34, 0xe4, 0x9e, 0x16,
-	0xd4, 0xfb, 0xa4, 0x73, 0xb0, 0x2b, 0x4b, 0xc7, 0x5f, 0x23, 0xa8, 0x25, 0x1e, 0x18, 0x78, 0x35,
-	0x3f, 0xeb, 0xe4, 0x7b, 0xc8, 0x5a, 0x2b, 0x69, 0xad, 0x39, 0xd7, 0x25, 0xe7, 0x0a, 0x6e, 0x96,
-	0xe5, 0xc4, 0x3f, 0x22, 0x78, 0x66, 0x42, 0xa2, 0x71, 0x2b, 0x3f, 0x6d, 0xde, 0xeb, 0xc2, 0xda,
-	0xfc, 0x57, 0x3e, 0x1a, 0xf8, 0xba, 0x04, 0x7e, 0x09, 0xb7, 0xb2, 0x81, 0x85, 0x71, 0xdc, 0xcd,
-	0x40, 0x7f, 0x80, 0xa0, 0x96, 0xd0, 0xc6, 0xa2, 0xce, 0x4e, 0x0a, 0x76, 0x51, 0x67, 0x33, 0x04,
-	0xd7, 0xb9, 0x22, 0x41, 0x6d, 0x7c, 0x29, 0x07, 0x54, 0x21, 0x7c, 0x8e, 0x60, 0xde, 0xc8, 0x16,
-	0x2e, 0x38, 0x5f, 0x63, 0x42, 0x68, 0xad, 0x94, 0x31, 0xd5, 0x24, 0xab, 0x92, 0xe4, 0x2a, 0xbe,
-	0x52, 0x44, 0x42, 0xee, 0xc9, 0xd3, 0x77, 0x1f, 0x7f, 0x84, 0xa0, 0xaa, 0xb4, 0x0a, 0x37, 0xf2,
-	0x93, 0xa4, 0x84, 0xd1, 0x6a, 0x4e, 0x37, 0x2c, 0xd7, 0x15, 0x25, 0x8b, 0xf8, 0x5b, 0x04, 0x4f,
-	0xa7, 0xa6, 0x39, 0x76, 0xf3, 0x33, 0x64, 0x29, 0x85, 0x45, 0x4a, 0xdb, 0x6b, 0xb0, 0x97, 0x25,
-	0x18, 0xc1, 0x6b, 0xd9, 0x60, 0xb2, 0x39, 0x62, 0xd7, 0x88, 0x42, 0xdc, 0xad, 0x6f, 0x10, 0x9c,
-	0x4b, 0xab, 0x2a, 0x9e, 0x96, 0x7a, 0x5c, 0xe6, 0xad, 0xf5, 0xf2, 0x0e, 0x1a, 0x76, 0x4d, 0xc2,
-	0x36, 0xf0, 0x8b, 0xa5, 0x60, 0x47, 0x13, 0xe5, 0x5c, 0x5a, 0x4c, 0x8a, 0x20, 0x33, 0x75, 0xae,
-	0x08, 0x32, 0x5b, 0xa7, 0x9c, 0x86, 0x84, 0x7c, 0x01, 0xd7, 0xf3, 0x20, 0x0d, 0xcb, 0x57, 0x08,
-	0x6a, 0x89, 0x39, 0x5f, 0x74, 0x2d, 0x27, 0xf5, 0xa3, 0xe8, 0x5a, 0x66, 0x88, 0x87, 0x43, 0x24,
-	0xd5, 0x32, 0x6e, 0x4c, 0xa1, 0x32, 0x3b, 0xbc, 0x75, 0xf3, 0xf0, 0xc8, 0x46, 0x8f, 0x8e, 0x6c,
-	0xf4, 0xe7, 0x91, 0x8d, 0x1e, 0x1c, 0xdb, 0x95, 0x47, 0xc7, 0x76, 0xe5, 0xb7, 0x63, 0xbb, 0xf2,
-	0xce, 0x72, 0xa1, 0xa4, 0x7e, 0xa0, 0x02, 0x4b, 0x65, 0xed, 0x54, 0xe5, 0xbf, 0xd9, 0x9b, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x18, 0x12, 0xcc, 0x21, 0x3e, 0x10, 0x00, 0x00,
+	// 1116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x98, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xc0, 0x3d, 0x2d, 0x75, 0x92, 0x67, 0x5a, 0xa9, 0x93, 0x00, 0xee, 0xb6, 0x59, 0xd3, 0x55,
+	0xa8, 0x9d, 0x10, 0x7b, 0x63, 0x1b, 0x84, 0xda, 0x0b, 0xaa, 0x5b, 0x41, 0x25, 0x84, 0x1a, 0x1c,
+	0x4e, 0x48, 0xc8, 0x1a, 0x7b, 0xa7, 0x8e, 0x89, 0xbd, 0xbb, 0xf5, 0xac, 0x11, 0xa6, 0xea, 0x01,
+	0x10, 0x12, 0x12, 0x07, 0x2a, 0x71, 0x42, 0x70, 0xa8, 0xb8, 0x20, 0xb8, 0x70, 0xe5, 0x23, 0xe4,
+	0xc0, 0xa1, 0xc0, 0x85, 0x13, 0xa0, 0xa4, 0x07, 0x3e, 0x06, 0xf2, 0xfc, 0x59, 0xaf, 0xbd, 0xbb,
+	0xf6, 0x56, 0x38, 0x42, 0x9c, 0xe2, 0x7d, 0xfb, 0xfe, 0xfc, 0xde, 0x7b, 0x3b, 0xf3, 0x9e, 0x02,
+	0xb9, 0x96, 0xc3, 0x7a, 0x0e, 0x33, 0x9b, 0xc4, 0x3e, 0x30, 0xdf, 0x2f, 0x37, 0xa9, 0x47, 0xca,
+	0xe6, 0xdd, 0x01, 0xed, 0x0f, 0x4b, 0x6e, 0xdf, 0xf1, 0x1c, 0xbc, 0x2a, 0x14, 0x4a, 0x23, 0x85,
+	0x92, 0x54, 0xd0, 0xb6, 0x7c, 0x2b, 0x46, 0x85, 0xb6, 0x6f, 0xeb, 0x92, 0x76, 0xc7, 0x26, 0x5e,
+	0xc7, 0xb1, 0x85, 0x03, 0x6d, 0xad, 0xed, 0xb4, 0x1d, 0xfe, 0xd3, 0x1c, 0xfd, 0x92, 0xd2, 0x4b,
+	0x6d, 0xc7, 0x69, 0x77, 0xa9, 0x49, 0xdc, 0x8e, 0x49, 0x6c, 0xdb, 0xf1, 0xb8, 0x09, 0x93, 0x6f,
+	0xf5, 0xa0, 0x7f, 0xe5, 0xb9, 0xe5, 0x74, 0xec, 0xd0, 0xfb, 0x00, 0x35, 0x27, 0xe4, 0xef, 0x8d,
+	0xdb, 0xb0, 0xfa, 0xd6, 0x88, 0xaa, 0x46, 0xba, 0xc4, 0x6e, 0xd1, 0x3a, 0xbd, 0x3b, 0xa0, 0xcc,
+	0xc3, 0x59, 0x58, 0x22, 0x96, 0xd5, 0xa7, 0x8c, 0x65, 0xd1, 0xf3, 0xa8, 0xb0, 0x52, 0x57, 0x8f,
+	0x78, 0x0d, 0xce, 0x58, 0xd4, 0x76, 0x7a, 0xd9, 0x53, 0x5c, 0x2e, 0x1e, 0xae, 0x2d, 0x7f, 0xf6,
+	0x30, 0x97, 0xfa, 0xfb, 0x61, 0x2e, 0x65, 0xbc, 0x01, 0x6b, 0x93, 0x0e, 0x99, 0xeb, 0xd8, 0x8c,
+	0xe2, 0x2a, 0x2c, 0x35, 0x85, 0x88, 0x7b, 0xcc, 0x54, 0x2e, 0x94, 0xfc, 0x7a, 0x31, 0xaa, 0xea,
+	0x55, 0xba, 0xe1, 0x74, 0xec, 0xba, 0xd2, 0x34, 0x3e, 0x45, 0xf0, 0x1c, 0xf7, 0x76, 0xbd, 0xdb,
+	0x95, 0x0e, 0xd9, 0x7c, 0xc4, 0xd7, 0x00, 0xc6, 0xb5, 0xe5, 0x9c, 0x99, 0xca, 0x95, 0x89, 0x68,
+	0xa2, 0x6d, 0x2a, 0xe6, 0x2e, 0x69, 0xab, 0xc4, 0xeb, 0x01, 0xcb, 0x40, 0x52, 0x3f, 0x23, 0xc8,
+	0x86, 0x39, 0x64, 0x66, 0x6d, 0x58, 0x96, 0xbc, 0x23, 0x92, 0xd3, 0x33, 0x53, 0xab, 0xed, 0x1c,
+	0xfe, 0x91, 0x4b, 0xfd, 0xf0, 0x67, 0xae, 0xd0, 0xee, 0x78, 0xfb, 0x83, 0x66, 0xa9, 0xe5, 0xf4,
+	0x4c, 0xd9, 0x22, 0xf1, 0xa7, 0xc8, 0xac, 0x03, 0xd3, 0x1b, 0xba, 0x94, 0x71, 0x03, 0x56, 0xf7,
+	0x9d, 0xe3, 0xd7, 0x23, 0xf2, 0xca, 0xcf, 0xcd, 0x4b, 0x50, 0x06, 0x13, 0x33, 0x3e, 0x47, 0xb0,
+	0xce, 0xd3, 0xd9, 0x73, 0xa9, 0x6d, 0x91, 0x66, 0x97, 0xfe, 0x97, 0xc5, 0xfd, 0x15, 0x81, 0x1e,
+	0x47, 0xf3, 0xbf, 0x2d, 0xf1, 0x81, 0xfc, 0x70, 0xdf, 0x76, 0x3c, 0xd2, 0xdd, 0x1b, 0xb8, 0x6e,
+	0x77, 0xa8, 0x6a, 0x3b, 0x59, 0x41, 0xb4, 0x80, 0x0a, 0x1e, 0xaa, 0xcf, 0x73, 0x22, 0x9a, 0xac,
+	0x5d, 0x0b, 0xd2, 0x8c, 0x4b, 0x4e, 0xa2, 0x72, 0xd2, 0xf5, 0xe2, 0xea, 0xb6, 0x2d, 0xaf, 0x0f,
+	0x91, 0xc4, 0xed, 0x3b, 0xaa, 0x68, 0xfe, 0xb5, 0x83, 0x02, 0xd7, 0x8e, 0xb1, 0x0b, 0xcf, 0x4c,
+	0x69, 0xcb, 0xa4, 0x5f, 0x81, 0x34, 0xe9, 0x39, 0x03, 0xdb, 0x9b, 0x7b, 0xd9, 0xd4, 0x9e, 0x1a,
+	0x25, 0x5d, 0x97, 0xea, 0xc6, 0x1a, 0x60, 0xee, 0x71, 0x97, 0xf4, 0x49, 0x4f, 0x1d, 0x07, 0x63,
+	0x17, 0x56, 0x27, 0xa4, 0x32, 0xca, 0x55, 0x48, 0xbb, 0x5c, 0x22, 0xa3, 0x5c, 0x2c, 0x45, 0x8c,
+	0x80, 0x92, 0x30, 0x52, 0x71, 0x84, 0x81, 0x61, 0x81, 0xc6, 0x3d, 0xde, 0x1c, 0xe5, 0xc1, 0xde,
+	0xa4, 0x1e, 0xb1, 0x88, 0x47, 0x16, 0xfc, 0x89, 0x18, 0xdf, 0x23, 0xb8, 0x18, 0x19, 0x46, 0x26,
+	0x70, 0x1d, 0x56, 0x7a, 0x52, 0xa6, 0x0e, 0xd6, 0x7a, 0x64, 0x0e, 0xca, 0x52, 0x66, 0x31, 0xb6,
+	0x5a, 0x5c, 0xe7, 0xcb, 0x70, 0x61, 0x8c, 0x3a, 0x5d, 0x90, 0xe8, 0xf6, 0xbf, 0x0b, 0x5a, 0x94,
+	0x89, 0x4c, 0xee, 0x55, 0x58, 0x56, 0x98, 0xb2, 0x84, 0x89, 0x72, 0xf3, 0x8d, 0x0c, 0x1b, 0xb4,
+	0xf1, 0xa9, 0xba, 0x49, 0xef, 0x74, 0x39, 0xe8, 0xc9, 0x1d, 0xe3, 0x5f, 0x54, 0xb7, 0xa6, 0x03,
+	0xca, 0x84, 0x3a, 0xb0, 0x62, 0x29, 0xe1, 0x49, 0x1c, 0xe6, 0xb1, 0xf7, 0xc5, 0x75, 0xd5, 0x94,
+	0xf7, 0xa0, 0x9f, 0xcd, 0xbc, 0x23, 0xbd, 0x07, 0xd9, 0xb0, 0xc1, 0xbf, 0x3d, 0xd5, 0x9f, 0xa8,
+	0x81, 0xe7, 0x7b, 0xad, 0x0d, 0xf7, 0x9c, 0x41, 0x7f, 0xbc, 0xf0, 0x3c, 0x0b, 0x69, 0xc6, 0x05,
+	0x92, 0x46, 0x3e, 0xe1, 0xcb, 0xf0, 0x34, 0xf3, 0x48, 0xdf, 0x6b, 0xec, 0xd3, 0x4e, 0x7b, 0xdf,
+	0xe3, 0xa5, 0x38, 0x5d, 0xcf, 0x70, 0xd9, 0x2d, 0x2e, 0xc2, 0xeb, 0x00, 0xd4, 0xb6, 0x94, 0xc2,
+	0x69, 0xae, 0xb0, 0x42, 0x6d, 0x4b, 0xbc, 0x0e, 0xf4, 0xf7, 0x3d, 0xd0, 0xe3, 0x20, 0x64, 0x82,
+	0xb7, 0xc2, 0x1d, 0xde, 0x88, 0xfc, 0x66, 0x7d, 0x17, 0xc2, 0x81, 0x3a, 0x96, 0xbe, 0x71, 0xe5,
+	0xf1, 0x59, 0x38, 0xc3, 0x83, 0xe1, 0xaf, 0x10, 0x2c, 0xc9, 0x81, 0x8a, 0x0b, 0x91, 0xce, 0x22,
+	0x16, 0x40, 0x6d, 0x33, 0x81, 0xa6, 0x80, 0x36, 0xae, 0x7e, 0xfc, 0xdb, 0xe3, 0x2f, 0x4f, 0x55,
+	0x71, 0xd9, 0xec, 0xb4, 0xdc, 0x2e, 0xf9, 0x90, 0x4c, 0x2f, 0x9b, 0x5c, 0x9d, 0x99, 0xf7, 0xe4,
+	0x0a, 0x71, 0xdf, 0x6c, 0x0e, 0x1b, 0xbc, 0xd9, 0xf8, 0x1b, 0x04, 0x99, 0xc0, 0x4a, 0x85, 0xb7,
+	0xe3, 0xa3, 0x86, 0x37, 0x40, 0xad, 0x98, 0x50, 0x5b, 0x72, 0xee, 0x70, 0xce, 0x2d, 0x5c, 0x48,
+	0xca, 0x89, 0x7f, 0x42, 0x70, 0x3e, 0xb4, 0x94, 0xe0, 0x4a, 0x7c, 0xd8, 0xb8, 0x7d, 0x4a, 0xab,
+	0x3e, 0x91, 0x8d, 0x04, 0xbe, 0xc6, 0x81, 0x5f, 0xc2, 0x95, 0x68, 0x60, 0xa6, 0x0c, 0x1b, 0x11,
+	0xe8, 0x0f, 0x10, 0x64, 0x02, 0xdb, 0xc0, 0xac, 0xca, 0x86, 0x57, 0x14, 0xad, 0x98, 0x50, 0x5b,
+	0x82, 0x6e, 0x70, 0x50, 0x1d, 0x5f, 0x8a, 0x01, 0x15, 0x08, 0x5f, 0x20, 0x58, 0x56, 0x83, 0x1a,
+	0xcf, 0xf8, 0xbe, 0xa6, 0x46, 0xbf, 0xb6, 0x95, 0x44, 0x55, 0x92, 0x6c, 0x73, 0x92, 0x2b, 0x78,
+	0x63, 0x16, 0x89, 0x79, 0x8f, 0x7f, 0x7d, 0xf7, 0xf1, 0x47, 0x08, 0xd2, 0x62, 0x3a, 0xe3, 0x7c,
+	0x7c, 0x90, 0x89, 0x55, 0x40, 0x2b, 0xcc, 0x57, 0x4c, 0x56, 0x15, 0xb1, 0x08, 0xe0, 0xef, 0x10,
+	0x9c, 0x9d, 0x98, 0x5f, 0xb8, 0x14, 0x1f, 0x21, 0x6a, 0x36, 0x6a, 0x66, 0x62, 0x7d, 0x09, 0xf6,
+	0x32, 0x07, 0x33, 0x71, 0x31, 0x1a, 0x8c, 0x17, 0x87, 0x35, 0xd4, 0x18, 0xf4, 0xab, 0xf5, 0x2d,
+	0x82, 0x73, 0x93, 0x7b, 0x04, 0x9e, 0x17, 0x7a, 0x7a, 0xb1, 0xd1, 0x76, 0x92, 0x1b, 0x48, 0xd8,
+	0x22, 0x87, 0xcd, 0xe3, 0x17, 0x12, 0xc1, 0x8e, 0x6e, 0x94, 0x73, 0x93, 0xe3, 0x73, 0x16, 0x64,
+	0xe4, 0x64, 0xd7, 0x76, 0x92, 0x1b, 0x48, 0xc8, 0x3c, 0x87, 0xbc, 0x8c, 0x73, 0x71, 0x90, 0x8a,
+	0xe5, 0x6b, 0x04, 0x99, 0xc0, 0x64, 0x9b, 0x75, 0x2c, 0xc3, 0x13, 0x53, 0x2b, 0x26, 0xd4, 0x96,
+	0x54, 0x26, 0xa7, 0xda, 0xc4, 0xf9, 0x39, 0x54, 0x7e, 0x87, 0x7f, 0x44, 0x70, 0x3e, 0x34, 0x9c,
+	0x66, 0xdd, 0x77, 0x71, 0xe3, 0x54, 0xab, 0x3e, 0x91, 0x8d, 0xe4, 0x2d, 0x73, 0xde, 0x17, 0xf1,
+	0xe6, 0x1c, 0xde, 0x46, 0x73, 0xd8, 0x10, 0xe3, 0xb9, 0x76, 0xe3, 0xf0, 0x48, 0x47, 0x8f, 0x8e,
+	0x74, 0xf4, 0xd7, 0x91, 0x8e, 0x1e, 0x1c, 0xeb, 0xa9, 0x47, 0xc7, 0x7a, 0xea, 0xf7, 0x63, 0x3d,
+	0xf5, 0xce, 0xe6, 0xcc, 0xb5, 0xe7, 0x03, 0xe1, 0x9a, 0x6f, 0x3f, 0xcd, 0x34, 0xff, 0x57, 0x48,
+	0xf5, 0x9f, 0x01, 0x00, 0x0b, 0x80, 0x97, 0xa0, 0xe2, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1100,6 +1203,9 @@ type QueryClient interface {
 	TotalDeflation(ctx context.Context, in *QueryTotalDeflationRequest, opts ...grpc.CallOption) (*QueryTotalDeflationResponse, error)
 	// DeflationOf queries the deflation of a single coin.
 	DeflationOf(ctx context.Context, in *QueryDeflationOfRequest, opts ...grpc.CallOption) (*QueryDeflationOfResponse, error)
+	// DeflationBySource queries the deflation of the coins by source module
+	// account, in total or over a range of heights of the deflation history.
+	DeflationBySource(ctx context.Context, in *QueryDeflationBySourceRequest, opts ...grpc.CallOption) (*QueryDeflationBySourceResponse, error)
 }
 
 type queryClient struct {
@@ -1200,6 +1306,15 @@ func (c *queryClient) DeflationOf(ctx context.Context, in *QueryDeflationOfReque
 	return out, nil
 }
 
+func (c *queryClient) DeflationBySource(ctx context.Context, in *QueryDeflationBySourceRequest, opts ...grpc.CallOption) (*QueryDeflationBySourceResponse, error) {
+	out := new(QueryDeflationBySourceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DeflationBySource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1223,6 +1338,9 @@ type QueryServer interface {
 	TotalDeflation(context.Context, *QueryTotalDeflationRequest) (*QueryTotalDeflationResponse, error)
 	// DeflationOf queries the deflation of a single coin.
 	DeflationOf(context.Context, *QueryDeflationOfRequest) (*QueryDeflationOfResponse, error)
+	// DeflationBySource queries the deflation of the coins by source module
+	// account, in total or over a range of heights of the deflation history.
+	DeflationBySource(context.Context, *QueryDeflationBySourceRequest) (*QueryDeflationBySourceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1259,6 +1377,9 @@ func (*UnimplementedQueryServer) TotalDeflation(ctx context.Context, req *QueryT
 func (*UnimplementedQueryServer) DeflationOf(ctx context.Context, req *QueryDeflationOfRequest) (*QueryDeflationOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeflationOf not implemented")
 }
+func (*UnimplementedQueryServer) DeflationBySource(ctx context.Context, req *QueryDeflationBySourceRequest) (*QueryDeflationBySourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeflationBySource not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1444,6 +1565,24 @@ func _Query_DeflationOf_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DeflationBySource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeflationBySourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeflationBySource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DeflationBySource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeflationBySource(ctx, req.(*QueryDeflationBySourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1488,6 +1627,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeflationOf",
 			Handler:    _Query_DeflationOf_Handler,
 		},
+		{
+			MethodName: "DeflationBySource",
+			Handler:    _Query_DeflationBySource_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -2244,6 +2387,83 @@ func (m *QueryDeflationOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDeflationBySourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeflationBySourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeflationBySourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeflationBySourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeflationBySourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeflationBySourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deflation) > 0 {
+		for iNdEx := len(m.Deflation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deflation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2545,6 +2765,40 @@ func (m *QueryDeflationOfResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDeflationBySourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryDeflationBySourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deflation) > 0 {
+		for _, e := range m.Deflation {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4473,6 +4727,210 @@ func (m *QueryDeflationOfResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDeflationBySourceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeflationBySourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeflationBySourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeflationBySourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeflationBySourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeflationBySourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deflation = append(m.Deflation, DeflationSource{})
+			if err := m.Deflation[len(m.Deflation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -29,7 +29,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorOutstandingRewards(),
 		GetCmdQueryValidatorCommission(),
 		GetCmdQueryValidatorSlashes(),
+		GetCmdQueryValidatorDelayedRewardsSchedule(),
+		GetCmdQueryTeamCommissionVesting(),
 		GetCmdQueryDelegatorRewards(),
+		GetCmdQueryDelegatorRecommanderRewards(),
 		GetCmdQueryCommunityPool(),
 	)
 
@@ -219,6 +222,102 @@ $ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryValidatorDelayedRewardsSchedule implements the query validator
+// delayed rewards schedule command.
+func GetCmdQueryValidatorDelayedRewardsSchedule() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delayed-rewards-schedule [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the release schedule of the delayed rewards of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delayed rewards of a validator which can be released now,
+and the date and amount of the upcoming releases. The consecutive releases of the
+same amount are merged, with the number of times it is released one delayed
+reward unit apart.
+
+Example:
+$ %s query distribution delayed-rewards-schedule %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorDelayedRewardsSchedule(
+				cmd.Context(),
+				&types.QueryValidatorDelayedRewardsScheduleRequest{ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTeamCommissionVesting implements the query team commission
+// vesting command.
+func GetCmdQueryTeamCommissionVesting() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "team-commission-vesting [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vesting of the team commission of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query how the team commission of a validator vests once withdrawn to
+its incentive team address. An empty vesting type means it does not vest.
+
+Example:
+$ %s query distribution team-commission-vesting %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TeamCommissionVesting(
+				cmd.Context(),
+				&types.QueryTeamCommissionVestingRequest{ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Vesting)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryDelegatorRewards implements the query delegator rewards command.
 func GetCmdQueryDelegatorRewards() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -285,6 +384,58 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 	return cmd
 }
 
+// GetCmdQueryDelegatorRecommanderRewards implements the query delegator recommander rewards command.
+func GetCmdQueryDelegatorRecommanderRewards() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "recommander-rewards [delegator-addr] [validator-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query how the recommanders rewards of a delegation would be split",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards each recommander of a delegation would receive by level,
+and the remainder going back to the validator operator, if the rewards were withdrawn now.
+
+Example:
+$ %s query distribution recommander-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationRecommanderRewardsBreakdown(
+				cmd.Context(),
+				&types.QueryDelegationRecommanderRewardsBreakdownRequest{DelegatorAddress: delegatorAddr.String(), ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info.
 func GetCmdQueryCommunityPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -20,6 +20,9 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagCliff            = "cliff"
+	FlagDuration         = "duration"
+	FlagPeriods          = "periods"
 )
 
 const (
@@ -42,6 +45,8 @@ func NewTxCmd() *cobra.Command {
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
 		NewWithdrawTeamCommissionCmd(),
+		NewReleaseDelayedRewardsCmd(),
+		NewSetTeamCommissionVestingCmd(),
 	)
 
 	return distTxCmd
@@ -375,3 +380,108 @@ $ %[1]s tx distribution withdraw-team-commission %s1gghjut3ccd8ay0zduzj64hwre2fx
 
 	return cmd
 }
+
+func NewReleaseDelayedRewardsCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "release-delayed-rewards [validator-address]",
+		Short: "release the due delayed rewards of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Release the delayed rewards of a validator which are due. Any account can send it.
+
+Example:
+$ %[1]s tx distribution release-delayed-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			val, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseDelayedRewards(sender, val)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetTeamCommissionVestingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-team-commission-vesting [continuous|periodic|none]",
+		Short: "set the vesting of the team commission of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set how the team commission of the validator operated by the sender vests
+once withdrawn to its incentive team address. The commission vests linearly over
+the duration for a continuous vesting, or in equal periods over the duration for
+a periodic vesting, in both cases after the cliff. The vesting can be enabled or
+made longer, but never weakened: a none vesting only applies to a validator
+without vesting, the cliff and the duration cannot be shortened, and a periodic
+vesting must keep its number of periods.
+
+Example:
+$ %[1]s tx distribution set-team-commission-vesting continuous --cliff=8760h --duration=17520h --from mykey
+$ %[1]s tx distribution set-team-commission-vesting periodic --duration=8760h --periods=12 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			vestingType := args[0]
+			if vestingType == "none" {
+				vestingType = types.TeamVestingTypeNone
+			}
+
+			cliff, err := cmd.Flags().GetDuration(FlagCliff)
+			if err != nil {
+				return err
+			}
+			duration, err := cmd.Flags().GetDuration(FlagDuration)
+			if err != nil {
+				return err
+			}
+			periods, err := cmd.Flags().GetUint32(FlagPeriods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTeamCommissionVesting(valAddr, types.NewTeamCommissionVesting(vestingType, cliff, duration, periods))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagCliff, 0, "The delay after the withdrawal before the team commission starts to vest")
+	cmd.Flags().Duration(FlagDuration, 0, "The time over which the team commission vests after the cliff")
+	cmd.Flags().Uint32(FlagPeriods, 0, "The number of equal periods of a periodic vesting")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
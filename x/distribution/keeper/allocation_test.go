@@ -97,6 +97,11 @@ func TestAllocateTokensToManyValidators(t *testing.T) {
 			SignedLastBlock: true,
 		},
 	}
+	// allocate the whole rewards at once, without delaying a part of them
+	params := app.DistrKeeper.GetParams(ctx)
+	params.DelayedRewardProportion = sdk.ZeroDec()
+	app.DistrKeeper.SetParams(ctx, params)
+
 	app.DistrKeeper.AllocateTokens(ctx, 200, 200, valConsAddr2, votes)
 
 	// 98 outstanding rewards (100 less 2 to community pool)
@@ -251,11 +251,49 @@ func (k Keeper) withdrawDelegationRecommandersRewards(ctx sdk.Context, val staki
 	}
 
 	totalRewards := sdk.Coins{}
+	recommanders, validatorRewards, remainder := k.splitDelegationRecommandersRewards(ctx, val, del, recommandersRewards)
+
+	for _, recommander := range recommanders {
+		if !recommander.Reward.IsZero() {
+			recommanderAddr, err := sdk.AccAddressFromBech32(recommander.RecommanderAddress)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recommanderAddr, recommander.Reward); err != nil {
+				return nil, nil, err
+			}
+		}
+		totalRewards = totalRewards.Add(recommander.Reward...)
+	}
+
+	if !validatorRewards.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(del.GetValidatorAddr()), validatorRewards)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	totalRewards = totalRewards.Add(validatorRewards...)
+	
+	return totalRewards, remainder, nil
+}
+
+// splitDelegationRecommandersRewards walks the recommander chain of a delegation and
+// splits its recommanders rewards by the validator's recommander class rates. It
+// returns the rewards of each recommander, the rewards going back to the validator
+// operator and the decimal remainder.
+func (k Keeper) splitDelegationRecommandersRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI,
+	recommandersRewards sdk.DecCoins) ([]types.DelegationRecommanderReward, sdk.Coins, sdk.DecCoins) {
+
+	recommanders := []types.DelegationRecommanderReward{}
+	if recommandersRewards.IsZero() {
+		return recommanders, sdk.Coins{}, sdk.DecCoins{}
+	}
+
 	remainderRewards := recommandersRewards
 	currentDel := del
 	_, _, _, recommanderClassRates := val.GetReallocatedCommissionRule()
 
-	for _, rate := range recommanderClassRates {
+	for i, rate := range recommanderClassRates {
 		if currentDel == nil { // to validator
 			break
 		}
@@ -279,32 +317,20 @@ func (k Keeper) withdrawDelegationRecommandersRewards(ctx sdk.Context, val staki
 		if recommanderAddr == nil {
 			break;
 		}
-		if !rewards.IsZero() {
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recommanderAddr, rewards); err != nil {
-				return nil, nil, err
-			}
-		}
-		totalRewards = totalRewards.Add(rewards...)
+		recommanders = append(recommanders, types.NewDelegationRecommanderReward(recommanderAddr, uint32(i+1), rewards))
 
 		currentDel = k.stakingKeeper.Delegation(ctx, recommanderAddr, del.GetValidatorAddr())
 	}
 
-	rewards, remainder := remainderRewards.TruncateDecimal()
-	if !rewards.IsZero() {
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(del.GetValidatorAddr()), rewards)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-	totalRewards = totalRewards.Add(rewards...)
-	
-	return totalRewards, remainder, nil
+	validatorRewards, remainder := remainderRewards.TruncateDecimal()
+	return recommanders, validatorRewards, remainder
 }
 
 func (k Keeper) getRealRecommander(ctx sdk.Context, del stakingtypes.DelegationI, valAddr sdk.ValAddress) sdk.AccAddress {
 	recommanderAddr := del.GetRecommanderAddr()
 
-	if recommanderAddr.Equals(del.GetDelegatorAddr()) {
+	// a cleared or self recommander means the delegation has no recommander
+	if recommanderAddr.Empty() || recommanderAddr.Equals(del.GetDelegatorAddr()) {
 		recommanderAddr = nil
 	}
 
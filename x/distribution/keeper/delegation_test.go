@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -44,7 +45,7 @@ func TestCalculateRewardsBasic(t *testing.T) {
 	require.Equal(t, uint64(2), app.DistrKeeper.GetValidatorHistoricalReferenceCount(ctx))
 
 	// calculate delegation rewards
-	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards, _ := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 
 	// rewards should be zero
 	require.True(t, rewards.IsZero())
@@ -58,7 +59,7 @@ func TestCalculateRewardsBasic(t *testing.T) {
 	endingPeriod = app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 
 	// rewards should be half the tokens
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial / 2)}}, rewards)
@@ -94,7 +95,7 @@ func TestCalculateRewardsAfterSlash(t *testing.T) {
 	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards
-	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards, _ := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 
 	// rewards should be zero
 	require.True(t, rewards.IsZero())
@@ -120,7 +121,7 @@ func TestCalculateRewardsAfterSlash(t *testing.T) {
 	endingPeriod = app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 
 	// rewards should be half the tokens
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial.QuoRaw(2).ToDec()}}, rewards)
@@ -157,7 +158,7 @@ func TestCalculateRewardsAfterManySlashes(t *testing.T) {
 	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards
-	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards, _ := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 
 	// rewards should be zero
 	require.True(t, rewards.IsZero())
@@ -195,7 +196,7 @@ func TestCalculateRewardsAfterManySlashes(t *testing.T) {
 	endingPeriod = app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 
 	// rewards should be half the tokens
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial.ToDec()}}, rewards)
@@ -253,13 +254,13 @@ func TestCalculateRewardsMultiDelegator(t *testing.T) {
 	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards for del1
-	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
+	rewards, _ := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
 
 	// rewards for del1 should be 3/4 initial
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial * 3 / 4)}}, rewards)
 
 	// calculate delegation rewards for del2
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
 
 	// rewards for del2 should be 1/4 initial
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial * 1 / 4)}}, rewards)
@@ -366,7 +367,7 @@ func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards
-	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards, _ := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 
 	// rewards should be zero
 	require.True(t, rewards.IsZero())
@@ -398,7 +399,7 @@ func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	endingPeriod = app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod)
 
 	// rewards should be half the tokens
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial}}, rewards)
@@ -466,13 +467,13 @@ func TestCalculateRewardsMultiDelegatorMultiSlash(t *testing.T) {
 	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards for del1
-	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
+	rewards, _ := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
 
 	// rewards for del1 should be 2/3 initial (half initial first period, 1/6 initial second period)
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial.QuoInt64(2).Add(initial.QuoInt64(6))}}, rewards)
 
 	// calculate delegation rewards for del2
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
 
 	// rewards for del2 should be initial / 3
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial.QuoInt64(3)}}, rewards)
@@ -555,13 +556,13 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards for del1
-	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
+	rewards, _ := app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
 
 	// rewards for del1 should be zero
 	require.True(t, rewards.IsZero())
 
 	// calculate delegation rewards for del2
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
 
 	// rewards for del2 should be zero
 	require.True(t, rewards.IsZero())
@@ -583,13 +584,13 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	endingPeriod = app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards for del1
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
 
 	// rewards for del1 should be zero
 	require.True(t, rewards.IsZero())
 
 	// calculate delegation rewards for del2
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
 
 	// rewards for del2 should be 1/4 initial
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial / 4)}}, rewards)
@@ -611,13 +612,13 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	endingPeriod = app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
 
 	// calculate delegation rewards for del1
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del1, endingPeriod)
 
 	// rewards for del1 should be 1/4 initial
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial / 4)}}, rewards)
 
 	// calculate delegation rewards for del2
-	rewards = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
+	rewards, _ = app.DistrKeeper.CalculateDelegationRewards(ctx, val, del2, endingPeriod)
 
 	// rewards for del2 should be 1/2 initial
 	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial / 2)}}, rewards)
@@ -625,3 +626,120 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
 }
+
+func TestDelegationRecommanderRewardsBreakdown(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// create validator with 50% commission, half of which goes to the recommanders
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.ReallocCommissionRule = stakingtypes.NewReallocatedCommissionRule(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), 2,
+		[]stakingtypes.RecommanderClassRate{
+			{Index: 0, Rate: sdk.NewDecWithPrec(5, 1)},
+			{Index: 1, Rate: sdk.NewDecWithPrec(3, 1)},
+		})
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	// addr[1] is recommended by the validator, addr[2] is recommended by addr[1]
+	tstaking.Delegate(addr[1], valAddrs[0], sdk.NewInt(100))
+	tstaking.Handle(stakingtypes.NewMsgDelegate(addr[2], valAddrs[0], addr[1], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), true)
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(1200)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// fund the module account with the allocated rewards
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1200))}
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, coins))
+
+	req := &types.QueryDelegationRecommanderRewardsBreakdownRequest{
+		DelegatorAddress: addr[2].String(),
+		ValidatorAddress: valAddrs[0].String(),
+	}
+	res, err := app.DistrKeeper.DelegationRecommanderRewardsBreakdown(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+
+	// recommanders rewards of addr[2] are 1200 * 50% * 50% / 3 = 100
+	require.Equal(t, []types.DelegationRecommanderReward{
+		types.NewDelegationRecommanderReward(addr[1], 1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)))),
+		types.NewDelegationRecommanderReward(sdk.AccAddress(valAddrs[0]), 2, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30)))),
+	}, res.Recommanders)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20))), res.ValidatorReward)
+	require.True(t, res.Remainder.IsZero())
+
+	// the withdrawal pays out the same split
+	balanceBefore := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, addr[2], valAddrs[0])
+	require.NoError(t, err)
+	balanceAfter := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+	require.Equal(t, sdk.NewInt(50), balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+	// the chain of addr[1] stops at the validator
+	req.DelegatorAddress = addr[1].String()
+	res, err = app.DistrKeeper.DelegationRecommanderRewardsBreakdown(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Len(t, res.Recommanders, 1)
+	require.Equal(t, sdk.AccAddress(valAddrs[0]).String(), res.Recommanders[0].RecommanderAddress)
+
+	req.DelegatorAddress = sdk.AccAddress(valConsPk3.Address()).String()
+	_, err = app.DistrKeeper.DelegationRecommanderRewardsBreakdown(sdk.WrapSDKContext(ctx), req)
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+}
+
+func TestClearedRecommanderRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// create validator with 50% commission, half of which goes to the recommanders
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.ReallocCommissionRule = stakingtypes.NewReallocatedCommissionRule(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), 1,
+		[]stakingtypes.RecommanderClassRate{{Index: 0, Rate: sdk.NewDecWithPrec(5, 1)}})
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	// addr[1] is recommended by the validator operator, then clears its recommander
+	tstaking.Delegate(addr[1], valAddrs[0], sdk.NewInt(100))
+	tstaking.Handle(stakingtypes.NewMsgSetDelegationRecommander(addr[1], valAddrs[0], nil), true)
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(1200)}}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	coins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1200))}
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, coins))
+
+	// the operator earns no recommander rewards, all the recommanders rewards
+	// of addr[1] go back to the validator
+	req := &types.QueryDelegationRecommanderRewardsBreakdownRequest{
+		DelegatorAddress: addr[1].String(),
+		ValidatorAddress: valAddrs[0].String(),
+	}
+	res, err := app.DistrKeeper.DelegationRecommanderRewardsBreakdown(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Empty(t, res.Recommanders)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(75))), res.ValidatorReward)
+
+	// the withdrawal pays the operator the validator reward only
+	balanceBefore := app.BankKeeper.GetBalance(ctx, addr[0], sdk.DefaultBondDenom)
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, addr[1], valAddrs[0])
+	require.NoError(t, err)
+	balanceAfter := app.BankKeeper.GetBalance(ctx, addr[0], sdk.DefaultBondDenom)
+	require.Equal(t, sdk.NewInt(75), balanceAfter.Amount.Sub(balanceBefore.Amount))
+}
@@ -84,6 +84,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorDelayedReward(ctx, valAddr, relay.StartTime, relay.ValidatorDelayedReward)
 	}
+	for _, record := range data.TeamCommissionVestings {
+		valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetTeamCommissionVesting(ctx, valAddr, record.Vesting)
+	}
 
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
@@ -202,5 +209,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, relayedRewards)
+	teamVestings := make([]types.TeamCommissionVestingRecord, 0)
+	k.IterateTeamCommissionVestings(ctx,
+		func(val sdk.ValAddress, vesting types.TeamCommissionVesting) (stop bool) {
+			teamVestings = append(teamVestings, types.TeamCommissionVestingRecord{
+				ValidatorAddress: val.String(),
+				Vesting:          vesting,
+			})
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, relayedRewards, teamVestings)
 }
@@ -160,6 +160,48 @@ func (k Keeper) ValidatorDelayedRewards(c context.Context, req *types.QueryValid
 	return &types.QueryValidatorDelayedRewardsResponse{Rewards: rewards, Pagination: pageRes}, nil
 }
 
+// ValidatorDelayedRewardsSchedule queries the projected release schedule of the delayed rewards of a validator
+func (k Keeper) ValidatorDelayedRewardsSchedule(c context.Context, req *types.QueryValidatorDelayedRewardsScheduleRequest) (*types.QueryValidatorDelayedRewardsScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	due, releases := k.GetValidatorDelayedRewardsSchedule(ctx, valAdr)
+
+	return &types.QueryValidatorDelayedRewardsScheduleResponse{Due: due, Releases: releases}, nil
+}
+
+// TeamCommissionVesting queries the team commission vesting of a validator
+func (k Keeper) TeamCommissionVesting(c context.Context, req *types.QueryTeamCommissionVestingRequest) (*types.QueryTeamCommissionVestingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTeamCommissionVestingResponse{Vesting: k.GetTeamCommissionVesting(ctx, valAdr)}, nil
+}
+
 // DelegationRewards the total rewards accrued by a delegation
 func (k Keeper) DelegationRewards(c context.Context, req *types.QueryDelegationRewardsRequest) (*types.QueryDelegationRewardsResponse, error) {
 	if req == nil {
@@ -204,6 +246,59 @@ func (k Keeper) DelegationRewards(c context.Context, req *types.QueryDelegationR
 	return &types.QueryDelegationRewardsResponse{Rewards: rewards, RecommandersRewards:recommandersRewards}, nil
 }
 
+// DelegationRecommanderRewardsBreakdown the split of the recommanders rewards accrued by a delegation
+func (k Keeper) DelegationRecommanderRewardsBreakdown(c context.Context, req *types.QueryDelegationRecommanderRewardsBreakdownRequest) (*types.QueryDelegationRecommanderRewardsBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	val := k.stakingKeeper.Validator(ctx, valAdr)
+	if val == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	del := k.stakingKeeper.Delegation(ctx, delAdr, valAdr)
+	if del == nil {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	k.WithdrawValidatorDelayedRewardsOf(ctx, valAdr)
+
+	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+	_, recommandersRewards := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+
+	// mirror the withdrawal, which never pays more than the outstanding rewards
+	outstanding := k.GetValidatorOutstandingRewardsCoins(ctx, valAdr)
+	recommandersRewards = recommandersRewards.Intersect(outstanding)
+
+	recommanders, validatorReward, remainder := k.splitDelegationRecommandersRewards(ctx, val, del, recommandersRewards)
+
+	return &types.QueryDelegationRecommanderRewardsBreakdownResponse{
+		Recommanders:    recommanders,
+		ValidatorReward: validatorReward,
+		Remainder:       remainder,
+	}, nil
+}
+
 // DelegationTotalRewards the total rewards accrued by a each validator
 func (k Keeper) DelegationTotalRewards(c context.Context, req *types.QueryDelegationTotalRewardsRequest) (*types.QueryDelegationTotalRewardsResponse, error) {
 	if req == nil {
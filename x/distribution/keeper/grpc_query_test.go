@@ -69,12 +69,11 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 		{
 			"valid request",
 			func() {
-				params = types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(3, 1),
-					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
-					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
-					WithdrawAddrEnabled: true,
-				}
+				params = types.DefaultParams()
+				params.CommunityTax = sdk.NewDecWithPrec(3, 1)
+				params.BaseProposerReward = sdk.NewDecWithPrec(2, 1)
+				params.BonusProposerReward = sdk.NewDecWithPrec(1, 1)
+				params.WithdrawAddrEnabled = true
 
 				app.DistrKeeper.SetParams(ctx, params)
 				req = &types.QueryParamsRequest{}
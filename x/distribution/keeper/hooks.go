@@ -69,6 +69,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 	// remove commission record
 	h.k.DeleteValidatorAccumulatedCommission(ctx, valAddr)
 
+	// remove team commission vesting
+	h.k.DeleteTeamCommissionVesting(ctx, valAddr)
+
 	// clear slashes
 	h.k.DeleteValidatorSlashEvents(ctx, valAddr)
 
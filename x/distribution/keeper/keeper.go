@@ -21,6 +21,10 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
 
+	// delayedRewardPeriodFunc overrides the built-in curves of the delayed
+	// reward period when set
+	delayedRewardPeriodFunc types.DelayedRewardPeriodFunc
+
 	blockedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
@@ -55,6 +59,18 @@ func NewKeeper(
 	}
 }
 
+// SetDelayedRewardPeriodFunc sets the function computing the period of the
+// delayed rewards of a validator, in place of the curve selected by the
+// DelayedRewardPeriodCurve parameter.
+func (k *Keeper) SetDelayedRewardPeriodFunc(fn types.DelayedRewardPeriodFunc) *Keeper {
+	if k.delayedRewardPeriodFunc != nil {
+		panic("cannot set delayed reward period func twice")
+	}
+
+	k.delayedRewardPeriodFunc = fn
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -160,32 +176,55 @@ func (k Keeper) WithdrawTeamCommission(ctx sdk.Context, valAddr sdk.ValAddress)
 		return nil, types.ErrNoTeamCommission
 	}
 
-	commission, remainder := accumCommission.TeamCommission.TruncateDecimal()
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil, types.ErrNoValidatorDistInfo
+	}
+
+	// split the team commission between the recipients by weight
+	recipients := val.GetIncentiveTeamRecipients()
+	shares := make([]sdk.Coins, len(recipients))
+	commission := sdk.NewCoins()
+	for i, recipient := range recipients {
+		shares[i], _ = accumCommission.TeamCommission.MulDecTruncate(recipient.Weight).TruncateDecimal()
+		commission = commission.Add(shares[i]...)
+	}
+
+	remainder := accumCommission.TeamCommission.Sub(sdk.NewDecCoinsFromCoins(commission...))
 	k.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission:accumCommission.Commission, TeamCommission: remainder}) // leave remainder to withdraw later
 
 	// update outstanding
 	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr).Rewards
 	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
 
-	if !commission.IsZero() {
-		val := k.stakingKeeper.Validator(ctx, valAddr)
-		if val == nil {
-			return nil, types.ErrNoValidatorDistInfo
+	vesting := k.GetTeamCommissionVesting(ctx, valAddr)
+	for i, recipient := range recipients {
+		if shares[i].IsZero() {
+			continue
 		}
 
-		withdrawAddr := val.GetIncentiveTeam()
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+		withdrawAddr := recipient.GetAddress()
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, shares[i])
 		if err != nil {
 			return nil, err
 		}
-	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWithdrawTeamCommission,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
-		),
-	)
+		// lock the team commission if the validator set a vesting
+		if vesting.Enabled() {
+			if err := k.vestTeamCommission(ctx, withdrawAddr, vesting, shares[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWithdrawTeamCommission,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, shares[i].String()),
+				sdk.NewAttribute(types.AttributeKeyWithdrawAddress, recipient.Address),
+				sdk.NewAttribute(types.AttributeKeyVestingType, vesting.VestingType),
+			),
+		)
+	}
 
 	return commission, nil
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -86,6 +88,61 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	require.True(t, true)
 }
 
+func TestWithdrawTeamCommission(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.ReallocCommissionRule = stakingtypes.NewReallocatedCommissionRule(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), 1,
+		[]stakingtypes.RecommanderClassRate{{Index: 0, Rate: sdk.NewDecWithPrec(5, 1)}})
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	// split the team commission between two accounts
+	validator := app.StakingKeeper.Validator(ctx, valAddrs[0]).(stakingtypes.Validator)
+	validator.IncentiveTeamRecipients = []stakingtypes.IncentiveTeamRecipient{
+		stakingtypes.NewIncentiveTeamRecipient(addr[1], sdk.NewDecWithPrec(3, 1)),
+		stakingtypes.NewIncentiveTeamRecipient(addr[2], sdk.NewDecWithPrec(7, 1)),
+	}
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	commission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDec(1))}
+	teamCommission := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("mytoken", sdk.NewDec(3)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(105, 1)),
+	}
+
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	coins := sdk.NewCoins(sdk.NewCoin("mytoken", sdk.NewInt(3)), sdk.NewCoin("stake", sdk.NewInt(12)))
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), coins))
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: commission.Add(teamCommission...)})
+	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: commission, TeamCommission: teamCommission})
+
+	balance1 := app.BankKeeper.GetAllBalances(ctx, addr[1])
+	balance2 := app.BankKeeper.GetAllBalances(ctx, addr[2])
+
+	withdrawn, err := app.DistrKeeper.WithdrawTeamCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("mytoken", sdk.NewInt(2)), sdk.NewCoin("stake", sdk.NewInt(10))), withdrawn)
+
+	// each recipient receives its truncated share
+	require.Equal(t, balance1.Add(sdk.NewCoin("stake", sdk.NewInt(3))), app.BankKeeper.GetAllBalances(ctx, addr[1]))
+	require.Equal(t, balance2.Add(sdk.NewCoin("mytoken", sdk.NewInt(2)), sdk.NewCoin("stake", sdk.NewInt(7))), app.BankKeeper.GetAllBalances(ctx, addr[2]))
+
+	// the rounding remainder is left to withdraw later
+	remainder := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("mytoken", sdk.NewDec(1)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(5, 1)),
+	}
+	accumCommission := app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0])
+	require.Equal(t, commission, accumCommission.Commission)
+	require.Equal(t, remainder, accumCommission.TeamCommission)
+	require.Equal(t, commission.Add(remainder...), app.DistrKeeper.GetValidatorOutstandingRewards(ctx, valAddrs[0]).Rewards)
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
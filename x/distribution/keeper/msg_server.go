@@ -178,3 +178,57 @@ func (k msgServer) WithdrawTeamCommission(goCtx context.Context, msg *types.MsgW
 
 	return &types.MsgWithdrawTeamCommissionResponse{}, nil
 }
+
+func (k msgServer) ReleaseDelayedRewards(goCtx context.Context, msg *types.MsgReleaseDelayedRewards) (*types.MsgReleaseDelayedRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.Keeper.ReleaseDelayedRewards(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgReleaseDelayedRewardsResponse{Amount: amount}, nil
+}
+
+func (k msgServer) SetTeamCommissionVesting(goCtx context.Context, msg *types.MsgSetTeamCommissionVesting) (*types.MsgSetTeamCommissionVestingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return nil, types.ErrNoValidatorExists
+	}
+
+	if err := k.Keeper.UpdateTeamCommissionVesting(ctx, valAddr, msg.Vesting); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTeamCommissionVesting,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyVestingType, msg.Vesting.VestingType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress),
+		),
+	})
+
+	return &types.MsgSetTeamCommissionVestingResponse{}, nil
+}
@@ -67,3 +67,9 @@ func (k Keeper) GetDelayedRewardUnit(ctx sdk.Context) (unit time.Duration) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyDelayedRewardUnit, &unit)
 	return unit
 }
+
+// GetDelayedRewardPeriodCurve returns the current distribution DelayedRewardPeriodCurve.
+func (k Keeper) GetDelayedRewardPeriodCurve(ctx sdk.Context) (curve string) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyDelayedRewardPeriodCurve, &curve)
+	return curve
+}
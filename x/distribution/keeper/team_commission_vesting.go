@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// get the team commission vesting of a validator
+func (k Keeper) GetTeamCommissionVesting(ctx sdk.Context, val sdk.ValAddress) (vesting types.TeamCommissionVesting) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorTeamCommissionVestingKey(val))
+	if b == nil {
+		return types.TeamCommissionVesting{}
+	}
+	k.cdc.MustUnmarshal(b, &vesting)
+	return
+}
+
+// set the team commission vesting of a validator, a disabled vesting is deleted
+func (k Keeper) SetTeamCommissionVesting(ctx sdk.Context, val sdk.ValAddress, vesting types.TeamCommissionVesting) {
+	if !vesting.Enabled() {
+		k.DeleteTeamCommissionVesting(ctx, val)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&vesting)
+	store.Set(types.GetValidatorTeamCommissionVestingKey(val), b)
+}
+
+// UpdateTeamCommissionVesting changes the team commission vesting of a
+// validator. The team commission is vested to protect the delegators, so the
+// vesting can be enabled or made longer but never weakened.
+func (k Keeper) UpdateTeamCommissionVesting(ctx sdk.Context, val sdk.ValAddress, vesting types.TeamCommissionVesting) error {
+	current := k.GetTeamCommissionVesting(ctx, val)
+	if vesting.WeakerThan(current) {
+		return sdkerrors.Wrapf(
+			types.ErrTeamVestingWeakened, "current %q vesting has a %s cliff, a %s duration and %d periods",
+			current.VestingType, current.Cliff, current.Duration, current.Periods,
+		)
+	}
+
+	k.SetTeamCommissionVesting(ctx, val, vesting)
+	return nil
+}
+
+// delete the team commission vesting of a validator
+func (k Keeper) DeleteTeamCommissionVesting(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorTeamCommissionVestingKey(val))
+}
+
+// iterate over the team commission vestings
+func (k Keeper) IterateTeamCommissionVestings(ctx sdk.Context, handler func(val sdk.ValAddress, vesting types.TeamCommissionVesting) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorTeamCommissionVestingPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vesting types.TeamCommissionVesting
+		k.cdc.MustUnmarshal(iter.Value(), &vesting)
+		addr := types.GetValidatorTeamCommissionVestingAddress(iter.Key())
+		if handler(addr, vesting) {
+			break
+		}
+	}
+}
+
+// vestTeamCommission locks the team commission already sent to the incentive
+// team address according to the vesting. The team account is turned into a
+// vesting account of the vesting type, or the commission is added to the
+// schedule of an existing vesting account:
+//
+//   - a continuous vesting account is first turned into a periodic one, the
+//     coins already vested stay vested and the remainder keeps vesting until
+//     the end of its schedule, in at most MaxTeamVestingPeriods periods.
+//   - the schedule of a periodic vesting account is merged period by period
+//     with the vesting, so that the coins already vesting keep their schedule.
+//
+// A continuous vesting added to a periodic schedule vests in at most
+// MaxTeamVestingPeriods periods, each releasing its coins at its end, so that
+// the coins are never released earlier than the vesting.
+func (k Keeper) vestTeamCommission(ctx sdk.Context, teamAddr sdk.AccAddress, vesting types.TeamCommissionVesting, amount sdk.Coins) error {
+	startTime := vesting.StartTime(ctx.BlockTime())
+	endTime := vesting.EndTime(ctx.BlockTime())
+
+	acc := k.authKeeper.GetAccount(ctx, teamAddr)
+	if continuousAcc, ok := acc.(*vestingtypes.ContinuousVestingAccount); ok {
+		acc = toPeriodicVestingAccount(continuousAcc, ctx.BlockTime())
+	}
+
+	var vestingAcc authtypes.AccountI
+	switch acc := acc.(type) {
+	case *authtypes.BaseAccount:
+		baseVestingAcc := vestingtypes.NewBaseVestingAccount(acc, amount, endTime)
+		if vesting.VestingType == types.TeamVestingTypeContinuous {
+			vestingAcc = vestingtypes.NewContinuousVestingAccountRaw(baseVestingAcc, startTime)
+		} else {
+			vestingAcc = vestingtypes.NewPeriodicVestingAccountRaw(baseVestingAcc, startTime, vesting.VestingPeriods(amount))
+		}
+
+	case *vestingtypes.PeriodicVestingAccount:
+		periods := vesting.VestingPeriods(amount)
+		if vesting.VestingType == types.TeamVestingTypeContinuous {
+			periods = linearVestingPeriods(amount, endTime-startTime)
+		}
+
+		acc.OriginalVesting = acc.OriginalVesting.Add(amount...)
+		acc.StartTime, acc.EndTime, acc.VestingPeriods = mergeVestingPeriods(acc.StartTime, acc.VestingPeriods, startTime, periods)
+		acc.VestingPeriods = capVestingPeriods(acc.StartTime, acc.VestingPeriods, ctx.BlockTime().Unix())
+		vestingAcc = acc
+
+	default:
+		return sdkerrors.Wrapf(types.ErrTeamAccountNotVestable, "%s is a %T", teamAddr, acc)
+	}
+
+	k.authKeeper.SetAccount(ctx, vestingAcc)
+
+	return nil
+}
+
+// toPeriodicVestingAccount turns a continuous vesting account into a periodic
+// one with the same original vesting. The coins vested at the block time are
+// vested by a first period ending at the block time, and the remainder vests
+// until the end time of the account in linear vesting periods.
+func toPeriodicVestingAccount(acc *vestingtypes.ContinuousVestingAccount, blockTime time.Time) *vestingtypes.PeriodicVestingAccount {
+	now := blockTime.Unix()
+
+	var periods vestingtypes.Periods
+	switch {
+	case now >= acc.EndTime:
+		periods = vestingtypes.Periods{{Length: acc.EndTime - acc.StartTime, Amount: acc.OriginalVesting}}
+	case now <= acc.StartTime:
+		periods = linearVestingPeriods(acc.OriginalVesting, acc.EndTime-acc.StartTime)
+	default:
+		vested := acc.GetVestedCoins(blockTime)
+		periods = append(
+			vestingtypes.Periods{{Length: now - acc.StartTime, Amount: vested}},
+			linearVestingPeriods(acc.OriginalVesting.Sub(vested), acc.EndTime-now)...,
+		)
+	}
+
+	return vestingtypes.NewPeriodicVestingAccountRaw(acc.BaseVestingAccount, acc.StartTime, periods)
+}
+
+// linearVestingPeriods splits an amount vesting linearly over duration seconds
+// into at most MaxTeamVestingPeriods periods. Each period vests the coins the
+// linear vesting has vested at its end, rounded down, and the periods vesting
+// no coin are joined with the next one.
+func linearVestingPeriods(amount sdk.Coins, duration int64) vestingtypes.Periods {
+	count := int64(types.MaxTeamVestingPeriods)
+	if duration < count {
+		count = duration
+	}
+	if count < 1 {
+		return vestingtypes.Periods{{Length: duration, Amount: amount}}
+	}
+
+	periods := vestingtypes.Periods{}
+	vested := sdk.NewCoins()
+	length := int64(0)
+	for i := int64(1); i <= count; i++ {
+		length += duration / count
+		if i == count {
+			length += duration % count
+		}
+
+		total := sdk.NewCoins()
+		for _, coin := range amount {
+			total = total.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(i).QuoRaw(count)))
+		}
+		if periodAmount := total.Sub(vested); !periodAmount.IsZero() {
+			periods = append(periods, vestingtypes.Period{Length: length, Amount: periodAmount})
+			vested, length = total, 0
+		}
+	}
+
+	return periods
+}
+
+// mergeVestingPeriods merges two vesting schedules into a single one, every
+// coin vests at the same time as in its original schedule. It returns the
+// start time, the end time and the periods of the merged schedule.
+func mergeVestingPeriods(startA int64, periodsA vestingtypes.Periods, startB int64, periodsB vestingtypes.Periods) (int64, int64, vestingtypes.Periods) {
+	start := startA
+	if startB < start {
+		start = startB
+	}
+
+	merged := vestingtypes.Periods{}
+	current, endA, endB := start, startA, startB
+	i, j := 0, 0
+	for i < len(periodsA) || j < len(periodsB) {
+		var next int64
+		amount := sdk.NewCoins()
+
+		takeA := i < len(periodsA) && (j >= len(periodsB) || endA+periodsA[i].Length <= endB+periodsB[j].Length)
+		takeB := j < len(periodsB) && (i >= len(periodsA) || endB+periodsB[j].Length <= endA+periodsA[i].Length)
+		if takeA {
+			endA += periodsA[i].Length
+			next = endA
+			amount = amount.Add(periodsA[i].Amount...)
+			i++
+		}
+		if takeB {
+			endB += periodsB[j].Length
+			next = endB
+			amount = amount.Add(periodsB[j].Amount...)
+			j++
+		}
+
+		merged = append(merged, vestingtypes.Period{Length: next - current, Amount: amount})
+		current = next
+	}
+
+	return start, current, merged
+}
+
+// capVestingPeriods joins adjacent periods of a vesting schedule until it has
+// at most MaxTeamVestingPeriods periods. The coins of a joined period vest at
+// its end, so the periods vested at the block time are joined first, a vested
+// period is never joined with an unvested one, and otherwise the shortest
+// joined period is preferred to keep the schedule evenly spread.
+func capVestingPeriods(start int64, periods vestingtypes.Periods, blockTime int64) vestingtypes.Periods {
+	for len(periods) > types.MaxTeamVestingPeriods {
+		join, length := -1, int64(0)
+
+		end := start + periods[0].Length
+		for i := 0; i+1 < len(periods); i++ {
+			vested := end <= blockTime
+			end += periods[i+1].Length
+
+			l := periods[i].Length + periods[i+1].Length
+			switch {
+			case end <= blockTime:
+				// joining two vested periods delays nothing
+				l = 0
+			case vested:
+				// joining would lock vested coins again
+				continue
+			}
+			if join < 0 || l < length {
+				join, length = i, l
+			}
+		}
+
+		joined := vestingtypes.Period{
+			Length: periods[join].Length + periods[join+1].Length,
+			Amount: periods[join].Amount.Add(periods[join+1].Amount...),
+		}
+		periods = append(append(periods[:join:join], joined), periods[join+2:]...)
+	}
+
+	return periods
+}
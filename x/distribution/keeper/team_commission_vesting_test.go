@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawTeamCommissionVesting(t *testing.T) {
+	app := simapp.Setup(false)
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.ReallocCommissionRule = stakingtypes.NewReallocatedCommissionRule(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), 1,
+		[]stakingtypes.RecommanderClassRate{{Index: 0, Rate: sdk.NewDecWithPrec(5, 1)}})
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	// the team commission is paid to the operator account by default
+	teamAddr := addr[0]
+	commission := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1))}
+	teamCommission := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(10))}
+	grant := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	accrue := func(ctx sdk.Context) {
+		distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+		require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), grant))
+		app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: commission.Add(teamCommission...)})
+		app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: commission, TeamCommission: teamCommission})
+	}
+
+	vesting := types.NewTeamCommissionVesting(types.TeamVestingTypePeriodic, 0, 100*time.Second, 2)
+	app.DistrKeeper.SetTeamCommissionVesting(ctx, valAddrs[0], vesting)
+	require.Equal(t, vesting, app.DistrKeeper.GetTeamCommissionVesting(ctx, valAddrs[0]))
+
+	// the first withdrawal turns the team account into a periodic vesting account
+	accrue(ctx)
+	withdrawn, err := app.DistrKeeper.WithdrawTeamCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, grant, withdrawn)
+
+	half := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	acc, ok := app.AccountKeeper.GetAccount(ctx, teamAddr).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, grant, acc.OriginalVesting)
+	require.Equal(t, startTime.Unix(), acc.StartTime)
+	require.Equal(t, startTime.Unix()+100, acc.EndTime)
+	require.Equal(t, []vestingtypes.Period{{Length: 50, Amount: half}, {Length: 50, Amount: half}}, acc.VestingPeriods)
+
+	// a later withdrawal is merged with the schedule of the first one
+	ctx = ctx.WithBlockTime(startTime.Add(25 * time.Second))
+	accrue(ctx)
+	_, err = app.DistrKeeper.WithdrawTeamCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+
+	acc, ok = app.AccountKeeper.GetAccount(ctx, teamAddr).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, grant.Add(grant...), acc.OriginalVesting)
+	require.Equal(t, startTime.Unix(), acc.StartTime)
+	require.Equal(t, startTime.Unix()+125, acc.EndTime)
+	require.Equal(t, []vestingtypes.Period{
+		{Length: 50, Amount: half},
+		{Length: 25, Amount: half},
+		{Length: 25, Amount: half},
+		{Length: 25, Amount: half},
+	}, acc.VestingPeriods)
+
+	// the vesting cannot be removed, so the team commission stays locked
+	err = app.DistrKeeper.UpdateTeamCommissionVesting(ctx, valAddrs[0], types.NewTeamCommissionVesting(types.TeamVestingTypeNone, 0, 0, 0))
+	require.ErrorIs(t, err, types.ErrTeamVestingWeakened)
+	require.Equal(t, vesting, app.DistrKeeper.GetTeamCommissionVesting(ctx, valAddrs[0]))
+
+	accrue(ctx)
+	_, err = app.DistrKeeper.WithdrawTeamCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+
+	acc, ok = app.AccountKeeper.GetAccount(ctx, teamAddr).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, grant.Add(grant...).Add(grant...), acc.OriginalVesting)
+	require.Equal(t, startTime.Unix()+125, acc.EndTime)
+	require.True(t, acc.GetVestingCoins(ctx.BlockTime()).IsAllGTE(grant))
+}
+
+func TestUpdateTeamCommissionVesting(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	valAddr := sdk.ValAddress(valConsAddr1)
+
+	none := types.NewTeamCommissionVesting(types.TeamVestingTypeNone, 0, 0, 0)
+	continuous := types.NewTeamCommissionVesting(types.TeamVestingTypeContinuous, time.Hour, 24*time.Hour, 0)
+	periodic := types.NewTeamCommissionVesting(types.TeamVestingTypePeriodic, time.Hour, 24*time.Hour, 4)
+	longer := types.NewTeamCommissionVesting(types.TeamVestingTypePeriodic, 2*time.Hour, 48*time.Hour, 4)
+
+	// a validator without vesting can keep it disabled or enable it
+	require.NoError(t, app.DistrKeeper.UpdateTeamCommissionVesting(ctx, valAddr, none))
+	require.NoError(t, app.DistrKeeper.UpdateTeamCommissionVesting(ctx, valAddr, continuous))
+	require.Equal(t, continuous, app.DistrKeeper.GetTeamCommissionVesting(ctx, valAddr))
+
+	// the vesting can be made periodic and longer
+	require.NoError(t, app.DistrKeeper.UpdateTeamCommissionVesting(ctx, valAddr, periodic))
+	require.NoError(t, app.DistrKeeper.UpdateTeamCommissionVesting(ctx, valAddr, longer))
+	require.Equal(t, longer, app.DistrKeeper.GetTeamCommissionVesting(ctx, valAddr))
+
+	// but never weakened
+	for _, vesting := range []types.TeamCommissionVesting{none, continuous, periodic} {
+		err := app.DistrKeeper.UpdateTeamCommissionVesting(ctx, valAddr, vesting)
+		require.ErrorIs(t, err, types.ErrTeamVestingWeakened)
+	}
+	require.Equal(t, longer, app.DistrKeeper.GetTeamCommissionVesting(ctx, valAddr))
+}
+
+func TestWithdrawTeamCommissionContinuousVesting(t *testing.T) {
+	app := simapp.Setup(false)
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.ReallocCommissionRule = stakingtypes.NewReallocatedCommissionRule(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), 1,
+		[]stakingtypes.RecommanderClassRate{{Index: 0, Rate: sdk.NewDecWithPrec(5, 1)}})
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	commission := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1))}
+	teamCommission := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(10))}
+	grant := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	accrue := func(ctx sdk.Context) {
+		distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+		require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), grant))
+		app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: commission.Add(teamCommission...)})
+		app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: commission, TeamCommission: teamCommission})
+	}
+
+	app.DistrKeeper.SetTeamCommissionVesting(ctx, valAddrs[0], types.NewTeamCommissionVesting(types.TeamVestingTypeContinuous, time.Hour, 24*time.Hour, 0))
+
+	accrue(ctx)
+	_, err := app.DistrKeeper.WithdrawTeamCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+
+	acc, ok := app.AccountKeeper.GetAccount(ctx, addr[0]).(*vestingtypes.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, grant, acc.OriginalVesting)
+	require.Equal(t, startTime.Add(time.Hour).Unix(), acc.StartTime)
+	require.Equal(t, startTime.Add(25*time.Hour).Unix(), acc.EndTime)
+	require.Equal(t, grant, acc.GetVestingCoins(startTime.Add(time.Hour)))
+
+	// half way through, a later withdrawal turns the account into a periodic
+	// vesting account which keeps the vested coins vested and the remainder
+	// vesting until the end of the first schedule
+	withdrawTime := startTime.Add(13 * time.Hour)
+	ctx = ctx.WithBlockTime(withdrawTime)
+	vested := acc.GetVestedCoins(withdrawTime)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)), vested)
+
+	accrue(ctx)
+	_, err = app.DistrKeeper.WithdrawTeamCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+
+	periodicAcc, ok := app.AccountKeeper.GetAccount(ctx, addr[0]).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, grant.Add(grant...), periodicAcc.OriginalVesting)
+	require.Equal(t, startTime.Add(time.Hour).Unix(), periodicAcc.StartTime)
+	require.Equal(t, withdrawTime.Add(25*time.Hour).Unix(), periodicAcc.EndTime)
+	require.LessOrEqual(t, len(periodicAcc.VestingPeriods), types.MaxTeamVestingPeriods)
+	require.Equal(t, vested, periodicAcc.GetVestedCoins(withdrawTime))
+	require.True(t, periodicAcc.GetVestedCoins(startTime.Add(25*time.Hour)).IsAllGTE(grant))
+	require.Equal(t, grant.Add(grant...), periodicAcc.GetVestedCoins(withdrawTime.Add(25*time.Hour)))
+
+	// the coins never vest earlier than the linear schedules
+	for _, hours := range []int64{14, 20, 26, 30, 37} {
+		blockTime := startTime.Add(time.Duration(hours) * time.Hour)
+		linear := acc.GetVestedCoins(blockTime)
+		second := vestingtypes.NewContinuousVestingAccount(nil, grant, withdrawTime.Add(time.Hour).Unix(), withdrawTime.Add(25*time.Hour).Unix())
+		linear = linear.Add(second.GetVestedCoins(blockTime)...)
+		require.True(t, linear.IsAllGTE(periodicAcc.GetVestedCoins(blockTime)), "%d hours", hours)
+	}
+}
+
+func TestWithdrawTeamCommissionMaxVestingPeriods(t *testing.T) {
+	app := simapp.Setup(false)
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	commission := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1))}
+	teamCommission := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1000))}
+	grant := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	vesting := types.NewTeamCommissionVesting(types.TeamVestingTypePeriodic, 0, 1000*time.Second, types.MaxTeamVestingPeriods)
+	app.DistrKeeper.SetTeamCommissionVesting(ctx, valAddrs[0], vesting)
+
+	// every withdrawal adds as many periods as the maximum
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i*15) * time.Second))
+		distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+		require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), grant))
+		app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: commission.Add(teamCommission...)})
+		app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: commission, TeamCommission: teamCommission})
+
+		_, err := app.DistrKeeper.WithdrawTeamCommission(ctx, valAddrs[0])
+		require.NoError(t, err)
+	}
+
+	acc, ok := app.AccountKeeper.GetAccount(ctx, addr[0]).(*vestingtypes.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Len(t, acc.VestingPeriods, types.MaxTeamVestingPeriods)
+	require.NoError(t, acc.Validate())
+	require.Equal(t, grant.Add(grant...).Add(grant...), acc.OriginalVesting)
+	require.Equal(t, startTime.Unix()+1030, acc.EndTime)
+
+	// the coins vested before the last withdrawal are still vested
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40)), acc.GetVestedCoins(ctx.BlockTime()))
+}
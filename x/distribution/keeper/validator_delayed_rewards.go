@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"math"
+	"sort"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,34 +39,66 @@ func (k Keeper) equeuTokensToValidatorQueue(ctx sdk.Context, val stakingtypes.Va
 	k.SetValidatorDelayedReward(ctx, val.GetOperator(), startTime, delayedReward)
 }
 
+// calcValidatorDelayedRewardPeriod returns the period of the delayed rewards of
+// a validator, computed by the DelayedRewardPeriodFunc of the keeper if set or
+// by the curve selected by the params. The period is at least one unit.
 func (k Keeper) calcValidatorDelayedRewardPeriod(ctx sdk.Context, val stakingtypes.ValidatorI, validatorVariance sdk.Dec) int64 {
-	factor := sdk.OneDec()
+	params := k.GetParams(ctx)
 
-	// |((t/g)/(tt/tg)) - 1| * interval + min_start_time
-	if val.GetDelegators().IsPositive() {
-		validatorTG := val.GetTokens().ToDec().Quo(val.GetDelegators().ToDec())
-		factor = validatorTG.Mul(validatorVariance).Sub(sdk.OneDec()).Abs()
+	var period int64
+	if k.delayedRewardPeriodFunc != nil {
+		period = k.delayedRewardPeriodFunc.DelayedRewardPeriod(ctx, val, validatorVariance, params)
+	} else {
+		period = params.DelayedRewardPeriod(types.DelayedRewardPeriodFactor(val, validatorVariance))
 	}
-	interval := sdk.NewDec(k.GetMaxDelayedRewardInterval(ctx)).MulTruncate(factor).TruncateInt64()
 
-	return (k.GetMinDelayedRewardPeriod(ctx) + interval)
+	if period < 1 {
+		period = 1
+	}
+	return period
 }
 
-
-func (k Keeper) WithdrawValidatorDelayedRewardsOf(ctx sdk.Context, val sdk.ValAddress) {
+// WithdrawValidatorDelayedRewardsOf releases the delayed rewards of a validator
+// which are due, at most once per delayed reward unit, and returns them.
+func (k Keeper) WithdrawValidatorDelayedRewardsOf(ctx sdk.Context, val sdk.ValAddress) sdk.DecCoins {
 	lastDoneTime := k.GetValidatorDelayedRewardInfo(ctx, val)
 	blockTime := k.GetDelayedRewardTime(ctx, ctx.BlockHeader().Time)
 	
 	if (AfterOfDelayedRewardTime(blockTime, lastDoneTime.LastTime)){
-		k.withdrawValidatorDelayedRewardsOf(ctx, val, blockTime)
+		tokens := k.withdrawValidatorDelayedRewardsOf(ctx, val, blockTime)
 
 		lastDoneTime.LastTime = blockTime
 		k.SetValidatorDelayedRewardInfo(ctx, val, lastDoneTime)
+		return tokens
 	}
+
+	return sdk.NewDecCoins()
+}
+
+// ReleaseDelayedRewards releases the delayed rewards of a validator which are due.
+func (k Keeper) ReleaseDelayedRewards(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.DecCoins, error) {
+	if val := k.stakingKeeper.Validator(ctx, valAddr); val == nil {
+		return nil, types.ErrNoValidatorExists
+	}
+
+	tokens := k.WithdrawValidatorDelayedRewardsOf(ctx, valAddr)
+	if tokens.IsZero() {
+		return nil, types.ErrNoDelayedRewardsDue
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseDelayedRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	return tokens, nil
 }
 
 // both startTime and currTime must be DelayedRewardTime
-func (k Keeper) withdrawValidatorDelayedRewardsOf(ctx sdk.Context, valAdr sdk.ValAddress, currTime time.Time) {
+func (k Keeper) withdrawValidatorDelayedRewardsOf(ctx sdk.Context, valAdr sdk.ValAddress, currTime time.Time) sdk.DecCoins {
 	tokens := sdk.NewDecCoins()
 	k.IterateValidatorDelayedRewardsOf(ctx, valAdr, func(startTime time.Time, v types.ValidatorDelayedReward) (stop bool) {
 		tokens = tokens.Add(k.withdrawValidatorDelayedRewardOf(ctx, valAdr, startTime, currTime, v)...)
@@ -75,6 +109,145 @@ func (k Keeper) withdrawValidatorDelayedRewardsOf(ctx sdk.Context, valAdr sdk.Va
 			k.AllocateTokensToValidator(ctx, val, tokens)
 		}
 	}
+	return tokens
+}
+
+// GetValidatorDelayedRewardsSchedule projects the releases of the delayed rewards
+// of a validator the same way withdrawValidatorDelayedRewardOf releases them. It
+// returns the rewards which can be released at the current block time and the
+// upcoming releases ordered by release time. Consecutive releases of the same
+// amount are merged into one entry with their count, so the schedule grows with
+// the number of delayed rewards and denoms rather than with their periods.
+func (k Keeper) GetValidatorDelayedRewardsSchedule(ctx sdk.Context, valAdr sdk.ValAddress) (due sdk.DecCoins, releases []types.DelayedRewardRelease) {
+	due = sdk.NewDecCoins()
+	releases = []types.DelayedRewardRelease{}
+
+	delayedRewardUnit := k.GetDelayedRewardUnit(ctx)
+	blockTime := k.GetDelayedRewardTime(ctx, ctx.BlockHeader().Time)
+
+	// the releases are counted in units after the block time. The rewards not
+	// aligned with the block time after a change of the delayed reward unit are
+	// scheduled at their own offset.
+	type cadence struct{ starts, ends map[int64]sdk.DecCoins }
+	cadences := map[time.Duration]cadence{}
+	schedule := func(offset time.Duration, index, count int64, amount sdk.DecCoins) {
+		c, ok := cadences[offset]
+		if !ok {
+			c = cadence{starts: map[int64]sdk.DecCoins{}, ends: map[int64]sdk.DecCoins{}}
+			cadences[offset] = c
+		}
+		c.starts[index] = c.starts[index].Add(amount...)
+		c.ends[index+count] = c.ends[index+count].Add(amount...)
+	}
+
+	k.IterateValidatorDelayedRewardsOf(ctx, valAdr, func(_ time.Time, v types.ValidatorDelayedReward) (stop bool) {
+		// the due rewards are released at the block time
+		var sinceBlockTime time.Duration
+		n := int64(blockTime.Sub(v.UpdateTime) / delayedRewardUnit)
+		if n <= 0 {
+			sinceBlockTime = v.UpdateTime.Sub(blockTime)
+		}
+		start := int64(sinceBlockTime/delayedRewardUnit) + 1
+		offset := sinceBlockTime % delayedRewardUnit
+		if offset < 0 {
+			offset += delayedRewardUnit
+			start--
+		}
+
+		for _, decCoin := range v.Reward {
+			unit := v.Unit.AmountOf(decCoin.Denom)
+			if unit.IsZero() {
+				unit = decCoin.Amount.QuoInt64(v.Period)
+			}
+			if unit.IsZero() {
+				// the unit truncates to zero, nothing is ever released
+				continue
+			}
+
+			remaining := decCoin.Amount
+			if n > 0 {
+				amount := unit.MulInt64(n)
+				if remaining.LT(amount) {
+					amount = remaining
+				}
+				due = due.Add(sdk.NewDecCoinFromDec(decCoin.Denom, amount))
+				remaining = remaining.Sub(amount)
+			}
+			if !remaining.IsPositive() {
+				continue
+			}
+
+			// the full units are released first, then the remainder
+			full := remaining.Quo(unit).TruncateInt64()
+			if unit.MulInt64(full).GT(remaining) {
+				// the quotient was rounded up
+				full--
+			}
+			index := start
+			if full > 0 {
+				schedule(offset, index, full, sdk.DecCoins{sdk.NewDecCoinFromDec(decCoin.Denom, unit)})
+				remaining = remaining.Sub(unit.MulInt64(full))
+				index += full
+			}
+			if remaining.IsPositive() {
+				schedule(offset, index, 1, sdk.DecCoins{sdk.NewDecCoinFromDec(decCoin.Denom, remaining)})
+			}
+		}
+
+		return false
+	})
+
+	for offset, c := range cadences {
+		indexes := make([]int64, 0, len(c.starts)+len(c.ends))
+		for index := range c.starts {
+			indexes = append(indexes, index)
+		}
+		for index := range c.ends {
+			if _, ok := c.starts[index]; !ok {
+				indexes = append(indexes, index)
+			}
+		}
+		sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+		// the amount released every unit only changes at these indexes
+		amount := sdk.NewDecCoins()
+		var last *types.DelayedRewardRelease
+		var lastEnd int64
+		for i, index := range indexes {
+			amount = amount.Add(c.starts[index]...).Sub(c.ends[index])
+			if amount.IsZero() || i+1 == len(indexes) {
+				continue
+			}
+
+			count := indexes[i+1] - index
+			if last != nil && lastEnd == index {
+				if diff, hasNeg := last.Amount.SafeSub(amount); !hasNeg && diff.IsZero() {
+					last.Count += uint64(count)
+					lastEnd += count
+					continue
+				}
+			}
+
+			releaseTime := addDelayedRewardUnits(blockTime.Add(offset), index, delayedRewardUnit)
+			releases = append(releases, types.NewDelayedRewardRelease(releaseTime, amount, uint64(count)))
+			last, lastEnd = &releases[len(releases)-1], index+count
+		}
+	}
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].ReleaseTime.Before(releases[j].ReleaseTime)
+	})
+
+	return due, releases
+}
+
+// addDelayedRewardUnits returns the time n delayed reward units after t, without
+// overflowing the duration of the units.
+func addDelayedRewardUnits(t time.Time, n int64, unit time.Duration) time.Time {
+	maxUnits := int64(math.MaxInt64 / unit)
+	for ; n > maxUnits; n -= maxUnits {
+		t = t.Add(time.Duration(maxUnits) * unit)
+	}
+	return t.Add(time.Duration(n) * unit)
 }
 
 // both startTime and currTime must be DelayedRewardTime
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestReleaseDelayedRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.ReallocCommissionRule = stakingtypes.NewReallocatedCommissionRule(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), 1,
+		[]stakingtypes.RecommanderClassRate{{Index: 0, Rate: sdk.NewDecWithPrec(5, 1)}})
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	// queue 100 tokens released over 4 units
+	unit := app.DistrKeeper.GetDelayedRewardUnit(ctx)
+	delayedRewardTime := app.DistrKeeper.GetDelayedRewardTime(ctx, startTime)
+	reward := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(100)}}
+	app.DistrKeeper.SetValidatorDelayedReward(ctx, valAddrs[0], delayedRewardTime,
+		types.NewValidatorDelayedReward(4, delayedRewardTime, sdk.NewDecCoins(), reward))
+
+	quarter := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(25)}}
+
+	due, releases := app.DistrKeeper.GetValidatorDelayedRewardsSchedule(ctx, valAddrs[0])
+	require.True(t, due.IsZero())
+	require.Equal(t, []types.DelayedRewardRelease{
+		types.NewDelayedRewardRelease(delayedRewardTime.Add(unit), quarter, 4),
+	}, releases)
+
+	_, err := app.DistrKeeper.ReleaseDelayedRewards(ctx, valAddrs[0])
+	require.ErrorIs(t, err, types.ErrNoDelayedRewardsDue)
+
+	// two units later half of the rewards are due
+	ctx = ctx.WithBlockTime(startTime.Add(2*unit + time.Hour))
+	due, releases = app.DistrKeeper.GetValidatorDelayedRewardsSchedule(ctx, valAddrs[0])
+	require.Equal(t, quarter.MulDec(sdk.NewDec(2)), due)
+	require.Equal(t, []types.DelayedRewardRelease{
+		types.NewDelayedRewardRelease(delayedRewardTime.Add(3*unit), quarter, 2),
+	}, releases)
+
+	outstanding := app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddrs[0])
+	released, err := app.DistrKeeper.ReleaseDelayedRewards(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, due, released)
+	require.Equal(t, outstanding.Add(due...), app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddrs[0]))
+
+	// the release matches the schedule
+	due, releasesAfter := app.DistrKeeper.GetValidatorDelayedRewardsSchedule(ctx, valAddrs[0])
+	require.True(t, due.IsZero())
+	require.Equal(t, releases, releasesAfter)
+
+	_, err = app.DistrKeeper.ReleaseDelayedRewards(ctx, valAddrs[0])
+	require.ErrorIs(t, err, types.ErrNoDelayedRewardsDue)
+
+	_, err = app.DistrKeeper.ReleaseDelayedRewards(ctx, sdk.ValAddress(valConsPk2.Address()))
+	require.ErrorIs(t, err, types.ErrNoValidatorExists)
+}
+
+func TestDelayedRewardsScheduleDust(t *testing.T) {
+	app := simapp.Setup(false)
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+
+	valAddr := sdk.ValAddress(valConsPk1.Address())
+
+	// the unit of the dust denom truncates to zero, it is never released
+	unit := app.DistrKeeper.GetDelayedRewardUnit(ctx)
+	delayedRewardTime := app.DistrKeeper.GetDelayedRewardTime(ctx, startTime)
+	reward := sdk.DecCoins{
+		{Denom: "dust", Amount: sdk.NewDecWithPrec(1, sdk.Precision)},
+		{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(30)},
+	}
+	app.DistrKeeper.SetValidatorDelayedReward(ctx, valAddr, delayedRewardTime,
+		types.NewValidatorDelayedReward(3, delayedRewardTime, sdk.NewDecCoins(), reward))
+
+	third := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(10)}}
+
+	due, releases := app.DistrKeeper.GetValidatorDelayedRewardsSchedule(ctx, valAddr)
+	require.True(t, due.IsZero())
+	require.Equal(t, []types.DelayedRewardRelease{
+		types.NewDelayedRewardRelease(delayedRewardTime.Add(unit), third, 3),
+	}, releases)
+}
+
+func TestDelayedRewardsScheduleMerged(t *testing.T) {
+	app := simapp.Setup(false)
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+
+	valAddr := sdk.ValAddress(valConsPk1.Address())
+	unit := app.DistrKeeper.GetDelayedRewardUnit(ctx)
+	delayedRewardTime := app.DistrKeeper.GetDelayedRewardTime(ctx, startTime)
+
+	// a reward released over a million units is a single entry
+	app.DistrKeeper.SetValidatorDelayedReward(ctx, valAddr, delayedRewardTime,
+		types.NewValidatorDelayedReward(1_000_000, delayedRewardTime, sdk.NewDecCoins(),
+			sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(2_000_000)}}))
+
+	_, releases := app.DistrKeeper.GetValidatorDelayedRewardsSchedule(ctx, valAddr)
+	require.Equal(t, []types.DelayedRewardRelease{
+		types.NewDelayedRewardRelease(delayedRewardTime.Add(unit), sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(2)}}, 1_000_000),
+	}, releases)
+
+	// an overlapping reward splits the schedule where the released amount
+	// changes, and its remainder is released once after its full units
+	secondTime := delayedRewardTime.Add(2 * unit)
+	app.DistrKeeper.SetValidatorDelayedReward(ctx, valAddr, secondTime,
+		types.NewValidatorDelayedReward(3, secondTime, sdk.NewDecCoins(),
+			sdk.DecCoins{{Denom: "token", Amount: sdk.NewDec(10)}}))
+
+	two := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(2))
+	third := sdk.NewDecCoinFromDec("token", sdk.NewDec(10).QuoInt64(3))
+	remainder := sdk.NewDecCoinFromDec("token", sdk.NewDec(10).Sub(third.Amount.MulInt64(3)))
+
+	_, releases = app.DistrKeeper.GetValidatorDelayedRewardsSchedule(ctx, valAddr)
+	require.Equal(t, []types.DelayedRewardRelease{
+		types.NewDelayedRewardRelease(delayedRewardTime.Add(unit), sdk.NewDecCoins(two), 2),
+		types.NewDelayedRewardRelease(secondTime.Add(unit), sdk.NewDecCoins(two, third), 3),
+		types.NewDelayedRewardRelease(secondTime.Add(4*unit), sdk.NewDecCoins(two, remainder), 1),
+		types.NewDelayedRewardRelease(secondTime.Add(5*unit), sdk.NewDecCoins(two), 1_000_000-6),
+	}, releases)
+
+	// the schedule matches the releases
+	ctx = ctx.WithBlockTime(secondTime.Add(4 * unit))
+	due, _ := app.DistrKeeper.GetValidatorDelayedRewardsSchedule(ctx, valAddr)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(12)),
+		sdk.NewDecCoinFromDec("token", third.Amount.MulInt64(2).Add(third.Amount).Add(remainder.Amount))), due)
+
+	// a reward starting to be released when another one ends with the same
+	// amount extends its entry
+	otherAddr := sdk.ValAddress(valConsPk2.Address())
+	app.DistrKeeper.SetValidatorDelayedReward(ctx, otherAddr, delayedRewardTime,
+		types.NewValidatorDelayedReward(2, delayedRewardTime, sdk.NewDecCoins(), sdk.NewDecCoins(two.Add(two))))
+	app.DistrKeeper.SetValidatorDelayedReward(ctx, otherAddr, secondTime,
+		types.NewValidatorDelayedReward(3, secondTime, sdk.NewDecCoins(), sdk.NewDecCoins(two.Add(two).Add(two))))
+
+	ctx = ctx.WithBlockTime(startTime)
+	_, releases = app.DistrKeeper.GetValidatorDelayedRewardsSchedule(ctx, otherAddr)
+	require.Equal(t, []types.DelayedRewardRelease{
+		types.NewDelayedRewardRelease(delayedRewardTime.Add(unit), sdk.NewDecCoins(two), 5),
+	}, releases)
+}
+
+type fixedDelayedRewardPeriod int64
+
+func (p fixedDelayedRewardPeriod) DelayedRewardPeriod(sdk.Context, stakingtypes.ValidatorI, sdk.Dec, types.Params) int64 {
+	return int64(p)
+}
+
+func TestDelayedRewardPeriodFunc(t *testing.T) {
+	app := simapp.Setup(false)
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	tstaking.ReallocCommissionRule = stakingtypes.NewReallocatedCommissionRule(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), 1,
+		[]stakingtypes.RecommanderClassRate{{Index: 0, Rate: sdk.NewDecWithPrec(5, 1)}})
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(100)}}
+	unit := app.DistrKeeper.GetDelayedRewardUnit(ctx)
+	params := app.DistrKeeper.GetParams(ctx)
+
+	// the period is computed with the curve of the params
+	params.DelayedRewardPeriodCurve = types.DelayedRewardPeriodCurveFlat
+	app.DistrKeeper.SetParams(ctx, params)
+	app.DistrKeeper.NewAllocateTokensToValidator(ctx, val, tokens, sdk.NewDecWithPrec(5, 1))
+	delayedReward, found := app.DistrKeeper.GetValidatorDelayedReward(ctx, valAddrs[0], app.DistrKeeper.GetDelayedRewardTime(ctx, startTime))
+	require.True(t, found)
+	require.Equal(t, params.MinDelayedRewardPeriod, delayedReward.Period)
+
+	// a period func takes precedence over the curve
+	distrKeeper := app.DistrKeeper
+	distrKeeper.SetDelayedRewardPeriodFunc(fixedDelayedRewardPeriod(7))
+	require.Panics(t, func() { distrKeeper.SetDelayedRewardPeriodFunc(fixedDelayedRewardPeriod(7)) })
+
+	ctx = ctx.WithBlockTime(startTime.Add(unit))
+	distrKeeper.NewAllocateTokensToValidator(ctx, val, tokens, sdk.NewDecWithPrec(5, 1))
+	delayedReward, found = distrKeeper.GetValidatorDelayedReward(ctx, valAddrs[0], distrKeeper.GetDelayedRewardTime(ctx, startTime.Add(unit)))
+	require.True(t, found)
+	require.Equal(t, int64(7), delayedReward.Period)
+
+	// the period is at least one unit
+	distrKeeper = app.DistrKeeper
+	distrKeeper.SetDelayedRewardPeriodFunc(fixedDelayedRewardPeriod(-1))
+
+	ctx = ctx.WithBlockTime(startTime.Add(2 * unit))
+	distrKeeper.NewAllocateTokensToValidator(ctx, val, tokens, sdk.NewDecWithPrec(5, 1))
+	delayedReward, found = distrKeeper.GetValidatorDelayedReward(ctx, valAddrs[0], distrKeeper.GetDelayedRewardTime(ctx, startTime.Add(2*unit)))
+	require.True(t, found)
+	require.Equal(t, int64(1), delayedReward.Period)
+}
@@ -0,0 +1,27 @@
+package v045
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// migrateParams sets the delayed reward period curve parameter, which did not
+// exist before, to the linear curve that was hard-coded until v0.45.
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	if paramSpace.Has(ctx, types.ParamStoreKeyDelayedRewardPeriodCurve) {
+		return
+	}
+
+	paramSpace.Set(ctx, types.ParamStoreKeyDelayedRewardPeriodCurve, types.DelayedRewardPeriodCurveLinear)
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Setting the delayed reward period curve parameter
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	migrateParams(ctx, paramSpace)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package v045_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v045distribution "github.com/cosmos/cosmos-sdk/x/distribution/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, tParamsKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	require.False(t, paramSpace.Has(ctx, types.ParamStoreKeyDelayedRewardPeriodCurve))
+
+	require.NoError(t, v045distribution.MigrateStore(ctx, paramSpace))
+
+	var curve string
+	paramSpace.Get(ctx, types.ParamStoreKeyDelayedRewardPeriodCurve, &curve)
+	require.Equal(t, types.DelayedRewardPeriodCurveLinear, curve)
+
+	// an already set curve is kept
+	paramSpace.Set(ctx, types.ParamStoreKeyDelayedRewardPeriodCurve, types.DelayedRewardPeriodCurveFlat)
+	require.NoError(t, v045distribution.MigrateStore(ctx, paramSpace))
+	paramSpace.Get(ctx, types.ParamStoreKeyDelayedRewardPeriodCurve, &curve)
+	require.Equal(t, types.DelayedRewardPeriodCurveFlat, curve)
+}
@@ -31,8 +31,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 	info := types.NewDelegatorStartingInfo(2, sdk.OneDec(), 200)
 	outstanding := types.ValidatorOutstandingRewards{Rewards: decCoins}
 	commission := types.ValidatorAccumulatedCommission{Commission: decCoins}
-	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
-	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
+	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, sdk.DecCoins{}, 100)
+	currentRewards := types.NewValidatorCurrentRewards(decCoins, sdk.DecCoins{}, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
 
 	kvPairs := kv.Pairs{
@@ -40,6 +40,17 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenDelayedRewardPeriodCurve returns a randomized DelayedRewardPeriodCurve parameter.
+func GenDelayedRewardPeriodCurve(r *rand.Rand) string {
+	curves := []string{
+		types.DelayedRewardPeriodCurveLinear,
+		types.DelayedRewardPeriodCurveCapped,
+		types.DelayedRewardPeriodCurveExponentialDecay,
+		types.DelayedRewardPeriodCurveFlat,
+	}
+	return curves[r.Intn(len(curves))]
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -3,6 +3,7 @@ package simulation
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -21,6 +22,8 @@ const (
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgReleaseDelayedRewards       = "op_weight_msg_release_delayed_rewards"
+	OpWeightMsgSetTeamCommissionVesting    = "op_weight_msg_set_team_commission_vesting"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -53,6 +56,20 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak ty
 		},
 	)
 
+	var weightMsgReleaseDelayedRewards int
+	appParams.GetOrGenerate(cdc, OpWeightMsgReleaseDelayedRewards, &weightMsgReleaseDelayedRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgReleaseDelayedRewards = simappparams.DefaultWeightMsgReleaseDelayedRewards
+		},
+	)
+
+	var weightMsgSetTeamCommissionVesting int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetTeamCommissionVesting, &weightMsgSetTeamCommissionVesting, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetTeamCommissionVesting = simappparams.DefaultWeightMsgSetTeamCommissionVesting
+		},
+	)
+
 	stakeKeeper := sk.(stakingkeeper.Keeper)
 
 	return simulation.WeightedOperations{
@@ -72,6 +89,14 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak ty
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(ak, bk, k, stakeKeeper),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgReleaseDelayedRewards,
+			SimulateMsgReleaseDelayedRewards(ak, bk, k, stakeKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetTeamCommissionVesting,
+			SimulateMsgSetTeamCommissionVesting(ak, bk, k, stakeKeeper),
+		),
 	}
 }
 
@@ -245,3 +270,108 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 		return simulation.GenAndDeliverTx(txCtx, fees)
 	}
 }
+
+// SimulateMsgReleaseDelayedRewards simulates MsgReleaseDelayedRewards execution where
+// a random account releases the due delayed rewards of a random validator.
+func SimulateMsgReleaseDelayedRewards(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReleaseDelayedRewards, "random validator is not ok"), nil, nil
+		}
+
+		due, _ := k.GetValidatorDelayedRewardsSchedule(ctx, validator.GetOperator())
+		if due.IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgReleaseDelayedRewards, "no delayed rewards due"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgReleaseDelayedRewards(simAccount.Address, validator.GetOperator())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSetTeamCommissionVesting simulates MsgSetTeamCommissionVesting
+// execution where the operator of a random validator sets a random vesting of
+// its team commission.
+func SimulateMsgSetTeamCommissionVesting(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetTeamCommissionVesting, "random validator is not ok"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetTeamCommissionVesting, "could not find account"), nil, fmt.Errorf("validator %s not found", validator.GetOperator())
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		vesting := RandomTeamCommissionVesting(r)
+		if vesting.WeakerThan(k.GetTeamCommissionVesting(ctx, validator.GetOperator())) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetTeamCommissionVesting, "vesting would weaken the current one"), nil, nil
+		}
+
+		msg := types.NewMsgSetTeamCommissionVesting(validator.GetOperator(), vesting)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// RandomTeamCommissionVesting returns a random team commission vesting, with
+// a cliff up to a week and a duration up to four weeks.
+func RandomTeamCommissionVesting(r *rand.Rand) types.TeamCommissionVesting {
+	cliff := time.Duration(simtypes.RandIntBetween(r, 0, 7*24)) * time.Hour
+	duration := time.Duration(simtypes.RandIntBetween(r, 1, 4*7*24)) * time.Hour
+
+	switch r.Intn(3) {
+	case 0:
+		return types.NewTeamCommissionVesting(types.TeamVestingTypeNone, 0, 0, 0)
+	case 1:
+		return types.NewTeamCommissionVesting(types.TeamVestingTypeContinuous, cliff, duration, 0)
+	default:
+		return types.NewTeamCommissionVesting(types.TeamVestingTypePeriodic, cliff, duration, uint32(simtypes.RandIntBetween(r, 1, 13)))
+	}
+}
@@ -41,6 +41,8 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		{simappparams.DefaultWeightMsgWithdrawDelegationReward, types.ModuleName, types.TypeMsgWithdrawDelegatorReward},
 		{simappparams.DefaultWeightMsgWithdrawValidatorCommission, types.ModuleName, types.TypeMsgWithdrawValidatorCommission},
 		{simappparams.DefaultWeightMsgFundCommunityPool, types.ModuleName, types.TypeMsgFundCommunityPool},
+		{simappparams.DefaultWeightMsgReleaseDelayedRewards, types.ModuleName, types.TypeMsgReleaseDelayedRewards},
+		{simappparams.DefaultWeightMsgSetTeamCommissionVesting, types.ModuleName, types.TypeMsgSetTeamCommissionVesting},
 	}
 
 	for i, w := range weightesOps {
@@ -98,7 +100,7 @@ func (suite *SimTestSuite) TestSimulateMsgWithdrawDelegatorReward() {
 	delTokens := suite.app.StakingKeeper.TokensFromConsensusPower(suite.ctx, 2)
 	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
 	delegator := accounts[1]
-	delegation := stakingtypes.NewDelegation(delegator.Address, validator0.GetOperator(), issuedShares)
+	delegation := stakingtypes.NewDelegation(delegator.Address, validator0.GetOperator(), sdk.AccAddress(validator0.GetOperator()), issuedShares)
 	suite.app.StakingKeeper.SetDelegation(suite.ctx, delegation)
 	suite.app.DistrKeeper.SetDelegatorStartingInfo(suite.ctx, validator0.GetOperator(), delegator.Address, distrtypes.NewDelegatorStartingInfo(2, sdk.OneDec(), 200))
 
@@ -260,10 +262,10 @@ func (suite *SimTestSuite) getTestingValidator(accounts []simtypes.Account, comm
 
 func (suite *SimTestSuite) setupValidatorRewards(valAddress sdk.ValAddress) {
 	decCoins := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.OneDec())}
-	historicalRewards := distrtypes.NewValidatorHistoricalRewards(decCoins, 2)
+	historicalRewards := distrtypes.NewValidatorHistoricalRewards(decCoins, sdk.DecCoins{}, 2)
 	suite.app.DistrKeeper.SetValidatorHistoricalRewards(suite.ctx, valAddress, 2, historicalRewards)
 	// setup current revards
-	currentRewards := distrtypes.NewValidatorCurrentRewards(decCoins, 3)
+	currentRewards := distrtypes.NewValidatorCurrentRewards(decCoins, sdk.DecCoins{}, 3)
 	suite.app.DistrKeeper.SetValidatorCurrentRewards(suite.ctx, valAddress, currentRewards)
 
 }
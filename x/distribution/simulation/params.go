@@ -16,6 +16,8 @@ const (
 	keyCommunityTax        = "communitytax"
 	keyBaseProposerReward  = "baseproposerreward"
 	keyBonusProposerReward = "bonusproposerreward"
+
+	keyDelayedRewardPeriodCurve = "delayedrewardperiodcurve"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -37,5 +39,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenBonusProposerReward(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyDelayedRewardPeriodCurve,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenDelayedRewardPeriodCurve(r))
+			},
+		),
 	}
 }
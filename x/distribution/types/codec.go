@@ -17,6 +17,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawTeamCommission{}, "cosmos-sdk/MsgWithdrawTeamCommission", nil)
 	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress", nil)
 	cdc.RegisterConcrete(&MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool", nil)
+	cdc.RegisterConcrete(&MsgReleaseDelayedRewards{}, "cosmos-sdk/MsgReleaseDelayedRewards", nil)
+	cdc.RegisterConcrete(&MsgSetTeamCommissionVesting{}, "cosmos-sdk/MsgSetTeamCommissionVesting", nil)
 	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
 }
 
@@ -28,6 +30,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawTeamCommission{},
 		&MsgSetWithdrawAddress{},
 		&MsgFundCommunityPool{},
+		&MsgReleaseDelayedRewards{},
+		&MsgSetTeamCommissionVesting{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
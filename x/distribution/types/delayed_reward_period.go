@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Built-in curves of the delayed reward period, selected by the
+// DelayedRewardPeriodCurve parameter. Every curve adds an interval, bounded by
+// MaxDelayedRewardInterval except for the linear curve, to the
+// MinDelayedRewardPeriod depending on the factor returned by
+// DelayedRewardPeriodFactor.
+const (
+	// min_period + interval * factor
+	DelayedRewardPeriodCurveLinear = "linear"
+	// min_period + interval * min(factor, 1)
+	DelayedRewardPeriodCurveCapped = "capped"
+	// min_period + interval * e^-factor
+	DelayedRewardPeriodCurveExponentialDecay = "exponential_decay"
+	// min_period
+	DelayedRewardPeriodCurveFlat = "flat"
+
+	DefaultDelayedRewardPeriodCurve = DelayedRewardPeriodCurveLinear
+)
+
+// maxExpDecayFactor is the factor above which e^-factor rounds to zero.
+var maxExpDecayFactor = sdk.NewDec(42)
+
+// DelayedRewardPeriodFunc computes the period, in delayed reward units, over
+// which the delayed rewards of a validator are released. validatorVariance is
+// the ratio of the bonded tokens per delegator of all the validators which
+// signed the last block.
+type DelayedRewardPeriodFunc interface {
+	DelayedRewardPeriod(ctx sdk.Context, val stakingtypes.ValidatorI, validatorVariance sdk.Dec, params Params) int64
+}
+
+// DelayedRewardPeriodFactor returns |((t/g)/(tt/tg)) - 1|, the distance of the
+// tokens per delegator of a validator to the tokens per delegator of all the
+// validators. It returns one for a validator without delegators.
+func DelayedRewardPeriodFactor(val stakingtypes.ValidatorI, validatorVariance sdk.Dec) sdk.Dec {
+	if !val.GetDelegators().IsPositive() {
+		return sdk.OneDec()
+	}
+
+	validatorTG := val.GetTokens().ToDec().Quo(val.GetDelegators().ToDec())
+	return validatorTG.Mul(validatorVariance).Sub(sdk.OneDec()).Abs()
+}
+
+// DelayedRewardPeriod returns the period of the delayed rewards for the given
+// factor, computed with the curve selected by the parameters.
+func (p Params) DelayedRewardPeriod(factor sdk.Dec) int64 {
+	interval := sdk.NewDec(p.MaxDelayedRewardInterval)
+
+	switch p.DelayedRewardPeriodCurve {
+	case DelayedRewardPeriodCurveCapped:
+		interval = interval.MulTruncate(sdk.MinDec(factor, sdk.OneDec()))
+	case DelayedRewardPeriodCurveExponentialDecay:
+		interval = interval.MulTruncate(expNeg(factor))
+	case DelayedRewardPeriodCurveFlat:
+		interval = sdk.ZeroDec()
+	default:
+		interval = interval.MulTruncate(factor)
+	}
+
+	return p.MinDelayedRewardPeriod + interval.TruncateInt64()
+}
+
+// expNeg returns e^-x for a non-negative x, computed as the inverse of the
+// Taylor series of e^x so that the result is deterministic.
+func expNeg(x sdk.Dec) sdk.Dec {
+	if x.GTE(maxExpDecayFactor) {
+		return sdk.ZeroDec()
+	}
+
+	sum, term := sdk.OneDec(), sdk.OneDec()
+	for k := int64(1); ; k++ {
+		term = term.Mul(x).QuoInt64(k)
+		if term.IsZero() {
+			break
+		}
+		sum = sum.Add(term)
+	}
+
+	return sdk.OneDec().Quo(sum)
+}
+
+func validateDelayedRewardPeriodCurve(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch v {
+	case DelayedRewardPeriodCurveLinear, DelayedRewardPeriodCurveCapped,
+		DelayedRewardPeriodCurveExponentialDecay, DelayedRewardPeriodCurveFlat:
+		return nil
+	default:
+		return fmt.Errorf("invalid delayed reward period curve: %s", v)
+	}
+}
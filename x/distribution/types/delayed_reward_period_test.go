@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestParamsDelayedRewardPeriod(t *testing.T) {
+	toDec := sdk.MustNewDecFromStr
+
+	tests := []struct {
+		curve  string
+		factor sdk.Dec
+		period int64
+	}{
+		{types.DelayedRewardPeriodCurveLinear, toDec("0"), 120},
+		{types.DelayedRewardPeriodCurveLinear, toDec("0.5"), 150},
+		{types.DelayedRewardPeriodCurveLinear, toDec("3"), 300},
+		{types.DelayedRewardPeriodCurveCapped, toDec("0.5"), 150},
+		{types.DelayedRewardPeriodCurveCapped, toDec("3"), 180},
+		{types.DelayedRewardPeriodCurveExponentialDecay, toDec("0"), 180},
+		{types.DelayedRewardPeriodCurveExponentialDecay, toDec("1"), 142}, // 60 * 0.3678
+		{types.DelayedRewardPeriodCurveExponentialDecay, toDec("3"), 122}, // 60 * 0.0497
+		{types.DelayedRewardPeriodCurveExponentialDecay, toDec("100"), 120},
+		{types.DelayedRewardPeriodCurveFlat, toDec("0.5"), 120},
+		{types.DelayedRewardPeriodCurveFlat, toDec("3"), 120},
+	}
+	for _, tt := range tests {
+		params := types.DefaultParams()
+		params.DelayedRewardPeriodCurve = tt.curve
+		require.Equal(t, tt.period, params.DelayedRewardPeriod(tt.factor), "%s curve, factor %s", tt.curve, tt.factor)
+	}
+}
+
+func TestParamsValidateDelayedRewardPeriodCurve(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, types.DelayedRewardPeriodCurveLinear, params.DelayedRewardPeriodCurve)
+
+	for _, curve := range []string{
+		types.DelayedRewardPeriodCurveLinear, types.DelayedRewardPeriodCurveCapped,
+		types.DelayedRewardPeriodCurveExponentialDecay, types.DelayedRewardPeriodCurveFlat,
+	} {
+		params.DelayedRewardPeriodCurve = curve
+		require.NoError(t, params.ValidateBasic())
+	}
+
+	params.DelayedRewardPeriodCurve = ""
+	require.Error(t, params.ValidateBasic())
+	params.DelayedRewardPeriodCurve = "quadratic"
+	require.Error(t, params.ValidateBasic())
+}
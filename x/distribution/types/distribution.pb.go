@@ -41,6 +41,9 @@ type Params struct {
 	MinDelayedRewardPeriod   int64                                  `protobuf:"varint,6,opt,name=min_delayed_reward_period,json=minDelayedRewardPeriod,proto3" json:"min_delayed_reward_period,omitempty"`
 	MaxDelayedRewardInterval int64                                  `protobuf:"varint,7,opt,name=max_delayed_reward_interval,json=maxDelayedRewardInterval,proto3" json:"max_delayed_reward_interval,omitempty"`
 	DelayedRewardUnit        time.Duration                          `protobuf:"bytes,8,opt,name=delayed_reward_unit,json=delayedRewardUnit,proto3,stdduration" json:"delayed_reward_unit,omitempty" yaml:"delayed_reward_unit"`
+	// delayed_reward_period_curve selects the built-in curve which computes the
+	// period of the delayed rewards of a validator.
+	DelayedRewardPeriodCurve string `protobuf:"bytes,9,opt,name=delayed_reward_period_curve,json=delayedRewardPeriodCurve,proto3" json:"delayed_reward_period_curve,omitempty" yaml:"delayed_reward_period_curve"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -103,6 +106,13 @@ func (m *Params) GetDelayedRewardUnit() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDelayedRewardPeriodCurve() string {
+	if m != nil {
+		return m.DelayedRewardPeriodCurve
+	}
+	return ""
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -617,6 +627,47 @@ func (m *DelegationDelegatorReward) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegationDelegatorReward proto.InternalMessageInfo
 
+// DelegationRecommanderReward represents the rewards a recommander would
+// receive from the recommanders rewards of a delegation.
+type DelegationRecommanderReward struct {
+	RecommanderAddress string                                   `protobuf:"bytes,1,opt,name=recommander_address,json=recommanderAddress,proto3" json:"recommander_address,omitempty" yaml:"recommander_address"`
+	Level              uint32                                   `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty" yaml:"level"`
+	Reward             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward"`
+}
+
+func (m *DelegationRecommanderReward) Reset()         { *m = DelegationRecommanderReward{} }
+func (m *DelegationRecommanderReward) String() string { return proto.CompactTextString(m) }
+func (*DelegationRecommanderReward) ProtoMessage()    {}
+func (*DelegationRecommanderReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{11}
+}
+func (m *DelegationRecommanderReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationRecommanderReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationRecommanderReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationRecommanderReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationRecommanderReward.Merge(m, src)
+}
+func (m *DelegationRecommanderReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationRecommanderReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationRecommanderReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationRecommanderReward proto.InternalMessageInfo
+
 // CommunityPoolSpendProposalWithDeposit defines a CommunityPoolSpendProposal
 // with a deposit
 type CommunityPoolSpendProposalWithDeposit struct {
@@ -631,7 +682,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +723,7 @@ func (m *ValidatorDelayedReward) Reset()         { *m = ValidatorDelayedReward{}
 func (m *ValidatorDelayedReward) String() string { return proto.CompactTextString(m) }
 func (*ValidatorDelayedReward) ProtoMessage()    {}
 func (*ValidatorDelayedReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{12}
+	return fileDescriptor_cd78a31ea281a992, []int{13}
 }
 func (m *ValidatorDelayedReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +760,7 @@ func (m *ValidatorDelayedRewardInfo) Reset()         { *m = ValidatorDelayedRewa
 func (m *ValidatorDelayedRewardInfo) String() string { return proto.CompactTextString(m) }
 func (*ValidatorDelayedRewardInfo) ProtoMessage()    {}
 func (*ValidatorDelayedRewardInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{13}
+	return fileDescriptor_cd78a31ea281a992, []int{14}
 }
 func (m *ValidatorDelayedRewardInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,6 +789,95 @@ func (m *ValidatorDelayedRewardInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorDelayedRewardInfo proto.InternalMessageInfo
 
+// DelayedRewardRelease represents projected releases of the delayed rewards
+// of a validator. The amount is released count times, one delayed reward unit
+// apart, starting at the release time.
+type DelayedRewardRelease struct {
+	ReleaseTime time.Time                                   `protobuf:"bytes,1,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time" yaml:"release_time"`
+	Amount      github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+	Count       uint64                                      `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *DelayedRewardRelease) Reset()         { *m = DelayedRewardRelease{} }
+func (m *DelayedRewardRelease) String() string { return proto.CompactTextString(m) }
+func (*DelayedRewardRelease) ProtoMessage()    {}
+func (*DelayedRewardRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{15}
+}
+func (m *DelayedRewardRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedRewardRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedRewardRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelayedRewardRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedRewardRelease.Merge(m, src)
+}
+func (m *DelayedRewardRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedRewardRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedRewardRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedRewardRelease proto.InternalMessageInfo
+
+// TeamCommissionVesting defines how the team commission of a validator vests
+// once withdrawn to its incentive team address.
+type TeamCommissionVesting struct {
+	// vesting_type is either continuous or periodic.
+	VestingType string `protobuf:"bytes,1,opt,name=vesting_type,json=vestingType,proto3" json:"vesting_type,omitempty" yaml:"vesting_type"`
+	// cliff is the delay after the withdrawal before the team commission starts
+	// to vest.
+	Cliff time.Duration `protobuf:"bytes,2,opt,name=cliff,proto3,stdduration" json:"cliff"`
+	// duration is the time over which the team commission vests after the cliff.
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+	// periods is the number of equal periods of a periodic vesting.
+	Periods uint32 `protobuf:"varint,4,opt,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (m *TeamCommissionVesting) Reset()         { *m = TeamCommissionVesting{} }
+func (m *TeamCommissionVesting) String() string { return proto.CompactTextString(m) }
+func (*TeamCommissionVesting) ProtoMessage()    {}
+func (*TeamCommissionVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{16}
+}
+func (m *TeamCommissionVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TeamCommissionVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TeamCommissionVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TeamCommissionVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamCommissionVesting.Merge(m, src)
+}
+func (m *TeamCommissionVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *TeamCommissionVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamCommissionVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TeamCommissionVesting proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -750,9 +890,12 @@ func init() {
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
+	proto.RegisterType((*DelegationRecommanderReward)(nil), "cosmos.distribution.v1beta1.DelegationRecommanderReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
 	proto.RegisterType((*ValidatorDelayedReward)(nil), "cosmos.distribution.v1beta1.ValidatorDelayedReward")
 	proto.RegisterType((*ValidatorDelayedRewardInfo)(nil), "cosmos.distribution.v1beta1.ValidatorDelayedRewardInfo")
+	proto.RegisterType((*DelayedRewardRelease)(nil), "cosmos.distribution.v1beta1.DelayedRewardRelease")
+	proto.RegisterType((*TeamCommissionVesting)(nil), "cosmos.distribution.v1beta1.TeamCommissionVesting")
 }
 
 func init() {
@@ -760,100 +903,114 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x26, 0xae, 0x93, 0x4e, 0xda, 0xa4, 0x9d, 0x38, 0x89, 0xe3, 0xa4, 0x5e, 0x7f, 0x47,
-	0xdf, 0x56, 0x41, 0x6d, 0x9d, 0x36, 0xbd, 0x40, 0x24, 0x90, 0xea, 0x24, 0x85, 0x22, 0xa0, 0xd1,
-	0x36, 0x05, 0x09, 0x0e, 0xd6, 0x78, 0x77, 0xe2, 0x8c, 0xb2, 0xbb, 0x63, 0x76, 0xc6, 0x69, 0x82,
-	0x84, 0xf8, 0x21, 0x0e, 0x5c, 0x80, 0xc2, 0xa9, 0x07, 0x90, 0x7a, 0x41, 0xe2, 0xd7, 0x1f, 0xd2,
-	0x63, 0xb9, 0x21, 0x90, 0x5c, 0x94, 0x08, 0x09, 0xf5, 0xe8, 0x0b, 0xe2, 0x86, 0x66, 0x67, 0xf6,
-	0x87, 0x1d, 0xb7, 0xb2, 0x2b, 0xe5, 0x94, 0xcc, 0x7b, 0xf3, 0xde, 0xfb, 0xbc, 0xcf, 0xbc, 0x79,
-	0xf3, 0xd6, 0xa0, 0x6c, 0x33, 0xee, 0x31, 0xbe, 0xe4, 0x50, 0x2e, 0x02, 0x5a, 0x6b, 0x0a, 0xca,
-	0xfc, 0xa5, 0xdd, 0xab, 0x35, 0x22, 0xf0, 0xd5, 0x0e, 0x61, 0xb9, 0x11, 0x30, 0xc1, 0xe0, 0xbc,
-	0xda, 0x5f, 0xee, 0x50, 0xe9, 0xfd, 0x85, 0x5c, 0x9d, 0xd5, 0x59, 0xb8, 0x6f, 0x49, 0xfe, 0xa7,
-	0x4c, 0x0a, 0xc5, 0x3a, 0x63, 0x75, 0x97, 0x2c, 0x85, 0xab, 0x5a, 0x73, 0x6b, 0xc9, 0x69, 0x06,
-	0x38, 0x71, 0x59, 0x30, 0xbb, 0xf5, 0x82, 0x7a, 0x84, 0x0b, 0xec, 0x35, 0x22, 0x07, 0x1a, 0x63,
-	0x0d, 0x73, 0x12, 0x63, 0xb3, 0x19, 0x8d, 0x1c, 0xcc, 0x29, 0x7d, 0x55, 0x45, 0xd6, 0x00, 0xc3,
-	0x05, 0xfa, 0x27, 0x0b, 0xb2, 0x1b, 0x38, 0xc0, 0x1e, 0x87, 0x3b, 0xe0, 0xb4, 0xcd, 0x3c, 0xaf,
-	0xe9, 0x53, 0xb1, 0x5f, 0x15, 0x78, 0x2f, 0x6f, 0x94, 0x8c, 0xc5, 0x93, 0x95, 0x1b, 0x0f, 0x5b,
-	0xe6, 0xd0, 0xef, 0x2d, 0xf3, 0x42, 0x9d, 0x8a, 0xed, 0x66, 0xad, 0x6c, 0x33, 0x4f, 0xbb, 0xd0,
-	0x7f, 0x2e, 0x73, 0x67, 0x67, 0x49, 0xec, 0x37, 0x08, 0x2f, 0xaf, 0x11, 0xbb, 0xdd, 0x32, 0x73,
-	0xfb, 0xd8, 0x73, 0x57, 0x50, 0x87, 0x33, 0x64, 0x9d, 0x8a, 0xd7, 0x9b, 0x78, 0x0f, 0x7e, 0x04,
-	0x72, 0x12, 0xad, 0x84, 0xd4, 0x60, 0x9c, 0x04, 0xd5, 0x80, 0xdc, 0xc5, 0x81, 0x93, 0x1f, 0x0e,
-	0x63, 0xbe, 0x39, 0x70, 0xcc, 0x79, 0x15, 0xb3, 0x97, 0x4f, 0x64, 0x41, 0x29, 0xde, 0xd0, 0x52,
-	0x2b, 0x14, 0xc2, 0x4f, 0x0d, 0x30, 0x5d, 0x63, 0x7e, 0x93, 0x1f, 0x81, 0x30, 0x12, 0x42, 0x78,
-	0x6b, 0x60, 0x08, 0x0b, 0x1a, 0x42, 0x2f, 0xa7, 0xc8, 0x9a, 0x0a, 0xe5, 0x5d, 0x20, 0x36, 0xc1,
-	0xf4, 0x5d, 0x2a, 0xb6, 0x9d, 0x00, 0xdf, 0xad, 0x62, 0xc7, 0x09, 0xaa, 0xc4, 0xc7, 0x35, 0x97,
-	0x38, 0xf9, 0x4c, 0xc9, 0x58, 0x1c, 0xab, 0x94, 0x12, 0xaf, 0x3d, 0xb7, 0x21, 0x6b, 0x2a, 0x92,
-	0x5f, 0x77, 0x9c, 0x60, 0x5d, 0x49, 0xe1, 0x97, 0x06, 0x98, 0x73, 0x88, 0x8b, 0xf7, 0x89, 0xa3,
-	0xc3, 0x2b, 0x38, 0x81, 0xac, 0xa9, 0xfc, 0x89, 0x30, 0x3d, 0x6b, 0xe0, 0xf4, 0x4a, 0x0a, 0xc8,
-	0x53, 0x1d, 0x23, 0x6b, 0x56, 0xeb, 0x54, 0x72, 0x1b, 0xb1, 0x06, 0xbe, 0x04, 0xe6, 0x3c, 0xea,
-	0x57, 0xbb, 0x4d, 0x49, 0x40, 0x99, 0x93, 0xcf, 0x96, 0x8c, 0xc5, 0x11, 0x6b, 0xc6, 0xa3, 0xfe,
-	0x5a, 0x87, 0x79, 0xa8, 0x85, 0x2f, 0x83, 0x79, 0x0f, 0xef, 0x75, 0x9b, 0x52, 0x5f, 0x90, 0x60,
-	0x17, 0xbb, 0xf9, 0xd1, 0xd0, 0x38, 0xef, 0xe1, 0xbd, 0x0e, 0xe3, 0x9b, 0x5a, 0x0f, 0xbf, 0x31,
-	0xc0, 0x54, 0x97, 0xad, 0x2c, 0xc1, 0xfc, 0x58, 0xc9, 0x58, 0x1c, 0x5f, 0x9e, 0x2b, 0xab, 0x9b,
-	0x55, 0x8e, 0x6e, 0x56, 0x79, 0x4d, 0xdf, 0xbc, 0xca, 0xab, 0x92, 0x9f, 0x27, 0x2d, 0xf3, 0x5c,
-	0x0f, 0xeb, 0x4b, 0xcc, 0xa3, 0x82, 0x78, 0x0d, 0xb1, 0xdf, 0x6e, 0x99, 0x85, 0x9e, 0xb4, 0xc8,
-	0x6d, 0xe8, 0xfe, 0x63, 0xd3, 0xb0, 0xce, 0x76, 0x90, 0x72, 0xc7, 0xa7, 0x62, 0x25, 0x73, 0xff,
-	0x81, 0x39, 0x84, 0x0e, 0x47, 0x40, 0xe1, 0x6d, 0xec, 0x52, 0x07, 0x0b, 0x16, 0xbc, 0x46, 0xb9,
-	0x60, 0x01, 0xb5, 0xb1, 0xab, 0xf6, 0x71, 0xf8, 0xb3, 0x01, 0x66, 0xed, 0xa6, 0xd7, 0x74, 0xb1,
-	0xa0, 0xbb, 0x24, 0xf2, 0x1b, 0xa2, 0xcb, 0x1b, 0xa5, 0x91, 0xc5, 0xf1, 0xe5, 0x05, 0xdd, 0x9a,
-	0xca, 0xb2, 0xba, 0xa3, 0x16, 0x23, 0x0f, 0x6b, 0x95, 0x51, 0xbf, 0x72, 0x47, 0x26, 0xd0, 0x6e,
-	0x99, 0x45, 0x7d, 0x19, 0x7b, 0xbb, 0x42, 0x3f, 0x3d, 0x36, 0x2f, 0xf6, 0x57, 0x02, 0xd2, 0x2b,
-	0xb7, 0xa6, 0x13, 0x47, 0x0a, 0xa9, 0x25, 0xdd, 0xc0, 0x55, 0x30, 0x19, 0x90, 0x2d, 0x12, 0x10,
-	0xdf, 0x26, 0x55, 0x9b, 0x35, 0x7d, 0x11, 0xde, 0xe4, 0xd3, 0x95, 0x42, 0xbb, 0x65, 0xce, 0x28,
-	0x08, 0x5d, 0x1b, 0x90, 0x35, 0x11, 0x4b, 0x56, 0xa5, 0x00, 0xfe, 0x6a, 0x80, 0xff, 0x77, 0xe0,
-	0x94, 0x1d, 0x03, 0xfb, 0x0e, 0x09, 0x78, 0x67, 0xfe, 0x23, 0x7d, 0xe4, 0x5f, 0xd3, 0xf9, 0x5f,
-	0xec, 0x91, 0xff, 0x53, 0xfc, 0x0e, 0x4c, 0xc6, 0xff, 0xd2, 0x64, 0x24, 0x4e, 0x53, 0xc4, 0xa0,
-	0xef, 0x87, 0xc1, 0x6c, 0x7c, 0xca, 0xab, 0xcd, 0x20, 0x20, 0xbe, 0x88, 0x8e, 0x78, 0x07, 0x8c,
-	0xaa, 0xf0, 0xbc, 0xaf, 0x13, 0xbd, 0x26, 0x33, 0x1a, 0x14, 0x62, 0x14, 0x01, 0xce, 0x80, 0xac,
-	0xbe, 0x70, 0xf2, 0x60, 0x32, 0x96, 0x5e, 0xc1, 0xcf, 0x0c, 0x90, 0xeb, 0xc1, 0x08, 0xef, 0x8b,
-	0xe4, 0xe7, 0x82, 0x34, 0x15, 0x1c, 0xe1, 0x8a, 0xa3, 0xaf, 0x86, 0x41, 0x31, 0xe6, 0xe9, 0xba,
-	0xad, 0x89, 0x25, 0xce, 0x2a, 0xf3, 0x3c, 0xca, 0xb9, 0xec, 0x22, 0xef, 0x03, 0x60, 0xc7, 0xab,
-	0xe3, 0x63, 0x2c, 0x15, 0x04, 0x7e, 0x00, 0x26, 0x05, 0xc1, 0x5e, 0x35, 0x15, 0x77, 0xf8, 0xb8,
-	0xe2, 0x4e, 0xc8, 0x48, 0x49, 0xba, 0xe8, 0x5b, 0x03, 0xcc, 0xc7, 0x8c, 0xdc, 0x6a, 0x0a, 0x2e,
-	0xb0, 0xef, 0x50, 0xbf, 0x1e, 0x55, 0xcf, 0x87, 0x83, 0x55, 0xcf, 0xba, 0xbe, 0x0f, 0x13, 0xd1,
-	0x65, 0x54, 0xbc, 0x3f, 0x6f, 0x3d, 0xa1, 0x1f, 0x0d, 0x30, 0x15, 0xc3, 0xbb, 0xed, 0x62, 0xbe,
-	0xbd, 0xbe, 0x4b, 0x7c, 0x01, 0x6f, 0x80, 0x33, 0xbb, 0x91, 0x38, 0x6a, 0xf1, 0x72, 0x90, 0xc8,
-	0x54, 0xe6, 0xdb, 0x2d, 0x73, 0x56, 0x45, 0xef, 0xde, 0x81, 0xac, 0xc9, 0x58, 0xa4, 0x1b, 0xff,
-	0xeb, 0x60, 0x6c, 0x2b, 0xc0, 0xb6, 0x50, 0x9c, 0xcb, 0x27, 0xab, 0x3c, 0xd8, 0x93, 0x65, 0xc5,
-	0xf6, 0xe8, 0x17, 0x03, 0xe4, 0x7a, 0x60, 0xe5, 0xf0, 0x0b, 0x03, 0xcc, 0x24, 0x58, 0xb8, 0xd4,
-	0x54, 0x49, 0xa8, 0xd2, 0x9c, 0x5e, 0x29, 0x3f, 0x63, 0x9c, 0x2b, 0xf7, 0xf0, 0x59, 0x39, 0xaf,
-	0x79, 0x3e, 0xd7, 0x9d, 0x69, 0xda, 0x3b, 0xb2, 0x72, 0xbb, 0x3d, 0xf0, 0xe8, 0x97, 0xe1, 0x3b,
-	0x03, 0x8c, 0xde, 0x20, 0x64, 0x83, 0x31, 0x17, 0x7e, 0x6d, 0x80, 0x89, 0x64, 0x90, 0x6a, 0x30,
-	0xe6, 0xf6, 0x75, 0xda, 0x6f, 0x68, 0x14, 0xd3, 0xdd, 0xa3, 0x98, 0xf4, 0x30, 0xf0, 0xa1, 0x27,
-	0x73, 0xa1, 0xc4, 0x84, 0xfe, 0x32, 0x40, 0x61, 0x35, 0x2d, 0xb9, 0xdd, 0x20, 0xbe, 0x7a, 0xef,
-	0x39, 0x76, 0x61, 0x0e, 0x9c, 0x10, 0x54, 0xb8, 0x44, 0xcd, 0x8f, 0x96, 0x5a, 0xc0, 0x12, 0x18,
-	0x77, 0x08, 0xb7, 0x03, 0xda, 0x48, 0x8e, 0xd4, 0x4a, 0x8b, 0xe0, 0x02, 0x38, 0x19, 0x10, 0x9b,
-	0x36, 0x28, 0xf1, 0x85, 0x1a, 0xc2, 0xac, 0x44, 0x00, 0x6d, 0x90, 0xc5, 0x5e, 0xf8, 0xb0, 0x64,
-	0xc2, 0xfc, 0xe7, 0x7a, 0xe6, 0x1f, 0x26, 0x7f, 0x45, 0x5f, 0xbf, 0xc5, 0x3e, 0x72, 0x54, 0x09,
-	0x6a, 0xd7, 0x2b, 0xa7, 0x3e, 0x7f, 0x60, 0x0e, 0xc9, 0x33, 0xf8, 0x5b, 0x9e, 0xc3, 0xbf, 0x06,
-	0x98, 0x5e, 0x23, 0x2e, 0xa9, 0x87, 0xc7, 0x24, 0x70, 0x20, 0xa8, 0x5f, 0xbf, 0xe9, 0x6f, 0x85,
-	0xcf, 0x5d, 0x23, 0x20, 0xbb, 0x94, 0xc9, 0x49, 0x2f, 0x5d, 0xe3, 0xa9, 0xe7, 0xae, 0x6b, 0x03,
-	0xb2, 0x26, 0x22, 0x89, 0xae, 0xf0, 0x4d, 0x70, 0x82, 0x0b, 0xbc, 0x43, 0x74, 0x79, 0xbf, 0x32,
-	0xf0, 0x44, 0x76, 0x4a, 0x05, 0x0a, 0x9d, 0x20, 0x4b, 0x39, 0x83, 0xeb, 0x20, 0xbb, 0x4d, 0x68,
-	0x7d, 0x5b, 0x51, 0x98, 0xa9, 0x5c, 0x7e, 0xd2, 0x32, 0x27, 0xed, 0x80, 0x84, 0x63, 0x4d, 0x55,
-	0xa9, 0x12, 0x90, 0x5d, 0x0a, 0x64, 0x69, 0x63, 0xf4, 0x87, 0x01, 0xe6, 0x74, 0xee, 0x94, 0xf9,
-	0x31, 0x0b, 0x7a, 0x6e, 0xbd, 0x09, 0xce, 0x26, 0x85, 0x2d, 0x27, 0x52, 0xc2, 0xb9, 0xfe, 0x5c,
-	0x58, 0x68, 0xb7, 0xcc, 0x7c, 0x77, 0xed, 0xeb, 0x2d, 0xc8, 0x4a, 0x7a, 0xc3, 0x75, 0x25, 0x82,
-	0x14, 0x64, 0xe3, 0xd1, 0xff, 0x98, 0x3a, 0xab, 0x0e, 0xb0, 0x32, 0xa6, 0x4f, 0xd7, 0x40, 0x0f,
-	0x86, 0xc1, 0xf9, 0xa7, 0x57, 0xf0, 0x3b, 0x54, 0x6c, 0xaf, 0x91, 0x06, 0xe3, 0x54, 0xc0, 0x0b,
-	0x1d, 0xc5, 0x5c, 0x39, 0x93, 0xd0, 0x1e, 0x8a, 0x51, 0x54, 0xde, 0x2f, 0xf6, 0x28, 0xef, 0xca,
-	0x4c, 0xbb, 0x65, 0xc2, 0x68, 0x3e, 0x8c, 0x95, 0xa8, 0xb3, 0xec, 0x97, 0x8f, 0x94, 0x7d, 0x25,
-	0xd7, 0x6e, 0x99, 0x67, 0xa2, 0x3e, 0xad, 0x55, 0x28, 0x7d, 0x19, 0x5e, 0x48, 0x5d, 0x06, 0x69,
-	0x70, 0xb6, 0xdd, 0x32, 0x4f, 0x2b, 0x03, 0x25, 0x47, 0x51, 0x49, 0xc3, 0x4b, 0x60, 0xd4, 0x51,
-	0xb9, 0xe8, 0xc9, 0x1f, 0x26, 0x8f, 0x80, 0x56, 0x20, 0x2b, 0xda, 0x92, 0xa2, 0xe8, 0xe3, 0x11,
-	0x30, 0x13, 0xf7, 0xb7, 0x8e, 0xe1, 0x5a, 0x46, 0x4f, 0x15, 0xfd, 0x48, 0x3a, 0x7a, 0x54, 0xeb,
-	0xd1, 0x74, 0xf1, 0x1e, 0x18, 0x6f, 0x36, 0x1c, 0x2c, 0x48, 0x55, 0x7e, 0xb3, 0x86, 0xb4, 0x8c,
-	0x2f, 0x17, 0x8e, 0x8c, 0xdd, 0x9b, 0xd1, 0x07, 0x6d, 0xa5, 0xa8, 0x1b, 0x97, 0xa6, 0x2d, 0x65,
-	0x8c, 0xee, 0xc9, 0x71, 0x1a, 0x28, 0x89, 0x34, 0x80, 0x04, 0x64, 0xc2, 0x61, 0xfe, 0xd8, 0x26,
-	0x95, 0xd0, 0x7d, 0xaa, 0x42, 0x33, 0xc7, 0x5d, 0xa1, 0x51, 0xff, 0x31, 0xc2, 0xfe, 0xf3, 0x89,
-	0x91, 0xfa, 0x42, 0xe8, 0xfa, 0xbe, 0xd9, 0x62, 0xf0, 0x0e, 0x38, 0xe9, 0x62, 0x2e, 0xfa, 0x65,
-	0x76, 0x41, 0x33, 0xab, 0x0b, 0x2b, 0x36, 0x55, 0xbc, 0x8e, 0xc9, 0xb5, 0xdc, 0xdc, 0x89, 0xa1,
-	0x72, 0xeb, 0x87, 0x83, 0xa2, 0xf1, 0xf0, 0xa0, 0x68, 0x3c, 0x3a, 0x28, 0x1a, 0x7f, 0x1e, 0x14,
-	0x8d, 0x7b, 0x87, 0xc5, 0xa1, 0x47, 0x87, 0xc5, 0xa1, 0xdf, 0x0e, 0x8b, 0x43, 0xef, 0x5e, 0x7d,
-	0x66, 0x92, 0x7b, 0x9d, 0x3f, 0x9a, 0x84, 0x39, 0xd7, 0xb2, 0x21, 0xb4, 0x6b, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x2b, 0xc2, 0xee, 0x82, 0x58, 0x11, 0x00, 0x00,
+	// 1703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x6f, 0x23, 0x49,
+	0x19, 0x4f, 0xdb, 0xce, 0xab, 0xf2, 0x9a, 0xa9, 0x38, 0x49, 0xc7, 0xc9, 0xba, 0x4d, 0x89, 0x1d,
+	0x05, 0xed, 0xae, 0xb3, 0x93, 0xbd, 0xb0, 0x91, 0x00, 0x8d, 0x93, 0x0c, 0x0c, 0x02, 0x26, 0xea,
+	0xcd, 0x2c, 0x12, 0x48, 0x58, 0xe5, 0xee, 0x8a, 0x53, 0x4a, 0x3f, 0x4c, 0x57, 0xd9, 0x93, 0x20,
+	0x21, 0x1e, 0x62, 0x25, 0x2e, 0xc0, 0xc2, 0x69, 0x0e, 0x20, 0xcd, 0x05, 0x89, 0xd7, 0x1f, 0x32,
+	0xc7, 0xe1, 0x86, 0x40, 0xf2, 0xa0, 0x44, 0x48, 0x68, 0x2e, 0x48, 0xbe, 0x71, 0x43, 0xf5, 0xe8,
+	0x87, 0x9d, 0x9e, 0x99, 0x18, 0x6d, 0x4e, 0x76, 0x7d, 0x5f, 0x7d, 0xaf, 0x5f, 0x7d, 0xdf, 0x57,
+	0x5f, 0x35, 0xa8, 0x3b, 0x21, 0xf3, 0x43, 0xb6, 0xed, 0x52, 0xc6, 0x23, 0xda, 0xea, 0x72, 0x1a,
+	0x06, 0xdb, 0xbd, 0xbb, 0x2d, 0xc2, 0xf1, 0xdd, 0x21, 0x62, 0xbd, 0x13, 0x85, 0x3c, 0x84, 0x1b,
+	0x6a, 0x7f, 0x7d, 0x88, 0xa5, 0xf7, 0x57, 0xca, 0xed, 0xb0, 0x1d, 0xca, 0x7d, 0xdb, 0xe2, 0x9f,
+	0x12, 0xa9, 0x54, 0xdb, 0x61, 0xd8, 0xf6, 0xc8, 0xb6, 0x5c, 0xb5, 0xba, 0xc7, 0xdb, 0x6e, 0x37,
+	0xc2, 0xa9, 0xca, 0x8a, 0x35, 0xca, 0xe7, 0xd4, 0x27, 0x8c, 0x63, 0xbf, 0x13, 0x2b, 0xd0, 0x3e,
+	0xb6, 0x30, 0x23, 0x89, 0x6f, 0x4e, 0x48, 0x63, 0x05, 0xeb, 0x8a, 0xdf, 0x54, 0x96, 0xb5, 0x83,
+	0x72, 0x81, 0xfa, 0xd3, 0x60, 0xea, 0x10, 0x47, 0xd8, 0x67, 0xf0, 0x14, 0x2c, 0x38, 0xa1, 0xef,
+	0x77, 0x03, 0xca, 0xcf, 0x9b, 0x1c, 0x9f, 0x99, 0x46, 0xcd, 0xd8, 0x9a, 0x6d, 0xdc, 0x7f, 0xd6,
+	0xb7, 0x26, 0xfe, 0xde, 0xb7, 0xee, 0xb4, 0x29, 0x3f, 0xe9, 0xb6, 0xea, 0x4e, 0xe8, 0x6b, 0x15,
+	0xfa, 0xe7, 0x3d, 0xe6, 0x9e, 0x6e, 0xf3, 0xf3, 0x0e, 0x61, 0xf5, 0x7d, 0xe2, 0x0c, 0xfa, 0x56,
+	0xf9, 0x1c, 0xfb, 0xde, 0x2e, 0x1a, 0x52, 0x86, 0xec, 0xf9, 0x64, 0x7d, 0x84, 0xcf, 0xe0, 0x8f,
+	0x40, 0x59, 0x78, 0x2b, 0x5c, 0xea, 0x84, 0x8c, 0x44, 0xcd, 0x88, 0x3c, 0xc6, 0x91, 0x6b, 0x16,
+	0xa4, 0xcd, 0x6f, 0x8e, 0x6d, 0x73, 0x43, 0xd9, 0xcc, 0xd3, 0x89, 0x6c, 0x28, 0xc8, 0x87, 0x9a,
+	0x6a, 0x4b, 0x22, 0xfc, 0xa9, 0x01, 0x56, 0x5a, 0x61, 0xd0, 0x65, 0x57, 0x5c, 0x28, 0x4a, 0x17,
+	0xbe, 0x35, 0xb6, 0x0b, 0x9b, 0xda, 0x85, 0x3c, 0xa5, 0xc8, 0x5e, 0x96, 0xf4, 0x11, 0x27, 0x8e,
+	0xc0, 0xca, 0x63, 0xca, 0x4f, 0xdc, 0x08, 0x3f, 0x6e, 0x62, 0xd7, 0x8d, 0x9a, 0x24, 0xc0, 0x2d,
+	0x8f, 0xb8, 0x66, 0xa9, 0x66, 0x6c, 0xcd, 0x34, 0x6a, 0xa9, 0xd6, 0xdc, 0x6d, 0xc8, 0x5e, 0x8e,
+	0xe9, 0xf7, 0x5c, 0x37, 0x3a, 0x50, 0x54, 0xf8, 0x4b, 0x03, 0xac, 0xbb, 0xc4, 0xc3, 0xe7, 0xc4,
+	0xd5, 0xe6, 0x95, 0x3b, 0x91, 0xc8, 0x29, 0x73, 0x52, 0x86, 0x67, 0x8f, 0x1d, 0x5e, 0x4d, 0x39,
+	0xf2, 0x4a, 0xc5, 0xc8, 0x5e, 0xd3, 0x3c, 0x15, 0xdc, 0x61, 0xc2, 0x81, 0x1f, 0x82, 0x75, 0x9f,
+	0x06, 0xcd, 0x51, 0x51, 0x12, 0xd1, 0xd0, 0x35, 0xa7, 0x6a, 0xc6, 0x56, 0xd1, 0x5e, 0xf5, 0x69,
+	0xb0, 0x3f, 0x24, 0x2e, 0xb9, 0xf0, 0x4b, 0x60, 0xc3, 0xc7, 0x67, 0xa3, 0xa2, 0x34, 0xe0, 0x24,
+	0xea, 0x61, 0xcf, 0x9c, 0x96, 0xc2, 0xa6, 0x8f, 0xcf, 0x86, 0x84, 0x1f, 0x68, 0x3e, 0xfc, 0x8d,
+	0x01, 0x96, 0x47, 0x64, 0x45, 0x0a, 0x9a, 0x33, 0x35, 0x63, 0x6b, 0x6e, 0x67, 0xbd, 0xae, 0x2a,
+	0xab, 0x1e, 0x57, 0x56, 0x7d, 0x5f, 0x57, 0x5e, 0xe3, 0xab, 0x02, 0x9f, 0x97, 0x7d, 0xeb, 0xad,
+	0x1c, 0xe9, 0x77, 0x43, 0x9f, 0x72, 0xe2, 0x77, 0xf8, 0xf9, 0xa0, 0x6f, 0x55, 0x72, 0x61, 0x11,
+	0xdb, 0xd0, 0x93, 0x17, 0x96, 0x61, 0xdf, 0x1e, 0x02, 0xe5, 0x51, 0x40, 0x39, 0x24, 0x60, 0x23,
+	0x17, 0x8a, 0xa6, 0xd3, 0x8d, 0x7a, 0xc4, 0x9c, 0x95, 0x07, 0x74, 0x67, 0xd0, 0xb7, 0x50, 0x3e,
+	0xe4, 0x99, 0xcd, 0xc8, 0x36, 0xdd, 0xab, 0xa8, 0xed, 0x09, 0xd6, 0x6e, 0xe9, 0xc9, 0x53, 0x6b,
+	0x02, 0x5d, 0x16, 0x41, 0xe5, 0x63, 0xec, 0x51, 0x17, 0xf3, 0x30, 0xfa, 0x1a, 0x65, 0x3c, 0x8c,
+	0xa8, 0x83, 0x3d, 0xb5, 0x9d, 0xc1, 0x3f, 0x1b, 0x60, 0xcd, 0xe9, 0xfa, 0x5d, 0x0f, 0x73, 0xda,
+	0x23, 0xb1, 0x09, 0x09, 0x82, 0x69, 0xd4, 0x8a, 0x5b, 0x73, 0x3b, 0x9b, 0xba, 0x03, 0xd6, 0x45,
+	0x11, 0xc5, 0x9d, 0x4c, 0xe4, 0xc4, 0x5e, 0x48, 0x83, 0xc6, 0x23, 0x81, 0xd3, 0xa0, 0x6f, 0x55,
+	0x75, 0xcd, 0xe7, 0xab, 0x42, 0x7f, 0x7a, 0x61, 0xbd, 0x73, 0xbd, 0x4c, 0x13, 0x5a, 0x99, 0xbd,
+	0x92, 0x2a, 0x52, 0x9e, 0xda, 0x42, 0x0d, 0xdc, 0x03, 0x4b, 0x11, 0x39, 0x26, 0x11, 0x09, 0x1c,
+	0xd2, 0x74, 0xc2, 0x6e, 0xc0, 0x65, 0xc3, 0x58, 0x68, 0x54, 0x06, 0x7d, 0x6b, 0x55, 0xb9, 0x30,
+	0xb2, 0x01, 0xd9, 0x8b, 0x09, 0x65, 0x4f, 0x10, 0xe0, 0x5f, 0x0d, 0xf0, 0xf9, 0x21, 0x3f, 0x45,
+	0x63, 0xc2, 0x81, 0x4b, 0x22, 0x36, 0x1c, 0x7f, 0xf1, 0x1a, 0xf1, 0xb7, 0x74, 0xfc, 0xef, 0xe4,
+	0xc4, 0xff, 0x0a, 0xbd, 0x63, 0x83, 0xf1, 0xb9, 0x2c, 0x18, 0xa9, 0xd2, 0x0c, 0x30, 0xe8, 0xf7,
+	0x05, 0xb0, 0x96, 0x9c, 0xf2, 0x5e, 0x37, 0x8a, 0x48, 0xc0, 0xe3, 0x23, 0x3e, 0x05, 0xd3, 0xca,
+	0x3c, 0xbb, 0xd6, 0x89, 0x7e, 0x20, 0x22, 0x1a, 0xd7, 0xc5, 0xd8, 0x02, 0x5c, 0x05, 0x53, 0xba,
+	0xae, 0xc5, 0xc1, 0x94, 0x6c, 0xbd, 0x82, 0x3f, 0x33, 0x40, 0x39, 0x07, 0x11, 0x66, 0x16, 0x6f,
+	0xca, 0xa5, 0xe5, 0xe8, 0x0a, 0x56, 0x0c, 0xfd, 0xaa, 0x00, 0xaa, 0x09, 0x4e, 0xf7, 0x1c, 0x0d,
+	0x2c, 0x71, 0xf7, 0x42, 0xdf, 0xa7, 0x8c, 0x89, 0x66, 0xf5, 0x7d, 0x00, 0x9c, 0x64, 0x75, 0x73,
+	0x88, 0x65, 0x8c, 0xc0, 0x1f, 0x80, 0x25, 0x4e, 0xb0, 0xdf, 0xcc, 0xd8, 0x2d, 0xdc, 0x94, 0xdd,
+	0x45, 0x61, 0x29, 0x0d, 0x17, 0xfd, 0xd6, 0x00, 0x1b, 0x09, 0x22, 0x0f, 0xbb, 0x9c, 0x71, 0x1c,
+	0xb8, 0x34, 0x68, 0xc7, 0xd9, 0xf3, 0xc3, 0xf1, 0xb2, 0xe7, 0x40, 0xd7, 0xc3, 0x62, 0x5c, 0x8c,
+	0x0a, 0xf7, 0xff, 0x37, 0x9f, 0xd0, 0x1f, 0x0d, 0xb0, 0x9c, 0xb8, 0xf7, 0x91, 0x87, 0xd9, 0xc9,
+	0x41, 0x8f, 0x04, 0x1c, 0xde, 0x07, 0xb7, 0x7a, 0x31, 0x39, 0xbe, 0x49, 0xc4, 0xbc, 0x52, 0x6a,
+	0x6c, 0x0c, 0xfa, 0xd6, 0x9a, 0xb2, 0x3e, 0xba, 0x03, 0xd9, 0x4b, 0x09, 0x49, 0xdf, 0x2f, 0x5f,
+	0x07, 0x33, 0xc7, 0x11, 0x76, 0xb8, 0xc2, 0x5c, 0x34, 0xde, 0xfa, 0x78, 0x37, 0xa3, 0x9d, 0xc8,
+	0xa3, 0xbf, 0x18, 0xa0, 0x9c, 0xe3, 0x2b, 0x83, 0xbf, 0x30, 0xc0, 0x6a, 0xea, 0x0b, 0x13, 0x9c,
+	0x26, 0x91, 0x2c, 0x8d, 0xe9, 0xfb, 0xf5, 0xd7, 0x4c, 0x8d, 0xf5, 0x1c, 0x9d, 0x8d, 0xb7, 0x35,
+	0xce, 0x6f, 0x8d, 0x46, 0x9a, 0xd5, 0x8e, 0xec, 0x72, 0x2f, 0xc7, 0x1f, 0x7d, 0x33, 0xfc, 0xce,
+	0x00, 0xd3, 0xf7, 0x09, 0x39, 0x0c, 0x43, 0x0f, 0xfe, 0xda, 0x00, 0x8b, 0xe9, 0xbc, 0xd6, 0x09,
+	0x43, 0xef, 0x5a, 0xa7, 0xfd, 0x0d, 0xed, 0xc5, 0xca, 0xe8, 0xc4, 0x27, 0x34, 0x8c, 0x7d, 0xe8,
+	0xe9, 0xf8, 0x29, 0x7c, 0x42, 0xff, 0x32, 0x40, 0x65, 0x2f, 0x4b, 0xf9, 0xa8, 0x43, 0x02, 0x35,
+	0x56, 0x30, 0xec, 0xc1, 0x32, 0x98, 0xe4, 0x94, 0x7b, 0x44, 0x8d, 0xa9, 0xb6, 0x5a, 0xc0, 0x1a,
+	0x98, 0x73, 0x09, 0x73, 0x22, 0xda, 0x49, 0x8f, 0xd4, 0xce, 0x92, 0xe0, 0x26, 0x98, 0x8d, 0x88,
+	0x43, 0x3b, 0x94, 0x04, 0x5c, 0xcd, 0x7a, 0x76, 0x4a, 0x80, 0x0e, 0x98, 0xc2, 0xbe, 0xbc, 0x58,
+	0x4a, 0x32, 0xfe, 0xf5, 0xdc, 0xf8, 0x65, 0xf0, 0xef, 0xeb, 0xf2, 0xdb, 0xba, 0x46, 0x8c, 0x2a,
+	0x40, 0xad, 0x7a, 0x77, 0xfe, 0xe7, 0x4f, 0xad, 0x09, 0x71, 0x06, 0xff, 0x16, 0xe7, 0xf0, 0x5f,
+	0x03, 0xac, 0xec, 0x13, 0x8f, 0xb4, 0xe5, 0x31, 0x71, 0x1c, 0x71, 0x1a, 0xb4, 0x1f, 0x04, 0xc7,
+	0xf2, 0xba, 0xeb, 0x44, 0xa4, 0x47, 0x43, 0x31, 0x50, 0x66, 0x73, 0x3c, 0x73, 0xdd, 0x8d, 0x6c,
+	0x40, 0xf6, 0x62, 0x4c, 0xd1, 0x19, 0x7e, 0x04, 0x26, 0x19, 0xc7, 0xa7, 0x44, 0xa7, 0xf7, 0x97,
+	0xc7, 0x1e, 0xfc, 0xe6, 0x95, 0x21, 0xa9, 0x04, 0xd9, 0x4a, 0x19, 0x3c, 0x00, 0x53, 0x27, 0x84,
+	0xb6, 0x4f, 0x14, 0x84, 0xa5, 0xc6, 0x7b, 0x2f, 0xfb, 0xd6, 0x92, 0x13, 0x11, 0x39, 0x3d, 0x35,
+	0x15, 0x2b, 0x75, 0x72, 0x84, 0x81, 0x6c, 0x2d, 0x8c, 0xfe, 0x61, 0x80, 0x75, 0x1d, 0x3b, 0x0d,
+	0x83, 0x04, 0x05, 0x3d, 0x1e, 0x3f, 0x00, 0xb7, 0xd3, 0xc4, 0x16, 0x83, 0x2f, 0x61, 0x4c, 0xbf,
+	0x4a, 0x36, 0x07, 0x7d, 0xcb, 0x1c, 0xcd, 0x7d, 0xbd, 0x05, 0xd9, 0x69, 0x6f, 0xb8, 0xa7, 0x48,
+	0x90, 0x82, 0xa9, 0xe4, 0x85, 0x71, 0x43, 0x9d, 0x55, 0x1b, 0xd8, 0x9d, 0xd1, 0xa7, 0x6b, 0xa0,
+	0x4f, 0x0a, 0x60, 0x23, 0x8d, 0x2e, 0x73, 0x77, 0xeb, 0xf8, 0x1e, 0x82, 0xec, 0x25, 0x35, 0x12,
+	0x61, 0x35, 0x1d, 0x2e, 0x73, 0x36, 0x21, 0x1b, 0x66, 0xa8, 0x71, 0x94, 0x77, 0xc0, 0xa4, 0x47,
+	0x7a, 0xc4, 0xd3, 0x53, 0xd1, 0xad, 0xf4, 0xf4, 0x24, 0x19, 0xd9, 0x8a, 0x2d, 0xb2, 0x3c, 0x79,
+	0xec, 0x7c, 0xf6, 0x59, 0x7e, 0x05, 0x87, 0xa7, 0x05, 0xf0, 0xf6, 0xab, 0x2b, 0xf9, 0xdb, 0x94,
+	0x9f, 0xec, 0x93, 0x4e, 0xc8, 0x28, 0x17, 0x01, 0x64, 0x8a, 0x3a, 0x1b, 0x80, 0x24, 0xa3, 0xb8,
+	0xcc, 0xbf, 0x98, 0x53, 0xe6, 0x8d, 0xd5, 0x41, 0xdf, 0x82, 0xf1, 0xc8, 0x9c, 0x30, 0xd1, 0x70,
+	0xf9, 0xef, 0x5c, 0x29, 0xff, 0x46, 0x79, 0xd0, 0xb7, 0x6e, 0x25, 0x48, 0x2b, 0x16, 0xca, 0x36,
+	0x85, 0x2f, 0x64, 0x9a, 0x82, 0x10, 0xb8, 0x3d, 0xe8, 0x5b, 0x0b, 0x4a, 0x40, 0xd1, 0x51, 0x5c,
+	0xda, 0xf0, 0x5d, 0x30, 0xed, 0xaa, 0x58, 0xf4, 0x43, 0x0b, 0xa6, 0x97, 0xa1, 0x66, 0x20, 0x3b,
+	0xde, 0x92, 0x81, 0xe8, 0xc7, 0x45, 0xb0, 0x9a, 0xf4, 0xf9, 0xa1, 0xb7, 0x8c, 0xb0, 0x9e, 0x29,
+	0xfe, 0x62, 0xd6, 0x7a, 0x5c, 0xf3, 0xf1, 0x94, 0xf5, 0x5d, 0x30, 0xd7, 0xed, 0xb8, 0x98, 0x93,
+	0x26, 0xa7, 0xbe, 0xaa, 0xf8, 0xb9, 0x9d, 0xca, 0x95, 0x57, 0xce, 0x51, 0xfc, 0xfd, 0xa0, 0x51,
+	0xd5, 0x0d, 0x5c, 0xc3, 0x96, 0x11, 0x46, 0x9f, 0x8a, 0xd7, 0x0b, 0x50, 0x14, 0x21, 0x00, 0x09,
+	0x28, 0xc9, 0xb7, 0xd3, 0x8d, 0x4d, 0x6c, 0x52, 0x7d, 0xa6, 0x52, 0x4b, 0x37, 0x5d, 0xa9, 0x71,
+	0x1f, 0x36, 0x64, 0x1f, 0xfe, 0x89, 0x01, 0x2a, 0xf9, 0x47, 0x20, 0x9b, 0xf1, 0x23, 0x30, 0xeb,
+	0x61, 0xc6, 0xaf, 0x8b, 0xec, 0xa6, 0x46, 0x56, 0x27, 0x56, 0x22, 0xaa, 0x70, 0x9d, 0x11, 0x6b,
+	0xb1, 0x79, 0xc4, 0x87, 0x4f, 0x0a, 0xa0, 0x3c, 0x64, 0xda, 0x26, 0x1e, 0xc1, 0x8c, 0xc0, 0xef,
+	0x81, 0xf9, 0x48, 0xfd, 0x55, 0x0e, 0x18, 0x6f, 0x74, 0xc0, 0xd2, 0x0e, 0x2c, 0xc7, 0x99, 0x9d,
+	0x4a, 0x2b, 0x1f, 0xe6, 0x34, 0x49, 0x1e, 0x2e, 0x4d, 0x52, 0xfc, 0xe6, 0xfa, 0xa3, 0x2e, 0x91,
+	0x32, 0x98, 0x54, 0x4f, 0x37, 0x79, 0x73, 0xd8, 0x6a, 0x31, 0x82, 0xc3, 0x7f, 0x0c, 0xb0, 0x72,
+	0x34, 0x34, 0xa8, 0x7e, 0x4c, 0x98, 0xb8, 0x17, 0xe1, 0x2e, 0x98, 0xef, 0xa9, 0xbf, 0x4d, 0xa1,
+	0x5f, 0x37, 0x8a, 0xb5, 0x34, 0xd0, 0x2c, 0x17, 0xd9, 0x73, 0x7a, 0x79, 0x74, 0xde, 0x21, 0xf0,
+	0x43, 0x30, 0xe9, 0x78, 0xf4, 0xf8, 0xd8, 0x2c, 0xbc, 0xe9, 0xf9, 0x3f, 0x23, 0x02, 0x94, 0xef,
+	0x77, 0x25, 0x01, 0xbf, 0x02, 0x66, 0xe2, 0xaf, 0x72, 0x66, 0xf1, 0xfa, 0xd2, 0x89, 0x10, 0x34,
+	0xc1, 0xb4, 0x2a, 0x52, 0x26, 0x9b, 0xc8, 0x82, 0x1d, 0x2f, 0x77, 0x4b, 0x22, 0xf2, 0xc6, 0xc3,
+	0x3f, 0x5c, 0x54, 0x8d, 0x67, 0x17, 0x55, 0xe3, 0xf9, 0x45, 0xd5, 0xf8, 0xe7, 0x45, 0xd5, 0xf8,
+	0xf4, 0xb2, 0x3a, 0xf1, 0xfc, 0xb2, 0x3a, 0xf1, 0xb7, 0xcb, 0xea, 0xc4, 0x77, 0xee, 0xbe, 0x16,
+	0xe8, 0xb3, 0xe1, 0xaf, 0x93, 0x12, 0xf7, 0xd6, 0x94, 0xf4, 0xeb, 0x83, 0xff, 0x0d, 0x00, 0x04,
+	0x37, 0xee, 0x7a, 0xc1, 0x14, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -899,6 +1056,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DelayedRewardUnit != that1.DelayedRewardUnit {
 		return false
 	}
+	if this.DelayedRewardPeriodCurve != that1.DelayedRewardPeriodCurve {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1194,6 +1354,41 @@ func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DelegationRecommanderReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationRecommanderReward)
+	if !ok {
+		that2, ok := that.(DelegationRecommanderReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RecommanderAddress != that1.RecommanderAddress {
+		return false
+	}
+	if this.Level != that1.Level {
+		return false
+	}
+	if len(this.Reward) != len(that1.Reward) {
+		return false
+	}
+	for i := range this.Reward {
+		if !this.Reward[i].Equal(&that1.Reward[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1230,6 +1425,39 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *TeamCommissionVesting) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TeamCommissionVesting)
+	if !ok {
+		that2, ok := that.(TeamCommissionVesting)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VestingType != that1.VestingType {
+		return false
+	}
+	if this.Cliff != that1.Cliff {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	if this.Periods != that1.Periods {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1250,6 +1478,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelayedRewardPeriodCurve) > 0 {
+		i -= len(m.DelayedRewardPeriodCurve)
+		copy(dAtA[i:], m.DelayedRewardPeriodCurve)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelayedRewardPeriodCurve)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DelayedRewardUnit, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DelayedRewardUnit):])
 	if err1 != nil {
 		return 0, err1
@@ -1778,6 +2013,55 @@ func (m *DelegationDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegationRecommanderReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationRecommanderReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationRecommanderReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Level != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecommanderAddress) > 0 {
+		i -= len(m.RecommanderAddress)
+		copy(dAtA[i:], m.RecommanderAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.RecommanderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommunityPoolSpendProposalWithDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1931,6 +2215,107 @@ func (m *ValidatorDelayedRewardInfo) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *DelayedRewardRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedRewardRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedRewardRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintDistribution(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TeamCommissionVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamCommissionVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamCommissionVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintDistribution(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Cliff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cliff):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintDistribution(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.VestingType) > 0 {
+		i -= len(m.VestingType)
+		copy(dAtA[i:], m.VestingType)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.VestingType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1967,6 +2352,10 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DelayedRewardUnit)
 	n += 1 + l + sovDistribution(uint64(l))
+	l = len(m.DelayedRewardPeriodCurve)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
 	return n
 }
 
@@ -2161,23 +2550,45 @@ func (m *DelegationDelegatorReward) Size() (n int) {
 	return n
 }
 
-func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+func (m *DelegationRecommanderReward) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.RecommanderAddress)
 	if l > 0 {
 		n += 1 + l + sovDistribution(uint64(l))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if m.Level != 0 {
+		n += 1 + sovDistribution(uint64(m.Level))
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
 	}
 	l = len(m.Amount)
 	if l > 0 {
@@ -2227,6 +2638,46 @@ func (m *ValidatorDelayedRewardInfo) Size() (n int) {
 	return n
 }
 
+func (m *DelayedRewardRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovDistribution(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *TeamCommissionVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingType)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Cliff)
+	n += 1 + l + sovDistribution(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Periods != 0 {
+		n += 1 + sovDistribution(uint64(m.Periods))
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2489,6 +2940,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedRewardPeriodCurve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedRewardPeriodCurve = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3675,6 +4158,141 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegationRecommanderReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationRecommanderReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationRecommanderReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommanderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommanderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4138,6 +4756,309 @@ func (m *ValidatorDelayedRewardInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelayedRewardRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedRewardRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedRewardRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeamCommissionVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamCommissionVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamCommissionVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Cliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
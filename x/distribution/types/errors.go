@@ -20,4 +20,8 @@ var (
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
 	ErrNoTeamCommission        = sdkerrors.Register(ModuleName, 14, "no team commission to withdraw")
 	ErrEmptyTeamAddr           = sdkerrors.Register(ModuleName, 15, "team address is empty")
+	ErrNoDelayedRewardsDue     = sdkerrors.Register(ModuleName, 16, "no delayed rewards due for release")
+	ErrInvalidTeamVesting      = sdkerrors.Register(ModuleName, 17, "invalid team commission vesting")
+	ErrTeamAccountNotVestable  = sdkerrors.Register(ModuleName, 18, "team commission cannot vest to the incentive team account")
+	ErrTeamVestingWeakened     = sdkerrors.Register(ModuleName, 19, "team commission vesting cannot be weakened")
 )
@@ -9,10 +9,13 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeWithdrawTeamCommission = "withdraw_team_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeReleaseDelayedRewards = "release_delayed_rewards"
+	EventTypeSetTeamCommissionVesting = "set_team_commission_vesting"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyRecommandersRewards = "recommanders_rewards"
+	AttributeKeyVestingType = "vesting_type"
 
 	AttributeValueCategory = ModuleName
 )
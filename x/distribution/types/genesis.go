@@ -9,7 +9,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
-	delays []ValidatorDelayedRewardRecord,
+	delays []ValidatorDelayedRewardRecord, teamVestings []TeamCommissionVestingRecord,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -24,6 +24,7 @@ func NewGenesisState(
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
 		ValidatorDelayedRewards:         delays,
+		TeamCommissionVestings:          teamVestings,
 	}
 }
 
@@ -41,6 +42,7 @@ func DefaultGenesisState() *GenesisState {
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
 		ValidatorDelayedRewards:         []ValidatorDelayedRewardRecord{},
+		TeamCommissionVestings:          []TeamCommissionVestingRecord{},
 	}
 }
 
@@ -49,5 +51,10 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, record := range gs.TeamCommissionVestings {
+		if err := record.Vesting.Validate(); err != nil {
+			return err
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
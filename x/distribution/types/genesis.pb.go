@@ -372,6 +372,47 @@ func (m *ValidatorDelayedRewardRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorDelayedRewardRecord proto.InternalMessageInfo
 
+// TeamCommissionVestingRecord is used for import / export via genesis json.
+type TeamCommissionVestingRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// vesting defines the team commission vesting of the validator.
+	Vesting TeamCommissionVesting `protobuf:"bytes,2,opt,name=vesting,proto3" json:"vesting"`
+}
+
+func (m *TeamCommissionVestingRecord) Reset()         { *m = TeamCommissionVestingRecord{} }
+func (m *TeamCommissionVestingRecord) String() string { return proto.CompactTextString(m) }
+func (*TeamCommissionVestingRecord) ProtoMessage()    {}
+func (*TeamCommissionVestingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{8}
+}
+func (m *TeamCommissionVestingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TeamCommissionVestingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TeamCommissionVestingRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TeamCommissionVestingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamCommissionVestingRecord.Merge(m, src)
+}
+func (m *TeamCommissionVestingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TeamCommissionVestingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamCommissionVestingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TeamCommissionVestingRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
@@ -396,13 +437,16 @@ type GenesisState struct {
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
 	//
 	ValidatorDelayedRewards []ValidatorDelayedRewardRecord `protobuf:"bytes,11,rep,name=validator_delayed_rewards,json=validatorDelayedRewards,proto3" json:"validator_delayed_rewards" yaml:"validator_delayed_rewards"`
+	// team_commission_vestings defines the team commission vestings of the
+	// validators at genesis.
+	TeamCommissionVestings []TeamCommissionVestingRecord `protobuf:"bytes,12,rep,name=team_commission_vestings,json=teamCommissionVestings,proto3" json:"team_commission_vestings" yaml:"team_commission_vestings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{8}
+	return fileDescriptor_76eed0f9489db580, []int{9}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,6 +484,7 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
 	proto.RegisterType((*ValidatorDelayedRewardRecord)(nil), "cosmos.distribution.v1beta1.ValidatorDelayedRewardRecord")
+	proto.RegisterType((*TeamCommissionVestingRecord)(nil), "cosmos.distribution.v1beta1.TeamCommissionVestingRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -448,80 +493,84 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x3a, 0x25, 0x4d, 0x26, 0x29, 0x4d, 0xb7, 0xf9, 0x70, 0x9d, 0xc4, 0x9b, 0x4e, 0x8b,
-	0x08, 0xaa, 0x58, 0x37, 0x29, 0xe2, 0x23, 0x08, 0xa4, 0x6c, 0x42, 0xa1, 0xa7, 0x86, 0x09, 0x82,
-	0x8a, 0x8b, 0xb5, 0xf6, 0x8e, 0xed, 0x11, 0xf6, 0x8e, 0xb5, 0x33, 0x76, 0x08, 0x7f, 0x01, 0x07,
-	0x0e, 0x95, 0x10, 0xa7, 0x22, 0x91, 0x23, 0x20, 0x8e, 0xbd, 0x73, 0xed, 0xb1, 0x47, 0x0e, 0x28,
-	0x45, 0xc9, 0x85, 0x73, 0x0e, 0x1c, 0x38, 0xa1, 0x9d, 0x99, 0xdd, 0x9d, 0xf5, 0x57, 0x9d, 0x90,
-	0x9c, 0x92, 0x1d, 0xbf, 0xfd, 0xbd, 0xdf, 0xfb, 0xbd, 0xf7, 0xe6, 0xbd, 0x05, 0x6f, 0x54, 0x28,
-	0x6b, 0x52, 0x56, 0xf4, 0x08, 0xe3, 0x01, 0x29, 0xb7, 0x39, 0xa1, 0x7e, 0xb1, 0xb3, 0x56, 0xc6,
-	0xdc, 0x5d, 0x2b, 0xd6, 0xb0, 0x8f, 0x19, 0x61, 0x76, 0x2b, 0xa0, 0x9c, 0x9a, 0x8b, 0xd2, 0xd4,
-	0xd6, 0x4d, 0x6d, 0x65, 0x9a, 0x9f, 0xad, 0xd1, 0x1a, 0x15, 0x76, 0xc5, 0xf0, 0x3f, 0xf9, 0x4a,
-	0xbe, 0xa0, 0xd0, 0xcb, 0x2e, 0xc3, 0x31, 0x6a, 0x85, 0x12, 0x5f, 0xfd, 0x6e, 0xd5, 0x28, 0xad,
-	0x35, 0x70, 0x51, 0x3c, 0x95, 0xdb, 0xd5, 0x22, 0x27, 0x4d, 0xcc, 0xb8, 0xdb, 0x6c, 0x29, 0x03,
-	0x7b, 0x18, 0xbd, 0x14, 0x11, 0x61, 0x0f, 0x9f, 0x1a, 0x60, 0x6e, 0x1b, 0x37, 0x70, 0xcd, 0xe5,
-	0x34, 0xf8, 0x82, 0xf0, 0xba, 0x17, 0xb8, 0x7b, 0x0f, 0xfc, 0x2a, 0x35, 0x1f, 0x80, 0x6b, 0x5e,
-	0xf4, 0x43, 0xc9, 0xf5, 0xbc, 0x00, 0x33, 0x96, 0x33, 0x56, 0x8c, 0xd5, 0x49, 0x67, 0xe9, 0xe4,
-	0xd0, 0xca, 0xed, 0xbb, 0xcd, 0xc6, 0x06, 0xec, 0x31, 0x81, 0x68, 0x26, 0x3e, 0xdb, 0x94, 0x47,
-	0xe6, 0x7d, 0x30, 0xb3, 0xa7, 0xa0, 0x63, 0xa4, 0xac, 0x40, 0x5a, 0x3c, 0x39, 0xb4, 0x16, 0x24,
-	0x52, 0xb7, 0x05, 0x44, 0x57, 0xa3, 0x23, 0x85, 0xb3, 0x31, 0xf1, 0xed, 0x81, 0x95, 0xf9, 0xfb,
-	0xc0, 0xca, 0xc0, 0x27, 0x59, 0x70, 0xf3, 0x73, 0xb7, 0x41, 0xbc, 0xd0, 0xcd, 0xc3, 0x36, 0x67,
-	0xdc, 0xf5, 0x3d, 0xe2, 0xd7, 0x10, 0xde, 0x73, 0x03, 0x8f, 0x21, 0x5c, 0xa1, 0x81, 0x17, 0x86,
-	0xd0, 0x89, 0x8c, 0x06, 0x87, 0xd0, 0x63, 0x02, 0xd1, 0x4c, 0x7c, 0x16, 0x85, 0x70, 0x60, 0x80,
-	0xeb, 0x34, 0xf1, 0x53, 0x0a, 0xa4, 0xa3, 0x5c, 0x76, 0x65, 0x6c, 0x75, 0x6a, 0x7d, 0x49, 0xc9,
-	0x6e, 0x87, 0x79, 0x8b, 0x52, 0x6c, 0x6f, 0xe3, 0xca, 0x16, 0x25, 0xbe, 0xf3, 0xe9, 0xb3, 0x43,
-	0x2b, 0x73, 0x72, 0x68, 0xe5, 0xa5, 0xbf, 0x3e, 0x30, 0xf0, 0xd7, 0x17, 0xd6, 0x9d, 0x1a, 0xe1,
-	0xf5, 0x76, 0xd9, 0xae, 0xd0, 0x66, 0x51, 0x25, 0x51, 0xfe, 0x79, 0x93, 0x79, 0x5f, 0x15, 0xf9,
-	0x7e, 0x0b, 0xb3, 0x08, 0x91, 0x21, 0x93, 0xf6, 0xc4, 0xac, 0xa9, 0xf3, 0x8f, 0x01, 0x6e, 0xc7,
-	0xea, 0x6c, 0x56, 0x2a, 0xed, 0x66, 0xbb, 0xe1, 0x72, 0xec, 0x6d, 0xd1, 0x66, 0x93, 0x30, 0x46,
-	0xa8, 0x7f, 0xfe, 0x02, 0xed, 0x83, 0x29, 0x37, 0xf1, 0x24, 0xd2, 0x3b, 0xb5, 0xfe, 0xbe, 0x3d,
-	0xa4, 0x05, 0xec, 0xe1, 0x14, 0x9d, 0xbc, 0x92, 0xcd, 0x94, 0x2c, 0x34, 0x74, 0x88, 0x74, 0x5f,
-	0x5a, 0xe0, 0xff, 0x1a, 0x60, 0x25, 0x46, 0xfd, 0x84, 0x30, 0x4e, 0x03, 0x52, 0x71, 0x1b, 0x17,
-	0x56, 0x15, 0xf3, 0x60, 0xbc, 0x85, 0x03, 0x42, 0x65, 0xbc, 0x97, 0x90, 0x7a, 0x32, 0x09, 0xb8,
-	0x1c, 0x15, 0xc8, 0x98, 0x10, 0xe2, 0x9d, 0xd1, 0x84, 0xe8, 0xa1, 0xec, 0xcc, 0x2b, 0x11, 0x5e,
-	0x95, 0xac, 0xa2, 0x7a, 0x41, 0x11, 0xbe, 0x16, 0xfc, 0x9f, 0x06, 0x58, 0x8e, 0x91, 0xb6, 0xda,
-	0x41, 0x80, 0x7d, 0x7e, 0x61, 0x91, 0x57, 0x93, 0x08, 0x65, 0xaa, 0xdf, 0x1a, 0x2d, 0xc2, 0x34,
-	0xaf, 0xd3, 0x84, 0xf7, 0x34, 0x0b, 0x16, 0xe3, 0x9b, 0x6a, 0x97, 0xbb, 0x01, 0x27, 0x7e, 0x2d,
-	0xbc, 0xa9, 0x92, 0xe0, 0xce, 0xeb, 0xbe, 0xea, 0xab, 0x53, 0xf6, 0x4c, 0x3a, 0xb5, 0xc1, 0x15,
-	0xa6, 0xb8, 0x96, 0x88, 0x5f, 0xa5, 0xaa, 0x1e, 0xd6, 0x87, 0xaa, 0xd5, 0x37, 0x4c, 0x67, 0x49,
-	0x69, 0x35, 0x2b, 0xdd, 0xa7, 0x60, 0x21, 0x9a, 0x66, 0x9a, 0xad, 0x26, 0xdb, 0x8f, 0x59, 0x70,
-	0x23, 0x56, 0x7f, 0xb7, 0xe1, 0xb2, 0xfa, 0x47, 0x1d, 0x91, 0x80, 0x0b, 0xe8, 0x85, 0x3a, 0x26,
-	0xb5, 0x3a, 0x8f, 0x7a, 0x41, 0x3e, 0x69, 0x3d, 0x32, 0x96, 0xea, 0x91, 0x6f, 0xc0, 0x5c, 0x82,
-	0xcb, 0x42, 0x62, 0x25, 0x1c, 0x32, 0xcb, 0x5d, 0x12, 0x0a, 0xdd, 0x1d, 0xad, 0x9e, 0x92, 0x88,
-	0x9c, 0x59, 0xa5, 0xcf, 0xb4, 0x24, 0x2d, 0xc0, 0x20, 0xba, 0xde, 0xe9, 0x35, 0xd5, 0x9b, 0x26,
-	0x0b, 0x96, 0x62, 0xb0, 0x6d, 0xdc, 0x70, 0xf7, 0xb1, 0x27, 0x8b, 0xf3, 0xfc, 0x15, 0x7a, 0x04,
-	0x80, 0x48, 0x52, 0x29, 0x1c, 0xda, 0xaa, 0x6d, 0xf2, 0xb6, 0x9c, 0xe8, 0x76, 0x34, 0xd1, 0xed,
-	0xcf, 0xa2, 0x89, 0xee, 0x2c, 0xab, 0x80, 0xae, 0x69, 0x09, 0x17, 0xef, 0xc2, 0xc7, 0x2f, 0x2c,
-	0x03, 0x4d, 0x8a, 0x83, 0xd0, 0xdc, 0xfc, 0xce, 0x00, 0xb9, 0x84, 0x82, 0x27, 0xc3, 0x50, 0xc3,
-	0x45, 0x55, 0xdc, 0xbd, 0xd1, 0xf4, 0x4c, 0x49, 0x10, 0x33, 0x98, 0x8b, 0x9b, 0x47, 0x03, 0x86,
-	0x68, 0xbe, 0xd3, 0xf7, 0x35, 0x4d, 0xde, 0x5f, 0xa6, 0xc1, 0xf4, 0xc7, 0x72, 0x29, 0xda, 0xe5,
-	0x2e, 0xc7, 0x26, 0x02, 0xe3, 0x2d, 0x37, 0x70, 0x9b, 0x52, 0xc3, 0xa9, 0xf5, 0x5b, 0x43, 0x69,
-	0xed, 0x08, 0x53, 0x67, 0x4e, 0xd1, 0xb8, 0x22, 0x69, 0x48, 0x00, 0x88, 0x14, 0x92, 0xf9, 0x08,
-	0x4c, 0x54, 0x31, 0x2e, 0xb5, 0x28, 0x6d, 0x28, 0x55, 0x6f, 0x0f, 0x45, 0xbd, 0x8f, 0xf1, 0x0e,
-	0xa5, 0x0d, 0x67, 0x41, 0xc1, 0x5e, 0x95, 0xb0, 0x11, 0x06, 0x44, 0x97, 0xab, 0xd2, 0xc2, 0xfc,
-	0xc1, 0x00, 0xb9, 0xe4, 0xc6, 0x88, 0x37, 0x94, 0xb0, 0xe3, 0xc2, 0x9b, 0x7d, 0x6c, 0xf4, 0x4e,
-	0xd6, 0x57, 0x2b, 0xe7, 0x75, 0xe5, 0xd8, 0xea, 0xbe, 0x93, 0xd2, 0x1e, 0x20, 0x9a, 0xf7, 0xfa,
-	0xbd, 0x2f, 0x2e, 0xa8, 0x56, 0x80, 0x3b, 0x84, 0xb6, 0x59, 0xa9, 0x15, 0xd0, 0x16, 0x65, 0x38,
-	0x10, 0x7d, 0x93, 0x2a, 0xca, 0x1e, 0x13, 0x88, 0x66, 0xa2, 0xb3, 0x1d, 0x75, 0x64, 0x7e, 0x3f,
-	0x60, 0xb1, 0x79, 0x45, 0x44, 0xf7, 0xe1, 0x68, 0x55, 0x33, 0x68, 0x03, 0x73, 0xe0, 0xcb, 0x57,
-	0x9f, 0x7e, 0xbb, 0x8c, 0xf9, 0xbb, 0x01, 0x6e, 0x6a, 0x3d, 0x95, 0x0c, 0xfb, 0x52, 0x25, 0x5e,
-	0x10, 0x58, 0x6e, 0x5c, 0x70, 0xdc, 0xfc, 0x1f, 0x4b, 0x86, 0xa2, 0x79, 0x57, 0xd1, 0x5c, 0xed,
-	0xe9, 0xe6, 0xfe, 0x9e, 0x21, 0xb2, 0x3a, 0x43, 0x71, 0x99, 0xf9, 0x9b, 0x01, 0x96, 0x12, 0x9c,
-	0x7a, 0x3c, 0xd8, 0x63, 0x81, 0x2f, 0x0b, 0xf2, 0x1f, 0x9c, 0x71, 0x31, 0x50, 0xc4, 0xef, 0x28,
-	0xe2, 0xb7, 0xba, 0x89, 0xf7, 0x3a, 0x84, 0x28, 0xdf, 0x19, 0x08, 0x17, 0xee, 0xb7, 0x37, 0x92,
-	0xb7, 0x2b, 0x72, 0x4a, 0xc7, 0x5c, 0x27, 0x04, 0xd7, 0x8d, 0xb3, 0x8c, 0x78, 0x45, 0x74, 0x55,
-	0x11, 0x5d, 0xe9, 0x26, 0xda, 0xe5, 0x0a, 0xa2, 0x85, 0x4e, 0x7f, 0x20, 0xf3, 0x49, 0xaa, 0x19,
-	0x53, 0xe3, 0x8f, 0xe5, 0x26, 0x05, 0xc3, 0x77, 0x4f, 0x3f, 0x56, 0x15, 0xbf, 0x81, 0x2d, 0x99,
-	0xf6, 0xa3, 0xb7, 0xa4, 0x8e, 0xc2, 0xc2, 0x3e, 0x9a, 0xef, 0x3b, 0xcf, 0x58, 0x0e, 0x08, 0x6e,
-	0x6f, 0x9f, 0x76, 0xa0, 0x29, 0x66, 0xaf, 0x29, 0x66, 0xcb, 0xdd, 0xca, 0xe9, 0x3e, 0x20, 0x9a,
-	0xed, 0x33, 0xe7, 0x98, 0xf9, 0x53, 0x2a, 0xad, 0xe9, 0xfb, 0x9b, 0xe5, 0xa6, 0x04, 0xb1, 0xf7,
-	0xce, 0x30, 0x19, 0x5e, 0x96, 0xd5, 0x2e, 0x4f, 0x7a, 0x56, 0x53, 0x38, 0xda, 0x82, 0xe7, 0x3c,
-	0xfc, 0xf9, 0xa8, 0x60, 0x3c, 0x3b, 0x2a, 0x18, 0xcf, 0x8f, 0x0a, 0xc6, 0x5f, 0x47, 0x05, 0xe3,
-	0xf1, 0x71, 0x21, 0xf3, 0xfc, 0xb8, 0x90, 0xf9, 0xe3, 0xb8, 0x90, 0xf9, 0x72, 0x6d, 0xe8, 0xe7,
-	0xd1, 0xd7, 0xe9, 0x0f, 0x5e, 0xf1, 0xb5, 0x54, 0x1e, 0x17, 0x33, 0xf5, 0xde, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x5d, 0x07, 0x2a, 0x22, 0xb3, 0x0f, 0x00, 0x00,
+	// 1229 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x3a, 0xf9, 0x26, 0xed, 0x24, 0xf9, 0x36, 0xdd, 0x26, 0xce, 0xd6, 0x49, 0xec, 0x74,
+	0x5a, 0x44, 0x50, 0xc5, 0xba, 0x49, 0x11, 0x3f, 0x82, 0x40, 0xca, 0x26, 0x14, 0x7a, 0x6a, 0x98,
+	0x54, 0xa5, 0xe2, 0x62, 0xad, 0xbd, 0x63, 0x7b, 0x85, 0xbd, 0x63, 0xed, 0x8c, 0x1d, 0xc2, 0x5f,
+	0xc0, 0x81, 0x43, 0x25, 0xc4, 0xa9, 0x48, 0xe4, 0x88, 0x10, 0xc7, 0xde, 0xb9, 0x41, 0x8f, 0x3d,
+	0x72, 0x40, 0x29, 0x4a, 0x2e, 0x9c, 0x73, 0xe0, 0xc0, 0x09, 0xed, 0xcc, 0xec, 0xee, 0xac, 0xbd,
+	0x76, 0x9d, 0x90, 0x9c, 0x92, 0x1d, 0xbf, 0xfd, 0xbc, 0xcf, 0xfb, 0xbc, 0xf7, 0xe6, 0x3d, 0x1b,
+	0xbc, 0x51, 0x25, 0xb4, 0x45, 0x68, 0xc9, 0x71, 0x29, 0xf3, 0xdd, 0x4a, 0x87, 0xb9, 0xc4, 0x2b,
+	0x75, 0xd7, 0x2a, 0x98, 0xd9, 0x6b, 0xa5, 0x3a, 0xf6, 0x30, 0x75, 0xa9, 0xd9, 0xf6, 0x09, 0x23,
+	0xfa, 0xa2, 0x30, 0x35, 0x55, 0x53, 0x53, 0x9a, 0xe6, 0xe7, 0xea, 0xa4, 0x4e, 0xb8, 0x5d, 0x29,
+	0xf8, 0x4f, 0xbc, 0x92, 0x2f, 0x48, 0xf4, 0x8a, 0x4d, 0x71, 0x84, 0x5a, 0x25, 0xae, 0x27, 0x3f,
+	0x2f, 0xd6, 0x09, 0xa9, 0x37, 0x71, 0x89, 0x3f, 0x55, 0x3a, 0xb5, 0x12, 0x73, 0x5b, 0x98, 0x32,
+	0xbb, 0xd5, 0x96, 0x06, 0xe6, 0x30, 0x7a, 0x09, 0x22, 0xdc, 0x1e, 0x3e, 0xd3, 0xc0, 0xfc, 0x36,
+	0x6e, 0xe2, 0xba, 0xcd, 0x88, 0xff, 0x99, 0xcb, 0x1a, 0x8e, 0x6f, 0xef, 0xdd, 0xf7, 0x6a, 0x44,
+	0xbf, 0x0f, 0xae, 0x3a, 0xe1, 0x07, 0x65, 0xdb, 0x71, 0x7c, 0x4c, 0xa9, 0xa1, 0xad, 0x68, 0xab,
+	0x97, 0xad, 0xa5, 0x93, 0xc3, 0xa2, 0xb1, 0x6f, 0xb7, 0x9a, 0x1b, 0xb0, 0xcf, 0x04, 0xa2, 0xd9,
+	0xe8, 0x6c, 0x53, 0x1c, 0xe9, 0xf7, 0xc0, 0xec, 0x9e, 0x84, 0x8e, 0x90, 0xb2, 0x1c, 0x69, 0xf1,
+	0xe4, 0xb0, 0xb8, 0x20, 0x90, 0x7a, 0x2d, 0x20, 0xba, 0x12, 0x1e, 0x49, 0x9c, 0x8d, 0x4b, 0x5f,
+	0x1f, 0x14, 0x33, 0x7f, 0x1d, 0x14, 0x33, 0xf0, 0x69, 0x16, 0xdc, 0x78, 0x64, 0x37, 0x5d, 0x27,
+	0x70, 0xf3, 0xa0, 0xc3, 0x28, 0xb3, 0x3d, 0xc7, 0xf5, 0xea, 0x08, 0xef, 0xd9, 0xbe, 0x43, 0x11,
+	0xae, 0x12, 0xdf, 0x09, 0x42, 0xe8, 0x86, 0x46, 0x83, 0x43, 0xe8, 0x33, 0x81, 0x68, 0x36, 0x3a,
+	0x0b, 0x43, 0x38, 0xd0, 0xc0, 0x35, 0x12, 0xfb, 0x29, 0xfb, 0xc2, 0x91, 0x91, 0x5d, 0x19, 0x5b,
+	0x9d, 0x5a, 0x5f, 0x92, 0xb2, 0x9b, 0x41, 0xde, 0xc2, 0x14, 0x9b, 0xdb, 0xb8, 0xba, 0x45, 0x5c,
+	0xcf, 0xfa, 0xf4, 0xf9, 0x61, 0x31, 0x73, 0x72, 0x58, 0xcc, 0x0b, 0x7f, 0x29, 0x30, 0xf0, 0xa7,
+	0x97, 0xc5, 0xdb, 0x75, 0x97, 0x35, 0x3a, 0x15, 0xb3, 0x4a, 0x5a, 0x25, 0x99, 0x44, 0xf1, 0xe7,
+	0x4d, 0xea, 0x7c, 0x51, 0x62, 0xfb, 0x6d, 0x4c, 0x43, 0x44, 0x8a, 0x74, 0xd2, 0x17, 0xb3, 0xa2,
+	0xce, 0xdf, 0x1a, 0xb8, 0x15, 0xa9, 0xb3, 0x59, 0xad, 0x76, 0x5a, 0x9d, 0xa6, 0xcd, 0xb0, 0xb3,
+	0x45, 0x5a, 0x2d, 0x97, 0x52, 0x97, 0x78, 0xe7, 0x2f, 0xd0, 0x3e, 0x98, 0xb2, 0x63, 0x4f, 0x3c,
+	0xbd, 0x53, 0xeb, 0xef, 0x9b, 0x43, 0x5a, 0xc0, 0x1c, 0x4e, 0xd1, 0xca, 0x4b, 0xd9, 0x74, 0xc1,
+	0x42, 0x41, 0x87, 0x48, 0xf5, 0xa5, 0x04, 0xfe, 0x8f, 0x06, 0x56, 0x22, 0xd4, 0x4f, 0x5c, 0xca,
+	0x88, 0xef, 0x56, 0xed, 0xe6, 0x85, 0x55, 0x45, 0x0e, 0x4c, 0xb4, 0xb1, 0xef, 0x12, 0x11, 0xef,
+	0x38, 0x92, 0x4f, 0xba, 0x0b, 0x26, 0xc3, 0x02, 0x19, 0xe3, 0x42, 0xbc, 0x33, 0x9a, 0x10, 0x7d,
+	0x94, 0xad, 0x9c, 0x14, 0xe1, 0xff, 0x82, 0x55, 0x58, 0x2f, 0x28, 0xc4, 0x57, 0x82, 0xff, 0x43,
+	0x03, 0xcb, 0x11, 0xd2, 0x56, 0xc7, 0xf7, 0xb1, 0xc7, 0x2e, 0x2c, 0xf2, 0x5a, 0x1c, 0xa1, 0x48,
+	0xf5, 0x5b, 0xa3, 0x45, 0x98, 0xe4, 0x75, 0x9a, 0xf0, 0x9e, 0x65, 0xc1, 0x62, 0x74, 0x53, 0xed,
+	0x32, 0xdb, 0x67, 0xae, 0x57, 0x0f, 0x6e, 0xaa, 0x38, 0xb8, 0xf3, 0xba, 0xaf, 0x52, 0x75, 0xca,
+	0x9e, 0x49, 0xa7, 0x0e, 0x98, 0xa1, 0x92, 0x6b, 0xd9, 0xf5, 0x6a, 0x44, 0xd6, 0xc3, 0xfa, 0x50,
+	0xb5, 0x52, 0xc3, 0xb4, 0x96, 0xa4, 0x56, 0x73, 0xc2, 0x7d, 0x02, 0x16, 0xa2, 0x69, 0xaa, 0xd8,
+	0x2a, 0xb2, 0x7d, 0x9f, 0x05, 0xd7, 0x23, 0xf5, 0x77, 0x9b, 0x36, 0x6d, 0x7c, 0xd4, 0xe5, 0x09,
+	0xb8, 0x80, 0x5e, 0x68, 0x60, 0xb7, 0xde, 0x60, 0x61, 0x2f, 0x88, 0x27, 0xa5, 0x47, 0xc6, 0x12,
+	0x3d, 0xf2, 0x15, 0x98, 0x8f, 0x71, 0x69, 0x40, 0xac, 0x8c, 0x03, 0x66, 0xc6, 0x38, 0x57, 0xe8,
+	0xce, 0x68, 0xf5, 0x14, 0x47, 0x64, 0xcd, 0x49, 0x7d, 0xa6, 0x05, 0x69, 0x0e, 0x06, 0xd1, 0xb5,
+	0x6e, 0xbf, 0xa9, 0xda, 0x34, 0x59, 0xb0, 0x14, 0x81, 0x6d, 0xe3, 0xa6, 0xbd, 0x8f, 0x1d, 0x51,
+	0x9c, 0xe7, 0xaf, 0xd0, 0x63, 0x00, 0x78, 0x92, 0xca, 0xc1, 0xd0, 0x96, 0x6d, 0x93, 0x37, 0xc5,
+	0x44, 0x37, 0xc3, 0x89, 0x6e, 0x3e, 0x0c, 0x27, 0xba, 0xb5, 0x2c, 0x03, 0xba, 0xaa, 0x24, 0x9c,
+	0xbf, 0x0b, 0x9f, 0xbc, 0x2c, 0x6a, 0xe8, 0x32, 0x3f, 0x08, 0xcc, 0xf5, 0x6f, 0x34, 0x60, 0xc4,
+	0x14, 0x1c, 0x11, 0x86, 0x1c, 0x2e, 0xb2, 0xe2, 0xee, 0x8e, 0xa6, 0x67, 0x42, 0x82, 0x88, 0xc1,
+	0x7c, 0xd4, 0x3c, 0x0a, 0x30, 0x44, 0xb9, 0x6e, 0xea, 0x6b, 0x8a, 0xbc, 0xbf, 0x6a, 0x60, 0xf1,
+	0x21, 0xb6, 0x5b, 0xf1, 0xb5, 0xfe, 0x08, 0x53, 0xc6, 0x87, 0xd6, 0x79, 0xab, 0x8b, 0xc0, 0x64,
+	0x57, 0x60, 0x1b, 0xd9, 0x11, 0x7a, 0x2c, 0x95, 0x95, 0x35, 0x1e, 0x04, 0x8c, 0x42, 0x20, 0x25,
+	0x90, 0xdf, 0x66, 0xc0, 0xf4, 0xc7, 0x62, 0xbb, 0xdb, 0x65, 0x36, 0xc3, 0x3a, 0x02, 0x13, 0x6d,
+	0xdb, 0xb7, 0x5b, 0x82, 0xee, 0xd4, 0xfa, 0xcd, 0xa1, 0xde, 0x76, 0xb8, 0xa9, 0x35, 0x2f, 0xf5,
+	0x9c, 0x11, 0x71, 0x09, 0x00, 0x88, 0x24, 0x92, 0xfe, 0x18, 0x5c, 0xaa, 0x61, 0x5c, 0x6e, 0x13,
+	0xd2, 0x94, 0x31, 0xdc, 0x1a, 0x8a, 0x7a, 0x0f, 0xe3, 0x1d, 0x42, 0x9a, 0xd6, 0x82, 0x84, 0xbd,
+	0x22, 0x60, 0x43, 0x0c, 0x88, 0x26, 0x6b, 0xc2, 0x42, 0xff, 0x4e, 0x03, 0x46, 0x7c, 0xf5, 0x45,
+	0xab, 0x56, 0x70, 0x75, 0x04, 0x23, 0x6a, 0x6c, 0xf4, 0x2b, 0x49, 0xdd, 0x11, 0xad, 0xd7, 0xa5,
+	0xe3, 0x62, 0xef, 0xe5, 0x9a, 0xf4, 0x00, 0x51, 0xce, 0x49, 0x7b, 0x9f, 0xdf, 0xb4, 0x6d, 0x1f,
+	0x77, 0x5d, 0xd2, 0xa1, 0xe5, 0xb6, 0x4f, 0xda, 0x84, 0x62, 0xdf, 0x18, 0xef, 0xcd, 0x7f, 0x9f,
+	0x09, 0x44, 0xb3, 0xe1, 0xd9, 0x8e, 0x3c, 0xd2, 0xbf, 0x1d, 0xb0, 0xa1, 0xfd, 0x8f, 0x47, 0xf7,
+	0xe1, 0x68, 0xe5, 0x3f, 0x68, 0x95, 0xb4, 0xe0, 0xab, 0x77, 0xb8, 0xb4, 0xa5, 0x4c, 0xff, 0x45,
+	0x03, 0x37, 0x94, 0xf2, 0x8d, 0xb7, 0x96, 0x72, 0x35, 0x2a, 0x3e, 0x6a, 0x4c, 0x70, 0x8e, 0x9b,
+	0xff, 0x61, 0x5b, 0x92, 0x34, 0xef, 0x48, 0x9a, 0xab, 0x7d, 0x8d, 0x93, 0xee, 0x19, 0xa2, 0x62,
+	0x77, 0x28, 0x2e, 0xd5, 0x7f, 0xd6, 0xc0, 0x52, 0x8c, 0xd3, 0x88, 0x36, 0x94, 0x48, 0xe0, 0x49,
+	0x4e, 0xfe, 0x83, 0x33, 0x6e, 0x38, 0x92, 0xf8, 0x6d, 0x49, 0xfc, 0x66, 0x2f, 0xf1, 0x7e, 0x87,
+	0x10, 0xe5, 0xbb, 0x03, 0xe1, 0x82, 0x45, 0xfd, 0x7a, 0xfc, 0x76, 0x55, 0xac, 0x1b, 0x11, 0xd7,
+	0x4b, 0x9c, 0xeb, 0xc6, 0x59, 0x76, 0x15, 0x49, 0x74, 0x55, 0x12, 0x5d, 0xe9, 0x25, 0xda, 0xe3,
+	0x0a, 0xa2, 0x85, 0x6e, 0x3a, 0x90, 0xfe, 0x34, 0xd1, 0x8c, 0x89, 0x39, 0x4e, 0x8d, 0xcb, 0x9c,
+	0xe1, 0xbb, 0xa7, 0xdf, 0x0f, 0x24, 0xbf, 0x81, 0x2d, 0x99, 0xf4, 0xa3, 0xb6, 0xa4, 0x8a, 0x42,
+	0x83, 0x3e, 0xca, 0xa5, 0x0e, 0x66, 0x6a, 0x00, 0xce, 0xed, 0xed, 0xd3, 0x4e, 0x66, 0xc9, 0xec,
+	0x35, 0xc9, 0x6c, 0xb9, 0x57, 0x39, 0xd5, 0x07, 0x44, 0x73, 0x29, 0x03, 0x9b, 0xea, 0x3f, 0x24,
+	0xd2, 0x9a, 0x1c, 0x44, 0xd4, 0x98, 0xe2, 0xc4, 0xde, 0x3b, 0xc3, 0x88, 0x7b, 0x55, 0x56, 0x7b,
+	0x3c, 0xa9, 0x59, 0x4d, 0xe0, 0x88, 0xac, 0x32, 0x6c, 0xb7, 0x94, 0xf6, 0x2a, 0xcb, 0x39, 0x42,
+	0x8d, 0xe9, 0x11, 0xb2, 0x3a, 0x64, 0x4e, 0xf6, 0x66, 0x75, 0x90, 0x1f, 0x88, 0x72, 0x2c, 0x0d,
+	0x45, 0xd9, 0xa3, 0xad, 0x07, 0x3f, 0x1e, 0x15, 0xb4, 0xe7, 0x47, 0x05, 0xed, 0xc5, 0x51, 0x41,
+	0xfb, 0xf3, 0xa8, 0xa0, 0x3d, 0x39, 0x2e, 0x64, 0x5e, 0x1c, 0x17, 0x32, 0xbf, 0x1f, 0x17, 0x32,
+	0x9f, 0xaf, 0x0d, 0xfd, 0x16, 0xfa, 0x65, 0xf2, 0x77, 0x05, 0xfe, 0xa5, 0xb4, 0x32, 0xc1, 0x57,
+	0x97, 0xbb, 0xff, 0x0e, 0x00, 0x78, 0xdc, 0x32, 0xde, 0x1a, 0x11, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -875,6 +924,46 @@ func (m *ValidatorDelayedRewardRecord) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *TeamCommissionVestingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamCommissionVestingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamCommissionVestingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -895,6 +984,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TeamCommissionVestings) > 0 {
+		for iNdEx := len(m.TeamCommissionVestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TeamCommissionVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ValidatorDelayedRewards) > 0 {
 		for iNdEx := len(m.ValidatorDelayedRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1189,6 +1292,21 @@ func (m *ValidatorDelayedRewardRecord) Size() (n int) {
 	return n
 }
 
+func (m *TeamCommissionVestingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Vesting.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1251,6 +1369,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TeamCommissionVestings) > 0 {
+		for _, e := range m.TeamCommissionVestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2302,6 +2426,121 @@ func (m *ValidatorDelayedRewardRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TeamCommissionVestingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamCommissionVestingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamCommissionVestingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2701,6 +2940,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamCommissionVestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeamCommissionVestings = append(m.TeamCommissionVestings, TeamCommissionVestingRecord{})
+			if err := m.TeamCommissionVestings[len(m.TeamCommissionVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
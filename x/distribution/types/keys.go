@@ -57,6 +57,7 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 	ValidatorDelayedRewardPrefix         = []byte{0x09} // key for validator relayed reward's queue
 	ValidatorDelayedRewardInfoPrefix     = []byte{0x0a} // key for validator relayed rewards info
+	ValidatorTeamCommissionVestingPrefix = []byte{0x0b} // key for validator team commission vesting
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -241,6 +242,24 @@ func GetValidatorDelayedRewardInfoKey(v sdk.ValAddress) []byte {
 	return append(ValidatorDelayedRewardInfoPrefix, address.MustLengthPrefix(v.Bytes())...)
 }
 
+// GetValidatorTeamCommissionVestingKey creates the key for a validator's team commission vesting.
+func GetValidatorTeamCommissionVestingKey(v sdk.ValAddress) []byte {
+	return append(ValidatorTeamCommissionVestingPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetValidatorTeamCommissionVestingAddress creates the address from a validator's team commission vesting key.
+func GetValidatorTeamCommissionVestingAddress(key []byte) (valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0b<valAddrLen (1 Byte)><valAddr_Bytes>: TeamCommissionVesting
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.ValAddress(addr)
+}
+
 // SplitValidatorDelayedRewardKey
 func SplitValidatorDelayedRewardKey(key []byte) (valAddr sdk.ValAddress, startTime time.Time) {
 	return splitKeyWithTime(key)
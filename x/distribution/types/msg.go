@@ -12,10 +12,12 @@ const (
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgWithdrawTeamCommission      = "withdraw_team_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgReleaseDelayedRewards       = "release_delayed_rewards"
+	TypeMsgSetTeamCommissionVesting    = "set_team_commission_vesting"
 )
 
 // Verify interface at compile time
-var _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgWithdrawTeamCommission{}
+var _, _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgWithdrawTeamCommission{}, &MsgReleaseDelayedRewards{}, &MsgSetTeamCommissionVesting{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -199,3 +201,86 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgReleaseDelayedRewards returns a new MsgReleaseDelayedRewards with a sender and
+// the validator whose delayed rewards are released.
+func NewMsgReleaseDelayedRewards(sender sdk.AccAddress, valAddr sdk.ValAddress) *MsgReleaseDelayedRewards {
+	return &MsgReleaseDelayedRewards{
+		Sender:           sender.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// Route returns the MsgReleaseDelayedRewards message route.
+func (msg MsgReleaseDelayedRewards) Route() string { return ModuleName }
+
+// Type returns the MsgReleaseDelayedRewards message type.
+func (msg MsgReleaseDelayedRewards) Type() string { return TypeMsgReleaseDelayedRewards }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgReleaseDelayedRewards) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes returns the raw bytes for a MsgReleaseDelayedRewards message that
+// the expected signer needs to sign.
+func (msg MsgReleaseDelayedRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgReleaseDelayedRewards message validation.
+func (msg MsgReleaseDelayedRewards) ValidateBasic() error {
+	if msg.Sender == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+	return nil
+}
+
+// NewMsgSetTeamCommissionVesting returns a new MsgSetTeamCommissionVesting
+// with a validator and the vesting of its team commission.
+func NewMsgSetTeamCommissionVesting(valAddr sdk.ValAddress, vesting TeamCommissionVesting) *MsgSetTeamCommissionVesting {
+	return &MsgSetTeamCommissionVesting{
+		ValidatorAddress: valAddr.String(),
+		Vesting:          vesting,
+	}
+}
+
+// Route returns the MsgSetTeamCommissionVesting message route.
+func (msg MsgSetTeamCommissionVesting) Route() string { return ModuleName }
+
+// Type returns the MsgSetTeamCommissionVesting message type.
+func (msg MsgSetTeamCommissionVesting) Type() string { return TypeMsgSetTeamCommissionVesting }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, the validator operator.
+func (msg MsgSetTeamCommissionVesting) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetTeamCommissionVesting message
+// that the expected signer needs to sign.
+func (msg MsgSetTeamCommissionVesting) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetTeamCommissionVesting message validation.
+func (msg MsgSetTeamCommissionVesting) ValidateBasic() error {
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+	return msg.Vesting.Validate()
+}
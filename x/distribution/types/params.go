@@ -26,6 +26,7 @@ var (
 	ParamStoreKeyMinDelayedRewardPeriod = []byte("mindelayedrewardperiod")
 	ParamStoreKeyMaxDelayedRewardInterval = []byte("maxdelayedrewardinterval")
 	ParamStoreKeyDelayedRewardUnit = []byte("delayedrewardunit")
+	ParamStoreKeyDelayedRewardPeriodCurve = []byte("delayedrewardperiodcurve")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -44,6 +45,7 @@ func DefaultParams() Params {
 		MinDelayedRewardPeriod:   DefaultMinDelayedPeriod,
 		MaxDelayedRewardInterval: DefaultMaxDelayedInterval,
 		DelayedRewardUnit:        DefaultDelayedUnit,
+		DelayedRewardPeriodCurve: DefaultDelayedRewardPeriodCurve,
 	}
 }
 
@@ -63,6 +65,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyMinDelayedRewardPeriod, &p.MinDelayedRewardPeriod, validateMinDelayedRewardPeriod),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxDelayedRewardInterval, &p.MaxDelayedRewardInterval, validateMaxDelayedRewardInterval),
 		paramtypes.NewParamSetPair(ParamStoreKeyDelayedRewardUnit, &p.DelayedRewardUnit, validateDelayedRewardUnit),
+		paramtypes.NewParamSetPair(ParamStoreKeyDelayedRewardPeriodCurve, &p.DelayedRewardPeriodCurve, validateDelayedRewardPeriodCurve),
 	}
 }
 
@@ -101,6 +104,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateDelayedRewardUnit(p.DelayedRewardUnit); err != nil {
 		return err
 	}
+	if err := validateDelayedRewardPeriodCurve(p.DelayedRewardPeriodCurve); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -198,7 +204,7 @@ func validateMinDelayedRewardPeriod(i interface{}) error {
 
 	if v <= 0 {
 		return fmt.Errorf(
-			"min delayed reward period must be positive: %s", v,
+			"min delayed reward period must be positive: %d", v,
 		)
 	}
 	
@@ -212,7 +218,7 @@ func validateMaxDelayedRewardInterval(i interface{}) error {
 	}
 
 	if v <= 0 {
-		return fmt.Errorf("delayed reward interval must be positive: %s", v)
+		return fmt.Errorf("delayed reward interval must be positive: %d", v)
 	}
 
 	return nil
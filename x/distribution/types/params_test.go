@@ -31,12 +31,11 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := types.Params{
-				CommunityTax:        tt.fields.CommunityTax,
-				BaseProposerReward:  tt.fields.BaseProposerReward,
-				BonusProposerReward: tt.fields.BonusProposerReward,
-				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
-			}
+			p := types.DefaultParams()
+			p.CommunityTax = tt.fields.CommunityTax
+			p.BaseProposerReward = tt.fields.BaseProposerReward
+			p.BonusProposerReward = tt.fields.BonusProposerReward
+			p.WithdrawAddrEnabled = tt.fields.WithdrawAddrEnabled
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
 			}
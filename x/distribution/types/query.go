@@ -38,3 +38,10 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress,
 	reward sdk.DecCoins) DelegationDelegatorReward {
 	return DelegationDelegatorReward{ValidatorAddress: valAddr.String(), Reward: reward}
 }
+
+// NewDelegationRecommanderReward constructs a DelegationRecommanderReward.
+//nolint:interfacer
+func NewDelegationRecommanderReward(recAddr sdk.AccAddress, level uint32,
+	reward sdk.Coins) DelegationRecommanderReward {
+	return DelegationRecommanderReward{RecommanderAddress: recAddr.String(), Level: level, Reward: reward}
+}
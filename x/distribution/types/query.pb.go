@@ -519,6 +519,213 @@ func (m *QueryValidatorDelayedRewardsResponse) GetPagination() *query.PageRespon
 	return nil
 }
 
+// QueryValidatorDelayedRewardsScheduleRequest is the request type for the
+// Query/ValidatorDelayedRewardsSchedule RPC method
+type QueryValidatorDelayedRewardsScheduleRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorDelayedRewardsScheduleRequest) Reset() {
+	*m = QueryValidatorDelayedRewardsScheduleRequest{}
+}
+func (m *QueryValidatorDelayedRewardsScheduleRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorDelayedRewardsScheduleRequest) ProtoMessage() {}
+func (*QueryValidatorDelayedRewardsScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{10}
+}
+func (m *QueryValidatorDelayedRewardsScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDelayedRewardsScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDelayedRewardsScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDelayedRewardsScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDelayedRewardsScheduleRequest.Merge(m, src)
+}
+func (m *QueryValidatorDelayedRewardsScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDelayedRewardsScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDelayedRewardsScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDelayedRewardsScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorDelayedRewardsScheduleRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryValidatorDelayedRewardsScheduleResponse is the response type for the
+// Query/ValidatorDelayedRewardsSchedule RPC method
+type QueryValidatorDelayedRewardsScheduleResponse struct {
+	// due defines the delayed rewards which can be released now.
+	Due github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=due,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"due"`
+	// releases defines the upcoming releases, ordered by release time. The
+	// consecutive releases of the same amount are merged into one entry.
+	Releases []DelayedRewardRelease `protobuf:"bytes,2,rep,name=releases,proto3" json:"releases"`
+}
+
+func (m *QueryValidatorDelayedRewardsScheduleResponse) Reset() {
+	*m = QueryValidatorDelayedRewardsScheduleResponse{}
+}
+func (m *QueryValidatorDelayedRewardsScheduleResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorDelayedRewardsScheduleResponse) ProtoMessage() {}
+func (*QueryValidatorDelayedRewardsScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{11}
+}
+func (m *QueryValidatorDelayedRewardsScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorDelayedRewardsScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorDelayedRewardsScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorDelayedRewardsScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorDelayedRewardsScheduleResponse.Merge(m, src)
+}
+func (m *QueryValidatorDelayedRewardsScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorDelayedRewardsScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorDelayedRewardsScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorDelayedRewardsScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorDelayedRewardsScheduleResponse) GetDue() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Due
+	}
+	return nil
+}
+
+func (m *QueryValidatorDelayedRewardsScheduleResponse) GetReleases() []DelayedRewardRelease {
+	if m != nil {
+		return m.Releases
+	}
+	return nil
+}
+
+// QueryTeamCommissionVestingRequest is the request type for the
+// Query/TeamCommissionVesting RPC method
+type QueryTeamCommissionVestingRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryTeamCommissionVestingRequest) Reset()         { *m = QueryTeamCommissionVestingRequest{} }
+func (m *QueryTeamCommissionVestingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamCommissionVestingRequest) ProtoMessage()    {}
+func (*QueryTeamCommissionVestingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+}
+func (m *QueryTeamCommissionVestingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamCommissionVestingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamCommissionVestingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamCommissionVestingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamCommissionVestingRequest.Merge(m, src)
+}
+func (m *QueryTeamCommissionVestingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamCommissionVestingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamCommissionVestingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamCommissionVestingRequest proto.InternalMessageInfo
+
+func (m *QueryTeamCommissionVestingRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryTeamCommissionVestingResponse is the response type for the
+// Query/TeamCommissionVesting RPC method
+type QueryTeamCommissionVestingResponse struct {
+	// vesting defines the team commission vesting of the validator, it has an
+	// empty vesting type if the team commission does not vest.
+	Vesting TeamCommissionVesting `protobuf:"bytes,1,opt,name=vesting,proto3" json:"vesting"`
+}
+
+func (m *QueryTeamCommissionVestingResponse) Reset()         { *m = QueryTeamCommissionVestingResponse{} }
+func (m *QueryTeamCommissionVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamCommissionVestingResponse) ProtoMessage()    {}
+func (*QueryTeamCommissionVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+}
+func (m *QueryTeamCommissionVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamCommissionVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamCommissionVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamCommissionVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamCommissionVestingResponse.Merge(m, src)
+}
+func (m *QueryTeamCommissionVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamCommissionVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamCommissionVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamCommissionVestingResponse proto.InternalMessageInfo
+
+func (m *QueryTeamCommissionVestingResponse) GetVesting() TeamCommissionVesting {
+	if m != nil {
+		return m.Vesting
+	}
+	return TeamCommissionVesting{}
+}
+
 // QueryDelegationRewardsRequest is the request type for the
 // Query/DelegationRewards RPC method.
 type QueryDelegationRewardsRequest struct {
@@ -532,7 +739,7 @@ func (m *QueryDelegationRewardsRequest) Reset()         { *m = QueryDelegationRe
 func (m *QueryDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{10}
+	return fileDescriptor_5efd02cbc06efdc9, []int{14}
 }
 func (m *QueryDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +781,7 @@ func (m *QueryDelegationRewardsResponse) Reset()         { *m = QueryDelegationR
 func (m *QueryDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{11}
+	return fileDescriptor_5efd02cbc06efdc9, []int{15}
 }
 func (m *QueryDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -617,6 +824,121 @@ func (m *QueryDelegationRewardsResponse) GetRecommandersRewards() github_com_cos
 	return nil
 }
 
+// QueryDelegationRecommanderRewardsBreakdownRequest is the request type for the
+// Query/DelegationRecommanderRewardsBreakdown RPC method.
+type QueryDelegationRecommanderRewardsBreakdownRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) Reset() {
+	*m = QueryDelegationRecommanderRewardsBreakdownRequest{}
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationRecommanderRewardsBreakdownRequest) ProtoMessage() {}
+func (*QueryDelegationRecommanderRewardsBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationRecommanderRewardsBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationRecommanderRewardsBreakdownRequest.Merge(m, src)
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationRecommanderRewardsBreakdownRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationRecommanderRewardsBreakdownRequest proto.InternalMessageInfo
+
+// QueryDelegationRecommanderRewardsBreakdownResponse is the response type for the
+// Query/DelegationRecommanderRewardsBreakdown RPC method.
+type QueryDelegationRecommanderRewardsBreakdownResponse struct {
+	// recommanders defines the rewards each recommander would receive, by level.
+	Recommanders []DelegationRecommanderReward `protobuf:"bytes,1,rep,name=recommanders,proto3" json:"recommanders"`
+	// validator_reward defines the rewards that would go back to the validator operator.
+	ValidatorReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=validator_reward,json=validatorReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"validator_reward"`
+	// remainder defines the decimal remainder that would go to the community pool.
+	Remainder github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"remainder"`
+}
+
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) Reset() {
+	*m = QueryDelegationRecommanderRewardsBreakdownResponse{}
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDelegationRecommanderRewardsBreakdownResponse) ProtoMessage() {}
+func (*QueryDelegationRecommanderRewardsBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationRecommanderRewardsBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationRecommanderRewardsBreakdownResponse.Merge(m, src)
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationRecommanderRewardsBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationRecommanderRewardsBreakdownResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) GetRecommanders() []DelegationRecommanderReward {
+	if m != nil {
+		return m.Recommanders
+	}
+	return nil
+}
+
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) GetValidatorReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ValidatorReward
+	}
+	return nil
+}
+
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) GetRemainder() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Remainder
+	}
+	return nil
+}
+
 // QueryDelegationTotalRewardsRequest is the request type for the
 // Query/DelegationTotalRewards RPC method.
 type QueryDelegationTotalRewardsRequest struct {
@@ -628,7 +950,7 @@ func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegat
 func (m *QueryDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
 }
 func (m *QueryDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,7 +992,7 @@ func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelega
 func (m *QueryDelegationTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
 }
 func (m *QueryDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +1046,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{14}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -764,7 +1086,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{15}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,7 +1126,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDeleg
 func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
 }
 func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,7 +1166,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
 }
 func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -882,7 +1204,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{24}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1244,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{25}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,8 +1291,14 @@ func init() {
 	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesResponse")
 	proto.RegisterType((*QueryValidatorDelayedRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorDelayedRewardsRequest")
 	proto.RegisterType((*QueryValidatorDelayedRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorDelayedRewardsResponse")
+	proto.RegisterType((*QueryValidatorDelayedRewardsScheduleRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorDelayedRewardsScheduleRequest")
+	proto.RegisterType((*QueryValidatorDelayedRewardsScheduleResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorDelayedRewardsScheduleResponse")
+	proto.RegisterType((*QueryTeamCommissionVestingRequest)(nil), "cosmos.distribution.v1beta1.QueryTeamCommissionVestingRequest")
+	proto.RegisterType((*QueryTeamCommissionVestingResponse)(nil), "cosmos.distribution.v1beta1.QueryTeamCommissionVestingResponse")
 	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsRequest")
 	proto.RegisterType((*QueryDelegationRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsResponse")
+	proto.RegisterType((*QueryDelegationRecommanderRewardsBreakdownRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationRecommanderRewardsBreakdownRequest")
+	proto.RegisterType((*QueryDelegationRecommanderRewardsBreakdownResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationRecommanderRewardsBreakdownResponse")
 	proto.RegisterType((*QueryDelegationTotalRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsRequest")
 	proto.RegisterType((*QueryDelegationTotalRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegationTotalRewardsResponse")
 	proto.RegisterType((*QueryDelegatorValidatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsRequest")
@@ -986,83 +1314,101 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xb8, 0x69, 0x4a, 0x5f, 0x29, 0x4d, 0x26, 0x11, 0x84, 0x4d, 0xb0, 0xa3, 0x4d, 0x69,
-	0x02, 0x2d, 0xde, 0x26, 0x41, 0x05, 0x5a, 0x10, 0x4d, 0xea, 0xb6, 0x20, 0x42, 0x13, 0xdc, 0x12,
-	0xa2, 0x02, 0x0a, 0x13, 0xef, 0x68, 0xbd, 0xaa, 0xbd, 0xe3, 0xee, 0xac, 0x13, 0x42, 0xd5, 0x0b,
-	0x0d, 0x12, 0x47, 0x24, 0x38, 0x00, 0xa7, 0x9c, 0xf9, 0x03, 0x38, 0x70, 0xe6, 0xd0, 0x63, 0x25,
-	0x0e, 0xf4, 0x04, 0x28, 0x41, 0x08, 0x84, 0xc4, 0x89, 0x03, 0x47, 0xe4, 0xd9, 0xd9, 0xf5, 0xae,
-	0xbd, 0x5e, 0xff, 0xd8, 0xe6, 0x14, 0xeb, 0xcd, 0xbc, 0xef, 0xbd, 0xef, 0xdb, 0x37, 0x33, 0x9f,
-	0x02, 0xd3, 0x45, 0xc6, 0x2b, 0x8c, 0x6b, 0xba, 0xc9, 0x1d, 0xdb, 0xdc, 0xa8, 0x39, 0x26, 0xb3,
-	0xb4, 0xcd, 0xd9, 0x0d, 0xea, 0x90, 0x59, 0xed, 0x76, 0x8d, 0xda, 0xdb, 0xb9, 0xaa, 0xcd, 0x1c,
-	0x86, 0xc7, 0xdd, 0x8d, 0xb9, 0xe0, 0xc6, 0x9c, 0xdc, 0xa8, 0x3c, 0x2f, 0x51, 0x36, 0x08, 0xa7,
-	0x6e, 0x96, 0x8f, 0x51, 0x25, 0x86, 0x69, 0x11, 0xb1, 0x5b, 0x00, 0x29, 0xa3, 0x06, 0x33, 0x98,
-	0xf8, 0xa9, 0xd5, 0x7f, 0xc9, 0xe8, 0x84, 0xc1, 0x98, 0x51, 0xa6, 0x1a, 0xa9, 0x9a, 0x1a, 0xb1,
-	0x2c, 0xe6, 0x88, 0x14, 0x2e, 0x57, 0x33, 0x41, 0x7c, 0x0f, 0xb9, 0xc8, 0x4c, 0x0f, 0x33, 0x17,
-	0xc7, 0x22, 0xd4, 0xb1, 0xd8, 0xaf, 0x8e, 0x02, 0x7e, 0xa7, 0xde, 0xe5, 0x0a, 0xb1, 0x49, 0x85,
-	0x17, 0xe8, 0xed, 0x1a, 0xe5, 0x8e, 0xba, 0x06, 0x23, 0xa1, 0x28, 0xaf, 0x32, 0x8b, 0x53, 0xbc,
-	0x00, 0x83, 0x55, 0x11, 0x19, 0x43, 0x93, 0x68, 0xe6, 0xd8, 0xdc, 0x54, 0x2e, 0x46, 0x8a, 0x9c,
-	0x9b, 0xbc, 0x38, 0x70, 0xff, 0x97, 0x6c, 0xaa, 0x20, 0x13, 0xd5, 0x55, 0x98, 0x16, 0xc8, 0xab,
-	0xa4, 0x6c, 0xea, 0xc4, 0x61, 0xf6, 0x72, 0xcd, 0xe1, 0x0e, 0xb1, 0x74, 0xd3, 0x32, 0x0a, 0x74,
-	0x8b, 0xd8, 0xba, 0xd7, 0x04, 0x3e, 0x0d, 0xc3, 0x9b, 0xde, 0xae, 0x75, 0xa2, 0xeb, 0x36, 0xe5,
-	0x6e, 0xe1, 0xa3, 0x85, 0x21, 0x7f, 0x61, 0xc1, 0x8d, 0xab, 0x3b, 0x08, 0x66, 0x3a, 0x03, 0x4b,
-	0x1e, 0x6b, 0x70, 0xc4, 0x76, 0x43, 0x92, 0xc8, 0xcb, 0xb1, 0x44, 0x62, 0x20, 0x25, 0x3b, 0x0f,
-	0x4e, 0xbd, 0x06, 0xd9, 0x70, 0x17, 0x97, 0x58, 0xa5, 0x62, 0x72, 0x6e, 0x32, 0xab, 0x2f, 0x5a,
-	0x9f, 0x21, 0x98, 0x6c, 0x0f, 0x28, 0xe9, 0x10, 0x80, 0xa2, 0x1f, 0x95, 0x8c, 0x2e, 0x74, 0xc7,
-	0x68, 0xa1, 0x58, 0xac, 0x55, 0x6a, 0x65, 0xe2, 0x50, 0xbd, 0x01, 0x2c, 0x49, 0x05, 0x40, 0xd5,
-	0xbf, 0x11, 0x4c, 0x84, 0xfb, 0xb8, 0x5e, 0x26, 0xbc, 0x44, 0xfb, 0xfa, 0x58, 0x78, 0x1a, 0x4e,
-	0x70, 0x87, 0xd8, 0x8e, 0x69, 0x19, 0xeb, 0x25, 0x6a, 0x1a, 0x25, 0x67, 0x2c, 0x3d, 0x89, 0x66,
-	0x06, 0x0a, 0x4f, 0x78, 0xe1, 0x37, 0x44, 0x14, 0x4f, 0xc1, 0x71, 0x2a, 0xe4, 0xf6, 0xb6, 0x1d,
-	0x12, 0xdb, 0x1e, 0x77, 0x83, 0x72, 0xd3, 0x15, 0x80, 0xc6, 0xd1, 0x1a, 0x1b, 0x10, 0xf4, 0x4f,
-	0x79, 0xf4, 0xeb, 0xe7, 0x24, 0xe7, 0x9e, 0xde, 0xc6, 0x5c, 0x1a, 0x54, 0xb6, 0x5d, 0x08, 0x64,
-	0x9e, 0x7f, 0xec, 0xf3, 0xdd, 0x6c, 0xea, 0xeb, 0xdd, 0x2c, 0x52, 0x7f, 0x40, 0xf0, 0x4c, 0x1b,
-	0xb6, 0x52, 0xf2, 0x15, 0x38, 0xc2, 0xdd, 0xd0, 0x18, 0x9a, 0x3c, 0x34, 0x73, 0x6c, 0xee, 0x6c,
-	0x77, 0x7a, 0x0b, 0x9c, 0xcb, 0x9b, 0xd4, 0x72, 0xbc, 0xc9, 0x91, 0x30, 0xf8, 0x6a, 0x88, 0x45,
-	0x5a, 0xb0, 0x98, 0xee, 0xc8, 0xc2, 0x6d, 0x27, 0x48, 0x43, 0xfd, 0x16, 0xc1, 0x54, 0xb8, 0xf9,
-	0x3c, 0x2d, 0x93, 0x6d, 0xaa, 0x27, 0x38, 0x5e, 0x4d, 0x1a, 0xa7, 0xfb, 0xd5, 0x58, 0xfd, 0x11,
-	0xc1, 0xc9, 0xf8, 0xe6, 0xa4, 0xc0, 0xd7, 0x83, 0x47, 0xb4, 0x2e, 0xf0, 0x7c, 0x77, 0x02, 0x87,
-	0xe0, 0x9a, 0x4e, 0xe7, 0xa3, 0xd3, 0xf8, 0x9e, 0x37, 0x20, 0x79, 0x5a, 0xa6, 0x86, 0x88, 0xb5,
-	0xaa, 0xab, 0xbb, 0x6b, 0xad, 0xea, 0xfa, 0x0b, 0x9e, 0xba, 0x91, 0x9f, 0x22, 0x1d, 0xfd, 0x29,
-	0xdc, 0x31, 0xfd, 0x73, 0x37, 0x9b, 0x52, 0xbf, 0x4a, 0x43, 0xa6, 0x5d, 0x17, 0x52, 0xc6, 0x5b,
-	0xcd, 0x32, 0x4e, 0x84, 0xe8, 0x7a, 0x44, 0xf3, 0xb4, 0x78, 0x89, 0x99, 0xd6, 0xe2, 0x7c, 0x5d,
-	0xaf, 0xef, 0x7e, 0xcd, 0x9e, 0x36, 0x4c, 0xa7, 0x54, 0xdb, 0xc8, 0x15, 0x59, 0x45, 0x93, 0x0f,
-	0x8a, 0xfb, 0xe7, 0x05, 0xae, 0xdf, 0xd2, 0x9c, 0xed, 0x2a, 0xe5, 0x5e, 0x0e, 0x6f, 0xc8, 0xbb,
-	0x83, 0x60, 0xd4, 0xa6, 0xf5, 0x5b, 0x83, 0x58, 0x3a, 0xb5, 0xf9, 0xba, 0x57, 0x3a, 0x7d, 0x50,
-	0xa5, 0x47, 0x82, 0xe5, 0x24, 0x77, 0xf5, 0x7d, 0x50, 0x9b, 0x54, 0xb9, 0xc1, 0x1c, 0x52, 0x4e,
-	0xf0, 0x81, 0x02, 0x9a, 0xff, 0xe1, 0x9d, 0xae, 0x76, 0xe8, 0x52, 0xf8, 0xd5, 0x66, 0xe1, 0xcf,
-	0xc5, 0xce, 0x6f, 0x03, 0x2d, 0xef, 0xd5, 0x8e, 0x1e, 0x61, 0x03, 0x0e, 0x3b, 0xf5, 0x7a, 0x07,
-	0xa7, 0xa9, 0x8b, 0xaf, 0xae, 0xc9, 0x97, 0xcc, 0xef, 0xc7, 0x3f, 0x62, 0x49, 0x25, 0x5c, 0x92,
-	0x4f, 0x5a, 0x24, 0xb2, 0x94, 0x2f, 0x03, 0xe0, 0x0f, 0xbe, 0xab, 0xe0, 0xd1, 0x42, 0x20, 0x12,
-	0x40, 0xfb, 0x50, 0x5e, 0x28, 0x3e, 0xda, 0x7b, 0xa6, 0x53, 0xd2, 0x6d, 0xb2, 0x25, 0x0b, 0x27,
-	0x6c, 0xf6, 0x03, 0x78, 0xb6, 0x03, 0xbc, 0xec, 0xf8, 0x39, 0x18, 0xda, 0x92, 0x4b, 0x4d, 0xf0,
-	0x27, 0xb6, 0xc2, 0x29, 0x01, 0xf4, 0x71, 0x78, 0x5a, 0xa0, 0xd7, 0xdf, 0xde, 0x9a, 0x65, 0x3a,
-	0xdb, 0x2b, 0x8c, 0x95, 0x3d, 0x13, 0x76, 0x0f, 0x81, 0x12, 0xb5, 0x2a, 0x0b, 0x52, 0x18, 0xa8,
-	0x32, 0x56, 0x3e, 0xb8, 0x73, 0x2d, 0xe0, 0xe7, 0x1e, 0x0e, 0xc3, 0x61, 0xd1, 0x05, 0xfe, 0x06,
-	0xc1, 0xa0, 0xeb, 0xe9, 0xb0, 0x16, 0x3b, 0xcc, 0xad, 0x86, 0x52, 0x39, 0xdb, 0x7d, 0x82, 0x4b,
-	0x4f, 0x3d, 0xf3, 0xe9, 0x4f, 0xbf, 0x7f, 0x99, 0x3e, 0x85, 0x4f, 0x6a, 0x66, 0xb1, 0x5a, 0x26,
-	0x9f, 0x90, 0x68, 0x4b, 0xeb, 0xda, 0x4a, 0xbc, 0x93, 0x86, 0xf1, 0x18, 0x9b, 0x86, 0xf3, 0x9d,
-	0xeb, 0x77, 0x76, 0xa4, 0xca, 0xe5, 0x84, 0x28, 0x92, 0xda, 0x9a, 0xa0, 0x56, 0xc0, 0x2b, 0xf1,
-	0xd4, 0x1a, 0xe3, 0xae, 0xdd, 0x69, 0x79, 0x1e, 0xee, 0x6a, 0xac, 0x51, 0xc0, 0xbb, 0x68, 0xf1,
-	0x3e, 0x82, 0x91, 0x08, 0xa7, 0x88, 0x5f, 0xed, 0xa1, 0xf1, 0x16, 0xc7, 0xaa, 0xbc, 0xd6, 0x67,
-	0xb6, 0xa4, 0xbb, 0x2c, 0xe8, 0xbe, 0x89, 0xaf, 0x26, 0xa2, 0xdb, 0x30, 0xa3, 0xf8, 0x67, 0x04,
-	0x43, 0xcd, 0xce, 0x0c, 0xbf, 0xd2, 0x43, 0x93, 0x61, 0xef, 0xaa, 0x9c, 0xef, 0x27, 0x55, 0x92,
-	0x5b, 0x12, 0xe4, 0xae, 0xe0, 0x7c, 0x22, 0x72, 0x9e, 0x09, 0xfc, 0x17, 0xc1, 0x53, 0x6d, 0x9c,
-	0x11, 0xbe, 0xd8, 0x43, 0x97, 0x91, 0x8e, 0x4f, 0x59, 0x48, 0x80, 0x20, 0xe9, 0xde, 0x10, 0x74,
-	0xaf, 0xe1, 0xa5, 0x44, 0x74, 0x75, 0x17, 0xdc, 0x1f, 0xdb, 0x7f, 0x10, 0x0c, 0xb7, 0x78, 0x18,
-	0xdc, 0xc5, 0x67, 0x69, 0x67, 0xbf, 0x94, 0x0b, 0x7d, 0xe5, 0x4a, 0x92, 0x1f, 0x09, 0x92, 0x37,
-	0xf1, 0x5a, 0x3c, 0x49, 0xff, 0xd5, 0xe0, 0xda, 0x9d, 0x96, 0xa7, 0xe5, 0xae, 0x26, 0xc9, 0x45,
-	0x09, 0x80, 0xff, 0x42, 0xf0, 0x64, 0xb4, 0x81, 0xc0, 0xaf, 0xf7, 0xd2, 0x79, 0x84, 0xb1, 0x51,
-	0x2e, 0xf6, 0x0f, 0xd0, 0xdb, 0x4c, 0x77, 0xc7, 0x5f, 0xdc, 0x49, 0x11, 0x4f, 0x7d, 0x37, 0x77,
-	0x52, 0x7b, 0xef, 0xd1, 0xcd, 0x9d, 0x14, 0xe3, 0x2f, 0xba, 0xbd, 0x93, 0x3a, 0x50, 0x6c, 0x8c,
-	0x39, 0xfe, 0x0f, 0xc1, 0x58, 0x3b, 0x8f, 0x80, 0x17, 0x7a, 0x68, 0x36, 0xda, 0xbe, 0x28, 0x8b,
-	0x49, 0x20, 0x24, 0xe9, 0x77, 0x05, 0xe9, 0x65, 0xfc, 0x76, 0x22, 0xd2, 0xcd, 0x2e, 0x07, 0x7f,
-	0x8f, 0xe0, 0x78, 0xc8, 0xa2, 0xe0, 0x73, 0x9d, 0x9b, 0x8d, 0x72, 0x3c, 0xca, 0x4b, 0x3d, 0xe7,
-	0x49, 0x66, 0x2f, 0x0a, 0x66, 0x39, 0x7c, 0x26, 0x9e, 0x59, 0xd1, 0x4b, 0x5e, 0xaf, 0x5b, 0x9b,
-	0xc5, 0xb7, 0xee, 0xef, 0x65, 0xd0, 0x83, 0xbd, 0x0c, 0xfa, 0x6d, 0x2f, 0x83, 0xbe, 0xd8, 0xcf,
-	0xa4, 0x1e, 0xec, 0x67, 0x52, 0x0f, 0xf7, 0x33, 0xa9, 0x9b, 0xb3, 0xb1, 0x3e, 0xe9, 0xe3, 0x30,
-	0xba, 0xb0, 0x4d, 0x1b, 0x83, 0xe2, 0xff, 0x69, 0xf3, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf0,
-	0xca, 0xc9, 0x6e, 0x47, 0x14, 0x00, 0x00,
+	// 1502 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdd, 0x6f, 0x14, 0x55,
+	0x14, 0xef, 0xdd, 0x96, 0xaf, 0x03, 0x08, 0x5c, 0x50, 0x97, 0x01, 0x77, 0xeb, 0xf0, 0xd1, 0x2a,
+	0xb8, 0x43, 0x8b, 0x41, 0x04, 0x11, 0x5a, 0xca, 0x57, 0x44, 0x5a, 0xb7, 0xa5, 0x34, 0x08, 0xa9,
+	0xb7, 0x3b, 0x37, 0xdb, 0x09, 0xbb, 0x33, 0xcb, 0xdc, 0xd9, 0x96, 0x4a, 0x78, 0x50, 0x30, 0xf1,
+	0xd1, 0x44, 0x8d, 0x1f, 0x4f, 0x24, 0xbe, 0xf9, 0x07, 0x68, 0xe2, 0xb3, 0x0f, 0x3c, 0x92, 0xf8,
+	0xa0, 0x4f, 0x6a, 0x5a, 0x63, 0x30, 0x26, 0x3e, 0x19, 0xe3, 0xa3, 0xd9, 0x3b, 0x67, 0x66, 0x67,
+	0x76, 0x67, 0x67, 0xbf, 0x5a, 0x9f, 0xba, 0xb9, 0x73, 0xcf, 0xef, 0x9c, 0xdf, 0x39, 0xf7, 0xdc,
+	0x73, 0x7f, 0x29, 0x0c, 0xe4, 0x2c, 0x51, 0xb4, 0x84, 0xa6, 0x1b, 0xc2, 0xb1, 0x8d, 0xb9, 0xb2,
+	0x63, 0x58, 0xa6, 0xb6, 0x30, 0x34, 0xc7, 0x1d, 0x36, 0xa4, 0xdd, 0x2e, 0x73, 0x7b, 0x29, 0x53,
+	0xb2, 0x2d, 0xc7, 0xa2, 0x7b, 0xdc, 0x8d, 0x99, 0xe0, 0xc6, 0x0c, 0x6e, 0x54, 0x5e, 0x44, 0x94,
+	0x39, 0x26, 0xb8, 0x6b, 0xe5, 0x63, 0x94, 0x58, 0xde, 0x30, 0x99, 0xdc, 0x2d, 0x81, 0x94, 0x5d,
+	0x79, 0x2b, 0x6f, 0xc9, 0x9f, 0x5a, 0xe5, 0x17, 0xae, 0xee, 0xcd, 0x5b, 0x56, 0xbe, 0xc0, 0x35,
+	0x56, 0x32, 0x34, 0x66, 0x9a, 0x96, 0x23, 0x4d, 0x04, 0x7e, 0x4d, 0x05, 0xf1, 0x3d, 0xe4, 0x9c,
+	0x65, 0x78, 0x98, 0x99, 0x38, 0x16, 0xa1, 0x88, 0xe5, 0x7e, 0x75, 0x17, 0xd0, 0xb7, 0x2a, 0x51,
+	0x4e, 0x30, 0x9b, 0x15, 0x45, 0x96, 0xdf, 0x2e, 0x73, 0xe1, 0xa8, 0x33, 0xb0, 0x33, 0xb4, 0x2a,
+	0x4a, 0x96, 0x29, 0x38, 0x1d, 0x81, 0xf5, 0x25, 0xb9, 0x92, 0x24, 0xfd, 0x64, 0x70, 0xf3, 0xf0,
+	0xbe, 0x4c, 0x4c, 0x2a, 0x32, 0xae, 0xf1, 0x68, 0xdf, 0xa3, 0x9f, 0xd3, 0x3d, 0x59, 0x34, 0x54,
+	0xa7, 0x61, 0x40, 0x22, 0x4f, 0xb3, 0x82, 0xa1, 0x33, 0xc7, 0xb2, 0xc7, 0xcb, 0x8e, 0x70, 0x98,
+	0xa9, 0x1b, 0x66, 0x3e, 0xcb, 0x17, 0x99, 0xad, 0x7b, 0x41, 0xd0, 0x43, 0xb0, 0x63, 0xc1, 0xdb,
+	0x35, 0xcb, 0x74, 0xdd, 0xe6, 0xc2, 0x75, 0xbc, 0x29, 0xbb, 0xdd, 0xff, 0x30, 0xe2, 0xae, 0xab,
+	0x0f, 0x08, 0x0c, 0x36, 0x07, 0x46, 0x1e, 0x33, 0xb0, 0xc1, 0x76, 0x97, 0x90, 0xc8, 0xf1, 0x58,
+	0x22, 0x31, 0x90, 0xc8, 0xce, 0x83, 0x53, 0xaf, 0x40, 0x3a, 0x1c, 0xc5, 0x59, 0xab, 0x58, 0x34,
+	0x84, 0x30, 0x2c, 0xb3, 0x23, 0x5a, 0x1f, 0x10, 0xe8, 0x6f, 0x0c, 0x88, 0x74, 0x18, 0x40, 0xce,
+	0x5f, 0x45, 0x46, 0x27, 0x5b, 0x63, 0x34, 0x92, 0xcb, 0x95, 0x8b, 0xe5, 0x02, 0x73, 0xb8, 0x5e,
+	0x05, 0x46, 0x52, 0x01, 0x50, 0xf5, 0x4f, 0x02, 0x7b, 0xc3, 0x71, 0x4c, 0x16, 0x98, 0x98, 0xe7,
+	0x1d, 0x15, 0x8b, 0x0e, 0xc0, 0x36, 0xe1, 0x30, 0xdb, 0x31, 0xcc, 0xfc, 0xec, 0x3c, 0x37, 0xf2,
+	0xf3, 0x4e, 0x32, 0xd1, 0x4f, 0x06, 0xfb, 0xb2, 0x4f, 0x79, 0xcb, 0x17, 0xe5, 0x2a, 0xdd, 0x07,
+	0x5b, 0xb9, 0xa9, 0x07, 0xb6, 0xf5, 0xca, 0x6d, 0x5b, 0xdc, 0x45, 0xdc, 0x74, 0x1e, 0xa0, 0xda,
+	0x5a, 0xc9, 0x3e, 0x49, 0xff, 0xa0, 0x47, 0xbf, 0xd2, 0x27, 0x19, 0xb7, 0x7b, 0xab, 0xe7, 0x32,
+	0xcf, 0x31, 0xec, 0x6c, 0xc0, 0xf2, 0xc4, 0xc6, 0x0f, 0x1f, 0xa6, 0x7b, 0x3e, 0x7f, 0x98, 0x26,
+	0xea, 0x77, 0x04, 0x9e, 0x6b, 0xc0, 0x16, 0x53, 0x3e, 0x01, 0x1b, 0x84, 0xbb, 0x94, 0x24, 0xfd,
+	0xbd, 0x83, 0x9b, 0x87, 0x8f, 0xb4, 0x96, 0x6f, 0x89, 0x73, 0x6e, 0x81, 0x9b, 0x8e, 0x77, 0x72,
+	0x10, 0x86, 0x5e, 0x08, 0xb1, 0x48, 0x48, 0x16, 0x03, 0x4d, 0x59, 0xb8, 0xe1, 0x04, 0x69, 0xa8,
+	0x5f, 0x12, 0xd8, 0x17, 0x0e, 0x7e, 0x8c, 0x17, 0xd8, 0x12, 0xd7, 0xbb, 0x68, 0x2f, 0x7a, 0x3e,
+	0x22, 0xba, 0x0e, 0x72, 0xac, 0x7e, 0x4f, 0x60, 0x7f, 0x7c, 0x70, 0x98, 0xe0, 0xc9, 0x60, 0x8b,
+	0x56, 0x12, 0x7c, 0xb4, 0xb5, 0x04, 0x87, 0xe0, 0x6a, 0xba, 0x73, 0xf5, 0x72, 0x7c, 0x1d, 0x0e,
+	0xc5, 0xb1, 0x98, 0xcc, 0xcd, 0x73, 0xbd, 0x5c, 0xe0, 0x1d, 0xb5, 0xfc, 0x13, 0x02, 0x87, 0x5b,
+	0x03, 0xc7, 0x54, 0xe5, 0xa0, 0x57, 0x2f, 0x73, 0x4c, 0xd3, 0xde, 0x10, 0x1d, 0x8f, 0xc8, 0x18,
+	0xcf, 0x9d, 0xb5, 0x0c, 0x73, 0xf4, 0x68, 0x25, 0x1f, 0x5f, 0xff, 0x92, 0x3e, 0x94, 0x37, 0x9c,
+	0xf9, 0xf2, 0x5c, 0x26, 0x67, 0x15, 0x35, 0x1c, 0x18, 0xee, 0x9f, 0x97, 0x84, 0x7e, 0x4b, 0x73,
+	0x96, 0x4a, 0x5c, 0x78, 0x36, 0x22, 0x5b, 0x41, 0xa7, 0x93, 0xb0, 0xd1, 0xe6, 0x05, 0xce, 0x04,
+	0x17, 0xc9, 0x84, 0xf4, 0x34, 0x14, 0x5b, 0x90, 0x50, 0xcc, 0x59, 0xd7, 0x12, 0xcb, 0xe1, 0x03,
+	0xa9, 0x13, 0xf0, 0xbc, 0x64, 0x3a, 0xc5, 0x59, 0xb1, 0x7a, 0xfd, 0x4c, 0x73, 0xe1, 0xc8, 0x1b,
+	0xb6, 0x83, 0xe4, 0xdd, 0x01, 0x35, 0x0e, 0x11, 0x33, 0x96, 0x85, 0x0d, 0x0b, 0xee, 0x12, 0xde,
+	0x96, 0xc3, 0xb1, 0x5c, 0x22, 0xc1, 0xbc, 0xb3, 0x85, 0x40, 0xea, 0x7d, 0xef, 0xce, 0x18, 0xe3,
+	0x05, 0x9e, 0x97, 0xc7, 0xa4, 0xbe, 0xe1, 0x74, 0xf7, 0x5b, 0x3d, 0x11, 0xff, 0x83, 0xd7, 0x70,
+	0x91, 0xac, 0x13, 0xd1, 0xac, 0xdd, 0x9b, 0xeb, 0xc9, 0xc3, 0x74, 0x8f, 0xfa, 0x49, 0x02, 0x52,
+	0x8d, 0xa2, 0x40, 0xf2, 0xb7, 0x6a, 0x3b, 0x6b, 0x0d, 0x8e, 0x8c, 0xdf, 0x71, 0x0f, 0x08, 0xec,
+	0xb2, 0x79, 0x65, 0x90, 0x30, 0x53, 0xe7, 0xb6, 0x98, 0xf5, 0x5c, 0x27, 0xd6, 0xca, 0xf5, 0xce,
+	0xa0, 0x3b, 0xe4, 0xae, 0x7e, 0x46, 0x60, 0xa8, 0x2e, 0x2d, 0xfe, 0x36, 0x6f, 0x96, 0xdb, 0x9c,
+	0xdd, 0xd2, 0xad, 0x45, 0xf3, 0xff, 0x2c, 0xd8, 0x7b, 0xbd, 0x30, 0xdc, 0x4e, 0x64, 0x58, 0xc4,
+	0x39, 0xd8, 0x12, 0xe4, 0x89, 0x95, 0x3c, 0xde, 0xac, 0x25, 0x1b, 0x79, 0xc0, 0xc3, 0x1c, 0xc2,
+	0xa4, 0x0b, 0x50, 0x0d, 0x1c, 0xeb, 0x86, 0x65, 0xdb, 0x1d, 0x59, 0x36, 0x59, 0xb3, 0x23, 0x58,
+	0xb3, 0xc1, 0x16, 0x6a, 0xe6, 0x16, 0x6c, 0x9b, 0xef, 0xc4, 0x8d, 0x85, 0x5a, 0xb0, 0xc9, 0xe6,
+	0x45, 0x66, 0x54, 0xa2, 0x48, 0xf6, 0xae, 0xd5, 0x39, 0xa9, 0xfa, 0x50, 0xdf, 0xc6, 0x4b, 0xa3,
+	0x9a, 0xa0, 0x29, 0xcb, 0x61, 0x85, 0x2e, 0xda, 0x37, 0x50, 0xe0, 0xdf, 0xbd, 0x71, 0xdc, 0x08,
+	0x1d, 0x2b, 0x3a, 0x5d, 0xdb, 0x96, 0xc7, 0x5a, 0x2c, 0xe6, 0x98, 0xe7, 0x3b, 0x7a, 0xe6, 0xe5,
+	0x61, 0x9d, 0x53, 0xf1, 0xb7, 0x76, 0x1d, 0xe7, 0xe2, 0xab, 0x33, 0xf8, 0xf4, 0xf5, 0xe3, 0xf1,
+	0xe7, 0x57, 0xb7, 0x29, 0xbc, 0x0c, 0xfd, 0x8d, 0x91, 0x31, 0x7d, 0x29, 0x00, 0xff, 0x1c, 0xb9,
+	0x19, 0xdc, 0x94, 0x0d, 0xac, 0x04, 0xd0, 0x6e, 0xc2, 0xfe, 0x30, 0xda, 0x35, 0xc3, 0x99, 0xd7,
+	0x6d, 0xb6, 0x88, 0x8e, 0xbb, 0x0c, 0xf6, 0x06, 0x1c, 0x68, 0x02, 0x8f, 0x11, 0xbf, 0x00, 0xdb,
+	0x17, 0xf1, 0x53, 0x0d, 0xfc, 0xb6, 0xc5, 0xb0, 0x49, 0x00, 0x7d, 0x0f, 0xec, 0x96, 0xe8, 0x95,
+	0x71, 0x54, 0x36, 0x0d, 0x67, 0x69, 0xc2, 0xb2, 0x0a, 0x9e, 0x6a, 0xbb, 0x4f, 0x40, 0x89, 0xfa,
+	0x8a, 0x0e, 0x39, 0xf4, 0x95, 0x2c, 0xab, 0xb0, 0x76, 0xb7, 0xbe, 0x84, 0x1f, 0xfe, 0x2a, 0x09,
+	0xeb, 0x64, 0x14, 0xf4, 0x0b, 0x02, 0xeb, 0x5d, 0x11, 0x48, 0xb5, 0xd8, 0xc3, 0x5c, 0xaf, 0x40,
+	0x95, 0x23, 0xad, 0x1b, 0xb8, 0xf4, 0xd4, 0xc3, 0xef, 0xff, 0xf0, 0xdb, 0xc7, 0x89, 0x83, 0x74,
+	0xbf, 0x66, 0xe4, 0x4a, 0x05, 0xf6, 0x2e, 0x8b, 0xd6, 0xc0, 0xae, 0x0e, 0xa5, 0x0f, 0x12, 0xb0,
+	0x27, 0x46, 0xd7, 0xd1, 0xb1, 0xe6, 0xfe, 0x9b, 0x4b, 0x58, 0xe5, 0x5c, 0x97, 0x28, 0x48, 0x6d,
+	0x46, 0x52, 0xcb, 0xd2, 0x89, 0x78, 0x6a, 0xd5, 0xe3, 0xae, 0xdd, 0xad, 0x9b, 0x45, 0xf7, 0x34,
+	0xab, 0xea, 0xc0, 0x1b, 0xc3, 0x74, 0x85, 0xc0, 0xce, 0x08, 0x69, 0x49, 0x5f, 0x6b, 0x23, 0xf0,
+	0x3a, 0x89, 0xab, 0x9c, 0xea, 0xd0, 0x1a, 0xe9, 0x8e, 0x4b, 0xba, 0x97, 0xe8, 0x85, 0xae, 0xe8,
+	0x56, 0xd5, 0x2b, 0xfd, 0x91, 0xc0, 0xf6, 0x5a, 0x29, 0x47, 0x5f, 0x6d, 0x23, 0xc8, 0xb0, 0xd8,
+	0x55, 0x4e, 0x74, 0x62, 0x8a, 0xe4, 0x2e, 0x4b, 0x72, 0xe7, 0xe9, 0x58, 0x57, 0xe4, 0x3c, 0xd5,
+	0xf8, 0x37, 0x81, 0x67, 0x1b, 0xe8, 0x04, 0x7a, 0xa6, 0x8d, 0x28, 0x23, 0x25, 0xa2, 0x32, 0xd2,
+	0x05, 0x02, 0xd2, 0x9d, 0x92, 0x74, 0xaf, 0xd0, 0xcb, 0x5d, 0xd1, 0xd5, 0x5d, 0x70, 0xff, 0xd8,
+	0x7e, 0x9a, 0x80, 0x74, 0x13, 0x79, 0x44, 0x2f, 0x76, 0x1c, 0x7c, 0x8d, 0x7c, 0x53, 0x2e, 0xad,
+	0x02, 0x12, 0xa6, 0xe3, 0xa6, 0x4c, 0xc7, 0x35, 0x7a, 0x75, 0x35, 0xd3, 0xa1, 0x09, 0x8f, 0xf3,
+	0x3f, 0x04, 0x9e, 0x8e, 0x54, 0x2b, 0xf4, 0xf5, 0xe6, 0x1c, 0xe2, 0x54, 0x98, 0x72, 0xba, 0x63,
+	0x7b, 0x64, 0x7e, 0x43, 0x32, 0x9f, 0xa6, 0x53, 0x5d, 0x31, 0x77, 0x38, 0x2b, 0xce, 0x56, 0x3b,
+	0x7b, 0x16, 0xd5, 0x17, 0xfd, 0x8b, 0xc0, 0x8e, 0x3a, 0xc9, 0x43, 0x5b, 0xe8, 0xd3, 0x46, 0x6a,
+	0x4d, 0x39, 0xd9, 0x91, 0x2d, 0x92, 0x7d, 0x47, 0x92, 0xbd, 0x4e, 0x67, 0xe2, 0xc9, 0xfa, 0xcf,
+	0x08, 0xa1, 0xdd, 0xad, 0x7b, 0x6b, 0xdc, 0xd3, 0xbc, 0xf2, 0x46, 0x24, 0x82, 0x7e, 0x9b, 0x80,
+	0x03, 0x2d, 0x49, 0x06, 0x7a, 0xa5, 0x3d, 0x22, 0xcd, 0x54, 0x91, 0x32, 0xbe, 0x6a, 0x78, 0x98,
+	0xac, 0xa2, 0x4c, 0x56, 0x9e, 0xf2, 0xb5, 0x4a, 0x96, 0x16, 0x92, 0x35, 0x7f, 0x10, 0x78, 0x26,
+	0xfa, 0x2d, 0x4e, 0x4f, 0xb7, 0x43, 0x2d, 0x42, 0x23, 0x28, 0x67, 0x3a, 0x07, 0x68, 0x6f, 0x3c,
+	0xb4, 0x96, 0x0c, 0x39, 0xde, 0x23, 0x5e, 0xcd, 0xad, 0x8c, 0xf7, 0xc6, 0xcf, 0x78, 0xe5, 0x54,
+	0x87, 0xd6, 0xed, 0x8d, 0xf7, 0x26, 0x14, 0xab, 0x17, 0x05, 0xfd, 0x97, 0x40, 0xb2, 0xd1, 0x73,
+	0x9b, 0x8e, 0xb4, 0x11, 0x6c, 0xb4, 0x12, 0x50, 0x46, 0xbb, 0x81, 0x40, 0xd2, 0x57, 0x25, 0xe9,
+	0x71, 0xfa, 0x66, 0x57, 0xa4, 0x6b, 0x05, 0x03, 0xfd, 0x86, 0xc0, 0xd6, 0xd0, 0x6b, 0x9f, 0x1e,
+	0x6b, 0x1e, 0x6c, 0x94, 0x78, 0x50, 0x5e, 0x69, 0xdb, 0x0e, 0x99, 0xbd, 0x2c, 0x99, 0x65, 0xe8,
+	0xe1, 0x78, 0x66, 0x39, 0xcf, 0x78, 0xb6, 0xa2, 0x12, 0x46, 0xdf, 0x78, 0xb4, 0x9c, 0x22, 0x8f,
+	0x97, 0x53, 0xe4, 0xd7, 0xe5, 0x14, 0xf9, 0x68, 0x25, 0xd5, 0xf3, 0x78, 0x25, 0xd5, 0xf3, 0xd3,
+	0x4a, 0xaa, 0xe7, 0xfa, 0x50, 0xac, 0xe4, 0xb8, 0x13, 0x46, 0x97, 0x0a, 0x64, 0x6e, 0xbd, 0xfc,
+	0x5f, 0xd6, 0xd1, 0xff, 0x06, 0x00, 0xc2, 0x83, 0xa5, 0xa5, 0xc3, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1087,8 +1433,16 @@ type QueryClient interface {
 	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
 	// ValidatorDelayedRewards queries the total rewards accrued by a validator.
 	ValidatorDelayedRewards(ctx context.Context, in *QueryValidatorDelayedRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorDelayedRewardsResponse, error)
+	// ValidatorDelayedRewardsSchedule queries the projected release schedule of
+	// the delayed rewards of a validator.
+	ValidatorDelayedRewardsSchedule(ctx context.Context, in *QueryValidatorDelayedRewardsScheduleRequest, opts ...grpc.CallOption) (*QueryValidatorDelayedRewardsScheduleResponse, error)
+	// TeamCommissionVesting queries the team commission vesting of a validator.
+	TeamCommissionVesting(ctx context.Context, in *QueryTeamCommissionVestingRequest, opts ...grpc.CallOption) (*QueryTeamCommissionVestingResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
 	DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error)
+	// DelegationRecommanderRewardsBreakdown queries how the recommanders rewards
+	// accrued by a delegation would be split along its recommander chain.
+	DelegationRecommanderRewardsBreakdown(ctx context.Context, in *QueryDelegationRecommanderRewardsBreakdownRequest, opts ...grpc.CallOption) (*QueryDelegationRecommanderRewardsBreakdownResponse, error)
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
@@ -1153,6 +1507,24 @@ func (c *queryClient) ValidatorDelayedRewards(ctx context.Context, in *QueryVali
 	return out, nil
 }
 
+func (c *queryClient) ValidatorDelayedRewardsSchedule(ctx context.Context, in *QueryValidatorDelayedRewardsScheduleRequest, opts ...grpc.CallOption) (*QueryValidatorDelayedRewardsScheduleResponse, error) {
+	out := new(QueryValidatorDelayedRewardsScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ValidatorDelayedRewardsSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TeamCommissionVesting(ctx context.Context, in *QueryTeamCommissionVestingRequest, opts ...grpc.CallOption) (*QueryTeamCommissionVestingResponse, error) {
+	out := new(QueryTeamCommissionVestingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/TeamCommissionVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationRewardsResponse, error) {
 	out := new(QueryDelegationRewardsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegationRewards", in, out, opts...)
@@ -1162,6 +1534,15 @@ func (c *queryClient) DelegationRewards(ctx context.Context, in *QueryDelegation
 	return out, nil
 }
 
+func (c *queryClient) DelegationRecommanderRewardsBreakdown(ctx context.Context, in *QueryDelegationRecommanderRewardsBreakdownRequest, opts ...grpc.CallOption) (*QueryDelegationRecommanderRewardsBreakdownResponse, error) {
+	out := new(QueryDelegationRecommanderRewardsBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegationRecommanderRewardsBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error) {
 	out := new(QueryDelegationTotalRewardsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards", in, out, opts...)
@@ -1210,8 +1591,16 @@ type QueryServer interface {
 	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
 	// ValidatorDelayedRewards queries the total rewards accrued by a validator.
 	ValidatorDelayedRewards(context.Context, *QueryValidatorDelayedRewardsRequest) (*QueryValidatorDelayedRewardsResponse, error)
+	// ValidatorDelayedRewardsSchedule queries the projected release schedule of
+	// the delayed rewards of a validator.
+	ValidatorDelayedRewardsSchedule(context.Context, *QueryValidatorDelayedRewardsScheduleRequest) (*QueryValidatorDelayedRewardsScheduleResponse, error)
+	// TeamCommissionVesting queries the team commission vesting of a validator.
+	TeamCommissionVesting(context.Context, *QueryTeamCommissionVestingRequest) (*QueryTeamCommissionVestingResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
 	DelegationRewards(context.Context, *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error)
+	// DelegationRecommanderRewardsBreakdown queries how the recommanders rewards
+	// accrued by a delegation would be split along its recommander chain.
+	DelegationRecommanderRewardsBreakdown(context.Context, *QueryDelegationRecommanderRewardsBreakdownRequest) (*QueryDelegationRecommanderRewardsBreakdownResponse, error)
 	// DelegationTotalRewards queries the total rewards accrued by a each
 	// validator.
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
@@ -1242,9 +1631,18 @@ func (*UnimplementedQueryServer) ValidatorSlashes(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) ValidatorDelayedRewards(ctx context.Context, req *QueryValidatorDelayedRewardsRequest) (*QueryValidatorDelayedRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDelayedRewards not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorDelayedRewardsSchedule(ctx context.Context, req *QueryValidatorDelayedRewardsScheduleRequest) (*QueryValidatorDelayedRewardsScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorDelayedRewardsSchedule not implemented")
+}
+func (*UnimplementedQueryServer) TeamCommissionVesting(ctx context.Context, req *QueryTeamCommissionVestingRequest) (*QueryTeamCommissionVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TeamCommissionVesting not implemented")
+}
 func (*UnimplementedQueryServer) DelegationRewards(ctx context.Context, req *QueryDelegationRewardsRequest) (*QueryDelegationRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationRewards not implemented")
 }
+func (*UnimplementedQueryServer) DelegationRecommanderRewardsBreakdown(ctx context.Context, req *QueryDelegationRecommanderRewardsBreakdownRequest) (*QueryDelegationRecommanderRewardsBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationRecommanderRewardsBreakdown not implemented")
+}
 func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegationTotalRewards not implemented")
 }
@@ -1352,89 +1750,143 @@ func _Query_ValidatorDelayedRewards_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegationRewardsRequest)
+func _Query_ValidatorDelayedRewardsSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorDelayedRewardsScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).DelegationRewards(ctx, in)
+		return srv.(QueryServer).ValidatorDelayedRewardsSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegationRewards",
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ValidatorDelayedRewardsSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegationRewards(ctx, req.(*QueryDelegationRewardsRequest))
+		return srv.(QueryServer).ValidatorDelayedRewardsSchedule(ctx, req.(*QueryValidatorDelayedRewardsScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegationTotalRewardsRequest)
+func _Query_TeamCommissionVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTeamCommissionVestingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).DelegationTotalRewards(ctx, in)
+		return srv.(QueryServer).TeamCommissionVesting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
+		FullMethod: "/cosmos.distribution.v1beta1.Query/TeamCommissionVesting",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegationTotalRewards(ctx, req.(*QueryDelegationTotalRewardsRequest))
+		return srv.(QueryServer).TeamCommissionVesting(ctx, req.(*QueryTeamCommissionVestingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_DelegatorValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorValidatorsRequest)
+func _Query_DelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRewardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).DelegatorValidators(ctx, in)
+		return srv.(QueryServer).DelegationRewards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorValidators",
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegationRewards",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorValidators(ctx, req.(*QueryDelegatorValidatorsRequest))
+		return srv.(QueryServer).DelegationRewards(ctx, req.(*QueryDelegationRewardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorWithdrawAddressRequest)
+func _Query_DelegationRecommanderRewardsBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationRecommanderRewardsBreakdownRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, in)
+		return srv.(QueryServer).DelegationRecommanderRewardsBreakdown(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress",
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegationRecommanderRewardsBreakdown",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, req.(*QueryDelegatorWithdrawAddressRequest))
+		return srv.(QueryServer).DelegationRecommanderRewardsBreakdown(ctx, req.(*QueryDelegationRecommanderRewardsBreakdownRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCommunityPoolRequest)
+func _Query_DelegationTotalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationTotalRewardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).CommunityPool(ctx, in)
+		return srv.(QueryServer).DelegationTotalRewards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.distribution.v1beta1.Query/CommunityPool",
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationTotalRewards(ctx, req.(*QueryDelegationTotalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorValidators(ctx, req.(*QueryDelegatorValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWithdrawAddress(ctx, req.(*QueryDelegatorWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommunityPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/CommunityPool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).CommunityPool(ctx, req.(*QueryCommunityPoolRequest))
@@ -1466,10 +1918,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorDelayedRewards",
 			Handler:    _Query_ValidatorDelayedRewards_Handler,
 		},
+		{
+			MethodName: "ValidatorDelayedRewardsSchedule",
+			Handler:    _Query_ValidatorDelayedRewardsSchedule_Handler,
+		},
+		{
+			MethodName: "TeamCommissionVesting",
+			Handler:    _Query_TeamCommissionVesting_Handler,
+		},
 		{
 			MethodName: "DelegationRewards",
 			Handler:    _Query_DelegationRewards_Handler,
 		},
+		{
+			MethodName: "DelegationRecommanderRewardsBreakdown",
+			Handler:    _Query_DelegationRecommanderRewardsBreakdown_Handler,
+		},
 		{
 			MethodName: "DelegationTotalRewards",
 			Handler:    _Query_DelegationTotalRewards_Handler,
@@ -1865,7 +2329,7 @@ func (m *QueryValidatorDelayedRewardsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorDelayedRewardsScheduleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1875,12 +2339,12 @@ func (m *QueryDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorDelayedRewardsScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorDelayedRewardsScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1890,19 +2354,12 @@ func (m *QueryDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		copy(dAtA[i:], m.ValidatorAddress)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorDelayedRewardsScheduleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1912,20 +2369,20 @@ func (m *QueryDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorDelayedRewardsScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorDelayedRewardsScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecommandersRewards) > 0 {
-		for iNdEx := len(m.RecommandersRewards) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Releases) > 0 {
+		for iNdEx := len(m.Releases) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RecommandersRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Releases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1936,10 +2393,10 @@ func (m *QueryDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Due) > 0 {
+		for iNdEx := len(m.Due) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Due[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1953,7 +2410,7 @@ func (m *QueryDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTeamCommissionVestingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1963,27 +2420,27 @@ func (m *QueryDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegationTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTeamCommissionVestingRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegationTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTeamCommissionVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegationTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTeamCommissionVestingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1993,48 +2450,30 @@ func (m *QueryDelegationTotalRewardsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegationTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTeamCommissionVestingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTeamCommissionVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorValidatorsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2044,16 +2483,23 @@ func (m *QueryDelegatorValidatorsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.DelegatorAddress) > 0 {
 		i -= len(m.DelegatorAddress)
 		copy(dAtA[i:], m.DelegatorAddress)
@@ -2064,7 +2510,7 @@ func (m *QueryDelegatorValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorValidatorsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2074,21 +2520,40 @@ func (m *QueryDelegatorValidatorsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Validators[iNdEx])
-			copy(dAtA[i:], m.Validators[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+	if len(m.RecommandersRewards) > 0 {
+		for iNdEx := len(m.RecommandersRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecommandersRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2096,7 +2561,7 @@ func (m *QueryDelegatorValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2106,16 +2571,23 @@ func (m *QueryDelegatorWithdrawAddressRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.DelegatorAddress) > 0 {
 		i -= len(m.DelegatorAddress)
 		copy(dAtA[i:], m.DelegatorAddress)
@@ -2126,7 +2598,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2136,27 +2608,62 @@ func (m *QueryDelegatorWithdrawAddressResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WithdrawAddress) > 0 {
-		i -= len(m.WithdrawAddress)
-		copy(dAtA[i:], m.WithdrawAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorReward) > 0 {
+		for iNdEx := len(m.ValidatorReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recommanders) > 0 {
+		for iNdEx := len(m.Recommanders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recommanders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegationTotalRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2166,20 +2673,27 @@ func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegationTotalRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegationTotalRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegationTotalRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2189,20 +2703,20 @@ func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegationTotalRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegationTotalRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pool) > 0 {
-		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2210,13 +2724,209 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
 	for v >= 1<<7 {
@@ -2373,16 +3083,12 @@ func (m *QueryValidatorDelayedRewardsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryDelegationRewardsRequest) Size() (n int) {
+func (m *QueryValidatorDelayedRewardsScheduleRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -2390,20 +3096,20 @@ func (m *QueryDelegationRewardsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryDelegationRewardsResponse) Size() (n int) {
+func (m *QueryValidatorDelayedRewardsScheduleResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
+	if len(m.Due) > 0 {
+		for _, e := range m.Due {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if len(m.RecommandersRewards) > 0 {
-		for _, e := range m.RecommandersRewards {
+	if len(m.Releases) > 0 {
+		for _, e := range m.Releases {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2411,41 +3117,31 @@ func (m *QueryDelegationRewardsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
+func (m *QueryTeamCommissionVestingRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
+func (m *QueryTeamCommissionVestingResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
+	l = m.Vesting.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryDelegatorValidatorsRequest) Size() (n int) {
+func (m *QueryDelegationRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2455,25 +3151,35 @@ func (m *QueryDelegatorValidatorsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryDelegatorValidatorsResponse) Size() (n int) {
+func (m *QueryDelegationRewardsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for _, s := range m.Validators {
-			l = len(s)
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RecommandersRewards) > 0 {
+		for _, e := range m.RecommandersRewards {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *QueryDelegatorWithdrawAddressRequest) Size() (n int) {
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2483,18 +3189,124 @@ func (m *QueryDelegatorWithdrawAddressRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Recommanders) > 0 {
+		for _, e := range m.Recommanders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ValidatorReward) > 0 {
+		for _, e := range m.ValidatorReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationTotalRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -2775,7 +3587,683 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
+			}
+			m.StartingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
+			}
+			m.EndingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorDelayedRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDelayedRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDelayedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorDelayedRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorDelayedRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorDelayedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, ValidatorDelayedReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2802,7 +4290,10 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2827,7 +4318,7 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDelayedRewardsScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2850,10 +4341,10 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDelayedRewardsScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDelayedRewardsScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2909,7 +4400,7 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorDelayedRewardsScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2932,15 +4423,15 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorDelayedRewardsScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorDelayedRewardsScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Due", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2967,7 +4458,42 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Due = append(m.Due, types.DecCoin{})
+			if err := m.Due[len(m.Due)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releases = append(m.Releases, DelayedRewardRelease{})
+			if err := m.Releases[len(m.Releases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2992,7 +4518,7 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTeamCommissionVestingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3015,10 +4541,10 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTeamCommissionVestingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTeamCommissionVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3053,80 +4579,6 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
-			}
-			m.StartingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
-			}
-			m.EndingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3148,7 +4600,7 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTeamCommissionVestingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3171,49 +4623,15 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTeamCommissionVestingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTeamCommissionVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
-			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3240,10 +4658,7 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3268,7 +4683,7 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorDelayedRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3291,15 +4706,15 @@ func (m *QueryValidatorDelayedRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorDelayedRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorDelayedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3327,13 +4742,13 @@ func (m *QueryValidatorDelayedRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3343,27 +4758,23 @@ func (m *QueryValidatorDelayedRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3386,7 +4797,7 @@ func (m *QueryValidatorDelayedRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorDelayedRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3409,10 +4820,10 @@ func (m *QueryValidatorDelayedRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorDelayedRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorDelayedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3444,14 +4855,14 @@ func (m *QueryValidatorDelayedRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, ValidatorDelayedReward{})
+			m.Rewards = append(m.Rewards, types.DecCoin{})
 			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommandersRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3478,10 +4889,8 @@ func (m *QueryValidatorDelayedRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RecommandersRewards = append(m.RecommandersRewards, types.DecCoin{})
+			if err := m.RecommandersRewards[len(m.RecommandersRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3506,7 +4915,7 @@ func (m *QueryValidatorDelayedRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRecommanderRewardsBreakdownRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3529,10 +4938,10 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRecommanderRewardsBreakdownRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRecommanderRewardsBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3620,7 +5029,7 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRecommanderRewardsBreakdownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3643,15 +5052,15 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRecommanderRewardsBreakdownResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRecommanderRewardsBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recommanders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3678,14 +5087,14 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recommanders = append(m.Recommanders, DelegationRecommanderReward{})
+			if err := m.Recommanders[len(m.Recommanders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecommandersRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorReward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3712,8 +5121,42 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecommandersRewards = append(m.RecommandersRewards, types.DecCoin{})
-			if err := m.RecommandersRewards[len(m.RecommandersRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorReward = append(m.ValidatorReward, types.Coin{})
+			if err := m.ValidatorReward[len(m.ValidatorReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainder = append(m.Remainder, types.DecCoin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
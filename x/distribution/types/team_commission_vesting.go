@@ -0,0 +1,129 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Vesting types of the team commission. The withdrawn team commission vests
+// linearly over the duration for a continuous vesting, or in equal periods over
+// the duration for a periodic vesting, in both cases after the cliff.
+const (
+	TeamVestingTypeNone       = ""
+	TeamVestingTypeContinuous = "continuous"
+	TeamVestingTypePeriodic   = "periodic"
+)
+
+// MaxTeamVestingPeriods is the maximum number of periods of a periodic team
+// commission vesting, and of the vesting schedule of a team account.
+const MaxTeamVestingPeriods = 100
+
+// NewTeamCommissionVesting returns a new TeamCommissionVesting object.
+func NewTeamCommissionVesting(vestingType string, cliff, duration time.Duration, periods uint32) TeamCommissionVesting {
+	return TeamCommissionVesting{
+		VestingType: vestingType,
+		Cliff:       cliff,
+		Duration:    duration,
+		Periods:     periods,
+	}
+}
+
+// Enabled returns true if the team commission vests.
+func (v TeamCommissionVesting) Enabled() bool {
+	return v.VestingType != TeamVestingTypeNone
+}
+
+// Validate performs a basic validation of the team commission vesting.
+func (v TeamCommissionVesting) Validate() error {
+	switch v.VestingType {
+	case TeamVestingTypeNone:
+		if v.Cliff != 0 || v.Duration != 0 || v.Periods != 0 {
+			return sdkerrors.Wrap(ErrInvalidTeamVesting, "no vesting cannot have a cliff, duration or periods")
+		}
+	case TeamVestingTypeContinuous:
+		if v.Periods != 0 {
+			return sdkerrors.Wrap(ErrInvalidTeamVesting, "continuous vesting cannot have periods")
+		}
+	case TeamVestingTypePeriodic:
+		if v.Periods == 0 {
+			return sdkerrors.Wrap(ErrInvalidTeamVesting, "periodic vesting must have at least one period")
+		}
+		if v.Periods > MaxTeamVestingPeriods {
+			return sdkerrors.Wrapf(ErrInvalidTeamVesting, "periodic vesting cannot have more than %d periods", MaxTeamVestingPeriods)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidTeamVesting, "unknown vesting type %s", v.VestingType)
+	}
+
+	if v.Cliff < 0 || v.Duration < 0 {
+		return sdkerrors.Wrap(ErrInvalidTeamVesting, "cliff and duration cannot be negative")
+	}
+	if v.Enabled() && v.Duration < time.Duration(v.Periods)*time.Second || v.VestingType == TeamVestingTypeContinuous && v.Duration < time.Second {
+		return sdkerrors.Wrap(ErrInvalidTeamVesting, "vesting duration and periods must be at least one second")
+	}
+
+	return nil
+}
+
+// WeakerThan returns true if the vesting may release the team commission earlier
+// than the current vesting. Any vesting is at least as strong as no vesting. An
+// enabled vesting is at least as strong as the current one if neither its cliff
+// nor its duration is shorter and, when the current vesting is periodic, it is
+// periodic with the same number of periods. A periodic vesting never releases
+// the commission earlier than a continuous vesting with the same schedule.
+func (v TeamCommissionVesting) WeakerThan(current TeamCommissionVesting) bool {
+	switch {
+	case !current.Enabled():
+		return false
+	case !v.Enabled():
+		return true
+	case v.Cliff < current.Cliff || v.Duration < current.Duration:
+		return true
+	case current.VestingType == TeamVestingTypePeriodic:
+		return v.VestingType != TeamVestingTypePeriodic || v.Periods != current.Periods
+	default:
+		return false
+	}
+}
+
+// StartTime returns the time at which the team commission withdrawn at the
+// given time starts to vest.
+func (v TeamCommissionVesting) StartTime(withdrawTime time.Time) int64 {
+	return withdrawTime.Add(v.Cliff).Unix()
+}
+
+// EndTime returns the time at which the team commission withdrawn at the
+// given time is fully vested.
+func (v TeamCommissionVesting) EndTime(withdrawTime time.Time) int64 {
+	return v.StartTime(withdrawTime) + int64(v.Duration/time.Second)
+}
+
+// VestingPeriods splits an amount into the periods of a periodic vesting. The
+// periods have the same length and amount, except for the last one which
+// receives the remainders.
+func (v TeamCommissionVesting) VestingPeriods(amount sdk.Coins) vestingtypes.Periods {
+	if v.Periods == 0 {
+		return nil
+	}
+
+	count := int64(v.Periods)
+	length := int64(v.Duration/time.Second) / count
+
+	periods := make(vestingtypes.Periods, count)
+	remaining := amount
+	for i := int64(0); i < count-1; i++ {
+		periodAmount := sdk.NewCoins()
+		for _, coin := range amount {
+			periodAmount = periodAmount.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(count)))
+		}
+
+		periods[i] = vestingtypes.Period{Length: length, Amount: periodAmount}
+		remaining = remaining.Sub(periodAmount)
+	}
+	periods[count-1] = vestingtypes.Period{Length: int64(v.Duration/time.Second) - length*(count-1), Amount: remaining}
+
+	return periods
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestTeamCommissionVestingValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		vesting TeamCommissionVesting
+		expErr  bool
+	}{
+		{"none", NewTeamCommissionVesting(TeamVestingTypeNone, 0, 0, 0), false},
+		{"none with duration", NewTeamCommissionVesting(TeamVestingTypeNone, 0, time.Hour, 0), true},
+		{"continuous", NewTeamCommissionVesting(TeamVestingTypeContinuous, time.Hour, 24*time.Hour, 0), false},
+		{"continuous without cliff", NewTeamCommissionVesting(TeamVestingTypeContinuous, 0, 24*time.Hour, 0), false},
+		{"continuous with periods", NewTeamCommissionVesting(TeamVestingTypeContinuous, 0, 24*time.Hour, 2), true},
+		{"continuous without duration", NewTeamCommissionVesting(TeamVestingTypeContinuous, time.Hour, 0, 0), true},
+		{"periodic", NewTeamCommissionVesting(TeamVestingTypePeriodic, time.Hour, 24*time.Hour, 4), false},
+		{"periodic without periods", NewTeamCommissionVesting(TeamVestingTypePeriodic, 0, 24*time.Hour, 0), true},
+		{"periodic shorter than periods", NewTeamCommissionVesting(TeamVestingTypePeriodic, 0, 3*time.Second, 4), true},
+		{"periodic with max periods", NewTeamCommissionVesting(TeamVestingTypePeriodic, 0, 24*time.Hour, MaxTeamVestingPeriods), false},
+		{"periodic with too many periods", NewTeamCommissionVesting(TeamVestingTypePeriodic, 0, 24*time.Hour, MaxTeamVestingPeriods+1), true},
+		{"negative cliff", NewTeamCommissionVesting(TeamVestingTypeContinuous, -time.Hour, 24*time.Hour, 0), true},
+		{"unknown type", NewTeamCommissionVesting("delayed", 0, 24*time.Hour, 0), true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.vesting.Validate()
+			if tc.expErr {
+				require.ErrorIs(t, err, ErrInvalidTeamVesting)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTeamCommissionVestingWeakerThan(t *testing.T) {
+	none := NewTeamCommissionVesting(TeamVestingTypeNone, 0, 0, 0)
+	continuous := NewTeamCommissionVesting(TeamVestingTypeContinuous, time.Hour, 24*time.Hour, 0)
+	periodic := NewTeamCommissionVesting(TeamVestingTypePeriodic, time.Hour, 24*time.Hour, 4)
+
+	tests := []struct {
+		name    string
+		vesting TeamCommissionVesting
+		current TeamCommissionVesting
+		weaker  bool
+	}{
+		{"none over none", none, none, false},
+		{"enable", continuous, none, false},
+		{"disable", none, continuous, true},
+		{"same", continuous, continuous, false},
+		{"longer cliff", NewTeamCommissionVesting(TeamVestingTypeContinuous, 2*time.Hour, 24*time.Hour, 0), continuous, false},
+		{"longer duration", NewTeamCommissionVesting(TeamVestingTypeContinuous, time.Hour, 48*time.Hour, 0), continuous, false},
+		{"shorter cliff", NewTeamCommissionVesting(TeamVestingTypeContinuous, 0, 24*time.Hour, 0), continuous, true},
+		{"shorter duration", NewTeamCommissionVesting(TeamVestingTypeContinuous, time.Hour, 12*time.Hour, 0), continuous, true},
+		{"continuous to periodic", periodic, continuous, false},
+		{"periodic to continuous", continuous, periodic, true},
+		{"more periods", NewTeamCommissionVesting(TeamVestingTypePeriodic, time.Hour, 24*time.Hour, 8), periodic, true},
+		{"fewer periods", NewTeamCommissionVesting(TeamVestingTypePeriodic, time.Hour, 24*time.Hour, 2), periodic, true},
+		{"longer periodic", NewTeamCommissionVesting(TeamVestingTypePeriodic, 2*time.Hour, 48*time.Hour, 4), periodic, false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.weaker, tc.vesting.WeakerThan(tc.current))
+		})
+	}
+}
+
+func TestTeamCommissionVestingTimes(t *testing.T) {
+	withdrawTime := time.Unix(1000, 0)
+	vesting := NewTeamCommissionVesting(TeamVestingTypeContinuous, 100*time.Second, 50*time.Second, 0)
+
+	require.Equal(t, int64(1100), vesting.StartTime(withdrawTime))
+	require.Equal(t, int64(1150), vesting.EndTime(withdrawTime))
+}
+
+func TestTeamCommissionVestingPeriods(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("token", 10))
+
+	vesting := NewTeamCommissionVesting(TeamVestingTypePeriodic, 0, 10*time.Second, 3)
+	require.Equal(t, vestingtypes.Periods{
+		{Length: 3, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 33), sdk.NewInt64Coin("token", 3))},
+		{Length: 3, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 33), sdk.NewInt64Coin("token", 3))},
+		{Length: 4, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 34), sdk.NewInt64Coin("token", 4))},
+	}, vesting.VestingPeriods(amount))
+
+	continuous := NewTeamCommissionVesting(TeamVestingTypeContinuous, 0, 10*time.Second, 0)
+	require.Nil(t, continuous.VestingPeriods(amount))
+}
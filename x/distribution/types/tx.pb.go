@@ -416,6 +416,168 @@ func (m *MsgWithdrawTeamCommissionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawTeamCommissionResponse proto.InternalMessageInfo
 
+// MsgReleaseDelayedRewards represents a release of the due delayed rewards
+// of a validator.
+type MsgReleaseDelayedRewards struct {
+	Sender           string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *MsgReleaseDelayedRewards) Reset()         { *m = MsgReleaseDelayedRewards{} }
+func (m *MsgReleaseDelayedRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseDelayedRewards) ProtoMessage()    {}
+func (*MsgReleaseDelayedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{10}
+}
+func (m *MsgReleaseDelayedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseDelayedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseDelayedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseDelayedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseDelayedRewards.Merge(m, src)
+}
+func (m *MsgReleaseDelayedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseDelayedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseDelayedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseDelayedRewards proto.InternalMessageInfo
+
+// MsgReleaseDelayedRewardsResponse defines the Msg/ReleaseDelayedRewards response type.
+type MsgReleaseDelayedRewardsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *MsgReleaseDelayedRewardsResponse) Reset()         { *m = MsgReleaseDelayedRewardsResponse{} }
+func (m *MsgReleaseDelayedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseDelayedRewardsResponse) ProtoMessage()    {}
+func (*MsgReleaseDelayedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{11}
+}
+func (m *MsgReleaseDelayedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseDelayedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseDelayedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseDelayedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseDelayedRewardsResponse.Merge(m, src)
+}
+func (m *MsgReleaseDelayedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseDelayedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseDelayedRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseDelayedRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgReleaseDelayedRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetTeamCommissionVesting represents a message to set the vesting of the
+// team commission of a validator, an empty vesting type removes the vesting.
+type MsgSetTeamCommissionVesting struct {
+	ValidatorAddress string                `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	Vesting          TeamCommissionVesting `protobuf:"bytes,2,opt,name=vesting,proto3" json:"vesting"`
+}
+
+func (m *MsgSetTeamCommissionVesting) Reset()         { *m = MsgSetTeamCommissionVesting{} }
+func (m *MsgSetTeamCommissionVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTeamCommissionVesting) ProtoMessage()    {}
+func (*MsgSetTeamCommissionVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{12}
+}
+func (m *MsgSetTeamCommissionVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTeamCommissionVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTeamCommissionVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTeamCommissionVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTeamCommissionVesting.Merge(m, src)
+}
+func (m *MsgSetTeamCommissionVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTeamCommissionVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTeamCommissionVesting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTeamCommissionVesting proto.InternalMessageInfo
+
+// MsgSetTeamCommissionVestingResponse defines the Msg/SetTeamCommissionVesting response type.
+type MsgSetTeamCommissionVestingResponse struct {
+}
+
+func (m *MsgSetTeamCommissionVestingResponse) Reset()         { *m = MsgSetTeamCommissionVestingResponse{} }
+func (m *MsgSetTeamCommissionVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTeamCommissionVestingResponse) ProtoMessage()    {}
+func (*MsgSetTeamCommissionVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{13}
+}
+func (m *MsgSetTeamCommissionVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTeamCommissionVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTeamCommissionVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTeamCommissionVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTeamCommissionVestingResponse.Merge(m, src)
+}
+func (m *MsgSetTeamCommissionVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTeamCommissionVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTeamCommissionVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTeamCommissionVestingResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -427,6 +589,10 @@ func init() {
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolResponse")
 	proto.RegisterType((*MsgWithdrawTeamCommission)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTeamCommission")
 	proto.RegisterType((*MsgWithdrawTeamCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTeamCommissionResponse")
+	proto.RegisterType((*MsgReleaseDelayedRewards)(nil), "cosmos.distribution.v1beta1.MsgReleaseDelayedRewards")
+	proto.RegisterType((*MsgReleaseDelayedRewardsResponse)(nil), "cosmos.distribution.v1beta1.MsgReleaseDelayedRewardsResponse")
+	proto.RegisterType((*MsgSetTeamCommissionVesting)(nil), "cosmos.distribution.v1beta1.MsgSetTeamCommissionVesting")
+	proto.RegisterType((*MsgSetTeamCommissionVestingResponse)(nil), "cosmos.distribution.v1beta1.MsgSetTeamCommissionVestingResponse")
 }
 
 func init() {
@@ -434,47 +600,56 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6b, 0x13, 0x5f,
-	0x14, 0x9d, 0xd7, 0xf2, 0x2b, 0xbf, 0xde, 0x0a, 0xb6, 0x63, 0xb5, 0xed, 0x24, 0xce, 0xd4, 0xb1,
-	0x48, 0x36, 0xce, 0x98, 0x08, 0x8a, 0x11, 0x14, 0x13, 0x29, 0x74, 0x11, 0x94, 0x51, 0x14, 0xdc,
-	0xc8, 0x24, 0xf3, 0x98, 0x3e, 0xcc, 0xcc, 0x0b, 0xf3, 0xde, 0x34, 0xcd, 0x52, 0x70, 0xe1, 0xb2,
-	0xe0, 0x07, 0xb0, 0xe0, 0x46, 0xc4, 0xa5, 0x4b, 0x3f, 0x40, 0x97, 0x5d, 0x0a, 0x42, 0x94, 0x64,
-	0xe3, 0x3a, 0x9f, 0x40, 0x92, 0xf9, 0xe3, 0x24, 0x99, 0xa4, 0xff, 0x74, 0x95, 0xe4, 0xde, 0x73,
-	0xce, 0x9c, 0xf3, 0x72, 0xef, 0x1b, 0xd8, 0xa8, 0x51, 0xe6, 0x50, 0xa6, 0x5b, 0x84, 0x71, 0x8f,
-	0x54, 0x7d, 0x4e, 0xa8, 0xab, 0xef, 0xe4, 0xab, 0x98, 0x9b, 0x79, 0x9d, 0xef, 0x6a, 0x0d, 0x8f,
-	0x72, 0x2a, 0x66, 0x02, 0x94, 0x96, 0x44, 0x69, 0x21, 0x4a, 0x5a, 0xb6, 0xa9, 0x4d, 0x07, 0x38,
-	0xbd, 0xff, 0x2d, 0xa0, 0x48, 0x72, 0x28, 0x5c, 0x35, 0x19, 0x8e, 0x05, 0x6b, 0x94, 0xb8, 0x41,
-	0x5f, 0xfd, 0x82, 0xe0, 0x62, 0x85, 0xd9, 0x4f, 0x30, 0x7f, 0x4e, 0xf8, 0xb6, 0xe5, 0x99, 0xcd,
-	0x07, 0x96, 0xe5, 0x61, 0xc6, 0xc4, 0x2d, 0x58, 0xb2, 0x70, 0x1d, 0xdb, 0x26, 0xa7, 0xde, 0x4b,
-	0x33, 0x28, 0xae, 0xa2, 0x75, 0x94, 0x9b, 0x2f, 0x65, 0x7b, 0x6d, 0x65, 0xb5, 0x65, 0x3a, 0xf5,
-	0xa2, 0x3a, 0x06, 0x51, 0x8d, 0xc5, 0xb8, 0x16, 0x49, 0x6d, 0xc2, 0x62, 0x33, 0x54, 0x8f, 0x95,
-	0x66, 0x06, 0x4a, 0x99, 0x5e, 0x5b, 0x59, 0x09, 0x94, 0x46, 0x11, 0xaa, 0x71, 0xbe, 0x39, 0x6c,
-	0xa9, 0xf8, 0xff, 0xdb, 0x7d, 0x45, 0xf8, 0xb5, 0xaf, 0x08, 0xaa, 0x02, 0x97, 0x53, 0x5d, 0x1b,
-	0x98, 0x35, 0xa8, 0xcb, 0xb0, 0xfa, 0x15, 0x81, 0x54, 0x61, 0x76, 0xd4, 0x7e, 0x18, 0x59, 0x32,
-	0x70, 0xd3, 0xf4, 0xac, 0xbf, 0x19, 0x6e, 0x0b, 0x96, 0x76, 0xcc, 0x3a, 0xb1, 0x86, 0xa4, 0x66,
-	0x46, 0xa5, 0xc6, 0x20, 0xaa, 0xb1, 0x18, 0xd7, 0xc6, 0xf3, 0x6d, 0x80, 0x3a, 0xd9, 0x7d, 0x1c,
-	0xd2, 0x07, 0x39, 0x81, 0x7a, 0x16, 0xc9, 0x95, 0xa9, 0xe3, 0x10, 0xc6, 0x08, 0x75, 0xd3, 0xcd,
-	0xa1, 0x33, 0x9a, 0xcb, 0xc1, 0xb5, 0xe9, 0x8f, 0x8d, 0x0d, 0x7e, 0x40, 0xb0, 0x5c, 0x61, 0xf6,
-	0xa6, 0xef, 0x5a, 0xfd, 0xae, 0xef, 0x12, 0xde, 0x7a, 0x4c, 0x69, 0x5d, 0xac, 0xc1, 0x9c, 0xe9,
-	0x50, 0xdf, 0xe5, 0xab, 0x68, 0x7d, 0x36, 0xb7, 0x50, 0x58, 0xd3, 0xc2, 0xd1, 0xee, 0xcf, 0x69,
-	0x34, 0xd2, 0x5a, 0x99, 0x12, 0xb7, 0x74, 0xe3, 0xa0, 0xad, 0x08, 0x9f, 0x7e, 0x28, 0x39, 0x9b,
-	0xf0, 0x6d, 0xbf, 0xaa, 0xd5, 0xa8, 0xa3, 0x87, 0x43, 0x1d, 0x7c, 0x5c, 0x67, 0xd6, 0x2b, 0x9d,
-	0xb7, 0x1a, 0x98, 0x0d, 0x08, 0xcc, 0x08, 0xa5, 0xc5, 0x2c, 0xcc, 0x5b, 0xb8, 0x41, 0x19, 0xe1,
-	0xd4, 0x0b, 0xfe, 0x11, 0xe3, 0x4f, 0x21, 0x91, 0x47, 0x86, 0x6c, 0x9a, 0xc9, 0x38, 0xc5, 0x67,
-	0x04, 0x6b, 0x89, 0xc0, 0x4f, 0xb1, 0xe9, 0x24, 0x8e, 0xb8, 0x08, 0xe7, 0x38, 0x36, 0x9d, 0x91,
-	0xd3, 0x5d, 0xe9, 0xb5, 0x95, 0x0b, 0xc1, 0xe9, 0x26, 0xbb, 0xaa, 0xb1, 0xd0, 0xff, 0xf9, 0x4f,
-	0x67, 0xe7, 0x2a, 0x5c, 0x99, 0xe8, 0x36, 0xca, 0x54, 0xf8, 0xfe, 0x1f, 0xcc, 0x56, 0x98, 0x2d,
-	0xbe, 0x41, 0x20, 0xa6, 0x2c, 0x7f, 0x41, 0x9b, 0x72, 0xd5, 0x68, 0xa9, 0xab, 0x27, 0x15, 0x4f,
-	0xce, 0x89, 0xec, 0x88, 0xef, 0x10, 0xac, 0x4c, 0xda, 0xd5, 0xdb, 0x47, 0xe9, 0x4e, 0x20, 0x4a,
-	0xf7, 0x4f, 0x49, 0x8c, 0x5d, 0xbd, 0x47, 0x90, 0x99, 0xb6, 0x5d, 0x77, 0x8f, 0xfb, 0x80, 0x14,
-	0xb2, 0x54, 0x3e, 0x03, 0x39, 0x76, 0xf8, 0x1a, 0xc1, 0xd2, 0xf8, 0x76, 0xe5, 0x8f, 0x92, 0x1e,
-	0xa3, 0x48, 0x77, 0x4e, 0x4c, 0x89, 0x3d, 0xec, 0x21, 0xb8, 0x34, 0x61, 0x37, 0x6e, 0x1d, 0x37,
-	0xe3, 0x30, 0x4f, 0xba, 0x77, 0x3a, 0x5e, 0x64, 0xa9, 0xf4, 0xe8, 0x63, 0x47, 0x46, 0x07, 0x1d,
-	0x19, 0x1d, 0x76, 0x64, 0xf4, 0xb3, 0x23, 0xa3, 0xbd, 0xae, 0x2c, 0x1c, 0x76, 0x65, 0xe1, 0x5b,
-	0x57, 0x16, 0x5e, 0xe4, 0xa7, 0xde, 0x24, 0xbb, 0xc3, 0x2f, 0xe1, 0xc1, 0xc5, 0x52, 0x9d, 0x1b,
-	0xbc, 0x2d, 0x6f, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x31, 0x9c, 0xc7, 0xc0, 0xa8, 0x07, 0x00,
-	0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4b, 0x6f, 0xd3, 0x4a,
+	0x18, 0xcd, 0xb4, 0x57, 0xbd, 0xed, 0xd7, 0x2b, 0xdd, 0xd6, 0xf4, 0x91, 0x3a, 0xc1, 0x2e, 0x6e,
+	0x41, 0x91, 0x10, 0x0e, 0x49, 0xc5, 0x2b, 0x88, 0x57, 0x5a, 0x55, 0xea, 0x22, 0x02, 0x19, 0x54,
+	0x24, 0x36, 0xc8, 0x89, 0x47, 0xae, 0x45, 0xec, 0x89, 0x3c, 0x4e, 0xd3, 0x2c, 0x91, 0x2a, 0xc1,
+	0xa6, 0x52, 0x25, 0x24, 0xb6, 0x54, 0x62, 0x83, 0x10, 0x4b, 0x96, 0xac, 0x51, 0x97, 0x5d, 0xb2,
+	0x0a, 0x28, 0xdd, 0xb0, 0xee, 0x2f, 0x40, 0xf1, 0x63, 0xc8, 0xc3, 0x49, 0xd3, 0xb4, 0x5d, 0x25,
+	0x9e, 0xf9, 0xce, 0x99, 0x73, 0x3e, 0x1f, 0x7f, 0x36, 0x2c, 0x16, 0x08, 0x35, 0x09, 0x4d, 0x6a,
+	0x06, 0x75, 0x6c, 0x23, 0x5f, 0x76, 0x0c, 0x62, 0x25, 0x37, 0x53, 0x79, 0xec, 0xa8, 0xa9, 0xa4,
+	0xb3, 0x25, 0x97, 0x6c, 0xe2, 0x10, 0x2e, 0xe6, 0x55, 0xc9, 0xcd, 0x55, 0xb2, 0x5f, 0xc5, 0x4f,
+	0xe9, 0x44, 0x27, 0x6e, 0x5d, 0xb2, 0xf1, 0xcf, 0x83, 0xf0, 0x82, 0x4f, 0x9c, 0x57, 0x29, 0x66,
+	0x84, 0x05, 0x62, 0x58, 0xfe, 0xbe, 0xdc, 0xeb, 0xe0, 0x96, 0x73, 0xdc, 0x7a, 0xe9, 0x2b, 0x82,
+	0xe9, 0x1c, 0xd5, 0x9f, 0x62, 0xe7, 0xb9, 0xe1, 0x6c, 0x68, 0xb6, 0x5a, 0x79, 0xa4, 0x69, 0x36,
+	0xa6, 0x94, 0x5b, 0x83, 0x49, 0x0d, 0x17, 0xb1, 0xae, 0x3a, 0xc4, 0x7e, 0xa9, 0x7a, 0x8b, 0x51,
+	0x34, 0x8f, 0x12, 0x63, 0xd9, 0xf8, 0x51, 0x4d, 0x8c, 0x56, 0x55, 0xb3, 0x98, 0x91, 0x3a, 0x4a,
+	0x24, 0x65, 0x82, 0xad, 0x05, 0x54, 0xab, 0x30, 0x51, 0xf1, 0xd9, 0x19, 0xd3, 0x90, 0xcb, 0x14,
+	0x3b, 0xaa, 0x89, 0xb3, 0x1e, 0x53, 0x7b, 0x85, 0xa4, 0xfc, 0x5f, 0x69, 0x95, 0x94, 0x19, 0x7d,
+	0xbb, 0x27, 0x46, 0x7e, 0xef, 0x89, 0x11, 0x49, 0x84, 0x8b, 0xa1, 0xaa, 0x15, 0x4c, 0x4b, 0xc4,
+	0xa2, 0x58, 0xfa, 0x86, 0x80, 0xcf, 0x51, 0x3d, 0xd8, 0x5e, 0x09, 0x24, 0x29, 0xb8, 0xa2, 0xda,
+	0xda, 0x59, 0x9a, 0x5b, 0x83, 0xc9, 0x4d, 0xb5, 0x68, 0x68, 0x2d, 0x54, 0x43, 0xed, 0x54, 0x1d,
+	0x25, 0x92, 0x32, 0xc1, 0xd6, 0x3a, 0xfd, 0x2d, 0x82, 0xd4, 0x5d, 0x3d, 0x33, 0x59, 0x06, 0xa1,
+	0xa9, 0x6a, 0x3d, 0xa0, 0x5b, 0x26, 0xa6, 0x69, 0x50, 0x6a, 0x10, 0x2b, 0x5c, 0x1c, 0x3a, 0xa5,
+	0xb8, 0x04, 0x5c, 0xe9, 0x7d, 0x2c, 0x13, 0xf8, 0x11, 0xc1, 0x54, 0x8e, 0xea, 0xab, 0x65, 0x4b,
+	0x6b, 0xec, 0x96, 0x2d, 0xc3, 0xa9, 0x3e, 0x21, 0xa4, 0xc8, 0x15, 0x60, 0x44, 0x35, 0x49, 0xd9,
+	0x72, 0xa2, 0x68, 0x7e, 0x38, 0x31, 0x9e, 0x9e, 0xf3, 0x73, 0x2b, 0x37, 0x72, 0x1d, 0x3c, 0x02,
+	0xf2, 0x32, 0x31, 0xac, 0xec, 0xf5, 0xfd, 0x9a, 0x18, 0xf9, 0xfc, 0x53, 0x4c, 0xe8, 0x86, 0xb3,
+	0x51, 0xce, 0xcb, 0x05, 0x62, 0x26, 0xfd, 0x90, 0x7b, 0x3f, 0xd7, 0xa8, 0xf6, 0x2a, 0xe9, 0x54,
+	0x4b, 0x98, 0xba, 0x00, 0xaa, 0xf8, 0xd4, 0x5c, 0x1c, 0xc6, 0x34, 0x5c, 0x22, 0xd4, 0x70, 0x88,
+	0xed, 0xdd, 0x11, 0xe5, 0xef, 0x42, 0x93, 0x1f, 0x01, 0xe2, 0x61, 0x22, 0x99, 0x8b, 0x2f, 0x08,
+	0xe6, 0x9a, 0x0c, 0x3f, 0xc3, 0xaa, 0xd9, 0xd4, 0xe2, 0x0c, 0xfc, 0xe7, 0x60, 0xd5, 0x6c, 0xeb,
+	0xee, 0xec, 0x51, 0x4d, 0xbc, 0xe0, 0x75, 0xb7, 0x79, 0x57, 0x52, 0xc6, 0x1b, 0x97, 0xe7, 0x9a,
+	0x9d, 0x05, 0xb8, 0xd4, 0x55, 0x2d, 0xf3, 0xf4, 0x06, 0x41, 0x34, 0x47, 0x75, 0x05, 0x17, 0xb1,
+	0x4a, 0xf1, 0x0a, 0x2e, 0xaa, 0x55, 0xac, 0x79, 0xf1, 0xa2, 0xdc, 0x0c, 0x8c, 0x50, 0x6c, 0x69,
+	0xd8, 0xf6, 0xcc, 0x28, 0xfe, 0xd5, 0xf9, 0xc8, 0xdd, 0x41, 0x30, 0xdf, 0x4d, 0x49, 0x20, 0x97,
+	0x33, 0xda, 0xf2, 0x12, 0x0f, 0xcd, 0xcb, 0x0a, 0x2e, 0xb8, 0x91, 0x59, 0xf2, 0x23, 0x73, 0xb5,
+	0x8f, 0xc8, 0xf8, 0x18, 0x96, 0x1a, 0xe9, 0x3b, 0x82, 0x98, 0x37, 0x5b, 0x5a, 0x5b, 0xb7, 0x8e,
+	0xa9, 0x63, 0x58, 0xfa, 0x19, 0x3e, 0x52, 0x9c, 0x02, 0xff, 0x6e, 0x7a, 0xac, 0x6e, 0x17, 0xc7,
+	0xd3, 0x69, 0xb9, 0xc7, 0x1b, 0x41, 0x0e, 0xd5, 0x93, 0xfd, 0xa7, 0x61, 0x56, 0x09, 0x88, 0x9a,
+	0x1a, 0x7b, 0x19, 0x16, 0x7a, 0xf8, 0x08, 0x5a, 0x9b, 0xde, 0x1e, 0x85, 0xe1, 0x1c, 0xd5, 0xb9,
+	0x6d, 0x04, 0x5c, 0xc8, 0x6b, 0xa0, 0xb7, 0xa4, 0xd0, 0x21, 0xcc, 0x67, 0x4e, 0x8e, 0x61, 0x77,
+	0xfa, 0x1d, 0x82, 0xd9, 0x6e, 0x53, 0xfb, 0xd6, 0x71, 0xbc, 0x5d, 0x80, 0xfc, 0x83, 0x01, 0x81,
+	0x4c, 0xd5, 0x07, 0x04, 0xb1, 0x5e, 0x73, 0xf6, 0x6e, 0xbf, 0x07, 0x84, 0x80, 0xf9, 0xe5, 0x53,
+	0x80, 0x99, 0xc2, 0xd7, 0x08, 0x26, 0x3b, 0xe7, 0x6c, 0xea, 0x38, 0xea, 0x0e, 0x08, 0x7f, 0xe7,
+	0xc4, 0x10, 0xa6, 0x61, 0x17, 0xc1, 0x4c, 0x97, 0x29, 0x79, 0xb3, 0x5f, 0x8f, 0xad, 0x38, 0xfe,
+	0xfe, 0x60, 0x38, 0x26, 0x69, 0x07, 0xc1, 0x74, 0xf8, 0x90, 0xbb, 0x71, 0x1c, 0x73, 0x28, 0x8c,
+	0xbf, 0x37, 0x10, 0x8c, 0xe9, 0x79, 0x8f, 0x20, 0xda, 0x75, 0xb4, 0xdc, 0xee, 0xe3, 0xb9, 0x09,
+	0x45, 0xf2, 0x0f, 0x07, 0x45, 0x06, 0xc2, 0xb2, 0x8f, 0x3f, 0xd5, 0x05, 0xb4, 0x5f, 0x17, 0xd0,
+	0x41, 0x5d, 0x40, 0xbf, 0xea, 0x02, 0xda, 0x3d, 0x14, 0x22, 0x07, 0x87, 0x42, 0xe4, 0xc7, 0xa1,
+	0x10, 0x79, 0x91, 0xea, 0x39, 0x49, 0xb7, 0x5a, 0x3f, 0x37, 0xdd, 0xc1, 0x9a, 0x1f, 0x71, 0x3f,
+	0x30, 0x97, 0xfe, 0x0c, 0x00, 0x1e, 0x9f, 0xf2, 0x77, 0x0b, 0x0b, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -582,6 +757,56 @@ func (this *MsgWithdrawTeamCommissionResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgReleaseDelayedRewardsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgReleaseDelayedRewardsResponse)
+	if !ok {
+		that2, ok := that.(MsgReleaseDelayedRewardsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgSetTeamCommissionVestingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetTeamCommissionVestingResponse)
+	if !ok {
+		that2, ok := that.(MsgSetTeamCommissionVestingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -610,6 +835,13 @@ type MsgClient interface {
 	// WithdrawTeamCommission defines a method to withdraw rewards of incentive team
 	// from a single validator.
 	WithdrawTeamCommission(ctx context.Context, in *MsgWithdrawTeamCommission, opts ...grpc.CallOption) (*MsgWithdrawTeamCommissionResponse, error)
+	// ReleaseDelayedRewards defines a method to release the delayed rewards of
+	// a validator which are due. It can be sent by any account.
+	ReleaseDelayedRewards(ctx context.Context, in *MsgReleaseDelayedRewards, opts ...grpc.CallOption) (*MsgReleaseDelayedRewardsResponse, error)
+	// SetTeamCommissionVesting defines a method to set the vesting of the team
+	// commission of a validator. The vesting can be enabled or made longer, but
+	// never weakened.
+	SetTeamCommissionVesting(ctx context.Context, in *MsgSetTeamCommissionVesting, opts ...grpc.CallOption) (*MsgSetTeamCommissionVestingResponse, error)
 }
 
 type msgClient struct {
@@ -665,6 +897,24 @@ func (c *msgClient) WithdrawTeamCommission(ctx context.Context, in *MsgWithdrawT
 	return out, nil
 }
 
+func (c *msgClient) ReleaseDelayedRewards(ctx context.Context, in *MsgReleaseDelayedRewards, opts ...grpc.CallOption) (*MsgReleaseDelayedRewardsResponse, error) {
+	out := new(MsgReleaseDelayedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/ReleaseDelayedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTeamCommissionVesting(ctx context.Context, in *MsgSetTeamCommissionVesting, opts ...grpc.CallOption) (*MsgSetTeamCommissionVestingResponse, error) {
+	out := new(MsgSetTeamCommissionVestingResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetTeamCommissionVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -682,6 +932,13 @@ type MsgServer interface {
 	// WithdrawTeamCommission defines a method to withdraw rewards of incentive team
 	// from a single validator.
 	WithdrawTeamCommission(context.Context, *MsgWithdrawTeamCommission) (*MsgWithdrawTeamCommissionResponse, error)
+	// ReleaseDelayedRewards defines a method to release the delayed rewards of
+	// a validator which are due. It can be sent by any account.
+	ReleaseDelayedRewards(context.Context, *MsgReleaseDelayedRewards) (*MsgReleaseDelayedRewardsResponse, error)
+	// SetTeamCommissionVesting defines a method to set the vesting of the team
+	// commission of a validator. The vesting can be enabled or made longer, but
+	// never weakened.
+	SetTeamCommissionVesting(context.Context, *MsgSetTeamCommissionVesting) (*MsgSetTeamCommissionVestingResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -703,6 +960,12 @@ func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFu
 func (*UnimplementedMsgServer) WithdrawTeamCommission(ctx context.Context, req *MsgWithdrawTeamCommission) (*MsgWithdrawTeamCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTeamCommission not implemented")
 }
+func (*UnimplementedMsgServer) ReleaseDelayedRewards(ctx context.Context, req *MsgReleaseDelayedRewards) (*MsgReleaseDelayedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDelayedRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetTeamCommissionVesting(ctx context.Context, req *MsgSetTeamCommissionVesting) (*MsgSetTeamCommissionVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamCommissionVesting not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -798,6 +1061,42 @@ func _Msg_WithdrawTeamCommission_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReleaseDelayedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseDelayedRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseDelayedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/ReleaseDelayedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseDelayedRewards(ctx, req.(*MsgReleaseDelayedRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTeamCommissionVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTeamCommissionVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTeamCommissionVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetTeamCommissionVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTeamCommissionVesting(ctx, req.(*MsgSetTeamCommissionVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -822,6 +1121,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawTeamCommission",
 			Handler:    _Msg_WithdrawTeamCommission_Handler,
 		},
+		{
+			MethodName: "ReleaseDelayedRewards",
+			Handler:    _Msg_ReleaseDelayedRewards_Handler,
+		},
+		{
+			MethodName: "SetTeamCommissionVesting",
+			Handler:    _Msg_SetTeamCommissionVesting_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1127,71 +1434,208 @@ func (m *MsgWithdrawTeamCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgReleaseDelayedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetWithdrawAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgReleaseDelayedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseDelayedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReleaseDelayedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgReleaseDelayedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgReleaseDelayedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgWithdrawValidatorCommission) Size() (n int) {
-	if m == nil {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTeamCommissionVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTeamCommissionVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTeamCommissionVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTeamCommissionVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTeamCommissionVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTeamCommissionVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDelegatorRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawValidatorCommission) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1266,6 +1710,62 @@ func (m *MsgWithdrawTeamCommissionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgReleaseDelayedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseDelayedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetTeamCommissionVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Vesting.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetTeamCommissionVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2062,6 +2562,369 @@ func (m *MsgWithdrawTeamCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgReleaseDelayedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseDelayedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseDelayedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseDelayedRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseDelayedRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseDelayedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTeamCommissionVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTeamCommissionVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTeamCommissionVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTeamCommissionVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTeamCommissionVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTeamCommissionVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
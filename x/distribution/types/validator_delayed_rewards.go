@@ -21,3 +21,11 @@ func NewValidatorDelayedRewardInfo(lastTime time.Time) ValidatorDelayedRewardInf
 		LastTime: lastTime,
 	}
 }
+
+func NewDelayedRewardRelease(releaseTime time.Time, amount sdk.DecCoins, count uint64) DelayedRewardRelease {
+	return DelayedRewardRelease{
+		ReleaseTime: releaseTime,
+		Amount: amount,
+		Count: count,
+	}
+}
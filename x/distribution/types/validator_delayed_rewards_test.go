@@ -11,7 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-func TestValidateGenesis(t *testing.T) {
+func TestValidatorDelayedRewardString(t *testing.T) {
 	vdr := types.NewValidatorDelayedReward(int64(1), time.Now(), 
 		// sdk.NewDecCoins(),
 		sdk.NewDecCoinsFromCoins(sdk.NewCoin("pptoken", sdk.NewInt(10))),
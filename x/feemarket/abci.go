@@ -0,0 +1,37 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker adjusts the base fee of the next block to the gas consumed by the
+// current block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return
+	}
+
+	var blockGas uint64
+	if meter := ctx.BlockGasMeter(); meter != nil {
+		blockGas = meter.GasConsumedToLimit()
+	}
+
+	baseFee := types.NextBaseFee(params, k.GetBaseFee(ctx), blockGas)
+	k.SetBaseFee(ctx, baseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBlockGas, sdk.NewIntFromUint64(blockGas).String()),
+			sdk.NewAttribute(types.AttributeKeyBaseFee, sdk.NewDecCoinFromDec(params.Denom, baseFee).String()),
+		),
+	)
+}
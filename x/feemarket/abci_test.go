@@ -0,0 +1,49 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+func TestEndBlockerAdjustsBaseFee(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.TargetBlockGas = 1000
+	app.FeeMarketKeeper.SetParams(ctx, params)
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.OneDec())
+
+	blockCtx := func(gas uint64) sdk.Context {
+		meter := sdk.NewGasMeter(10 * params.TargetBlockGas)
+		meter.ConsumeGas(gas, "test")
+		return ctx.WithBlockGasMeter(meter)
+	}
+
+	// disabled fee markets keep their base fee
+	feemarket.EndBlocker(blockCtx(2000), app.FeeMarketKeeper)
+	require.Equal(t, sdk.OneDec(), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	params.Enabled = true
+	app.FeeMarketKeeper.SetParams(ctx, params)
+
+	feemarket.EndBlocker(blockCtx(2000), app.FeeMarketKeeper)
+	require.Equal(t, sdk.MustNewDecFromStr("1.125"), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	feemarket.EndBlocker(blockCtx(1000), app.FeeMarketKeeper)
+	require.Equal(t, sdk.MustNewDecFromStr("1.125"), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	feemarket.EndBlocker(blockCtx(0), app.FeeMarketKeeper)
+	require.Equal(t, sdk.MustNewDecFromStr("0.984375"), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// the genesis export carries the adjusted base fee
+	genesis := feemarket.ExportGenesis(ctx, app.FeeMarketKeeper)
+	require.Equal(t, params, genesis.Params)
+	require.Equal(t, sdk.MustNewDecFromStr("0.984375"), genesis.BaseFee)
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type FeeMarketTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *FeeMarketTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	suite.app = app
+	suite.ctx = ctx
+
+	suite.queryClient = queryClient
+}
+
+func (suite *FeeMarketTestSuite) TestGRPCParams() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.Params, app.FeeMarketKeeper.GetParams(ctx))
+}
+
+func (suite *FeeMarketTestSuite) TestGRPCBaseFee() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, types.DefaultParams().MinBaseFee), res.BaseFee)
+
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(25, 3))
+	res, err = queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 3)), res.BaseFee)
+}
+
+func (suite *FeeMarketTestSuite) TestBaseFeePerGas() {
+	app, ctx := suite.app, suite.ctx
+
+	// disabled by default
+	_, enabled := app.FeeMarketKeeper.BaseFeePerGas(ctx)
+	suite.Require().False(enabled)
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.Enabled = true
+	app.FeeMarketKeeper.SetParams(ctx, params)
+
+	baseFee, enabled := app.FeeMarketKeeper.BaseFeePerGas(ctx)
+	suite.Require().True(enabled)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(params.Denom, app.FeeMarketKeeper.GetBaseFee(ctx)), baseFee)
+}
+
+func TestFeeMarketTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeMarketTestSuite))
+}
@@ -0,0 +1,56 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	BaseFee        = "base_fee"
+	TargetBlockGas = "target_block_gas"
+)
+
+// GenBaseFee randomized BaseFee
+func GenBaseFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(1000)+1), 3)
+}
+
+// GenTargetBlockGas randomized TargetBlockGas
+func GenTargetBlockGas(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10_000_000) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket. The fee
+// market is left disabled since the simulated transactions do not account
+// for the base fee.
+func RandomizedGenState(simState *module.SimulationState) {
+	var baseFee sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseFee, &baseFee, simState.Rand,
+		func(r *rand.Rand) { baseFee = GenBaseFee(r) },
+	)
+
+	var targetBlockGas uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBlockGas, &targetBlockGas, simState.Rand,
+		func(r *rand.Rand) { targetBlockGas = GenTargetBlockGas(r) },
+	)
+
+	params := types.NewParams(false, sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3), targetBlockGas, 8)
+	feemarketGenesis := types.NewGenesisState(params, baseFee)
+
+	bz, err := json.MarshalIndent(&feemarketGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated feemarket parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
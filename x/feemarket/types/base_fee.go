@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee per gas following a block which consumed
+// blockGas, as in EIP-1559: the base fee moves towards the target block gas by
+// at most 1/BaseFeeChangeDenominator of itself, and never goes below the
+// minimum base fee.
+func NextBaseFee(params Params, baseFee sdk.Dec, blockGas uint64) sdk.Dec {
+	target := params.TargetBlockGas
+	if blockGas == target {
+		return sdk.MaxDec(baseFee, params.MinBaseFee)
+	}
+
+	var gasDelta uint64
+	if blockGas > target {
+		gasDelta = blockGas - target
+	} else {
+		gasDelta = target - blockGas
+	}
+
+	delta := baseFee.
+		MulInt(sdk.NewIntFromUint64(gasDelta)).
+		QuoInt(sdk.NewIntFromUint64(target)).
+		QuoInt64(int64(params.BaseFeeChangeDenominator))
+
+	if blockGas > target {
+		baseFee = baseFee.Add(delta)
+	} else {
+		baseFee = baseFee.Sub(delta)
+	}
+
+	return sdk.MaxDec(baseFee, params.MinBaseFee)
+}
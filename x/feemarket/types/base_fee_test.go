@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams(true, sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2), 1000, 8)
+
+	tests := []struct {
+		name     string
+		baseFee  sdk.Dec
+		blockGas uint64
+		expected sdk.Dec
+	}{
+		{"at target", sdk.OneDec(), 1000, sdk.OneDec()},
+		{"full block", sdk.OneDec(), 2000, sdk.MustNewDecFromStr("1.125")},
+		{"above twice the target", sdk.OneDec(), 3000, sdk.MustNewDecFromStr("1.25")},
+		{"half block", sdk.OneDec(), 500, sdk.MustNewDecFromStr("0.9375")},
+		{"empty block", sdk.OneDec(), 0, sdk.MustNewDecFromStr("0.875")},
+		{"bounded by the min base fee", sdk.NewDecWithPrec(1, 2), 0, sdk.NewDecWithPrec(1, 2)},
+		{"raised to the min base fee", sdk.ZeroDec(), 1000, sdk.NewDecWithPrec(1, 2)},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, types.NextBaseFee(params, tc.baseFee, tc.blockGas))
+		})
+	}
+}
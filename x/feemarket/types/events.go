@@ -0,0 +1,9 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeFeeMarket = ModuleName
+
+	AttributeKeyBaseFee  = "base_fee"
+	AttributeKeyBlockGas = "block_gas"
+)
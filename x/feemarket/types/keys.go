@@ -0,0 +1,15 @@
+package types
+
+// BaseFeeKey is the key of the base fee in the feemarket store.
+var BaseFeeKey = []byte{0x00}
+
+const (
+	// module name
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the feemarket store.
+	QuerierRoute = StoreKey
+)
@@ -4,20 +4,12 @@ import (
 	"fmt"
 	"time"
 
-	abci "github.com/tendermint/tendermint/abci/types"
-
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block
-func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
-	k.BurnTokens(ctx)
-}
-
 // EndBlocker called every block, process inflation, update validator set.
 func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
@@ -44,7 +36,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.ProposalId,
 			"title", proposal.GetTitle(),
-			"min_deposit", keeper.GetDepositParams(ctx).MinDeposit.String(),
+			"min_deposit", keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited).String(),
 			"total_deposit", proposal.TotalDeposit.String(),
 		)
 
@@ -57,20 +49,57 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// An expedited proposal that does not pass is converted to a regular
+		// proposal: its voting period is extended to the regular one, and its
+		// votes and deposits are kept until it is tallied again at the end of it.
+		if proposal.Expedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			logger.Info(
+				"expedited proposal converted to regular",
+				"proposal", proposal.ProposalId,
+				"title", proposal.GetTitle(),
+				"voting_end_time", proposal.VotingEndTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalId)
 		} else {
 			keeper.RefundDeposits(ctx, proposal.ProposalId)
 		}
+		keeper.DeleteVotes(ctx, proposal.ProposalId)
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+			var err error
 			cacheCtx, writeCache := ctx.CacheContext()
 
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			// The proposal handler, or the messages of a MessagesProposal, may
+			// execute state mutating logic depending on the proposal content. If
+			// the execution fails, no state mutation is written and the error
+			// message is logged.
+			if msgsContent, ok := proposal.GetContent().(*types.MessagesProposal); ok {
+				proposal.MsgResults, err = keeper.ExecuteProposalMessages(cacheCtx, msgsContent)
+			} else {
+				handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+				err = handler(cacheCtx, proposal.GetContent())
+			}
+
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
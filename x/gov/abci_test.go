@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -270,6 +271,74 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 }
 
+func TestTickExpeditedProposalConvertedToRegular(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	govHandler := gov.NewHandler(app.GovKeeper)
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+
+	// the minimum deposit of a regular proposal does not activate an expedited one
+	newProposalMsg, err := types.NewMsgSubmitProposal(TestProposal, depositParams.MinDeposit, addrs[0])
+	require.NoError(t, err)
+	newProposalMsg.SetExpedited(true)
+
+	res, err := govHandler(ctx, newProposalMsg)
+	require.NoError(t, err)
+
+	var proposalData types.MsgSubmitProposalResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &proposalData))
+	proposalID := proposalData.ProposalId
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, proposal.Expedited)
+	require.Equal(t, types.StatusDepositPeriod, proposal.Status)
+
+	newDepositMsg := types.NewMsgDeposit(addrs[1], proposalID, depositParams.ExpeditedMinDeposit.Sub(depositParams.MinDeposit))
+	_, err = govHandler(ctx, newDepositMsg)
+	require.NoError(t, err)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+	// the expedited proposal does not pass, it is converted to a regular one
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+	require.Len(t, app.GovKeeper.GetDeposits(ctx, proposalID), 2)
+
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	// the regular proposal is tallied at the end of the regular voting period
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusRejected, proposal.Status)
+}
+
 func TestProposalPassedEndblocker(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -292,7 +361,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -340,7 +409,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -362,3 +431,55 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestEndBlockerMessagesProposalFailed(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the governance account can only pay for the first of the two messages
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	send := banktypes.NewMsgSend(govAddr, addrs[1], coins)
+	content, err := types.NewMessagesProposal("title", "description", []sdk.Msg{send, send})
+	require.NoError(t, err)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, content, nil, nil, false)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	newDepositMsg := types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins)
+
+	handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, newDepositMsg)
+
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	require.NoError(t, err)
+
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, coins))
+	govBalances := app.BankKeeper.GetAllBalances(ctx, govAddr).Sub(proposalCoins)
+	recipientBalances := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusFailed, proposal.Status)
+	require.Empty(t, proposal.MsgResults)
+
+	// the first message is not executed either: only the deposits are refunded
+	require.Equal(t, govBalances, app.BankKeeper.GetAllBalances(ctx, govAddr))
+	require.Equal(t, recipientBalances, app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+}
@@ -7,7 +7,10 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
@@ -42,3 +45,53 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseMetadataFlags returns the proposal metadata given by the metadata
+// flags, or nil if none of them is set.
+func parseMetadataFlags(fs *pflag.FlagSet) (*types.ProposalMetadata, error) {
+	uri, _ := fs.GetString(FlagMetadataURI)
+	hash, _ := fs.GetString(FlagMetadataHash)
+	if uri == "" && hash == "" {
+		return nil, nil
+	}
+
+	metadata := types.NewProposalMetadata(uri, hash)
+	if err := metadata.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// messagesProposal defines a MessagesProposal with a deposit, as given in a
+// proposal JSON file.
+type messagesProposal struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Messages    []json.RawMessage `json:"messages"`
+	Deposit     string            `json:"deposit"`
+}
+
+// parseMessagesProposal reads and parses a messagesProposal from a file, and
+// returns it along with its decoded messages.
+func parseMessagesProposal(cdc codec.Codec, proposalFile string) (messagesProposal, []sdk.Msg, error) {
+	var proposal messagesProposal
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, nil, err
+	}
+
+	if err = json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, msgJSON := range proposal.Messages {
+		if err := cdc.UnmarshalInterfaceJSON(msgJSON, &msgs[i]); err != nil {
+			return proposal, nil, fmt.Errorf("failed to parse message %d: %w", i, err)
+		}
+	}
+
+	return proposal, msgs, nil
+}
@@ -1,11 +1,13 @@
 package cli
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -67,3 +69,29 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseMetadataFlags(t *testing.T) {
+	uri := "ipfs://QmTzQ1Nj5Rz2WpWjfxTk7cvgxC8hPe6ozqnCjWmM3aYbYk"
+	hash := strings.Repeat("ab", 32)
+	fs := NewCmdSubmitProposal().Flags()
+
+	// no metadata
+	metadata, err := parseMetadataFlags(fs)
+	require.NoError(t, err)
+	require.Nil(t, metadata)
+
+	// uri without hash
+	fs.Set(FlagMetadataURI, uri)
+	_, err = parseMetadataFlags(fs)
+	require.Error(t, err)
+
+	// invalid hash
+	fs.Set(FlagMetadataHash, "not a hash")
+	_, err = parseMetadataFlags(fs)
+	require.Error(t, err)
+
+	fs.Set(FlagMetadataHash, hash)
+	metadata, err = parseMetadataFlags(fs)
+	require.NoError(t, err)
+	require.Equal(t, types.NewProposalMetadata(uri, hash), metadata)
+}
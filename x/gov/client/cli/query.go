@@ -37,6 +37,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryTallyDetails(),
 	)
 
 	return govQueryCmd
@@ -544,6 +545,54 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryTallyDetails implements the command to query the tally of a
+// proposal broken down by bonded validator.
+func GetCmdQueryTallyDetails() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-details [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the tally of a proposal vote broken down by validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tally of votes on a proposal in voting period, with the
+voting power of each bonded validator and of its delegators, and that of the
+unbonding delegations of the voters.
+
+Example:
+$ %s query gov tally-details 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.TallyDetails(
+				cmd.Context(),
+				&types.QueryTallyDetailsRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
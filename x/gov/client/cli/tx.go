@@ -26,6 +26,9 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
+	FlagMetadataURI  = "metadata-uri"
+	FlagMetadataHash = "metadata-hash"
 )
 
 type proposal struct {
@@ -60,6 +63,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	}
 
 	cmdSubmitProp := NewCmdSubmitProposal()
+	cmdSubmitProp.AddCommand(NewCmdSubmitMessagesProposal())
 	for _, propCmd := range propCmds {
 		flags.AddTxFlagsToCmd(propCmd)
 		cmdSubmitProp.AddCommand(propCmd)
@@ -70,6 +74,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 		NewCmdVote(),
 		NewCmdWeightedVote(),
 		cmdSubmitProp,
+		NewCmdCancelProposal(),
 	)
 
 	return govTxCmd
@@ -99,8 +104,12 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+An off-chain document, e.g. a larger specification, can be linked to the proposal and pinned by its SHA-256 hash:
+
+$ %s tx gov submit-proposal --proposal="path/to/proposal.json" --metadata-uri="ipfs://<cid>" --metadata-hash="$(sha256sum spec.md | cut -d' ' -f1)" --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -126,6 +135,18 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			expedited, err := cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+			msg.SetExpedited(expedited)
+
+			metadata, err := parseMetadataFlags(cmd.Flags())
+			if err != nil {
+				return fmt.Errorf("invalid metadata: %w", err)
+			}
+			msg.SetMetadata(metadata)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -135,6 +156,94 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal as expedited, with a shorter voting period and a higher deposit and threshold")
+	cmd.Flags().String(FlagMetadataURI, "", "The URI of an off-chain document of the proposal")
+	cmd.Flags().String(FlagMetadataHash, "", "The hex-encoded SHA-256 hash of the off-chain document of the proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitMessagesProposal implements submitting a messages proposal
+// transaction command.
+func NewCmdSubmitMessagesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "messages [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages as the governance account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal along with an initial deposit, whose messages are executed
+by the governance module account once the proposal passes. The messages must be
+signed by the governance module account only. The proposal details must be
+supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal messages <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Transfer",
+  "description": "Send the governance account funds",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "<gov_module_address>",
+      "to_address": "<recipient_address>",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ],
+  "deposit": "10stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, msgs, err := parseMessagesProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content, err := types.NewMessagesProposal(proposal.Title, proposal.Description, msgs)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			expedited, err := cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+			msg.SetExpedited(expedited)
+
+			metadata, err := parseMetadataFlags(cmd.Flags())
+			if err != nil {
+				return fmt.Errorf("invalid metadata: %w", err)
+			}
+			msg.SetMetadata(metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal as expedited, with a shorter voting period and a higher deposit and threshold")
+	cmd.Flags().String(FlagMetadataURI, "", "The URI of an off-chain document of the proposal")
+	cmd.Flags().String(FlagMetadataHash, "", "The hex-encoded SHA-256 hash of the off-chain document of the proposal")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -188,6 +297,46 @@ $ %s tx gov deposit 1 10stake --from mykey
 	return cmd
 }
 
+// NewCmdCancelProposal implements canceling a proposal by its proposer.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal in deposit or voting period, by its proposer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal in deposit or voting period. Only the proposer of the
+proposal can cancel it. A part of the proposal deposits, set by the governance
+params, is burned and the rest is refunded to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(proposalID, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdVote implements creating a new vote command.
 func NewCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -85,7 +85,8 @@ func createValidators(t *testing.T, stakingHandler sdk.Handler, ctx sdk.Context,
 		valTokens := sdk.TokensFromConsensusPower(powerAmt[i], sdk.DefaultPowerReduction)
 		valCreateMsg, err := stakingtypes.NewMsgCreateValidator(
 			addrs[i], pubkeys[i], sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
-			TestDescription, TestCommissionRates, sdk.OneInt(),
+			TestDescription, TestCommissionRates,
+			stakingtypes.NewReallocatedCommissionRule(sdk.OneDec(), sdk.ZeroDec(), 0, nil), sdk.AccAddress(addrs[i]), sdk.OneInt(),
 		)
 		require.NoError(t, err)
 		handleAndCheck(t, stakingHandler, ctx, valCreateMsg)
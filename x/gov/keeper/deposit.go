@@ -130,7 +130,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -177,3 +177,41 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 		return false
 	})
 }
+
+// ChargeDeposits burns the given proportion of the deposits on a specific
+// proposal, refunds the rest of them to their depositors and deletes them. It
+// returns the burned amount.
+func (keeper Keeper) ChargeDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) sdk.Coins {
+	store := ctx.KVStore(keeper.storeKey)
+	burned := sdk.NewCoins()
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		charge := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			charge = charge.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(burnRatio).TruncateInt()))
+		}
+
+		refund := deposit.Amount.Sub(charge)
+		if !refund.IsZero() {
+			err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		burned = burned.Add(charge...)
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+
+	if !burned.IsZero() {
+		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burned)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return burned
+}
@@ -277,3 +277,47 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 
 	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
 }
+
+// TallyDetails queries the tally of a proposal in voting period, broken down by bonded validator
+func (q Keeper) TallyDetails(c context.Context, req *types.QueryTallyDetailsRequest) (*types.QueryTallyDetailsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	// the votes of a proposal are deleted once it is finalized
+	if proposal.Status != types.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	state := q.tallyVotes(ctx, proposal)
+
+	validators := make([]types.ValidatorTally, len(state.validators))
+	for i, val := range state.validators {
+		valAddrStr := val.Address.String()
+		validators[i] = types.ValidatorTally{
+			ValidatorAddress: valAddrStr,
+			BondedTokens:     val.BondedTokens,
+			Options:          val.Vote,
+			ValidatorTally:   types.NewTallyResultFromMap(state.validatorResults[valAddrStr]),
+			DelegatorsTally:  types.NewTallyResultFromMap(state.delegatorResults[valAddrStr]),
+		}
+	}
+
+	return &types.QueryTallyDetailsResponse{
+		Tally:          types.NewTallyResultFromMap(state.results),
+		Validators:     validators,
+		UnbondingTally: types.NewTallyResultFromMap(state.unbondingResults),
+		TotalStake:     state.totalStake,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,7 +51,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 			func() {
 				req = &types.QueryProposalRequest{ProposalId: 1}
 				testProposal := types.NewTextProposal("Proposal", "testing proposal")
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil, nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -58,6 +59,20 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 			},
 			true,
 		},
+		{
+			"valid request with metadata",
+			func() {
+				req = &types.QueryProposalRequest{ProposalId: 2}
+				testProposal := types.NewTextProposal("Proposal", "testing proposal")
+				metadata := types.NewProposalMetadata("ipfs://QmTzQ1Nj5Rz2WpWjfxTk7cvgxC8hPe6ozqnCjWmM3aYbYk", strings.Repeat("ab", 32))
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil, metadata, false)
+				suite.Require().NoError(err)
+				suite.Require().Equal(metadata, submittedProposal.Metadata)
+
+				expProposal = submittedProposal
+			},
+			true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -106,7 +121,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 				for i := 0; i < 5; i++ {
 					num := strconv.Itoa(i + 1)
 					testProposal := types.NewTextProposal("Proposal"+num, "testing proposal "+num)
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal)
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil, nil, false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, proposal)
@@ -274,7 +289,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVoteRequest{
@@ -379,7 +394,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryVotesRequest{
@@ -460,7 +475,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamDeposit}
 				expRes = &types.QueryParamsResponse{
 					DepositParams: types.DefaultDepositParams(),
-					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), false),
 				}
 			},
 			true,
@@ -470,8 +485,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamVoting}
 				expRes = &types.QueryParamsResponse{
-					VotingParams: types.DefaultVotingParams(),
-					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					VotingParams:  types.DefaultVotingParams(),
+					DepositParams: types.NewDepositParams(nil, 0, nil, sdk.NewDec(0), 0),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0), false),
 				}
 			},
 			true,
@@ -481,7 +497,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamTallying}
 				expRes = &types.QueryParamsResponse{
-					TallyParams: types.DefaultTallyParams(),
+					DepositParams: types.NewDepositParams(nil, 0, nil, sdk.NewDec(0), 0),
+					TallyParams:   types.DefaultTallyParams(),
 				}
 			},
 			true,
@@ -570,7 +587,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -659,7 +676,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 
 				req = &types.QueryDepositsRequest{
@@ -751,7 +768,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -816,3 +833,43 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryTallyDetails() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	proposal := setupUnbondingTally(suite.T(), app, ctx)
+	tenTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	fifteenTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 15)
+
+	_, err := queryClient.TallyDetails(gocontext.Background(), &types.QueryTallyDetailsRequest{})
+	suite.Require().Error(err)
+
+	depositProposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
+	suite.Require().NoError(err)
+	_, err = queryClient.TallyDetails(gocontext.Background(), &types.QueryTallyDetailsRequest{ProposalId: depositProposal.ProposalId})
+	suite.Require().Error(err)
+
+	res, err := queryClient.TallyDetails(gocontext.Background(), &types.QueryTallyDetailsRequest{ProposalId: proposal.ProposalId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewTallyResult(tenTokens, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()), res.Tally)
+	suite.Require().Equal(types.EmptyTallyResult(), res.UnbondingTally)
+	suite.Require().Equal(tenTokens, res.TotalStake)
+	suite.Require().Len(res.Validators, 1)
+
+	// the validator votes with its self-delegation only
+	val := res.Validators[0]
+	suite.Require().Equal(tenTokens, val.BondedTokens)
+	suite.Require().Equal([]types.WeightedVoteOption(types.NewNonSplitVoteOption(types.OptionYes)), val.Options)
+	suite.Require().Equal(types.NewTallyResult(tenTokens, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()), val.ValidatorTally)
+	suite.Require().Equal(types.EmptyTallyResult(), val.DelegatorsTally)
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.CountUnbondingDelegations = true
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	res, err = queryClient.TallyDetails(gocontext.Background(), &types.QueryTallyDetailsRequest{ProposalId: proposal.ProposalId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewTallyResult(tenTokens, sdk.ZeroInt(), fifteenTokens, sdk.ZeroInt()), res.Tally)
+	suite.Require().Equal(types.NewTallyResult(sdk.ZeroInt(), sdk.ZeroInt(), fifteenTokens, sdk.ZeroInt()), res.UnbondingTally)
+	suite.Require().Equal(app.StakingKeeper.TokensFromConsensusPower(ctx, 30), res.TotalStake)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ValidateProposalMessages checks that the messages of a MessagesProposal are
+// signed by the governance module account only, and that they have a handler
+// in the MsgServiceRouter.
+func (keeper Keeper) ValidateProposalMessages(content *types.MessagesProposal) error {
+	msgs, err := content.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	govAddr := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return sdkerrors.Wrapf(types.ErrInvalidSigner, "message %d: %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		if keeper.msgRouter == nil || keeper.msgRouter.Handler(msg) == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
+// ExecuteProposalMessages executes the messages of a passed MessagesProposal
+// through the MsgServiceRouter, and returns the data of each message result.
+// The messages are executed atomically: the caller must only write the state
+// changes to the underlying store if no error is returned.
+func (keeper Keeper) ExecuteProposalMessages(ctx sdk.Context, content *types.MessagesProposal) ([][]byte, error) {
+	if err := keeper.ValidateProposalMessages(content); err != nil {
+		return nil, err
+	}
+
+	msgs, err := content.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		handler := keeper.msgRouter.Handler(msg)
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		results[i] = res.Data
+
+		// the handler is executed with a new EventManager, the events are
+		// emitted to the given context so that they are kept on success
+		for _, event := range res.GetEvents() {
+			ctx.EventManager().EmitEvent(sdk.Event(event))
+		}
+	}
+
+	return results, nil
+}
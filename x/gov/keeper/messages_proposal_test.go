@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMessagesProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(30000000))
+	govAddr := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	send := banktypes.NewMsgSend(govAddr, addrs[0], coins)
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr bool
+	}{
+		{"gov account signer", []sdk.Msg{send}, false},
+		{"other signer", []sdk.Msg{send, banktypes.NewMsgSend(addrs[0], govAddr, coins)}, true},
+		{"unrouted message", []sdk.Msg{testdata.NewTestMsg(govAddr)}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content, err := types.NewMessagesProposal("title", "description", tc.msgs)
+			require.NoError(t, err)
+
+			_, err = app.GovKeeper.SubmitProposal(ctx, content, nil, nil, false)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidProposalContent)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	content, err := types.NewMessagesProposal("title", "description", []sdk.Msg{send, send})
+	require.NoError(t, err)
+
+	// the messages fail if the governance account cannot pay for all of them,
+	// see TestEndBlockerMessagesProposalFailed for the state changes
+	cacheCtx, _ := ctx.CacheContext()
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, cacheCtx, types.ModuleName, coins))
+	_, err = app.GovKeeper.ExecuteProposalMessages(cacheCtx, content)
+	require.Error(t, err)
+
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, coins.Add(coins...)))
+	balance := app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom)
+
+	results, err := app.GovKeeper.ExecuteProposalMessages(ctx, content)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+	require.Equal(t, balance.Add(coins[0]).Add(coins[0]), app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom))
+}
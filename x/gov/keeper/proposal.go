@@ -9,19 +9,35 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content, its proposer and its
+// optional metadata. An expedited proposal requires a higher deposit and
+// threshold, and has a shorter voting period.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content, proposer sdk.AccAddress, metadata *types.ProposalMetadata, expedited bool) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
 
-	// Execute the proposal content in a new context branch (with branched store)
-	// to validate the actual parameter changes before the proposal proceeds
-	// through the governance process. State is not persisted.
-	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+	// only the URI is bounded, the metadata hash has a fixed length
+	depositParams := keeper.GetDepositParams(ctx)
+	if metadata != nil && uint64(len(metadata.URI)) > depositParams.MaxMetadataLen {
+		return types.Proposal{}, sdkerrors.Wrapf(types.ErrMetadataTooLong, "uri length %d exceeds %d", len(metadata.URI), depositParams.MaxMetadataLen)
+	}
+
+	if msgsContent, ok := content.(*types.MessagesProposal); ok {
+		// The messages are only executed once the proposal passes, as the state
+		// they depend on may change during the governance process.
+		if err := keeper.ValidateProposalMessages(msgsContent); err != nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+		}
+	} else {
+		// Execute the proposal content in a new context branch (with branched store)
+		// to validate the actual parameter changes before the proposal proceeds
+		// through the governance process. State is not persisted.
+		cacheCtx, _ := ctx.CacheContext()
+		handler := keeper.router.GetRoute(content.ProposalRoute())
+		if err := handler(cacheCtx, content); err != nil {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+		}
 	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
@@ -30,12 +46,14 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	}
 
 	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
+	depositPeriod := depositParams.MaxDepositPeriod
 
-	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod), expedited)
 	if err != nil {
 		return types.Proposal{}, err
 	}
+	proposal.Proposer = proposer.String()
+	proposal.Metadata = metadata
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -54,6 +72,51 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// CancelProposal cancels a proposal in deposit or voting period on behalf of its
+// proposer: the proposal and its votes are deleted, the proposal cancel ratio of
+// its deposits is burned and the rest of them is refunded.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	if (proposal.Status != types.StatusDepositPeriod) && (proposal.Status != types.StatusVotingPeriod) {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	// the proposal is only tallied at the end of the block in which its voting
+	// period ends, it cannot be canceled in the meantime
+	if proposal.Status == types.StatusVotingPeriod && !ctx.BlockHeader().Time.Before(proposal.VotingEndTime) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "%d", proposalID)
+	}
+
+	burned := keeper.ChargeDeposits(ctx, proposalID, keeper.GetDepositParams(ctx).ProposalCancelRatio)
+	keeper.DeleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	keeper.Logger(ctx).Info(
+		"proposal canceled",
+		"proposal", proposalID,
+		"title", proposal.GetTitle(),
+		"burned_deposit", burned.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalBurnedDeposit, burned.String()),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -185,7 +248,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.Expedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
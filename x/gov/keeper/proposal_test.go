@@ -12,7 +12,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil, nil, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.ProposalId
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -24,7 +24,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil, nil, false)
 	suite.Require().NoError(err)
 
 	suite.Require().True(proposal.VotingStartTime.Equal(time.Time{}))
@@ -64,11 +64,33 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 
 	for i, tc := range testCases {
-		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content)
+		_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tc.content, nil, nil, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalMetadata() {
+	hash := strings.Repeat("ab", 32)
+	maxLen := suite.app.GovKeeper.GetDepositParams(suite.ctx).MaxMetadataLen
+
+	metadata := types.NewProposalMetadata("ipfs://QmTzQ1Nj5Rz2WpWjfxTk7cvgxC8hPe6ozqnCjWmM3aYbYk", hash)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, nil, metadata, false)
+	suite.Require().NoError(err)
+
+	gotProposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+	suite.Require().Equal(metadata, gotProposal.Metadata)
+
+	// the uri can be as long as the max metadata length
+	metadata = types.NewProposalMetadata("https://"+strings.Repeat("a", int(maxLen)-8), hash)
+	_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, nil, metadata, false)
+	suite.Require().NoError(err)
+
+	metadata = types.NewProposalMetadata("https://"+strings.Repeat("a", int(maxLen)-7), hash)
+	_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, nil, metadata, false)
+	suite.Require().ErrorIs(err, types.ErrMetadataTooLong)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []types.ProposalStatus{types.StatusDepositPeriod, types.StatusVotingPeriod}
@@ -77,7 +99,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now())
+			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now(), false)
 			suite.Require().NoError(err)
 
 			p.Status = s
@@ -125,3 +147,49 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], nil, false)
+	suite.Require().NoError(err)
+	proposalID := proposal.ProposalId
+
+	threeStake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))
+	fiveStake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[0], threeStake)
+	suite.Require().NoError(err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[1], fiveStake)
+	suite.Require().NoError(err)
+
+	addr0Balance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+	addr1Balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+
+	// only the proposer can cancel the proposal
+	err = app.GovKeeper.CancelProposal(ctx, proposalID, addrs[1])
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+	err = app.GovKeeper.CancelProposal(ctx, proposalID+1, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrUnknownProposal)
+
+	// half of each deposit is burned, rounded down, and the rest is refunded
+	suite.Require().NoError(app.GovKeeper.CancelProposal(ctx, proposalID, addrs[0]))
+
+	_, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	suite.Require().False(ok)
+	suite.Require().Empty(app.GovKeeper.GetDeposits(ctx, proposalID))
+	suite.Require().Equal(addr0Balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)), app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	suite.Require().Equal(addr1Balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3)), app.BankKeeper.GetDeflationOfSource(ctx, types.ModuleName))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()).IsZero())
+
+	// a proposal whose voting period ended cannot be canceled anymore
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], nil, false)
+	suite.Require().NoError(err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, _ = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+
+	endCtx := ctx.WithBlockTime(proposal.VotingEndTime)
+	err = app.GovKeeper.CancelProposal(endCtx, proposal.ProposalId, addrs[0])
+	suite.Require().ErrorIs(err, types.ErrVotingPeriodEnded)
+	suite.Require().NoError(app.GovKeeper.CancelProposal(ctx, proposal.ProposalId, addrs[0]))
+}
@@ -6,29 +6,98 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: Break into several smaller functions for clarity
+// tallyState holds the voting power of the votes of a proposal, in total and
+// broken down by bonded validator.
+type tallyState struct {
+	results          map[types.VoteOption]sdk.Dec
+	totalVotingPower sdk.Dec
+	// totalStake is the stake the quorum is computed against
+	totalStake sdk.Int
+
+	// validators are the bonded validators by power
+	validators []types.ValidatorGovInfo
+	// validatorResults are the voting power cast with the vote of each validator
+	validatorResults map[string]map[types.VoteOption]sdk.Dec
+	// delegatorResults are the voting power of the delegators of each validator
+	// that voted themselves, the self-delegation of the validator excluded
+	delegatorResults map[string]map[types.VoteOption]sdk.Dec
+	unbondingResults map[types.VoteOption]sdk.Dec
+}
 
-// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
-func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
+func newVoteResults() map[types.VoteOption]sdk.Dec {
 	results := make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
 	results[types.OptionAbstain] = sdk.ZeroDec()
 	results[types.OptionNo] = sdk.ZeroDec()
 	results[types.OptionNoWithVeto] = sdk.ZeroDec()
+	return results
+}
+
+// addVotingPower splits the voting power between the given options, and adds it
+// to the results.
+func (ts *tallyState) addVotingPower(results map[types.VoteOption]sdk.Dec, options types.WeightedVoteOptions, votingPower sdk.Dec) {
+	for _, option := range options {
+		subPower := votingPower.Mul(option.Weight)
+		results[option.Option] = results[option.Option].Add(subPower)
+		ts.results[option.Option] = ts.results[option.Option].Add(subPower)
+	}
+	ts.totalVotingPower = ts.totalVotingPower.Add(votingPower)
+}
+
+// unbondingTokens returns the tokens of the unbonding delegations of a
+// delegator that are not mature yet.
+func (keeper Keeper) unbondingTokens(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	tokens := sdk.ZeroInt()
+	keeper.sk.IterateDelegatorUnbondingDelegations(ctx, delegator, func(ubd stakingtypes.UnbondingDelegation) bool {
+		for _, entry := range ubd.Entries {
+			if !entry.IsMature(ctx.BlockHeader().Time) {
+				tokens = tokens.Add(entry.Balance)
+			}
+		}
+		return false
+	})
 
-	totalVotingPower := sdk.ZeroDec()
+	return tokens
+}
+
+// tallyVotes iterates over the votes of a proposal and computes their voting
+// power. If the CountUnbondingDelegations param is enabled, the unbonding
+// delegations of a voter count in its voting power, and all of them count in
+// the total stake. The unbonding delegations of the delegators that did not
+// vote do not inherit the vote of their validator.
+func (keeper Keeper) tallyVotes(ctx sdk.Context, proposal types.Proposal) tallyState {
+	countUnbonding := keeper.GetTallyParams(ctx).CountUnbondingDelegations
+
+	state := tallyState{
+		results:          newVoteResults(),
+		totalVotingPower: sdk.ZeroDec(),
+		totalStake:       keeper.sk.TotalBondedTokens(ctx),
+		validatorResults: make(map[string]map[types.VoteOption]sdk.Dec),
+		delegatorResults: make(map[string]map[types.VoteOption]sdk.Dec),
+		unbondingResults: newVoteResults(),
+	}
 	currValidators := make(map[string]types.ValidatorGovInfo)
+	var valAddrs []string
 
 	// fetch all the bonded validators, insert them into currValidators
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
+		valAddrStr := validator.GetOperator().String()
+		// a validator is tallied once, even if the power index yields it more
+		// than once
+		if _, ok := currValidators[valAddrStr]; ok {
+			return false
+		}
+
+		currValidators[valAddrStr] = types.NewValidatorGovInfo(
 			validator.GetOperator(),
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
 			types.WeightedVoteOptions{},
 		)
+		valAddrs = append(valAddrs, valAddrStr)
+		state.validatorResults[valAddrStr] = newVoteResults()
+		state.delegatorResults[valAddrStr] = newVoteResults()
 
 		return false
 	})
@@ -47,45 +116,60 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
 
+			// the self-delegation of a validator is not deducted, it is tallied
+			// with the vote of the validator
+			if valAddrStr == sdk.ValAddress(voter).String() {
+				return false
+			}
+
 			if val, ok := currValidators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will deduct voter's voting power from validator.
 				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
 				currValidators[valAddrStr] = val
 
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					subPower := votingPower.Mul(option.Weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
+				state.addVotingPower(state.delegatorResults[valAddrStr], vote.Options, votingPower)
 			}
 
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
+		if countUnbonding {
+			if unbonding := keeper.unbondingTokens(ctx, voter); unbonding.IsPositive() {
+				state.addVotingPower(state.unbondingResults, vote.Options, unbonding.ToDec())
+			}
+		}
+
 		return false
 	})
 
 	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
+	for _, valAddrStr := range valAddrs {
+		val := currValidators[valAddrStr]
+		state.validators = append(state.validators, val)
 		if len(val.Vote) == 0 {
 			continue
 		}
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		state.addVotingPower(state.validatorResults[valAddrStr], val.Vote, votingPower)
+	}
 
-		for _, option := range val.Vote {
-			subPower := votingPower.Mul(option.Weight)
-			results[option.Option] = results[option.Option].Add(subPower)
-		}
-		totalVotingPower = totalVotingPower.Add(votingPower)
+	if countUnbonding {
+		state.totalStake = state.totalStake.Add(keeper.sk.TotalUnbondingTokens(ctx))
 	}
 
+	return state
+}
+
+// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
+// voters. The votes are not deleted, as those of an expedited proposal that does not pass are
+// tallied again once it is converted to a regular proposal.
+func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
+	state := keeper.tallyVotes(ctx, proposal)
+	results, totalVotingPower := state.results, state.totalVotingPower
+
 	tallyParams := keeper.GetTallyParams(ctx)
 	tallyResults = types.NewTallyResultFromMap(results)
 
@@ -96,7 +180,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
+	percentVoting := totalVotingPower.Quo(state.totalStake.ToDec())
 	if percentVoting.LT(tallyParams.Quorum) {
 		return false, true, tallyResults
 	}
@@ -111,8 +195,10 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		return false, true, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
+	// If more than 1/2 (2/3 for an expedited proposal) of non-abstaining voters
+	// vote Yes, proposal passes
+	threshold := tallyParams.GetThreshold(proposal.Expedited)
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
 
@@ -122,10 +122,16 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
-// deleteVote deletes a vote from a given proposalID and voter from the store
-func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+// DeleteVotes deletes all the votes on a specific proposal from the store
+func (keeper Keeper) DeleteVotes(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(types.VoteKey(proposalID, voterAddr))
+
+	keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		voter := sdk.MustAccAddressFromBech32(vote.Voter)
+
+		store.Delete(types.VoteKey(proposalID, voter))
+		return false
+	})
 }
 
 // populateLegacyOption adds graceful fallback of deprecated `Option` field, in case
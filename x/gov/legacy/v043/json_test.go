@@ -49,13 +49,18 @@ func TestMigrateJSON(t *testing.T) {
 	// - Votes are all ADR-037 weighted votes with weight 1.
 	expected := `{
 	"deposit_params": {
+		"expedited_min_deposit": [],
 		"max_deposit_period": "0s",
-		"min_deposit": []
+		"max_metadata_len": "0",
+		"min_deposit": [],
+		"proposal_cancel_ratio": "0"
 	},
 	"deposits": [],
 	"proposals": [],
 	"starting_proposal_id": "0",
 	"tally_params": {
+		"count_unbonding_delegations": false,
+		"expedited_threshold": "0",
 		"quorum": "0",
 		"threshold": "0",
 		"veto_threshold": "0"
@@ -118,6 +123,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": "0s",
 		"voting_period": "0s"
 	}
 }`
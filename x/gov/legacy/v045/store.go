@@ -0,0 +1,57 @@
+package v045
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// expeditedDepositMultiplier is the ratio between the default expedited
+// minimum deposit and the default minimum deposit.
+const expeditedDepositMultiplier = 5
+
+// migrateParams sets the expedited proposal, proposal cancel and proposal
+// metadata params, which did not exist before, relative to the existing params:
+//
+// - the expedited minimum deposit, to 5 times the minimum deposit
+// - the expedited voting period, to half the voting period
+// - the expedited threshold, to its default value, or to the threshold if it is
+// higher
+// - the proposal cancel ratio, to its default value
+// - the maximum metadata length, to its default value
+func migrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	depositParams.ExpeditedMinDeposit = sdk.NewCoins()
+	for _, coin := range depositParams.MinDeposit {
+		depositParams.ExpeditedMinDeposit = depositParams.ExpeditedMinDeposit.Add(
+			sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(expeditedDepositMultiplier)),
+		)
+	}
+	depositParams.ProposalCancelRatio = types.DefaultProposalCancelRatio
+	depositParams.MaxMetadataLen = types.DefaultMaxMetadataLen
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+
+	var votingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod / 2
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	tallyParams.ExpeditedThreshold = types.DefaultExpeditedThreshold
+	if tallyParams.Threshold.GT(tallyParams.ExpeditedThreshold) {
+		tallyParams.ExpeditedThreshold = tallyParams.Threshold
+	}
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Setting the expedited proposal params
+// - Setting the proposal cancel ratio param
+// - Setting the maximum proposal metadata length param
+func MigrateStore(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	migrateParams(ctx, paramSpace)
+	return nil
+}
@@ -0,0 +1,56 @@
+package v045_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v045gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, tParamsKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// params set before the expedited params existed
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin("uict", 1000))
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &types.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: time.Hour})
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &types.VotingParams{VotingPeriod: time.Hour})
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &types.TallyParams{
+		Quorum:        types.DefaultQuorum,
+		Threshold:     types.DefaultThreshold,
+		VetoThreshold: types.DefaultVetoThreshold,
+	})
+
+	require.NoError(t, v045gov.MigrateStore(ctx, paramSpace))
+
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	require.Equal(t, minDeposit, depositParams.MinDeposit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uict", 5000)), depositParams.ExpeditedMinDeposit)
+	require.Equal(t, types.DefaultProposalCancelRatio, depositParams.ProposalCancelRatio)
+	require.Equal(t, types.DefaultMaxMetadataLen, depositParams.MaxMetadataLen)
+
+	var votingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	require.Equal(t, time.Hour, votingParams.VotingPeriod)
+	require.Equal(t, 30*time.Minute, votingParams.ExpeditedVotingPeriod)
+
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, types.DefaultThreshold, tallyParams.Threshold)
+	require.Equal(t, types.DefaultExpeditedThreshold, tallyParams.ExpeditedThreshold)
+
+	genState := types.NewGenesisState(types.DefaultStartingProposalID, depositParams, votingParams, tallyParams)
+	require.NoError(t, types.ValidateGenesis(genState))
+}
@@ -43,13 +43,17 @@ func TestRandomizedGenState(t *testing.T) {
 	dec1, _ := sdk.NewDecFromStr("0.361000000000000000")
 	dec2, _ := sdk.NewDecFromStr("0.512000000000000000")
 	dec3, _ := sdk.NewDecFromStr("0.267000000000000000")
+	dec4, _ := sdk.NewDecFromStr("0.661000000000000000")
 
 	require.Equal(t, "905stake", govGenesis.DepositParams.MinDeposit.String())
 	require.Equal(t, "77h26m10s", govGenesis.DepositParams.MaxDepositPeriod.String())
-	require.Equal(t, float64(148296), govGenesis.VotingParams.VotingPeriod.Seconds())
+	require.Equal(t, "6728stake", govGenesis.DepositParams.ExpeditedMinDeposit.String())
+	require.Equal(t, float64(231494), govGenesis.VotingParams.VotingPeriod.Seconds())
+	require.Equal(t, float64(39329), govGenesis.VotingParams.ExpeditedVotingPeriod.Seconds())
 	require.Equal(t, dec1, govGenesis.TallyParams.Quorum)
 	require.Equal(t, dec2, govGenesis.TallyParams.Threshold)
 	require.Equal(t, dec3, govGenesis.TallyParams.VetoThreshold)
+	require.Equal(t, dec4, govGenesis.TallyParams.ExpeditedThreshold)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, types.Deposits{}, govGenesis.Deposits)
 	require.Equal(t, types.Votes{}, govGenesis.Votes)
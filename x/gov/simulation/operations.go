@@ -20,9 +20,10 @@ var initialProposalID = uint64(100000000000000)
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgDeposit      = "op_weight_msg_deposit"
-	OpWeightMsgVote         = "op_weight_msg_vote"
-	OpWeightMsgVoteWeighted = "op_weight_msg_weighted_vote"
+	OpWeightMsgDeposit        = "op_weight_msg_deposit"
+	OpWeightMsgVote           = "op_weight_msg_vote"
+	OpWeightMsgVoteWeighted   = "op_weight_msg_weighted_vote"
+	OpWeightMsgCancelProposal = "op_weight_msg_cancel_proposal"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -32,9 +33,10 @@ func WeightedOperations(
 ) simulation.WeightedOperations {
 
 	var (
-		weightMsgDeposit      int
-		weightMsgVote         int
-		weightMsgVoteWeighted int
+		weightMsgDeposit        int
+		weightMsgVote           int
+		weightMsgVoteWeighted   int
+		weightMsgCancelProposal int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -55,6 +57,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelProposal, &weightMsgCancelProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelProposal = simappparams.DefaultWeightMsgCancelProposal
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -86,6 +94,10 @@ func WeightedOperations(
 			weightMsgVoteWeighted,
 			SimulateMsgVoteWeighted(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelProposal,
+			SimulateMsgCancelProposal(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -399,6 +411,62 @@ func randomDeposit(r *rand.Rand, ctx sdk.Context,
 	return sdk.Coins{sdk.NewCoin(denom, amount)}, false, nil
 }
 
+// SimulateMsgCancelProposal generates a MsgCancelProposal for a random
+// proposal in deposit or voting period, signed by its proposer.
+func SimulateMsgCancelProposal(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		status := types.StatusDepositPeriod
+		if r.Intn(2) == 0 {
+			status = types.StatusVotingPeriod
+		}
+
+		proposalID, ok := randomProposalID(r, k, ctx, status)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelProposal, "unable to generate proposalID"), nil, nil
+		}
+
+		proposal, _ := k.GetProposal(ctx, proposalID)
+		if status == types.StatusVotingPeriod && !ctx.BlockTime().Before(proposal.VotingEndTime) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelProposal, "voting period ended"), nil, nil
+		}
+
+		proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelProposal, "proposal has no proposer"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, proposer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelProposal, "unable to find proposer"), nil, nil
+		}
+
+		msg := types.NewMsgCancelProposal(proposalID, simAccount.Address)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // Pick a random proposal ID between the initial proposal ID
 // (defined in gov GenesisState) and the latest proposal ID
 // that matches a given Status.
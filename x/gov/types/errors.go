@@ -14,4 +14,9 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 10, "expected gov account as only signer for proposal message")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 11, "invalid proposer")
+	ErrVotingPeriodEnded       = sdkerrors.Register(ModuleName, 12, "voting period already ended")
+	ErrInvalidMetadata         = sdkerrors.Register(ModuleName, 13, "invalid proposal metadata")
+	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 14, "proposal metadata too long")
 )
@@ -24,6 +24,12 @@ type StakingKeeper interface {
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
 	)
+
+	// the unbonding delegations, used to count them in the tally
+	TotalUnbondingTokens(sdk.Context) sdk.Int // total tokens of the unbonding delegations not mature yet
+	IterateDelegatorUnbondingDelegations(
+		ctx sdk.Context, delegator sdk.AccAddress, cb func(ubd stakingtypes.UnbondingDelegation) (stop bool),
+	)
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
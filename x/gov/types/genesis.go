@@ -50,6 +50,12 @@ func ValidateGenesis(data *GenesisState) error {
 			threshold.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if expeditedThreshold.LTE(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be greater than the vote threshold and less or equal to one, is %s",
+			expeditedThreshold.String())
+	}
+
 	veto := data.TallyParams.VetoThreshold
 	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("governance vote veto threshold should be positive and less or equal to one, is %s",
@@ -61,6 +67,28 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if !data.DepositParams.ExpeditedMinDeposit.IsValid() || data.DepositParams.MinDeposit.IsAllGTE(data.DepositParams.ExpeditedMinDeposit) {
+		return fmt.Errorf("governance expedited deposit amount must be a valid sdk.Coins amount greater than the minimum deposit, is %s",
+			data.DepositParams.ExpeditedMinDeposit.String())
+	}
+
+	cancelRatio := data.DepositParams.ProposalCancelRatio
+	if cancelRatio.IsNil() || cancelRatio.IsNegative() || cancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance proposal cancel ratio should be non-negative and less or equal to one, is %s",
+			cancelRatio)
+	}
+
+	if data.DepositParams.MaxMetadataLen == 0 {
+		return fmt.Errorf("governance maximum metadata length should be positive, is %d",
+			data.DepositParams.MaxMetadataLen)
+	}
+
+	expeditedVotingPeriod := data.VotingParams.ExpeditedVotingPeriod
+	if expeditedVotingPeriod <= 0 || expeditedVotingPeriod >= data.VotingParams.VotingPeriod {
+		return fmt.Errorf("governance expedited voting period should be positive and shorter than the voting period, is %s",
+			expeditedVotingPeriod.String())
+	}
+
 	return nil
 }
 
@@ -4,10 +4,11 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -200,6 +201,47 @@ func (m *TextProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TextProposal proto.InternalMessageInfo
 
+// MessagesProposal defines a proposal whose messages, signed by the governance
+// module account, are executed atomically through the MsgServiceRouter once the
+// proposal passes.
+type MessagesProposal struct {
+	Title       string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Messages    []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *MessagesProposal) Reset()      { *m = MessagesProposal{} }
+func (*MessagesProposal) ProtoMessage() {}
+func (*MessagesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+}
+func (m *MessagesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessagesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagesProposal.Merge(m, src)
+}
+func (m *MessagesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagesProposal proto.InternalMessageInfo
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
@@ -211,7 +253,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +285,7 @@ var xxx_messageInfo_Deposit proto.InternalMessageInfo
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	ProposalId       uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"id" yaml:"id"`
-	Content          *types1.Any                              `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Content          *types.Any                               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Status           ProposalStatus                           `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1beta1.ProposalStatus" json:"status,omitempty" yaml:"proposal_status"`
 	FinalTallyResult TallyResult                              `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result" yaml:"final_tally_result"`
 	SubmitTime       time.Time                                `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
@@ -251,12 +293,22 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// msg_results are the data returned by the messages of a MessagesProposal,
+	// set once the proposal passed and its messages were executed.
+	MsgResults [][]byte `protobuf:"bytes,10,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty" yaml:"msg_results,omitempty"`
+	// expedited defines if the proposal is expedited. An expedited proposal that
+	// does not reach the expedited threshold is converted to a regular proposal.
+	Expedited bool `protobuf:"varint,11,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposer is the address of the proposal submitter.
+	Proposer string `protobuf:"bytes,12,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// metadata is the optional off-chain document of the proposal.
+	Metadata *ProposalMetadata `protobuf:"bytes,13,opt,name=metadata,proto3" json:"metadata,omitempty" yaml:"metadata,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,6 +337,47 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// ProposalMetadata links a proposal to an off-chain document, e.g. a larger
+// specification, and pins its content.
+type ProposalMetadata struct {
+	// uri is the location of the document, e.g. an ipfs:// or https:// URI.
+	URI string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// hash is the hex-encoded SHA-256 hash of the document.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *ProposalMetadata) Reset()      { *m = ProposalMetadata{} }
+func (*ProposalMetadata) ProtoMessage() {}
+func (*ProposalMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+}
+func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalMetadata.Merge(m, src)
+}
+func (m *ProposalMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalMetadata proto.InternalMessageInfo
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	Yes        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=yes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"yes"`
@@ -296,7 +389,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +434,7 @@ type Vote struct {
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,12 +470,20 @@ type DepositParams struct {
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expedited_min_deposit,omitempty" yaml:"expedited_min_deposit"`
+	//  Proportion of the deposits burned when a proposal is canceled by its
+	//  proposer, the rest is refunded. Default value: 0.5.
+	ProposalCancelRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proposal_cancel_ratio,omitempty" yaml:"proposal_cancel_ratio"`
+	//  Maximum length of the metadata URI of a proposal, the hash has a fixed
+	//  length. Must be positive. Default value: 255.
+	MaxMetadataLen uint64 `protobuf:"varint,5,opt,name=max_metadata_len,json=maxMetadataLen,proto3" json:"max_metadata_len,omitempty" yaml:"max_metadata_len"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,12 +516,14 @@ var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty" yaml:"voting_period"`
+	//  Length of the voting period of an expedited proposal.
+	ExpeditedVotingPeriod time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{9}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,12 +562,19 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty" yaml:"veto_threshold"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass. Default
+	//  value: 0.667.
+	ExpeditedThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_threshold,omitempty" yaml:"expedited_threshold"`
+	//  Whether the tokens of the unbonding delegations of a voter count in its
+	//  voting power, and in the total stake the quorum is computed against.
+	//  Default value: false.
+	CountUnbondingDelegations bool `protobuf:"varint,5,opt,name=count_unbonding_delegations,json=countUnbondingDelegations,proto3" json:"count_unbonding_delegations,omitempty" yaml:"count_unbonding_delegations"`
 }
 
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{10}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,8 +608,10 @@ func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
+	proto.RegisterType((*MessagesProposal)(nil), "cosmos.gov.v1beta1.MessagesProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
+	proto.RegisterType((*ProposalMetadata)(nil), "cosmos.gov.v1beta1.ProposalMetadata")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1beta1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1beta1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta1.DepositParams")
@@ -510,98 +622,123 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x51, 0x68, 0xdb, 0x56,
-	0x17, 0xb6, 0x6c, 0xc7, 0x89, 0xaf, 0x9d, 0x44, 0xbd, 0x49, 0x13, 0xc7, 0x7f, 0x7f, 0xc9, 0xbf,
-	0xfe, 0x9f, 0x12, 0x4a, 0xeb, 0xb4, 0xf9, 0xc7, 0xc6, 0x52, 0xd8, 0x66, 0xc5, 0xca, 0xea, 0x51,
-	0x6c, 0x23, 0xab, 0x0e, 0xed, 0x1e, 0x84, 0x62, 0xdf, 0x3a, 0xda, 0x2c, 0x5d, 0xcf, 0xba, 0x4e,
-	0x13, 0xf6, 0xb2, 0xc7, 0xe2, 0xc1, 0xe8, 0x63, 0x61, 0x18, 0x0a, 0x63, 0x2f, 0x7b, 0xde, 0xf3,
-	0x9e, 0xc3, 0x18, 0xac, 0xec, 0xa9, 0x6c, 0xe0, 0xae, 0x09, 0x8c, 0x92, 0xc7, 0x3c, 0xec, 0x79,
-	0x48, 0xf7, 0x2a, 0x96, 0xed, 0xb0, 0xd4, 0x7b, 0x8a, 0x74, 0xee, 0xf9, 0xbe, 0x73, 0xce, 0xe7,
-	0x73, 0xce, 0x55, 0xc0, 0x95, 0x1a, 0x76, 0x2c, 0xec, 0xac, 0x35, 0xf0, 0xde, 0xda, 0xde, 0xad,
-	0x1d, 0x44, 0x8c, 0x5b, 0xee, 0x73, 0xb6, 0xd5, 0xc6, 0x04, 0x43, 0x48, 0x4f, 0xb3, 0xae, 0x85,
-	0x9d, 0xa6, 0x05, 0x86, 0xd8, 0x31, 0x1c, 0x74, 0x06, 0xa9, 0x61, 0xd3, 0xa6, 0x98, 0xf4, 0x62,
-	0x03, 0x37, 0xb0, 0xf7, 0xb8, 0xe6, 0x3e, 0x31, 0xeb, 0x0a, 0x45, 0xe9, 0xf4, 0x80, 0xd1, 0xd2,
-	0x23, 0xb1, 0x81, 0x71, 0xa3, 0x89, 0xd6, 0xbc, 0xb7, 0x9d, 0xce, 0xc3, 0x35, 0x62, 0x5a, 0xc8,
-	0x21, 0x86, 0xd5, 0xf2, 0xb1, 0xa3, 0x0e, 0x86, 0x7d, 0xc0, 0x8e, 0x84, 0xd1, 0xa3, 0x7a, 0xa7,
-	0x6d, 0x10, 0x13, 0xb3, 0x64, 0xa4, 0x6f, 0x39, 0x00, 0xb7, 0x91, 0xd9, 0xd8, 0x25, 0xa8, 0x5e,
-	0xc5, 0x04, 0x95, 0x5a, 0xee, 0x21, 0x7c, 0x1b, 0xc4, 0xb0, 0xf7, 0x94, 0xe2, 0x32, 0xdc, 0xea,
-	0xdc, 0xba, 0x90, 0x1d, 0x2f, 0x34, 0x3b, 0xf0, 0x57, 0x99, 0x37, 0xdc, 0x06, 0xb1, 0x47, 0x1e,
-	0x5b, 0x2a, 0x9c, 0xe1, 0x56, 0xe3, 0xf2, 0xfb, 0x87, 0x7d, 0x31, 0xf4, 0x6b, 0x5f, 0xbc, 0xda,
-	0x30, 0xc9, 0x6e, 0x67, 0x27, 0x5b, 0xc3, 0x16, 0xab, 0x8d, 0xfd, 0xb9, 0xe1, 0xd4, 0x3f, 0x5d,
-	0x23, 0x07, 0x2d, 0xe4, 0x64, 0xf3, 0xa8, 0x76, 0xda, 0x17, 0x67, 0x0f, 0x0c, 0xab, 0xb9, 0x21,
-	0x51, 0x16, 0x49, 0x65, 0x74, 0xd2, 0x36, 0x48, 0x6a, 0x68, 0x9f, 0x94, 0xdb, 0xb8, 0x85, 0x1d,
-	0xa3, 0x09, 0x17, 0xc1, 0x14, 0x31, 0x49, 0x13, 0x79, 0xf9, 0xc5, 0x55, 0xfa, 0x02, 0x33, 0x20,
-	0x51, 0x47, 0x4e, 0xad, 0x6d, 0xd2, 0xdc, 0xbd, 0x1c, 0xd4, 0xa0, 0x69, 0x63, 0xfe, 0xf5, 0x33,
-	0x91, 0xfb, 0xe5, 0xfb, 0x1b, 0xd3, 0x9b, 0xd8, 0x26, 0xc8, 0x26, 0xd2, 0xcf, 0x1c, 0x98, 0xce,
-	0xa3, 0x16, 0x76, 0x4c, 0x02, 0xdf, 0x01, 0x89, 0x16, 0x0b, 0xa0, 0x9b, 0x75, 0x8f, 0x3a, 0x2a,
-	0x2f, 0x9d, 0xf6, 0x45, 0x48, 0x93, 0x0a, 0x1c, 0x4a, 0x2a, 0xf0, 0xdf, 0x0a, 0x75, 0x78, 0x05,
-	0xc4, 0xeb, 0x94, 0x03, 0xb7, 0x59, 0xd4, 0x81, 0x01, 0xd6, 0x40, 0xcc, 0xb0, 0x70, 0xc7, 0x26,
-	0xa9, 0x48, 0x26, 0xb2, 0x9a, 0x58, 0x5f, 0xf1, 0xc5, 0x74, 0x3b, 0xe4, 0x4c, 0xcd, 0x4d, 0x6c,
-	0xda, 0xf2, 0x4d, 0x57, 0xaf, 0xef, 0x5e, 0x8a, 0xab, 0x6f, 0xa0, 0x97, 0x0b, 0x70, 0x54, 0x46,
-	0xbd, 0x31, 0xf3, 0xf8, 0x99, 0x18, 0x7a, 0xfd, 0x4c, 0x0c, 0x49, 0x7f, 0xc6, 0xc0, 0xcc, 0x99,
-	0x4e, 0x6f, 0x9d, 0x57, 0xd2, 0xc2, 0x49, 0x5f, 0x0c, 0x9b, 0xf5, 0xd3, 0xbe, 0x18, 0xa7, 0x85,
-	0x8d, 0xd6, 0x73, 0x1b, 0x4c, 0xd7, 0xa8, 0x3e, 0x5e, 0x35, 0x89, 0xf5, 0xc5, 0x2c, 0xed, 0xa3,
-	0xac, 0xdf, 0x47, 0xd9, 0x9c, 0x7d, 0x20, 0x27, 0x7e, 0x1c, 0x08, 0xa9, 0xfa, 0x08, 0x58, 0x05,
-	0x31, 0x87, 0x18, 0xa4, 0xe3, 0xa4, 0x22, 0x5e, 0xef, 0x48, 0xe7, 0xf5, 0x8e, 0x9f, 0x60, 0xc5,
-	0xf3, 0x94, 0xd3, 0xa7, 0x7d, 0x71, 0x69, 0x44, 0x64, 0x4a, 0x22, 0xa9, 0x8c, 0x0d, 0xb6, 0x00,
-	0x7c, 0x68, 0xda, 0x46, 0x53, 0x27, 0x46, 0xb3, 0x79, 0xa0, 0xb7, 0x91, 0xd3, 0x69, 0x92, 0x54,
-	0xd4, 0xcb, 0x4f, 0x3c, 0x2f, 0x86, 0xe6, 0xfa, 0xa9, 0x9e, 0x9b, 0xfc, 0x1f, 0x57, 0xd8, 0xd3,
-	0xbe, 0xb8, 0x42, 0x83, 0x8c, 0x13, 0x49, 0x2a, 0xef, 0x19, 0x03, 0x20, 0xf8, 0x31, 0x48, 0x38,
-	0x9d, 0x1d, 0xcb, 0x24, 0xba, 0x3b, 0x71, 0xa9, 0x29, 0x2f, 0x54, 0x7a, 0x4c, 0x0a, 0xcd, 0x1f,
-	0x47, 0x59, 0x60, 0x51, 0x58, 0xbf, 0x04, 0xc0, 0xd2, 0x93, 0x97, 0x22, 0xa7, 0x02, 0x6a, 0x71,
-	0x01, 0xd0, 0x04, 0x3c, 0x6b, 0x11, 0x1d, 0xd9, 0x75, 0x1a, 0x21, 0x76, 0x61, 0x84, 0xff, 0xb2,
-	0x08, 0xcb, 0x34, 0xc2, 0x28, 0x03, 0x0d, 0x33, 0xc7, 0xcc, 0x8a, 0x5d, 0xf7, 0x42, 0x3d, 0xe6,
-	0xc0, 0x2c, 0xc1, 0xc4, 0x68, 0xea, 0xec, 0x20, 0x35, 0x7d, 0x51, 0x23, 0xde, 0x61, 0x71, 0x16,
-	0x69, 0x9c, 0x21, 0xb4, 0x34, 0x51, 0x83, 0x26, 0x3d, 0xac, 0x3f, 0x62, 0x4d, 0x70, 0x69, 0x0f,
-	0x13, 0xd3, 0x6e, 0xb8, 0x3f, 0x6f, 0x9b, 0x09, 0x3b, 0x73, 0x61, 0xd9, 0xff, 0x63, 0xe9, 0xa4,
-	0x68, 0x3a, 0x63, 0x14, 0xb4, 0xee, 0x79, 0x6a, 0xaf, 0xb8, 0x66, 0xaf, 0xf0, 0x87, 0x80, 0x99,
-	0x06, 0x12, 0xc7, 0x2f, 0x8c, 0x25, 0xb1, 0x58, 0x4b, 0x43, 0xb1, 0x86, 0x15, 0x9e, 0xa5, 0x56,
-	0x26, 0xf0, 0x46, 0xd4, 0xdd, 0x2a, 0xd2, 0x61, 0x18, 0x24, 0x82, 0xed, 0xf3, 0x01, 0x88, 0x1c,
-	0x20, 0x87, 0x6e, 0x28, 0x39, 0x3b, 0xc1, 0x26, 0x2c, 0xd8, 0x44, 0x75, 0xa1, 0xf0, 0x0e, 0x98,
-	0x36, 0x76, 0x1c, 0x62, 0x98, 0x6c, 0x97, 0x4d, 0xcc, 0xe2, 0xc3, 0xe1, 0x7b, 0x20, 0x6c, 0x63,
-	0x6f, 0x20, 0x27, 0x27, 0x09, 0xdb, 0x18, 0x36, 0x40, 0xd2, 0xc6, 0xfa, 0x23, 0x93, 0xec, 0xea,
-	0x7b, 0x88, 0x60, 0x6f, 0xec, 0xe2, 0xb2, 0x32, 0x19, 0xd3, 0x69, 0x5f, 0x5c, 0xa0, 0xa2, 0x06,
-	0xb9, 0x24, 0x15, 0xd8, 0x78, 0xdb, 0x24, 0xbb, 0x55, 0x44, 0x30, 0x93, 0xf2, 0x98, 0x03, 0x51,
-	0xf7, 0x7a, 0xf9, 0xe7, 0x2b, 0x79, 0x11, 0x4c, 0xed, 0x61, 0x82, 0xfc, 0x75, 0x4c, 0x5f, 0xe0,
-	0xc6, 0xd9, 0xbd, 0x16, 0x79, 0x93, 0x7b, 0x4d, 0x0e, 0xa7, 0xb8, 0xb3, 0xbb, 0x6d, 0x0b, 0x4c,
-	0xd3, 0x27, 0x27, 0x15, 0xf5, 0xc6, 0xe7, 0xea, 0x79, 0xe0, 0xf1, 0xcb, 0x54, 0x8e, 0xba, 0x2a,
-	0xa9, 0x3e, 0x78, 0x63, 0xe6, 0xa9, 0xbf, 0xa9, 0x7f, 0x08, 0x83, 0x59, 0x36, 0x18, 0x65, 0xa3,
-	0x6d, 0x58, 0x0e, 0xfc, 0x9a, 0x03, 0x09, 0xcb, 0xb4, 0xcf, 0xe6, 0x94, 0xbb, 0x68, 0x4e, 0x75,
-	0x97, 0xfb, 0xa4, 0x2f, 0x5e, 0x0e, 0xa0, 0xae, 0x63, 0xcb, 0x24, 0xc8, 0x6a, 0x91, 0x83, 0x81,
-	0x4e, 0x81, 0xe3, 0xc9, 0xc6, 0x17, 0x58, 0xa6, 0xed, 0x0f, 0xef, 0x57, 0x1c, 0x80, 0x96, 0xb1,
-	0xef, 0x13, 0xe9, 0x2d, 0xd4, 0x36, 0x71, 0x9d, 0x5d, 0x11, 0x2b, 0x63, 0x23, 0x95, 0x67, 0x9f,
-	0x1a, 0xb4, 0x4d, 0x4e, 0xfa, 0xe2, 0x95, 0x71, 0xf0, 0x50, 0xae, 0x6c, 0x39, 0x8f, 0x7b, 0x49,
-	0x4f, 0xdd, 0xa1, 0xe3, 0x2d, 0x63, 0xdf, 0x97, 0x8b, 0x9a, 0xbf, 0xe4, 0x40, 0xb2, 0xea, 0x4d,
-	0x22, 0xd3, 0xef, 0x73, 0xc0, 0x26, 0xd3, 0xcf, 0x8d, 0xbb, 0x28, 0xb7, 0xdb, 0x2c, 0xb7, 0xe5,
-	0x21, 0xdc, 0x50, 0x5a, 0x8b, 0x43, 0x8b, 0x20, 0x98, 0x51, 0x92, 0xda, 0x58, 0x36, 0xbf, 0xf9,
-	0xf3, 0xcf, 0x92, 0x79, 0x00, 0x62, 0x9f, 0x75, 0x70, 0xbb, 0x63, 0x79, 0x59, 0x24, 0x65, 0x79,
-	0xb2, 0x8f, 0xa1, 0x93, 0xbe, 0xc8, 0x53, 0xfc, 0x20, 0x1b, 0x95, 0x31, 0xc2, 0x1a, 0x88, 0x93,
-	0xdd, 0x36, 0x72, 0x76, 0x71, 0x93, 0xfe, 0x00, 0xc9, 0x89, 0x86, 0x91, 0xd2, 0x2f, 0x9c, 0x51,
-	0x04, 0x22, 0x0c, 0x78, 0x61, 0x97, 0x03, 0x73, 0xee, 0x84, 0xea, 0x83, 0x50, 0x11, 0x2f, 0x54,
-	0x6d, 0xe2, 0x50, 0xa9, 0x61, 0x9e, 0x21, 0x7d, 0x2f, 0x33, 0x7d, 0x87, 0x3c, 0x24, 0x75, 0xd6,
-	0x35, 0x68, 0xfe, 0xfb, 0xb5, 0x3f, 0x38, 0x00, 0x02, 0x5f, 0xa8, 0xd7, 0xc1, 0x72, 0xb5, 0xa4,
-	0x29, 0x7a, 0xa9, 0xac, 0x15, 0x4a, 0x45, 0xfd, 0x5e, 0xb1, 0x52, 0x56, 0x36, 0x0b, 0x5b, 0x05,
-	0x25, 0xcf, 0x87, 0xd2, 0xf3, 0xdd, 0x5e, 0x26, 0x41, 0x1d, 0x15, 0x37, 0x08, 0x94, 0xc0, 0x7c,
-	0xd0, 0xfb, 0xbe, 0x52, 0xe1, 0xb9, 0xf4, 0x6c, 0xb7, 0x97, 0x89, 0x53, 0xaf, 0xfb, 0xc8, 0x81,
-	0xd7, 0xc0, 0x42, 0xd0, 0x27, 0x27, 0x57, 0xb4, 0x5c, 0xa1, 0xc8, 0x87, 0xd3, 0x97, 0xba, 0xbd,
-	0xcc, 0x2c, 0xf5, 0xcb, 0xb1, 0x75, 0x9a, 0x01, 0x73, 0x41, 0xdf, 0x62, 0x89, 0x8f, 0xa4, 0x93,
-	0xdd, 0x5e, 0x66, 0x86, 0xba, 0x15, 0x31, 0x5c, 0x07, 0xa9, 0x61, 0x0f, 0x7d, 0xbb, 0xa0, 0xdd,
-	0xd1, 0xab, 0x8a, 0x56, 0xe2, 0xa3, 0xe9, 0xc5, 0x6e, 0x2f, 0xc3, 0xfb, 0xbe, 0xfe, 0xee, 0x4b,
-	0x47, 0x1f, 0x7f, 0x23, 0x84, 0xae, 0xfd, 0x14, 0x06, 0x73, 0xc3, 0x9f, 0x47, 0x30, 0x0b, 0xfe,
-	0x55, 0x56, 0x4b, 0xe5, 0x52, 0x25, 0x77, 0x57, 0xaf, 0x68, 0x39, 0xed, 0x5e, 0x65, 0xa4, 0x60,
-	0xaf, 0x14, 0xea, 0x5c, 0x34, 0x9b, 0xf0, 0x36, 0x10, 0x46, 0xfd, 0xf3, 0x4a, 0xb9, 0x54, 0x29,
-	0x68, 0x7a, 0x59, 0x51, 0x0b, 0xa5, 0x3c, 0xcf, 0xa5, 0x97, 0xbb, 0xbd, 0xcc, 0x02, 0x85, 0x0c,
-	0x0d, 0x15, 0x7c, 0x17, 0xfc, 0x7b, 0x14, 0x5c, 0x2d, 0x69, 0x85, 0xe2, 0x87, 0x3e, 0x36, 0x9c,
-	0x5e, 0xea, 0xf6, 0x32, 0x90, 0x62, 0xab, 0x81, 0x09, 0x80, 0xd7, 0xc1, 0xd2, 0x28, 0xb4, 0x9c,
-	0xab, 0x54, 0x94, 0x3c, 0x1f, 0x49, 0xf3, 0xdd, 0x5e, 0x26, 0x49, 0x31, 0x65, 0xc3, 0x71, 0x50,
-	0x1d, 0xde, 0x04, 0xa9, 0x51, 0x6f, 0x55, 0xf9, 0x48, 0xd9, 0xd4, 0x94, 0x3c, 0x1f, 0x4d, 0xc3,
-	0x6e, 0x2f, 0x33, 0x47, 0xfd, 0x55, 0xf4, 0x09, 0xaa, 0x11, 0x74, 0x2e, 0xff, 0x56, 0xae, 0x70,
-	0x57, 0xc9, 0xf3, 0x53, 0x41, 0xfe, 0x2d, 0xc3, 0x6c, 0xa2, 0x3a, 0x95, 0x53, 0x2e, 0x1e, 0xbe,
-	0x12, 0x42, 0x2f, 0x5e, 0x09, 0xa1, 0x2f, 0x8e, 0x84, 0xd0, 0xe1, 0x91, 0xc0, 0x3d, 0x3f, 0x12,
-	0xb8, 0xdf, 0x8f, 0x04, 0xee, 0xc9, 0xb1, 0x10, 0x7a, 0x7e, 0x2c, 0x84, 0x5e, 0x1c, 0x0b, 0xa1,
-	0x07, 0x7f, 0xbf, 0x10, 0xf7, 0xbd, 0x7f, 0xff, 0xbc, 0x7e, 0xde, 0x89, 0x79, 0x3b, 0xe4, 0xff,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x14, 0xb4, 0xf2, 0xfe, 0x19, 0x0e, 0x00, 0x00,
+	// 1848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe6, 0x92, 0x94, 0x44, 0x3d, 0x52, 0x32, 0x33, 0x92, 0x25, 0x8a, 0xb1, 0xb9, 0xcc, 0x36,
+	0x0d, 0x04, 0xc3, 0xa6, 0x12, 0xb7, 0x68, 0x11, 0x19, 0xfd, 0xa1, 0x15, 0xe9, 0x9a, 0xad, 0x2d,
+	0x12, 0x4b, 0x5a, 0x42, 0xd2, 0xc3, 0x62, 0xc5, 0x1d, 0x53, 0xdb, 0x70, 0x77, 0x18, 0xee, 0x50,
+	0x91, 0xd0, 0x4b, 0x8f, 0x06, 0x0f, 0xa9, 0x8f, 0x41, 0x0b, 0x16, 0x46, 0x8b, 0x5c, 0x7a, 0xea,
+	0x21, 0x7f, 0x41, 0x4f, 0x46, 0x51, 0xa0, 0x41, 0xd1, 0x43, 0xd0, 0x03, 0xd3, 0xc8, 0x40, 0x11,
+	0xe8, 0xa8, 0xbf, 0xa0, 0xd8, 0x99, 0x59, 0x72, 0x97, 0x64, 0xa5, 0xd0, 0x39, 0x69, 0xf6, 0xcd,
+	0xfb, 0xbe, 0xf7, 0xcd, 0x9b, 0x37, 0x6f, 0x46, 0x84, 0x1b, 0x0d, 0xe2, 0xda, 0xc4, 0xdd, 0x6a,
+	0x92, 0xe3, 0xad, 0xe3, 0x77, 0x0e, 0x31, 0x35, 0xde, 0xf1, 0xc6, 0x85, 0x76, 0x87, 0x50, 0x82,
+	0x10, 0x9f, 0x2d, 0x78, 0x16, 0x31, 0x9b, 0xcd, 0x09, 0xc4, 0xa1, 0xe1, 0xe2, 0x21, 0xa4, 0x41,
+	0x2c, 0x87, 0x63, 0xb2, 0xab, 0x4d, 0xd2, 0x24, 0x6c, 0xb8, 0xe5, 0x8d, 0x84, 0x75, 0x83, 0xa3,
+	0x74, 0x3e, 0x21, 0x68, 0xf9, 0x94, 0xdc, 0x24, 0xa4, 0xd9, 0xc2, 0x5b, 0xec, 0xeb, 0xb0, 0xfb,
+	0x64, 0x8b, 0x5a, 0x36, 0x76, 0xa9, 0x61, 0xb7, 0x7d, 0xec, 0xb8, 0x83, 0xe1, 0x9c, 0x8a, 0xa9,
+	0xdc, 0xf8, 0x94, 0xd9, 0xed, 0x18, 0xd4, 0x22, 0x42, 0x8c, 0xf2, 0xa9, 0x04, 0xe8, 0x00, 0x5b,
+	0xcd, 0x23, 0x8a, 0xcd, 0x7d, 0x42, 0x71, 0xa5, 0xed, 0x4d, 0xa2, 0x1f, 0xc0, 0x3c, 0x61, 0xa3,
+	0x8c, 0x94, 0x97, 0x36, 0x97, 0xef, 0xe6, 0x0a, 0x93, 0x0b, 0x2d, 0x8c, 0xfc, 0x35, 0xe1, 0x8d,
+	0x0e, 0x60, 0xfe, 0x23, 0xc6, 0x96, 0x89, 0xe6, 0xa5, 0xcd, 0x45, 0xf5, 0x27, 0x2f, 0x06, 0x72,
+	0xe4, 0xdf, 0x03, 0xf9, 0xad, 0xa6, 0x45, 0x8f, 0xba, 0x87, 0x85, 0x06, 0xb1, 0xc5, 0xda, 0xc4,
+	0x9f, 0x3b, 0xae, 0xf9, 0xc1, 0x16, 0x3d, 0x6d, 0x63, 0xb7, 0x50, 0xc4, 0x8d, 0x8b, 0x81, 0xbc,
+	0x74, 0x6a, 0xd8, 0xad, 0x6d, 0x85, 0xb3, 0x28, 0x9a, 0xa0, 0x53, 0x0e, 0x20, 0x55, 0xc7, 0x27,
+	0xb4, 0xda, 0x21, 0x6d, 0xe2, 0x1a, 0x2d, 0xb4, 0x0a, 0x73, 0xd4, 0xa2, 0x2d, 0xcc, 0xf4, 0x2d,
+	0x6a, 0xfc, 0x03, 0xe5, 0x21, 0x69, 0x62, 0xb7, 0xd1, 0xb1, 0xb8, 0x76, 0xa6, 0x41, 0x0b, 0x9a,
+	0xb6, 0xaf, 0x7d, 0xfd, 0x5c, 0x96, 0xfe, 0xf9, 0xd9, 0x9d, 0x85, 0x5d, 0xe2, 0x50, 0xec, 0x50,
+	0xe5, 0x77, 0x12, 0xa4, 0x1f, 0x61, 0xd7, 0x35, 0x9a, 0xd8, 0xfd, 0xb6, 0xec, 0xe8, 0x47, 0x90,
+	0xb0, 0x05, 0x57, 0x26, 0x96, 0x8f, 0x6d, 0x26, 0xef, 0xae, 0x16, 0xf8, 0x06, 0x14, 0xfc, 0x0d,
+	0x28, 0xec, 0x38, 0xa7, 0x6a, 0xf2, 0x6f, 0x9f, 0xdd, 0x59, 0x70, 0xcd, 0x0f, 0x0a, 0x8f, 0xdc,
+	0xa6, 0x36, 0x84, 0x4c, 0x8a, 0xfb, 0x87, 0x04, 0x0b, 0x45, 0xdc, 0x26, 0xae, 0x45, 0xd1, 0x0f,
+	0x21, 0xd9, 0x16, 0xfa, 0x74, 0xcb, 0x64, 0xca, 0xe2, 0xea, 0xda, 0xc5, 0x40, 0x46, 0x3c, 0x63,
+	0x81, 0x49, 0x45, 0x03, 0xff, 0xab, 0x6c, 0xa2, 0x1b, 0xb0, 0x68, 0x72, 0x0e, 0xd2, 0x11, 0xa2,
+	0x47, 0x06, 0xd4, 0x80, 0x79, 0xc3, 0x26, 0x5d, 0x87, 0x0a, 0xc1, 0x1b, 0xfe, 0x4e, 0x7b, 0xe5,
+	0x3b, 0xdc, 0xea, 0x5d, 0x62, 0x39, 0xea, 0xdb, 0xde, 0x66, 0xfe, 0xf9, 0x4b, 0x79, 0xf3, 0x1b,
+	0x6c, 0xa6, 0x07, 0x70, 0x35, 0x41, 0xbd, 0x9d, 0x78, 0xfa, 0x5c, 0x8e, 0x7c, 0xfd, 0x5c, 0x8e,
+	0x28, 0xff, 0x4a, 0x40, 0x62, 0x98, 0xe6, 0xef, 0x4f, 0x5b, 0xd2, 0xca, 0xf9, 0x40, 0x8e, 0x5a,
+	0xe6, 0xc5, 0x40, 0x5e, 0xe4, 0x0b, 0x1b, 0x5f, 0xcf, 0x3d, 0x58, 0x68, 0xf0, 0xfc, 0xb0, 0xd5,
+	0x5c, 0x9a, 0x63, 0x91, 0x48, 0xcd, 0x47, 0xa0, 0x7d, 0x98, 0x77, 0xa9, 0x41, 0xbb, 0xde, 0xfe,
+	0x78, 0x85, 0xad, 0x4c, 0x2b, 0x6c, 0x5f, 0x60, 0x8d, 0x79, 0xaa, 0xd9, 0x8b, 0x81, 0xbc, 0x36,
+	0x96, 0x64, 0x4e, 0xa2, 0x68, 0x82, 0x0d, 0xb5, 0x01, 0x3d, 0xb1, 0x1c, 0xa3, 0xa5, 0x53, 0xa3,
+	0xd5, 0x3a, 0xd5, 0x3b, 0xd8, 0xed, 0xb6, 0x68, 0x26, 0xce, 0xf4, 0xc9, 0xd3, 0x62, 0xd4, 0x3d,
+	0x3f, 0x8d, 0xb9, 0xa9, 0x6f, 0x78, 0x89, 0xbd, 0x18, 0xc8, 0x1b, 0x3c, 0xc8, 0x24, 0x91, 0xa2,
+	0xa5, 0x99, 0x31, 0x00, 0x42, 0xbf, 0x84, 0xa4, 0xdb, 0x3d, 0xb4, 0x2d, 0xaa, 0x7b, 0xed, 0x20,
+	0x33, 0xc7, 0x42, 0x65, 0x27, 0x52, 0x51, 0xf7, 0x7b, 0x85, 0x9a, 0x13, 0x51, 0x44, 0xbd, 0x04,
+	0xc0, 0xca, 0xb3, 0x2f, 0x65, 0x49, 0x03, 0x6e, 0xf1, 0x00, 0xc8, 0x82, 0xb4, 0x28, 0x11, 0x1d,
+	0x3b, 0x26, 0x8f, 0x30, 0x7f, 0x65, 0x84, 0xef, 0x88, 0x08, 0xeb, 0x3c, 0xc2, 0x38, 0x03, 0x0f,
+	0xb3, 0x2c, 0xcc, 0x25, 0xc7, 0x64, 0xa1, 0x9e, 0x4a, 0xb0, 0x44, 0x09, 0x35, 0x5a, 0xba, 0x98,
+	0xc8, 0x2c, 0x5c, 0x55, 0x88, 0x0f, 0x44, 0x9c, 0x55, 0x1e, 0x27, 0x84, 0x56, 0x66, 0x2a, 0xd0,
+	0x14, 0xc3, 0xfa, 0x47, 0xac, 0x05, 0xaf, 0x1d, 0x13, 0x6a, 0x39, 0x4d, 0x6f, 0x7b, 0x3b, 0x22,
+	0xb1, 0x89, 0x2b, 0x97, 0xfd, 0xa6, 0x90, 0x93, 0xe1, 0x72, 0x26, 0x28, 0xf8, 0xba, 0xaf, 0x71,
+	0x7b, 0xcd, 0x33, 0xb3, 0x85, 0x3f, 0x01, 0x61, 0x1a, 0xa5, 0x78, 0xf1, 0xca, 0x58, 0x8a, 0x88,
+	0xb5, 0x16, 0x8a, 0x15, 0xce, 0xf0, 0x12, 0xb7, 0xfa, 0x09, 0xde, 0x81, 0xa4, 0xed, 0x36, 0x45,
+	0x25, 0xb9, 0x19, 0xc8, 0xc7, 0x36, 0x53, 0x6a, 0xfe, 0x62, 0x20, 0xdf, 0xe0, 0x1c, 0x81, 0xc9,
+	0xdb, 0xc4, 0xb6, 0x28, 0xb6, 0xdb, 0xf4, 0x54, 0xd1, 0xc0, 0x76, 0x9b, 0xbc, 0xd4, 0x5c, 0xaf,
+	0x85, 0xe0, 0x93, 0x36, 0x36, 0x2d, 0x8a, 0xcd, 0x4c, 0x32, 0x2f, 0x6d, 0x26, 0xb4, 0x91, 0x01,
+	0x65, 0x21, 0xc1, 0xcf, 0x05, 0xee, 0x64, 0x52, 0xac, 0xbf, 0x0c, 0xbf, 0x91, 0xee, 0x75, 0x44,
+	0x6a, 0x98, 0x06, 0x35, 0x32, 0x4b, 0x6c, 0x75, 0x6f, 0x5e, 0x76, 0xe2, 0x1e, 0x09, 0x5f, 0xf5,
+	0xe6, 0xe8, 0x38, 0xf8, 0xf8, 0xa0, 0xb8, 0x21, 0xe9, 0x76, 0xdc, 0xeb, 0x99, 0xca, 0x2f, 0x20,
+	0x3d, 0x4e, 0x81, 0x36, 0x20, 0xd6, 0xed, 0x58, 0xbc, 0x85, 0xab, 0x0b, 0x67, 0x03, 0x39, 0xf6,
+	0x58, 0x2b, 0x6b, 0x9e, 0x0d, 0x21, 0x88, 0x1f, 0x19, 0xee, 0x91, 0xe8, 0x86, 0x6c, 0xbc, 0x9d,
+	0xf8, 0x84, 0xf7, 0x28, 0x49, 0x79, 0x11, 0x85, 0x64, 0xf0, 0xa4, 0xfd, 0x14, 0x62, 0xa7, 0xd8,
+	0x15, 0x44, 0x85, 0x19, 0x6e, 0xb4, 0xb2, 0x43, 0x35, 0x0f, 0x8a, 0x1e, 0xc0, 0x82, 0x71, 0xe8,
+	0x52, 0xc3, 0x12, 0xb7, 0xc6, 0xcc, 0x2c, 0x3e, 0x1c, 0xfd, 0x18, 0xa2, 0x0e, 0xc9, 0xc4, 0x5e,
+	0x89, 0x24, 0xea, 0x10, 0xd4, 0x84, 0x94, 0x43, 0xf4, 0x8f, 0x2c, 0x7a, 0xa4, 0x1f, 0x63, 0x4a,
+	0x58, 0x87, 0x5a, 0x54, 0x4b, 0xb3, 0x31, 0x5d, 0x0c, 0xe4, 0x15, 0xbe, 0x37, 0x41, 0x2e, 0x45,
+	0x03, 0x87, 0x1c, 0x58, 0xf4, 0x68, 0x1f, 0x53, 0x22, 0xf6, 0xe5, 0xa5, 0x04, 0x71, 0xef, 0x99,
+	0xf0, 0xea, 0xb7, 0xd7, 0x2a, 0xcc, 0x1d, 0x13, 0x8a, 0xfd, 0x9b, 0x8b, 0x7f, 0xa0, 0xed, 0xe1,
+	0xfb, 0x24, 0xf6, 0x4d, 0xde, 0x27, 0x6a, 0x34, 0x23, 0x0d, 0xdf, 0x28, 0xf7, 0x61, 0x81, 0x8f,
+	0xdc, 0x4c, 0x9c, 0x75, 0x9a, 0xb7, 0xa6, 0x81, 0x27, 0x1f, 0x45, 0x6a, 0xdc, 0xcb, 0x92, 0xe6,
+	0x83, 0x87, 0x05, 0x13, 0x51, 0x9e, 0xcd, 0xc3, 0x92, 0xe8, 0x21, 0x55, 0xa3, 0x63, 0xd8, 0x2e,
+	0xfa, 0xbd, 0x04, 0x49, 0xdb, 0x72, 0x86, 0x2d, 0x4d, 0xba, 0xaa, 0xa5, 0xe9, 0x1e, 0xf7, 0xf9,
+	0x40, 0xbe, 0x1e, 0x40, 0x8d, 0x0a, 0x7e, 0x94, 0xa7, 0xc0, 0xf4, 0x6c, 0x9d, 0x0e, 0x6c, 0xcb,
+	0xf1, 0xfb, 0xdc, 0xc7, 0x12, 0x20, 0xdb, 0x38, 0xf1, 0x89, 0xf4, 0x36, 0xee, 0x58, 0xc4, 0x14,
+	0xb7, 0xe9, 0xc6, 0x44, 0xf7, 0x29, 0x8a, 0x27, 0x23, 0x2f, 0x93, 0xf3, 0x81, 0x7c, 0x63, 0x12,
+	0x1c, 0xd2, 0xea, 0x1f, 0xdc, 0x09, 0x2f, 0xe5, 0x13, 0xaf, 0x3f, 0xa5, 0x6d, 0xe3, 0xc4, 0x4f,
+	0x17, 0x33, 0xa3, 0xbf, 0x4a, 0x70, 0x7d, 0xd8, 0x4f, 0xf4, 0x60, 0xe2, 0xae, 0x7c, 0x94, 0xb8,
+	0x42, 0x93, 0x3c, 0x15, 0x1f, 0x92, 0x25, 0xfa, 0xdd, 0x54, 0xc7, 0xd9, 0x92, 0xb9, 0x32, 0xe4,
+	0x78, 0x34, 0xca, 0xea, 0xa7, 0x12, 0x5c, 0x1f, 0x96, 0x71, 0xc3, 0x70, 0x1a, 0xb8, 0xa5, 0xb3,
+	0xc4, 0xb1, 0x43, 0x96, 0x52, 0x3f, 0x9c, 0xed, 0x2d, 0xec, 0xad, 0x69, 0x2a, 0xdd, 0xb4, 0x35,
+	0x4d, 0x75, 0x54, 0xb4, 0x15, 0xdf, 0xbe, 0xcb, 0xcc, 0x9a, 0x67, 0x45, 0x0d, 0xf0, 0x36, 0x40,
+	0xf7, 0x3b, 0xa8, 0xde, 0xc2, 0x0e, 0x7b, 0x3d, 0xc4, 0xd5, 0x77, 0xcf, 0x07, 0x72, 0x76, 0x7c,
+	0x2e, 0x14, 0x6e, 0x7d, 0xb4, 0xb3, 0x41, 0x1f, 0x45, 0x5b, 0xb6, 0x8d, 0x13, 0xbf, 0xf3, 0x3e,
+	0xc4, 0x8e, 0xf2, 0x97, 0x28, 0xa4, 0xf6, 0xd9, 0x35, 0x24, 0x4e, 0xc4, 0xaf, 0x41, 0x5c, 0x4b,
+	0x7e, 0xb5, 0x49, 0x57, 0x55, 0xdb, 0x3d, 0xb1, 0xb3, 0xeb, 0x21, 0x5c, 0x48, 0xce, 0x6a, 0xe8,
+	0x16, 0x0c, 0xd6, 0x58, 0x8a, 0xdb, 0x44, 0x7d, 0xfd, 0x51, 0x82, 0xf5, 0xd1, 0xb6, 0x87, 0x75,
+	0x5c, 0x59, 0xf5, 0x15, 0xa1, 0xe3, 0x8d, 0xff, 0xc3, 0x10, 0x52, 0x94, 0x1b, 0xaf, 0xb1, 0x29,
+	0xda, 0x46, 0xa5, 0xbe, 0x1f, 0x10, 0xa9, 0x7c, 0x3c, 0x27, 0xae, 0x1d, 0x91, 0xb1, 0xf7, 0x61,
+	0xfe, 0xc3, 0x2e, 0xe9, 0x74, 0x6d, 0x96, 0xaa, 0x94, 0xaa, 0xce, 0x5c, 0x3f, 0x69, 0x8e, 0x1f,
+	0x09, 0xd4, 0x04, 0x23, 0x6a, 0xc0, 0x22, 0x3d, 0xea, 0x60, 0xf7, 0x88, 0xb4, 0x78, 0x06, 0x52,
+	0x6a, 0x69, 0x66, 0xfa, 0x95, 0x21, 0x45, 0x20, 0xc2, 0x88, 0x17, 0xf5, 0x24, 0x58, 0xf6, 0x2e,
+	0x06, 0x7d, 0x14, 0x2a, 0xc6, 0x42, 0x35, 0x66, 0x0e, 0x95, 0x09, 0xf3, 0x84, 0x52, 0x7e, 0x5d,
+	0x14, 0x41, 0xc8, 0x43, 0xd1, 0x96, 0x3c, 0x43, 0x7d, 0x28, 0xe6, 0x0f, 0x12, 0x8c, 0x4e, 0x6d,
+	0x40, 0x11, 0x3f, 0x9b, 0xf6, 0xcc, 0x8a, 0x6e, 0x4e, 0x21, 0x0b, 0xc9, 0xca, 0x8e, 0x57, 0x42,
+	0x40, 0x1b, 0x1a, 0x5a, 0x47, 0x02, 0x7f, 0x2b, 0xc1, 0xeb, 0x0d, 0xef, 0xbf, 0x25, 0xbd, 0xeb,
+	0x1c, 0x12, 0xc7, 0xf4, 0xaa, 0xc6, 0xc4, 0x2d, 0xdc, 0x34, 0xf8, 0x5d, 0xe5, 0x1d, 0xd1, 0x84,
+	0x5a, 0x39, 0x1f, 0xc8, 0xdf, 0xbd, 0xc4, 0x2d, 0x24, 0x41, 0xe1, 0x12, 0x2e, 0x71, 0x57, 0xb4,
+	0x0d, 0x36, 0xfb, 0xd8, 0x9f, 0x2c, 0x8e, 0xe6, 0x6e, 0xfd, 0x57, 0x02, 0x08, 0xfc, 0x26, 0x70,
+	0x1b, 0xd6, 0xf7, 0x2b, 0xf5, 0x92, 0x5e, 0xa9, 0xd6, 0xcb, 0x95, 0x3d, 0xfd, 0xf1, 0x5e, 0xad,
+	0x5a, 0xda, 0x2d, 0xdf, 0x2f, 0x97, 0x8a, 0xe9, 0x48, 0xf6, 0x5a, 0xaf, 0x9f, 0x4f, 0x72, 0xc7,
+	0x92, 0x17, 0x1d, 0x29, 0x70, 0x2d, 0xe8, 0xfd, 0x5e, 0xa9, 0x96, 0x96, 0xb2, 0x4b, 0xbd, 0x7e,
+	0x7e, 0x91, 0x7b, 0xbd, 0x87, 0x5d, 0x74, 0x0b, 0x56, 0x82, 0x3e, 0x3b, 0x6a, 0xad, 0xbe, 0x53,
+	0xde, 0x4b, 0x47, 0xb3, 0xaf, 0xf5, 0xfa, 0xf9, 0x25, 0xee, 0xb7, 0x23, 0x1e, 0x3e, 0x79, 0x58,
+	0x0e, 0xfa, 0xee, 0x55, 0xd2, 0xb1, 0x6c, 0xaa, 0xd7, 0xcf, 0x27, 0xb8, 0xdb, 0x1e, 0x41, 0x77,
+	0x21, 0x13, 0xf6, 0xd0, 0x0f, 0xca, 0xf5, 0x07, 0xfa, 0x7e, 0xa9, 0x5e, 0x49, 0xc7, 0xb3, 0xab,
+	0xbd, 0x7e, 0x3e, 0xed, 0xfb, 0xfa, 0xaf, 0x94, 0x6c, 0xfc, 0xe9, 0x9f, 0x72, 0x91, 0x5b, 0x7f,
+	0x8f, 0xc2, 0x72, 0xf8, 0x7f, 0x3e, 0x54, 0x80, 0xd7, 0xab, 0x5a, 0xa5, 0x5a, 0xa9, 0xed, 0x3c,
+	0xd4, 0x6b, 0xf5, 0x9d, 0xfa, 0xe3, 0xda, 0xd8, 0x82, 0xd9, 0x52, 0xb8, 0xf3, 0x9e, 0xd5, 0x42,
+	0xf7, 0x20, 0x37, 0xee, 0x5f, 0x2c, 0x55, 0x2b, 0xb5, 0x72, 0x5d, 0xaf, 0x96, 0xb4, 0x72, 0xa5,
+	0x98, 0x96, 0xb2, 0xeb, 0xbd, 0x7e, 0x7e, 0x85, 0x43, 0xc2, 0xd7, 0xdf, 0xbb, 0x70, 0x73, 0x1c,
+	0xbc, 0x5f, 0xa9, 0x97, 0xf7, 0x7e, 0xe6, 0x63, 0xa3, 0xd9, 0xb5, 0x5e, 0x3f, 0x8f, 0x38, 0x36,
+	0xd8, 0x34, 0xd0, 0x6d, 0x58, 0x1b, 0x87, 0x56, 0x77, 0x6a, 0xb5, 0x52, 0x31, 0x1d, 0xcb, 0xa6,
+	0x7b, 0xfd, 0x7c, 0x8a, 0x63, 0xaa, 0x86, 0xeb, 0x62, 0x13, 0xbd, 0x0d, 0x99, 0x71, 0x6f, 0xad,
+	0xf4, 0xf3, 0xd2, 0x6e, 0xbd, 0x54, 0x4c, 0xc7, 0xb3, 0xa8, 0xd7, 0xcf, 0x2f, 0x73, 0x7f, 0x0d,
+	0xff, 0x0a, 0x37, 0x28, 0x9e, 0xca, 0x7f, 0x7f, 0xa7, 0xfc, 0xb0, 0x54, 0x4c, 0xcf, 0x05, 0xf9,
+	0xef, 0x1b, 0x56, 0x0b, 0x9b, 0x3c, 0x9d, 0xea, 0xde, 0x8b, 0xaf, 0x72, 0x91, 0x2f, 0xbe, 0xca,
+	0x45, 0x7e, 0x73, 0x96, 0x8b, 0xbc, 0x38, 0xcb, 0x49, 0x9f, 0x9f, 0xe5, 0xa4, 0xff, 0x9c, 0xe5,
+	0xa4, 0x67, 0x2f, 0x73, 0x91, 0xcf, 0x5f, 0xe6, 0x22, 0x5f, 0xbc, 0xcc, 0x45, 0xde, 0xbf, 0xfc,
+	0xb6, 0x3d, 0x61, 0x3f, 0xb8, 0xb1, 0x03, 0x77, 0x38, 0xcf, 0x7a, 0xf2, 0xf7, 0xfe, 0x37, 0x00,
+	0xc2, 0x30, 0xa3, 0x56, 0x8b, 0x13, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -631,6 +768,41 @@ func (this *TextProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MessagesProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessagesProposal)
+	if !ok {
+		that2, ok := that.(MessagesProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *Proposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -682,6 +854,50 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if len(this.MsgResults) != len(that1.MsgResults) {
+		return false
+	}
+	for i := range this.MsgResults {
+		if !bytes.Equal(this.MsgResults[i], that1.MsgResults[i]) {
+			return false
+		}
+	}
+	if this.Expedited != that1.Expedited {
+		return false
+	}
+	if this.Proposer != that1.Proposer {
+		return false
+	}
+	if !this.Metadata.Equal(that1.Metadata) {
+		return false
+	}
+	return true
+}
+func (this *ProposalMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProposalMetadata)
+	if !ok {
+		that2, ok := that.(ProposalMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.URI != that1.URI {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -792,6 +1008,57 @@ func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessagesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessagesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessagesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -861,21 +1128,59 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
-	if err1 != nil {
-		return 0, err1
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	i -= n1
-	i = encodeVarintGov(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x4a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgResults[iNdEx])
+			copy(dAtA[i:], m.MsgResults[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.MsgResults[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGov(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGov(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x42
 	if len(m.TotalDeposit) > 0 {
 		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
@@ -891,21 +1196,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintGov(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x32
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintGov(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGov(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x2a
 	{
 		size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
@@ -942,6 +1247,43 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1079,12 +1421,41 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
-	if err7 != nil {
-		return 0, err7
+	if m.MaxMetadataLen != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxMetadataLen))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ProposalCancelRatio.Size()
+		i -= size
+		if _, err := m.ProposalCancelRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintGov(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintGov(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if len(m.MinDeposit) > 0 {
@@ -1124,14 +1495,22 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintGov(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintGov(dAtA, i, uint64(n9))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGov(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -1155,6 +1534,26 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CountUnbondingDelegations {
+		i--
+		if m.CountUnbondingDelegations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ExpeditedThreshold.Size()
+		i -= size
+		if _, err := m.ExpeditedThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.VetoThreshold.Size()
 		i -= size
@@ -1230,6 +1629,29 @@ func (m *TextProposal) Size() (n int) {
 	return n
 }
 
+func (m *MessagesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Deposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1284,6 +1706,40 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.MsgResults) > 0 {
+		for _, b := range m.MsgResults {
+			l = len(b)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1343,6 +1799,17 @@ func (m *DepositParams) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = m.ProposalCancelRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.MaxMetadataLen != 0 {
+		n += 1 + sovGov(uint64(m.MaxMetadataLen))
+	}
 	return n
 }
 
@@ -1354,6 +1821,8 @@ func (m *VotingParams) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1369,6 +1838,11 @@ func (m *TallyParams) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.VetoThreshold.Size()
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ExpeditedThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
+	if m.CountUnbondingDelegations {
+		n += 2
+	}
 	return n
 }
 
@@ -1595,6 +2069,154 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MessagesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessagesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessagesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Deposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1704,7 +2326,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
+			m.Amount = append(m.Amount, types1.Coin{})
 			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1808,7 +2430,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Content == nil {
-				m.Content = &types1.Any{}
+				m.Content = &types.Any{}
 			}
 			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1961,7 +2583,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
 			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2032,34 +2654,268 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+			m.MsgResults = append(m.MsgResults, make([]byte, postIndex-iNdEx))
+			copy(m.MsgResults[len(m.MsgResults)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ProposalMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowGov
 			}
@@ -2451,7 +3307,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
 			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2489,6 +3345,92 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types1.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalCancelRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMetadataLen", wireType)
+			}
+			m.MaxMetadataLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMetadataLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2572,6 +3514,39 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2721,6 +3696,59 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountUnbondingDelegations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountUnbondingDelegations = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
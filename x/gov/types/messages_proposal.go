@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Implements Content Interface
+var (
+	_ Content                       = &MessagesProposal{}
+	_ types.UnpackInterfacesMessage = &MessagesProposal{}
+)
+
+// NewMessagesProposal creates a new messages proposal Content
+func NewMessagesProposal(title, description string, msgs []sdk.Msg) (*MessagesProposal, error) {
+	msgsAny := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgsAny[i] = any
+	}
+
+	return &MessagesProposal{Title: title, Description: description, Messages: msgsAny}, nil
+}
+
+// GetTitle returns the proposal title
+func (mp *MessagesProposal) GetTitle() string { return mp.Title }
+
+// GetDescription returns the proposal description
+func (mp *MessagesProposal) GetDescription() string { return mp.Description }
+
+// ProposalRoute returns the proposal router key
+func (mp *MessagesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "Messages"
+func (mp *MessagesProposal) ProposalType() string { return ProposalTypeMessages }
+
+// GetMessages returns the cached values of the proposal messages.
+func (mp *MessagesProposal) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(mp.Messages))
+	for i, msgAny := range mp.Messages {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// ValidateBasic validates the content's title and description of the proposal
+// and its messages.
+func (mp *MessagesProposal) ValidateBasic() error {
+	if err := ValidateAbstract(mp); err != nil {
+		return err
+	}
+
+	if len(mp.Messages) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "proposal messages cannot be empty")
+	}
+
+	msgs, err := mp.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid proposal message %d", i)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (mp *MessagesProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, x := range mp.Messages {
+		var msg sdk.Msg
+		err := unpacker.UnpackAny(x, &msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (mp MessagesProposal) String() string {
+	out := fmt.Sprintf(`Messages Proposal:
+  Title:       %s
+  Description: %s
+  Messages:
+`, mp.Title, mp.Description)
+	for _, msg := range mp.Messages {
+		out += fmt.Sprintf("    - %s\n", msg.TypeUrl)
+	}
+	return out
+}
@@ -18,10 +18,12 @@ const (
 	TypeMsgVote           = "vote"
 	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCancelProposal = "cancel_proposal"
 )
 
 var (
 	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
+	_          sdk.Msg                       = &MsgCancelProposal{}
 	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
@@ -54,6 +56,20 @@ func (m *MsgSubmitProposal) GetContent() Content {
 	return content
 }
 
+// GetMessages returns the messages executed by the proposal, if its content is
+// a MessagesProposal.
+func (m *MsgSubmitProposal) GetMessages() ([]sdk.Msg, error) {
+	content, ok := m.GetContent().(*MessagesProposal)
+	if !ok {
+		return nil, nil
+	}
+	return content.GetMessages()
+}
+
+func (m *MsgSubmitProposal) GetExpedited() bool { return m.Expedited }
+
+func (m *MsgSubmitProposal) GetMetadata() *ProposalMetadata { return m.Metadata }
+
 func (m *MsgSubmitProposal) SetInitialDeposit(coins sdk.Coins) {
 	m.InitialDeposit = coins
 }
@@ -62,6 +78,14 @@ func (m *MsgSubmitProposal) SetProposer(address fmt.Stringer) {
 	m.Proposer = address.String()
 }
 
+func (m *MsgSubmitProposal) SetExpedited(expedited bool) {
+	m.Expedited = expedited
+}
+
+func (m *MsgSubmitProposal) SetMetadata(metadata *ProposalMetadata) {
+	m.Metadata = metadata
+}
+
 func (m *MsgSubmitProposal) SetContent(content Content) error {
 	msg, ok := content.(proto.Message)
 	if !ok {
@@ -104,6 +128,12 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return err
 	}
 
+	if m.Metadata != nil {
+		if err := m.Metadata.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -282,3 +312,42 @@ func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
 	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
 	return []sdk.AccAddress{voter}
 }
+
+// NewMsgCancelProposal creates a message to cancel a proposal by its proposer
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) *MsgCancelProposal {
+	return &MsgCancelProposal{proposalID, proposer.String()}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address (%s)", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCancelProposal) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
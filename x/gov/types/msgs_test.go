@@ -92,6 +92,58 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgSubmitProposal with metadata
+func TestMsgSubmitProposalMetadata(t *testing.T) {
+	hash := strings.Repeat("ab", 32)
+	tests := []struct {
+		metadata   *ProposalMetadata
+		expectPass bool
+	}{
+		{nil, true},
+		{NewProposalMetadata("ipfs://QmTzQ1Nj5Rz2WpWjfxTk7cvgxC8hPe6ozqnCjWmM3aYbYk", hash), true},
+		{NewProposalMetadata("https://example.com/proposal.md", strings.ToUpper(hash)), true},
+		{NewProposalMetadata("", hash), false},
+		{NewProposalMetadata("example.com/proposal.md", hash), false},
+		{NewProposalMetadata("https://example.com/proposal.md", ""), false},
+		{NewProposalMetadata("https://example.com/proposal.md", hash[:62]), false},
+		{NewProposalMetadata("https://example.com/proposal.md", strings.Repeat("zz", 32)), false},
+	}
+
+	for i, tc := range tests {
+		msg, err := NewMsgSubmitProposal(NewTextProposal("test", "abcd"), coinsPos, addrs[0])
+		require.NoError(t, err)
+		msg.SetMetadata(tc.metadata)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidMetadata, "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{0, addrs[0], true},
+		{1, addrs[0], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposalID, tc.proposerAddr)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgVote
 func TestMsgVote(t *testing.T) {
 	tests := []struct {
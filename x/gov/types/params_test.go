@@ -0,0 +1,21 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDepositParams(t *testing.T) {
+	params := DefaultDepositParams()
+	require.NoError(t, validateDepositParams(params))
+
+	params.MaxMetadataLen = 0
+	require.Error(t, validateDepositParams(params))
+
+	genState := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.DepositParams.MaxMetadataLen = 0
+	require.Error(t, ValidateGenesis(genState))
+}
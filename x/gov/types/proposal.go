@@ -1,7 +1,10 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -17,7 +20,7 @@ import (
 const DefaultStartingProposalID uint64 = 1
 
 // NewProposal creates a new Proposal instance
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time, expedited bool) (Proposal, error) {
 	msg, ok := content.(proto.Message)
 	if !ok {
 		return Proposal{}, fmt.Errorf("%T does not implement proto.Message", content)
@@ -36,6 +39,7 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 		TotalDeposit:     sdk.NewCoins(),
 		SubmitTime:       submitTime,
 		DepositEndTime:   depositEndTime,
+		Expedited:        expedited,
 	}
 
 	return p, nil
@@ -47,6 +51,36 @@ func (p Proposal) String() string {
 	return string(out)
 }
 
+// NewProposalMetadata creates a new ProposalMetadata instance
+func NewProposalMetadata(uri, hash string) *ProposalMetadata {
+	return &ProposalMetadata{
+		URI:  uri,
+		Hash: hash,
+	}
+}
+
+// ValidateBasic checks that the URI of the metadata has a scheme, and that its
+// hash is a hex-encoded SHA-256 hash.
+func (pm ProposalMetadata) ValidateBasic() error {
+	uri, err := url.Parse(pm.URI)
+	if err != nil || uri.Scheme == "" {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "invalid uri: %q", pm.URI)
+	}
+
+	hash, err := hex.DecodeString(pm.Hash)
+	if err != nil || len(hash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "hash must be a hex-encoded SHA-256 hash: %q", pm.Hash)
+	}
+
+	return nil
+}
+
+// String implements stringer interface
+func (pm ProposalMetadata) String() string {
+	out, _ := yaml.Marshal(pm)
+	return string(out)
+}
+
 // GetContent returns the proposal Content
 func (p Proposal) GetContent() Content {
 	content, ok := p.Content.GetCachedValue().(Content)
@@ -180,7 +214,8 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText string = "Text"
+	ProposalTypeText     string = "Text"
+	ProposalTypeMessages string = "Messages"
 )
 
 // Implements Content Interface
@@ -213,7 +248,8 @@ func (tp TextProposal) String() string {
 }
 
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:     {},
+	ProposalTypeMessages: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
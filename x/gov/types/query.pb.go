@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -817,6 +818,199 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// QueryTallyDetailsRequest is the request type for the Query/TallyDetails RPC
+// method.
+type QueryTallyDetailsRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTallyDetailsRequest) Reset()         { *m = QueryTallyDetailsRequest{} }
+func (m *QueryTallyDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyDetailsRequest) ProtoMessage()    {}
+func (*QueryTallyDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{16}
+}
+func (m *QueryTallyDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyDetailsRequest.Merge(m, src)
+}
+func (m *QueryTallyDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyDetailsRequest proto.InternalMessageInfo
+
+func (m *QueryTallyDetailsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTallyDetailsResponse is the response type for the Query/TallyDetails
+// RPC method.
+type QueryTallyDetailsResponse struct {
+	// tally defines the tally of the proposal.
+	Tally TallyResult `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally"`
+	// validators defines the tally of each bonded validator, by voting power.
+	Validators []ValidatorTally `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+	// unbonding_tally defines the voting power of the unbonding delegations of
+	// the voters, counted if the count_unbonding_delegations param is enabled.
+	UnbondingTally TallyResult `protobuf:"bytes,3,opt,name=unbonding_tally,json=unbondingTally,proto3" json:"unbonding_tally" yaml:"unbonding_tally"`
+	// total_stake defines the stake the quorum is computed against.
+	TotalStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_stake,json=totalStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_stake" yaml:"total_stake"`
+}
+
+func (m *QueryTallyDetailsResponse) Reset()         { *m = QueryTallyDetailsResponse{} }
+func (m *QueryTallyDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyDetailsResponse) ProtoMessage()    {}
+func (*QueryTallyDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{17}
+}
+func (m *QueryTallyDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyDetailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTallyDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyDetailsResponse.Merge(m, src)
+}
+func (m *QueryTallyDetailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyDetailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyDetailsResponse proto.InternalMessageInfo
+
+func (m *QueryTallyDetailsResponse) GetTally() TallyResult {
+	if m != nil {
+		return m.Tally
+	}
+	return TallyResult{}
+}
+
+func (m *QueryTallyDetailsResponse) GetValidators() []ValidatorTally {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryTallyDetailsResponse) GetUnbondingTally() TallyResult {
+	if m != nil {
+		return m.UnbondingTally
+	}
+	return TallyResult{}
+}
+
+// ValidatorTally defines the voting power of a bonded validator and of its
+// delegators in the tally of a proposal.
+type ValidatorTally struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// bonded_tokens defines the bonded tokens of the validator.
+	BondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens" yaml:"bonded_tokens"`
+	// options defines the vote of the validator, empty if it did not vote.
+	Options []WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+	// validator_tally defines the voting power cast with the vote of the
+	// validator, i.e. that of its self-delegation and of the delegations that
+	// were not cast by their delegator.
+	ValidatorTally TallyResult `protobuf:"bytes,4,opt,name=validator_tally,json=validatorTally,proto3" json:"validator_tally" yaml:"validator_tally"`
+	// delegators_tally defines the voting power of the delegations to the
+	// validator cast by their delegator, other than the validator itself.
+	DelegatorsTally TallyResult `protobuf:"bytes,5,opt,name=delegators_tally,json=delegatorsTally,proto3" json:"delegators_tally" yaml:"delegators_tally"`
+}
+
+func (m *ValidatorTally) Reset()         { *m = ValidatorTally{} }
+func (m *ValidatorTally) String() string { return proto.CompactTextString(m) }
+func (*ValidatorTally) ProtoMessage()    {}
+func (*ValidatorTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{18}
+}
+func (m *ValidatorTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTally.Merge(m, src)
+}
+func (m *ValidatorTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorTally proto.InternalMessageInfo
+
+func (m *ValidatorTally) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorTally) GetOptions() []WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *ValidatorTally) GetValidatorTally() TallyResult {
+	if m != nil {
+		return m.ValidatorTally
+	}
+	return TallyResult{}
+}
+
+func (m *ValidatorTally) GetDelegatorsTally() TallyResult {
+	if m != nil {
+		return m.DelegatorsTally
+	}
+	return TallyResult{}
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1beta1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1beta1.QueryProposalResponse")
@@ -834,73 +1028,94 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1beta1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1beta1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1beta1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryTallyDetailsRequest)(nil), "cosmos.gov.v1beta1.QueryTallyDetailsRequest")
+	proto.RegisterType((*QueryTallyDetailsResponse)(nil), "cosmos.gov.v1beta1.QueryTallyDetailsResponse")
+	proto.RegisterType((*ValidatorTally)(nil), "cosmos.gov.v1beta1.ValidatorTally")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 970 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x55,
-	0x10, 0xf6, 0x4b, 0x9c, 0xd6, 0x9e, 0xb4, 0x01, 0x86, 0x00, 0x96, 0x89, 0xec, 0xb0, 0x82, 0xd4,
-	0xfd, 0x81, 0x57, 0x31, 0xa9, 0x2a, 0x05, 0x8a, 0x20, 0xa0, 0x96, 0xaa, 0x12, 0x2a, 0x4e, 0x05,
-	0x12, 0x07, 0xa2, 0x4d, 0xbc, 0x5a, 0x56, 0x38, 0x7e, 0x5b, 0xbf, 0x67, 0x8b, 0x10, 0x22, 0x24,
-	0x4e, 0x08, 0x71, 0x40, 0x54, 0x88, 0x1b, 0x54, 0x02, 0x89, 0x7f, 0xa5, 0xc7, 0x4a, 0x5c, 0x38,
-	0x20, 0x84, 0x12, 0x0e, 0x88, 0xbf, 0x81, 0x03, 0xda, 0xf7, 0xe6, 0xad, 0x77, 0x9d, 0x75, 0x76,
-	0x5d, 0x2a, 0x4e, 0xb1, 0xe7, 0x7d, 0x33, 0xf3, 0x7d, 0x33, 0xf3, 0xe6, 0xc5, 0x50, 0xdb, 0xe1,
-	0x62, 0x97, 0x0b, 0xdb, 0xe3, 0x43, 0x7b, 0xb8, 0xba, 0xed, 0x4a, 0x67, 0xd5, 0xbe, 0x33, 0x70,
-	0xfb, 0x7b, 0xcd, 0xa0, 0xcf, 0x25, 0x47, 0xd4, 0xe7, 0x4d, 0x8f, 0x0f, 0x9b, 0x74, 0x5e, 0xbd,
-	0x40, 0x3e, 0xdb, 0x8e, 0x70, 0x35, 0x38, 0x72, 0x0d, 0x1c, 0xcf, 0xef, 0x39, 0xd2, 0xe7, 0x3d,
-	0xed, 0x5f, 0x5d, 0xf4, 0xb8, 0xc7, 0xd5, 0x47, 0x3b, 0xfc, 0x44, 0xd6, 0x25, 0x8f, 0x73, 0xaf,
-	0xeb, 0xda, 0x4e, 0xe0, 0xdb, 0x4e, 0xaf, 0xc7, 0xa5, 0x72, 0x11, 0xe6, 0x34, 0x85, 0x53, 0x98,
-	0x5f, 0x9d, 0x5a, 0x57, 0x60, 0xf1, 0x9d, 0x30, 0xe7, 0xad, 0x3e, 0x0f, 0xb8, 0x70, 0xba, 0x6d,
-	0xf7, 0xce, 0xc0, 0x15, 0x12, 0xeb, 0x30, 0x1f, 0x90, 0x69, 0xcb, 0xef, 0x54, 0xd8, 0x32, 0x6b,
-	0x14, 0xdb, 0x60, 0x4c, 0x37, 0x3a, 0xd6, 0x7b, 0xf0, 0xd4, 0x98, 0xa3, 0x08, 0x78, 0x4f, 0xb8,
-	0xf8, 0x2a, 0x94, 0x0c, 0x4c, 0xb9, 0xcd, 0xb7, 0x96, 0x9a, 0xc7, 0x65, 0x37, 0x8d, 0xdf, 0x46,
-	0xf1, 0xfe, 0xef, 0xf5, 0x42, 0x3b, 0xf2, 0xb1, 0xfe, 0x66, 0x63, 0x91, 0x85, 0xe1, 0x74, 0x13,
-	0x1e, 0x8b, 0x38, 0x09, 0xe9, 0xc8, 0x81, 0x50, 0x09, 0x16, 0x5a, 0xd6, 0x49, 0x09, 0x36, 0x15,
-	0xb2, 0xbd, 0x10, 0x24, 0xbe, 0xe3, 0x22, 0xcc, 0x0d, 0xb9, 0x74, 0xfb, 0x95, 0x99, 0x65, 0xd6,
-	0x28, 0xb7, 0xf5, 0x17, 0x5c, 0x82, 0x72, 0xc7, 0x0d, 0xb8, 0xf0, 0x25, 0xef, 0x57, 0x66, 0xd5,
-	0xc9, 0xc8, 0x80, 0xd7, 0x00, 0x46, 0x2d, 0xa9, 0x14, 0x95, 0xb8, 0x15, 0x93, 0x3b, 0xec, 0x5f,
-	0x53, 0x37, 0x3b, 0xa2, 0xe0, 0x78, 0x2e, 0x91, 0x6f, 0xc7, 0x3c, 0xd7, 0x4b, 0x5f, 0xdc, 0xab,
-	0x17, 0xfe, 0xba, 0x57, 0x2f, 0x58, 0x3f, 0x32, 0x78, 0x7a, 0x5c, 0x2c, 0xd5, 0xf1, 0x35, 0x28,
-	0x1b, 0xca, 0xa1, 0xce, 0xd9, 0x9c, 0x85, 0x1c, 0x39, 0xe1, 0xf5, 0x04, 0xdd, 0x19, 0x45, 0xf7,
-	0x5c, 0x26, 0x5d, 0x9d, 0x3e, 0xce, 0xd7, 0xda, 0x84, 0xc7, 0x15, 0xc9, 0x77, 0xb9, 0x74, 0xf3,
-	0x0e, 0x48, 0x7a, 0x81, 0x63, 0xd2, 0xaf, 0xc3, 0x13, 0xb1, 0xa0, 0x24, 0xba, 0x05, 0xc5, 0x10,
-	0x47, 0x83, 0x53, 0x49, 0xd3, 0x1b, 0xe2, 0x49, 0xab, 0xc2, 0x5a, 0x9f, 0xc6, 0x02, 0x89, 0xdc,
-	0xf4, 0xae, 0xa5, 0x14, 0xe7, 0x21, 0x7a, 0x69, 0xdd, 0x65, 0x80, 0xf1, 0xf4, 0x24, 0x64, 0x4d,
-	0xab, 0x37, 0x9d, 0xcb, 0x52, 0xa2, 0xc1, 0x8f, 0xae, 0x63, 0x97, 0x89, 0xd4, 0x2d, 0xa7, 0xef,
-	0xec, 0x26, 0x8a, 0xa2, 0x0c, 0x5b, 0x72, 0x2f, 0xd0, 0x45, 0x2e, 0x87, 0x6e, 0xa1, 0xe9, 0xf6,
-	0x5e, 0xe0, 0x5a, 0xff, 0x30, 0x78, 0x32, 0xe1, 0x47, 0x6a, 0x6e, 0xc2, 0xd9, 0x21, 0x97, 0x7e,
-	0xcf, 0xdb, 0xd2, 0x60, 0xea, 0xcf, 0xf2, 0x04, 0x55, 0x7e, 0xcf, 0xd3, 0x01, 0x48, 0xdd, 0x99,
-	0x61, 0xcc, 0x86, 0x6f, 0xc3, 0x02, 0x5d, 0x29, 0x13, 0x4d, 0x0b, 0x7d, 0x2e, 0x2d, 0xda, 0x9b,
-	0x1a, 0x99, 0x08, 0x77, 0xb6, 0x13, 0x37, 0xe2, 0x5b, 0x70, 0x46, 0x3a, 0xdd, 0xee, 0x9e, 0x89,
-	0x36, 0xab, 0xa2, 0xd5, 0xd3, 0xa2, 0xdd, 0x0e, 0x71, 0x89, 0x58, 0xf3, 0x72, 0x64, 0xb2, 0x3e,
-	0x20, 0xf5, 0x94, 0x34, 0xf7, 0x2c, 0x25, 0xb6, 0xc6, 0xcc, 0xd8, 0xd6, 0x88, 0x8d, 0xfc, 0x26,
-	0x2d, 0xdb, 0x28, 0x3e, 0x95, 0xf7, 0x65, 0x38, 0x4d, 0x70, 0x2a, 0xec, 0xb3, 0x27, 0x94, 0x82,
-	0x88, 0x1b, 0x0f, 0xeb, 0xb3, 0x64, 0xd0, 0xff, 0xff, 0x06, 0xfc, 0x60, 0x16, 0xf6, 0x88, 0x01,
-	0xe9, 0xba, 0x0a, 0x25, 0x62, 0x69, 0xee, 0x41, 0x0e, 0x61, 0x91, 0xcb, 0xa3, 0xbb, 0x0d, 0xeb,
-	0xf0, 0x8c, 0x22, 0xa8, 0xda, 0xdf, 0x76, 0xc5, 0xa0, 0x2b, 0xa7, 0x78, 0xe7, 0x2a, 0xc7, 0x7d,
-	0xa3, 0xbe, 0xcd, 0xa9, 0xf1, 0xa1, 0xae, 0x4d, 0x1e, 0x39, 0xed, 0x67, 0xee, 0xba, 0xf2, 0x69,
-	0xfd, 0x56, 0x86, 0x39, 0x15, 0x19, 0xbf, 0x65, 0x50, 0x32, 0x5b, 0x1c, 0x1b, 0x69, 0x41, 0xd2,
-	0x9e, 0xe8, 0xea, 0xf9, 0x1c, 0x48, 0x4d, 0xd4, 0x5a, 0xfb, 0xfc, 0x97, 0x3f, 0xef, 0xce, 0x34,
-	0xf1, 0x92, 0xed, 0xef, 0x04, 0x5d, 0xe7, 0x13, 0x27, 0xf1, 0xdf, 0x40, 0xf4, 0x62, 0xd8, 0xfb,
-	0xb1, 0x5a, 0x1c, 0xe0, 0x97, 0x0c, 0xca, 0xd1, 0xbb, 0x84, 0xd9, 0xe9, 0xcc, 0xe8, 0x55, 0x2f,
-	0xe4, 0x81, 0x12, 0xb5, 0x15, 0x45, 0x6d, 0x19, 0x6b, 0x27, 0x53, 0xc3, 0xef, 0x18, 0x14, 0xc3,
-	0x85, 0x89, 0xcf, 0x4f, 0x0c, 0x1e, 0x7b, 0x9e, 0xaa, 0x2f, 0x64, 0xa0, 0x28, 0xfb, 0x86, 0xca,
-	0xfe, 0x0a, 0xae, 0x4f, 0x53, 0x18, 0x5b, 0x2d, 0x6b, 0x7b, 0x5f, 0xbd, 0x68, 0x07, 0xf8, 0x0d,
-	0x83, 0x39, 0xb5, 0xfc, 0xf1, 0xe4, 0xa4, 0x51, 0x79, 0x56, 0xb2, 0x60, 0x44, 0x6e, 0x5d, 0x91,
-	0x5b, 0xc3, 0xd6, 0xf4, 0xe4, 0xf0, 0x2b, 0x06, 0xa7, 0x68, 0x3f, 0x4e, 0x4e, 0x97, 0x78, 0x1d,
-	0xaa, 0xe7, 0x32, 0x71, 0xc4, 0x6b, 0x55, 0xf1, 0xba, 0x88, 0xe7, 0xd3, 0x79, 0x29, 0xb0, 0xbd,
-	0x1f, 0x7b, 0x69, 0x0e, 0xf0, 0x67, 0x06, 0xa7, 0xe9, 0x9a, 0xe3, 0xe4, 0x3c, 0xc9, 0xbd, 0x5b,
-	0x6d, 0x64, 0x03, 0x89, 0xd1, 0x0d, 0xc5, 0xe8, 0x0d, 0x7c, 0x7d, 0xaa, 0x4a, 0x99, 0x45, 0x63,
-	0xef, 0x47, 0xcb, 0xfa, 0x00, 0xbf, 0x67, 0x50, 0x32, 0x8b, 0x0c, 0x33, 0x19, 0x88, 0xec, 0xcb,
-	0x38, 0xbe, 0x15, 0xad, 0xab, 0x8a, 0xec, 0x15, 0xbc, 0xfc, 0x50, 0x64, 0xf1, 0x27, 0x06, 0xf3,
-	0xb1, 0xa5, 0x82, 0x17, 0x27, 0x66, 0x3e, 0xbe, 0xee, 0xaa, 0x97, 0xf2, 0x81, 0xff, 0xd3, 0x00,
-	0xaa, 0xf5, 0xb6, 0xb1, 0x71, 0xff, 0xb0, 0xc6, 0x1e, 0x1c, 0xd6, 0xd8, 0x1f, 0x87, 0x35, 0xf6,
-	0xf5, 0x51, 0xad, 0xf0, 0xe0, 0xa8, 0x56, 0xf8, 0xf5, 0xa8, 0x56, 0x78, 0xbf, 0xe1, 0xf9, 0xf2,
-	0xc3, 0xc1, 0x76, 0x73, 0x87, 0xef, 0xda, 0xf4, 0xdb, 0x44, 0xff, 0x79, 0x51, 0x74, 0x3e, 0xb2,
-	0x3f, 0x56, 0x39, 0xc2, 0xa9, 0x11, 0xdb, 0xa7, 0xd4, 0x6f, 0x94, 0x97, 0xfe, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xc0, 0x62, 0x29, 0x9d, 0x57, 0x0d, 0x00, 0x00,
+	// 1263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6b, 0x1c, 0x55,
+	0x14, 0xde, 0xc9, 0x6e, 0xda, 0xec, 0xd9, 0x64, 0x93, 0x5e, 0x63, 0xbb, 0xae, 0x61, 0x37, 0x5e,
+	0x34, 0x4d, 0x7f, 0xed, 0x90, 0x98, 0x52, 0x48, 0xad, 0xd8, 0x35, 0xa4, 0x0d, 0x05, 0xad, 0x93,
+	0xd0, 0x82, 0x0f, 0x86, 0x49, 0xe6, 0x32, 0x19, 0xb2, 0xd9, 0x3b, 0xdd, 0x7b, 0x77, 0x31, 0xc6,
+	0x20, 0xf8, 0x24, 0x22, 0x22, 0x16, 0xf1, 0x4d, 0x0b, 0x0a, 0x3e, 0xfa, 0x6f, 0xf4, 0xb1, 0xe0,
+	0x8b, 0xf8, 0x10, 0x24, 0x11, 0x14, 0x1f, 0xe3, 0xab, 0x0f, 0x32, 0xf7, 0xde, 0x99, 0x9d, 0xd9,
+	0x4c, 0x32, 0x93, 0x34, 0xf8, 0x94, 0xbd, 0x67, 0xce, 0xf9, 0xbe, 0xef, 0x9c, 0x73, 0x7f, 0x1c,
+	0x02, 0x95, 0x55, 0xca, 0x36, 0x28, 0xd3, 0x6d, 0xda, 0xd1, 0x3b, 0x53, 0x2b, 0x84, 0x9b, 0x53,
+	0xfa, 0xa3, 0x36, 0x69, 0x6d, 0xd6, 0xdc, 0x16, 0xe5, 0x14, 0x21, 0xf9, 0xbd, 0x66, 0xd3, 0x4e,
+	0x4d, 0x7d, 0x2f, 0x5f, 0x56, 0x31, 0x2b, 0x26, 0x23, 0xd2, 0x39, 0x08, 0x75, 0x4d, 0xdb, 0x69,
+	0x9a, 0xdc, 0xa1, 0x4d, 0x19, 0x5f, 0x1e, 0xb5, 0xa9, 0x4d, 0xc5, 0x4f, 0xdd, 0xfb, 0xa5, 0xac,
+	0x63, 0x36, 0xa5, 0x76, 0x83, 0xe8, 0xa6, 0xeb, 0xe8, 0x66, 0xb3, 0x49, 0xb9, 0x08, 0x61, 0xfe,
+	0xd7, 0x18, 0x4d, 0x1e, 0xbf, 0xf8, 0x8a, 0x6f, 0xc0, 0xe8, 0x7b, 0x1e, 0xe7, 0xfd, 0x16, 0x75,
+	0x29, 0x33, 0x1b, 0x06, 0x79, 0xd4, 0x26, 0x8c, 0xa3, 0x2a, 0x14, 0x5c, 0x65, 0x5a, 0x76, 0xac,
+	0x92, 0x36, 0xae, 0x4d, 0xe6, 0x0c, 0xf0, 0x4d, 0x0b, 0x16, 0x7e, 0x08, 0x2f, 0xf6, 0x04, 0x32,
+	0x97, 0x36, 0x19, 0x41, 0x6f, 0xc2, 0x80, 0xef, 0x26, 0xc2, 0x0a, 0xd3, 0x63, 0xb5, 0x83, 0x69,
+	0xd7, 0xfc, 0xb8, 0x7a, 0xee, 0xe9, 0x4e, 0x35, 0x63, 0x04, 0x31, 0xf8, 0x6f, 0xad, 0x07, 0x99,
+	0xf9, 0x9a, 0xee, 0xc1, 0x70, 0xa0, 0x89, 0x71, 0x93, 0xb7, 0x99, 0x20, 0x28, 0x4e, 0xe3, 0xa3,
+	0x08, 0x16, 0x85, 0xa7, 0x51, 0x74, 0x23, 0x6b, 0x34, 0x0a, 0xfd, 0x1d, 0xca, 0x49, 0xab, 0xd4,
+	0x37, 0xae, 0x4d, 0xe6, 0x0d, 0xb9, 0x40, 0x63, 0x90, 0xb7, 0x88, 0x4b, 0x99, 0xc3, 0x69, 0xab,
+	0x94, 0x15, 0x5f, 0xba, 0x06, 0x34, 0x0f, 0xd0, 0x6d, 0x49, 0x29, 0x27, 0x92, 0x9b, 0xf0, 0xb9,
+	0xbd, 0xfe, 0xd5, 0x64, 0xb3, 0x03, 0x09, 0xa6, 0x4d, 0x94, 0x78, 0x23, 0x14, 0x39, 0x3b, 0xf0,
+	0xd9, 0x93, 0x6a, 0xe6, 0xaf, 0x27, 0xd5, 0x0c, 0xfe, 0x41, 0x83, 0xf3, 0xbd, 0xc9, 0xaa, 0x3a,
+	0xbe, 0x05, 0x79, 0x5f, 0xb2, 0x97, 0x67, 0x36, 0x65, 0x21, 0xbb, 0x41, 0xe8, 0x4e, 0x44, 0x6e,
+	0x9f, 0x90, 0x7b, 0x31, 0x51, 0xae, 0xa4, 0x0f, 0xeb, 0xc5, 0x8b, 0x30, 0x22, 0x44, 0x3e, 0xa0,
+	0x9c, 0xa4, 0xdd, 0x20, 0xf1, 0x05, 0x0e, 0xa5, 0x7e, 0x07, 0xce, 0x85, 0x40, 0x55, 0xd2, 0xd3,
+	0x90, 0xf3, 0xfc, 0xd4, 0xc6, 0x29, 0xc5, 0xe5, 0xeb, 0xf9, 0xab, 0x5c, 0x85, 0x2f, 0xfe, 0x38,
+	0x04, 0xc4, 0x52, 0xcb, 0x9b, 0x8f, 0x29, 0xce, 0x09, 0x7a, 0x89, 0x1f, 0x6b, 0x80, 0xc2, 0xf4,
+	0x2a, 0x91, 0x19, 0x99, 0xbd, 0xdf, 0xb9, 0xa4, 0x4c, 0xa4, 0xf3, 0xe9, 0x75, 0xec, 0xba, 0x12,
+	0x75, 0xdf, 0x6c, 0x99, 0x1b, 0x91, 0xa2, 0x08, 0xc3, 0x32, 0xdf, 0x74, 0x65, 0x91, 0xf3, 0x06,
+	0x48, 0xd3, 0xd2, 0xa6, 0x4b, 0xf0, 0xbf, 0x1a, 0xbc, 0x10, 0x89, 0x53, 0xd9, 0xdc, 0x83, 0xa1,
+	0x0e, 0xe5, 0x4e, 0xd3, 0x5e, 0x96, 0xce, 0xaa, 0x3f, 0xe3, 0x87, 0x64, 0xe5, 0x34, 0x6d, 0x09,
+	0xa0, 0xb2, 0x1b, 0xec, 0x84, 0x6c, 0xe8, 0x1d, 0x28, 0xaa, 0x23, 0xe5, 0xa3, 0xc9, 0x44, 0x5f,
+	0x89, 0x43, 0x9b, 0x93, 0x9e, 0x11, 0xb8, 0x21, 0x2b, 0x6c, 0x44, 0x77, 0x61, 0x90, 0x9b, 0x8d,
+	0xc6, 0xa6, 0x8f, 0x96, 0x15, 0x68, 0xd5, 0x38, 0xb4, 0x25, 0xcf, 0x2f, 0x82, 0x55, 0xe0, 0x5d,
+	0x13, 0xfe, 0x40, 0x65, 0xaf, 0x48, 0x53, 0xef, 0xa5, 0xc8, 0xad, 0xd1, 0xd7, 0x73, 0x6b, 0x84,
+	0xb6, 0xfc, 0x22, 0x8c, 0x46, 0xf1, 0x55, 0x79, 0x6f, 0xc2, 0x59, 0xe5, 0xae, 0x0a, 0xfb, 0xf2,
+	0x11, 0xa5, 0x50, 0xc2, 0xfd, 0x08, 0xfc, 0x49, 0x14, 0xf4, 0xff, 0x3f, 0x01, 0xdf, 0xfb, 0x17,
+	0x76, 0x57, 0x81, 0xca, 0xeb, 0x16, 0x0c, 0x28, 0x95, 0xfe, 0x39, 0x48, 0x91, 0x58, 0x10, 0x72,
+	0x7a, 0xa7, 0x61, 0x16, 0x2e, 0x08, 0x81, 0xa2, 0xfd, 0x06, 0x61, 0xed, 0x06, 0x3f, 0xc6, 0x3b,
+	0x57, 0x3a, 0x18, 0x1b, 0xf4, 0xad, 0x5f, 0x6c, 0x9f, 0x92, 0x96, 0xb0, 0xe5, 0x64, 0x9c, 0x7f,
+	0xd6, 0x45, 0x0c, 0xbe, 0x19, 0x06, 0x9e, 0x23, 0xdc, 0x74, 0x1a, 0xa9, 0x7b, 0x87, 0xff, 0xe9,
+	0x83, 0x97, 0x62, 0xa2, 0x4f, 0x41, 0x17, 0xba, 0x0b, 0xd0, 0x31, 0x1b, 0x8e, 0x65, 0x72, 0xda,
+	0xf2, 0x8e, 0xa6, 0xd7, 0xb6, 0xd8, 0x07, 0xf6, 0x81, 0xef, 0x25, 0xa0, 0x14, 0x48, 0x28, 0x16,
+	0xad, 0xc1, 0x70, 0xbb, 0xb9, 0x42, 0x9b, 0x96, 0x77, 0x71, 0x48, 0x41, 0xd9, 0x74, 0x82, 0x2a,
+	0x1e, 0xd6, 0xfe, 0x4e, 0xf5, 0xfc, 0xa6, 0xb9, 0xd1, 0x98, 0xc5, 0x3d, 0x28, 0xd8, 0x28, 0x06,
+	0x16, 0x11, 0x85, 0x08, 0x14, 0x38, 0xe5, 0x72, 0x2c, 0x58, 0x27, 0xe2, 0x65, 0xce, 0xd7, 0xe7,
+	0x3c, 0x90, 0xdf, 0x76, 0xaa, 0x13, 0xb6, 0xc3, 0xd7, 0xda, 0x2b, 0xb5, 0x55, 0xba, 0xa1, 0xab,
+	0x59, 0x48, 0xfe, 0xb9, 0xc6, 0xac, 0x75, 0xdd, 0xbb, 0x09, 0x59, 0x6d, 0xa1, 0xc9, 0xf7, 0x77,
+	0xaa, 0x48, 0xd2, 0x85, 0xa0, 0xb0, 0x01, 0x62, 0xb5, 0x28, 0x16, 0x7f, 0x66, 0xa1, 0x18, 0xcd,
+	0x1a, 0x2d, 0xc0, 0xb9, 0x20, 0xe3, 0x65, 0xd3, 0xb2, 0x5a, 0x84, 0xc9, 0xdb, 0x31, 0x5f, 0x1f,
+	0xdb, 0xdf, 0xa9, 0x96, 0x24, 0xe2, 0x01, 0x17, 0x6c, 0x8c, 0x04, 0xb6, 0xdb, 0xd2, 0x84, 0xd6,
+	0x61, 0xc8, 0x4b, 0x8a, 0x58, 0xcb, 0x9c, 0xae, 0x93, 0xa6, 0xbc, 0x16, 0xf3, 0xf5, 0xf9, 0x63,
+	0xa7, 0x31, 0x2a, 0x49, 0x23, 0x60, 0xd8, 0x18, 0x94, 0xeb, 0x25, 0xb1, 0x44, 0xf3, 0x70, 0x96,
+	0xba, 0x62, 0x4c, 0x2c, 0x65, 0xc7, 0xb3, 0xe1, 0x93, 0x1f, 0xee, 0xc9, 0x43, 0xe2, 0xd8, 0x6b,
+	0x9c, 0x58, 0xde, 0x4b, 0xf5, 0xae, 0x70, 0xf7, 0x6f, 0x1f, 0x15, 0xec, 0xf5, 0xb8, 0x9b, 0x9c,
+	0xec, 0x71, 0xee, 0x44, 0x3d, 0xee, 0x41, 0xc1, 0x46, 0xb1, 0x13, 0xad, 0xf4, 0x3a, 0x8c, 0x58,
+	0xa4, 0x41, 0x6c, 0xcf, 0xc2, 0x14, 0x55, 0x7f, 0x3a, 0xaa, 0xaa, 0xa2, 0xba, 0x20, 0xa9, 0x7a,
+	0x61, 0xb0, 0x31, 0xdc, 0x35, 0x89, 0xb8, 0xe9, 0x2f, 0x0b, 0xd0, 0x2f, 0xce, 0x17, 0xfa, 0x46,
+	0x83, 0x01, 0x7f, 0xc4, 0x42, 0x93, 0x71, 0x4c, 0x71, 0xf3, 0x73, 0xf9, 0x52, 0x0a, 0x4f, 0x79,
+	0x5a, 0xf1, 0xcc, 0xa7, 0xbf, 0xfc, 0xf1, 0xb8, 0xaf, 0x86, 0xae, 0xea, 0xce, 0xaa, 0xdb, 0x30,
+	0x3f, 0x32, 0x23, 0xa3, 0x7a, 0x30, 0xce, 0xe9, 0x5b, 0xa1, 0x2b, 0x61, 0x1b, 0x7d, 0xae, 0x41,
+	0xde, 0x87, 0x62, 0x28, 0x99, 0xce, 0xbf, 0x5b, 0xca, 0x97, 0xd3, 0xb8, 0x2a, 0x69, 0x13, 0x42,
+	0xda, 0x38, 0xaa, 0x1c, 0x2d, 0x0d, 0x7d, 0xab, 0x41, 0xce, 0xdb, 0x23, 0xe8, 0xd5, 0x43, 0xc1,
+	0x43, 0xb3, 0x63, 0xf9, 0xb5, 0x04, 0x2f, 0xc5, 0x5e, 0x17, 0xec, 0x6f, 0xa0, 0xd9, 0xe3, 0x14,
+	0x46, 0x17, 0x93, 0x94, 0xbe, 0xe5, 0xfd, 0x69, 0x6d, 0xa3, 0xaf, 0x35, 0xe8, 0xf7, 0x40, 0x19,
+	0x3a, 0x9a, 0x34, 0x28, 0xcf, 0x44, 0x92, 0x9b, 0x12, 0x37, 0x2b, 0xc4, 0xcd, 0xa0, 0xe9, 0xe3,
+	0x8b, 0x43, 0x5f, 0x68, 0x70, 0x46, 0x0d, 0x2f, 0x87, 0xd3, 0x45, 0x46, 0xb7, 0xf2, 0xc5, 0x44,
+	0x3f, 0xa5, 0x6b, 0x4a, 0xe8, 0xba, 0x82, 0x2e, 0xc5, 0xeb, 0x12, 0xce, 0xfa, 0x56, 0x68, 0x0c,
+	0xdc, 0x46, 0x3f, 0x69, 0x70, 0x56, 0xbd, 0xc1, 0xe8, 0x70, 0x9e, 0xe8, 0x50, 0x54, 0x9e, 0x4c,
+	0x76, 0x54, 0x8a, 0x16, 0x84, 0xa2, 0xb7, 0xd1, 0xed, 0x63, 0x55, 0xca, 0x9f, 0x02, 0xf4, 0x2d,
+	0xf5, 0x8b, 0xb6, 0xb6, 0xd1, 0x77, 0x1a, 0x0c, 0x28, 0x78, 0x86, 0x12, 0x15, 0xb0, 0xe4, 0xc3,
+	0xd8, 0x3b, 0xb2, 0xe0, 0x5b, 0x42, 0xec, 0x0d, 0x74, 0xfd, 0x44, 0x62, 0xd1, 0x8f, 0x1a, 0x14,
+	0x42, 0x37, 0x0f, 0xba, 0x72, 0x28, 0xf3, 0xc1, 0x59, 0xa4, 0x7c, 0x35, 0x9d, 0xf3, 0x73, 0x6d,
+	0x40, 0xf9, 0xc6, 0xff, 0xac, 0xc1, 0x60, 0x78, 0x72, 0x40, 0x09, 0xd4, 0xd1, 0xf1, 0xa4, 0x7c,
+	0x2d, 0xa5, 0xf7, 0x73, 0x9d, 0x63, 0x39, 0xd3, 0x5b, 0x12, 0xab, 0x5e, 0x7f, 0xba, 0x5b, 0xd1,
+	0x9e, 0xed, 0x56, 0xb4, 0xdf, 0x77, 0x2b, 0xda, 0x57, 0x7b, 0x95, 0xcc, 0xb3, 0xbd, 0x4a, 0xe6,
+	0xd7, 0xbd, 0x4a, 0xe6, 0xfd, 0xc9, 0x23, 0xdf, 0xc5, 0x0f, 0x05, 0x97, 0x78, 0x1d, 0x57, 0xce,
+	0x88, 0x7f, 0x79, 0xbc, 0xfe, 0xdf, 0x00, 0xcf, 0x90, 0xbb, 0x67, 0xa6, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -931,6 +1146,9 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// TallyDetails queries the tally of a proposal in voting period, broken down
+	// by bonded validator.
+	TallyDetails(ctx context.Context, in *QueryTallyDetailsRequest, opts ...grpc.CallOption) (*QueryTallyDetailsResponse, error)
 }
 
 type queryClient struct {
@@ -1013,6 +1231,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) TallyDetails(ctx context.Context, in *QueryTallyDetailsRequest, opts ...grpc.CallOption) (*QueryTallyDetailsResponse, error) {
+	out := new(QueryTallyDetailsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/TallyDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1031,6 +1258,9 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// TallyDetails queries the tally of a proposal in voting period, broken down
+	// by bonded validator.
+	TallyDetails(context.Context, *QueryTallyDetailsRequest) (*QueryTallyDetailsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1061,6 +1291,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) TallyDetails(ctx context.Context, req *QueryTallyDetailsRequest) (*QueryTallyDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyDetails not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1210,6 +1443,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TallyDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/TallyDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyDetails(ctx, req.(*QueryTallyDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1246,6 +1497,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "TallyDetails",
+			Handler:    _Query_TallyDetails_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1beta1/query.proto",
@@ -1873,80 +2128,249 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTallyDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTallyDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTallyDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Proposal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTallyDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalStatus != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	{
+		size := m.TotalStake.Size()
+		i -= size
+		if _, err := m.TotalStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.UnbondingTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.DelegatorsTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ValidatorTally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
@@ -2125,6 +2549,64 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTallyDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallyDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.UnbondingTally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalStake.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.ValidatorTally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegatorsTally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3773,6 +4255,475 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTallyDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorTally{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorsTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorsTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
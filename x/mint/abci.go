@@ -57,7 +57,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 func getNextAnnualProvisions(ctx sdk.Context, k keeper.Keeper, minter types.Minter, params types.Params, totalSupply sdk.Int) sdk.Dec {
 	totalDeflation := k.GetDeflation(ctx, params.MintDenom)
-	rc := k.BeforeNextAnnualProvisions(ctx.BlockHeight(), params.BlocksPerYear, totalSupply.Add(totalDeflation.Amount), minter.Inflation.IsZero())
+	rc := k.BeforeNextAnnualProvisions(ctx, ctx.BlockHeight(), params.BlocksPerYear, totalSupply.Add(totalDeflation.Amount), minter.Inflation.IsZero())
 	if rc.IsNegative() {
 		rc = minter.NextAnnualProvisions(params, totalSupply)
 	}
@@ -2,14 +2,20 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
+// FlagInterval is the flag of the number of blocks between two projected
+// points of the supply projection.
+const FlagInterval = "interval"
+
 // GetQueryCmd returns the cli query commands for the minting module.
 func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd := &cobra.Command{
@@ -24,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQuerySupplyProjection(),
 	)
 
 	return mintingQueryCmd
@@ -118,3 +125,46 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySupplyProjection implements a command to return the supply
+// projected by the supply schedule.
+func GetCmdQuerySupplyProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-projection [blocks]",
+		Short: "Query the supply projected by the supply schedule for the next blocks",
+		Example: fmt.Sprintf("$ %s query %s supply-projection 6311520 --%s=525960",
+			version.AppName, types.ModuleName, FlagInterval),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			blocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("blocks %s not a valid uint, please input a valid number of blocks", args[0])
+			}
+
+			interval, err := cmd.Flags().GetUint64(FlagInterval)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySupplyProjectionRequest{Blocks: blocks, Interval: interval}
+			res, err := queryClient.SupplyProjection(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagInterval, 0, "Number of blocks between two projected points, defaults to one hundredth of the blocks")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -32,3 +35,32 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// SupplyProjection returns the supply projected by the supply schedule of the
+// mint module.
+func (k Keeper) SupplyProjection(c context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Blocks == 0 {
+		return nil, status.Error(codes.InvalidArgument, "number of blocks cannot be zero")
+	}
+
+	interval := req.Interval
+	if interval == 0 {
+		interval = req.Blocks / 100
+		if interval == 0 {
+			interval = 1
+		}
+	}
+	if req.Blocks/interval > types.MaxSupplyProjectionPoints {
+		return nil, status.Errorf(codes.InvalidArgument, "projection cannot have more than %d points", types.MaxSupplyProjectionPoints)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.GetParams(ctx).SupplySchedule.Enabled() {
+		return nil, status.Error(codes.FailedPrecondition, "supply schedule is disabled")
+	}
+
+	return &types.QuerySupplyProjectionResponse{Projection: k.ProjectSupply(ctx, req.Blocks, interval)}, nil
+}
@@ -51,6 +51,54 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCSupplyProjection() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	// disabled by default
+	_, err := queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Blocks: 10})
+	suite.Require().Error(err)
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.BlocksPerYear = 10
+	params.SupplySchedule = types.NewSupplySchedule([]types.ProvisionPoint{types.NewProvisionPoint(0, sdk.NewDec(1000))}, 0, sdk.ZeroInt())
+	app.MintKeeper.SetParams(ctx, params)
+
+	_, err = queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Blocks: 10000, Interval: 1})
+	suite.Require().Error(err)
+
+	res, err := queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Blocks: 10, Interval: 5})
+	suite.Require().NoError(err)
+	supply := app.MintKeeper.StakingTokenSupply(ctx)
+	suite.Require().Equal([]types.SupplyProjectionPoint{
+		types.NewSupplyProjectionPoint(0, supply, sdk.NewDec(1000)),
+		types.NewSupplyProjectionPoint(5, supply.AddRaw(500), sdk.NewDec(1000)),
+		types.NewSupplyProjectionPoint(10, supply.AddRaw(1000), sdk.NewDec(1000)),
+	}, res.Projection)
+
+	// the default interval is one hundredth of the blocks
+	res, err = queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Blocks: 1000})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Projection, 101)
+}
+
+func (suite *MintTestSuite) TestSupplyTargetHooks() {
+	app, ctx := suite.app, suite.ctx
+	hooks := app.MintKeeper.SupplyTargetHooks()
+
+	// the inflation drives the provisions while the schedule is disabled
+	suite.Require().True(hooks.BeforeNextAnnualProvisions(ctx, 1, 10, sdk.NewInt(100), false).IsNegative())
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.SupplySchedule = types.NewSupplySchedule([]types.ProvisionPoint{types.NewProvisionPoint(0, sdk.NewDec(1000))}, 0, sdk.NewInt(1050))
+	app.MintKeeper.SetParams(ctx, params)
+
+	suite.Require().Equal(sdk.NewDec(1000), hooks.BeforeNextAnnualProvisions(ctx, 1, 10, sdk.NewInt(900), false))
+	suite.Require().Equal(sdk.NewDec(500), hooks.BeforeNextAnnualProvisions(ctx, 1, 10, sdk.NewInt(1000), false))
+	suite.Require().True(hooks.BeforeNextAnnualProvisions(ctx, 1, 10, sdk.NewInt(1050), false).IsZero())
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
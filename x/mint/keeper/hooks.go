@@ -9,11 +9,11 @@ import (
 var _ types.MintHooks = Keeper{}
 
 // BeforeNextAnnualProvisions - call hook if NextAnnualProvisions
-func (k Keeper) BeforeNextAnnualProvisions(blockHeight int64, blocksPerYear uint64, totalSupply sdk.Int, customProvision bool) sdk.Dec {
+func (k Keeper) BeforeNextAnnualProvisions(ctx sdk.Context, blockHeight int64, blocksPerYear uint64, totalSupply sdk.Int, customProvision bool) sdk.Dec {
 	rc := sdk.NewDec(-1)
 
 	if k.hooks != nil {
-		rc = k.hooks.BeforeNextAnnualProvisions(blockHeight, blocksPerYear, totalSupply, customProvision)
+		rc = k.hooks.BeforeNextAnnualProvisions(ctx, blockHeight, blocksPerYear, totalSupply, customProvision)
 	}
 
 	return rc
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// SupplyTargetHooks is a MintHooks implementation which provisions the mint
+// denom according to the supply schedule of the mint parameters.
+type SupplyTargetHooks struct {
+	k Keeper
+}
+
+var _ types.MintHooks = SupplyTargetHooks{}
+
+// SupplyTargetHooks returns the mint hooks driven by the supply schedule.
+func (k Keeper) SupplyTargetHooks() SupplyTargetHooks {
+	return SupplyTargetHooks{k}
+}
+
+// BeforeNextAnnualProvisions returns the annual provisions of the supply
+// schedule at the block height. The total supply includes the burnt coins, so
+// that they are counted against the supply cap. A negative value is returned
+// if the schedule is disabled, so that the inflation drives the provisions.
+func (h SupplyTargetHooks) BeforeNextAnnualProvisions(ctx sdk.Context, blockHeight int64, blocksPerYear uint64, totalSupply sdk.Int, _ bool) sdk.Dec {
+	schedule := h.k.GetParams(ctx).SupplySchedule
+	if !schedule.Enabled() {
+		return sdk.NewDec(-1)
+	}
+
+	return schedule.AnnualProvisions(blockHeight, blocksPerYear, totalSupply)
+}
+
+// ProjectSupply returns the supply of the mint denom projected by the supply
+// schedule from the current height.
+func (k Keeper) ProjectSupply(ctx sdk.Context, blocks, interval uint64) []types.SupplyProjectionPoint {
+	params := k.GetParams(ctx)
+
+	supply := k.StakingTokenSupply(ctx)
+	totalMinted := supply.Add(k.GetDeflation(ctx, params.MintDenom).Amount)
+
+	return params.SupplySchedule.Project(ctx.BlockHeight(), supply, totalMinted, params.BlocksPerYear, blocks, interval)
+}
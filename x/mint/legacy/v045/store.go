@@ -0,0 +1,28 @@
+package v045
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// migrateParams sets the supply schedule parameter, which did not exist
+// before, to the disabled schedule so that the provisions are still driven by
+// the inflation.
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	if paramSpace.Has(ctx, types.KeySupplySchedule) {
+		return
+	}
+
+	paramSpace.Set(ctx, types.KeySupplySchedule, types.DefaultSupplySchedule())
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Setting the supply schedule parameter
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	migrateParams(ctx, paramSpace)
+
+	return nil
+}
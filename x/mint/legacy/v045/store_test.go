@@ -0,0 +1,39 @@
+package v045_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v045mint "github.com/cosmos/cosmos-sdk/x/mint/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, tParamsKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	require.False(t, paramSpace.Has(ctx, types.KeySupplySchedule))
+
+	require.NoError(t, v045mint.MigrateStore(ctx, paramSpace))
+
+	var schedule types.SupplySchedule
+	paramSpace.Get(ctx, types.KeySupplySchedule, &schedule)
+	require.False(t, schedule.Enabled())
+	require.True(t, schedule.SupplyCap.IsZero())
+
+	// an already set schedule is kept
+	expected := types.NewSupplySchedule([]types.ProvisionPoint{types.NewProvisionPoint(1, sdk.NewDec(100))}, 10, sdk.NewInt(1000))
+	paramSpace.Set(ctx, types.KeySupplySchedule, expected)
+	require.NoError(t, v045mint.MigrateStore(ctx, paramSpace))
+	paramSpace.Get(ctx, types.KeySupplySchedule, &schedule)
+	require.Equal(t, expected, schedule)
+}
@@ -2,9 +2,14 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint      = ModuleName
+	EventTypeMintHook  = "mint_hook"
+	EventTypeMintHooks = "mint_hooks"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyHookIndex        = "hook_index"
+	AttributeKeyHook             = "hook"
+	AttributeKeyPolicy           = "policy"
 )
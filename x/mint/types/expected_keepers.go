@@ -37,5 +37,5 @@ type BankKeeper interface {
 
 // MintHooks event hooks for mint object (noalias)
 type MintHooks interface {
-	BeforeNextAnnualProvisions(blockHeight int64, blocksPerYear uint64, totalSupply sdk.Int, customProvision bool) sdk.Dec                      // Must be called when a mint state changes
+	BeforeNextAnnualProvisions(ctx sdk.Context, blockHeight int64, blocksPerYear uint64, totalSupply sdk.Int, customProvision bool) sdk.Dec // Must be called when a mint state changes
 }
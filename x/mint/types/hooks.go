@@ -1,21 +1,108 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MintHooksPolicy defines how the annual provisions returned by multiple mint
+// hooks are combined. A negative value returned by a hook means the hook has
+// no custom provisions and is never combined.
+type MintHooksPolicy int
+
+const (
+	// the first non-negative value, in the hooks order, is used
+	MintHooksPolicyFirstNonNegative MintHooksPolicy = iota
+	// the minimum of the non-negative values is used
+	MintHooksPolicyMin
+	// the maximum of the non-negative values is used
+	MintHooksPolicyMax
+	// the sum of the non-negative values is used
+	MintHooksPolicySum
+)
+
+// String implements the Stringer interface.
+func (p MintHooksPolicy) String() string {
+	switch p {
+	case MintHooksPolicyFirstNonNegative:
+		return "first_non_negative"
+	case MintHooksPolicyMin:
+		return "min"
+	case MintHooksPolicyMax:
+		return "max"
+	case MintHooksPolicySum:
+		return "sum"
+	default:
+		return fmt.Sprintf("%d", int(p))
+	}
+}
+
 // combine multiple mint hooks, all hook functions are run in array sequence
-type MultiMintHooks []MintHooks
+// and their results are combined according to the policy
+type MultiMintHooks struct {
+	hooks  []MintHooks
+	policy MintHooksPolicy
+}
 
+// NewMultiMintHooks combines the hooks with the first non-negative policy.
 func NewMultiMintHooks(hooks ...MintHooks) MultiMintHooks {
-	return hooks
+	return NewMultiMintHooksWithPolicy(MintHooksPolicyFirstNonNegative, hooks...)
 }
 
-func (h MultiMintHooks) BeforeNextAnnualProvisions(blockHeight int64, blocksPerYear uint64, totalSupply sdk.Int, customProvision bool) (rc sdk.Dec) {
+// NewMultiMintHooksWithPolicy combines the hooks with the given policy.
+func NewMultiMintHooksWithPolicy(policy MintHooksPolicy, hooks ...MintHooks) MultiMintHooks {
+	switch policy {
+	case MintHooksPolicyFirstNonNegative, MintHooksPolicyMin, MintHooksPolicyMax, MintHooksPolicySum:
+	default:
+		panic(fmt.Sprintf("unknown mint hooks policy %s", policy))
+	}
+
+	return MultiMintHooks{hooks: hooks, policy: policy}
+}
+
+// BeforeNextAnnualProvisions runs all the hooks and combines their non-negative
+// results according to the policy, or returns a negative value if no hook has
+// custom provisions. An event is emitted with the value returned by each hook.
+func (h MultiMintHooks) BeforeNextAnnualProvisions(ctx sdk.Context, blockHeight int64, blocksPerYear uint64, totalSupply sdk.Int, customProvision bool) (rc sdk.Dec) {
 	rc = sdk.NewDec(-1)
 
-	for i := range h {
-		rc = h[i].BeforeNextAnnualProvisions(blockHeight, blocksPerYear, totalSupply, customProvision)
+	for i := range h.hooks {
+		provisions := h.hooks[i].BeforeNextAnnualProvisions(ctx, blockHeight, blocksPerYear, totalSupply, customProvision)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeMintHook,
+				sdk.NewAttribute(AttributeKeyHookIndex, strconv.Itoa(i)),
+				sdk.NewAttribute(AttributeKeyHook, fmt.Sprintf("%T", h.hooks[i])),
+				sdk.NewAttribute(AttributeKeyAnnualProvisions, provisions.String()),
+			),
+		)
+
+		if provisions.IsNegative() {
+			continue
+		}
+
+		switch {
+		case rc.IsNegative():
+			rc = provisions
+		case h.policy == MintHooksPolicyMin:
+			rc = sdk.MinDec(rc, provisions)
+		case h.policy == MintHooksPolicyMax:
+			rc = sdk.MaxDec(rc, provisions)
+		case h.policy == MintHooksPolicySum:
+			rc = rc.Add(provisions)
+		}
 	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMintHooks,
+			sdk.NewAttribute(AttributeKeyPolicy, h.policy.String()),
+			sdk.NewAttribute(AttributeKeyAnnualProvisions, rc.String()),
+		),
+	)
+
 	return rc
 }
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type fixedMintHooks int64
+
+func (h fixedMintHooks) BeforeNextAnnualProvisions(_ sdk.Context, _ int64, _ uint64, _ sdk.Int, _ bool) sdk.Dec {
+	return sdk.NewDec(int64(h))
+}
+
+func TestMultiMintHooks(t *testing.T) {
+	hooks := []MintHooks{fixedMintHooks(-1), fixedMintHooks(300), fixedMintHooks(-1), fixedMintHooks(100), fixedMintHooks(200)}
+
+	tests := []struct {
+		policy MintHooksPolicy
+		hooks  []MintHooks
+		exp    sdk.Dec
+	}{
+		{MintHooksPolicyFirstNonNegative, hooks, sdk.NewDec(300)},
+		{MintHooksPolicyMin, hooks, sdk.NewDec(100)},
+		{MintHooksPolicyMax, hooks, sdk.NewDec(300)},
+		{MintHooksPolicySum, hooks, sdk.NewDec(600)},
+		{MintHooksPolicyFirstNonNegative, []MintHooks{fixedMintHooks(0), fixedMintHooks(100)}, sdk.ZeroDec()},
+		{MintHooksPolicyMin, []MintHooks{fixedMintHooks(-1), fixedMintHooks(-1)}, sdk.NewDec(-1)},
+		{MintHooksPolicySum, nil, sdk.NewDec(-1)},
+	}
+	for _, tc := range tests {
+		ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+		rc := NewMultiMintHooksWithPolicy(tc.policy, tc.hooks...).BeforeNextAnnualProvisions(ctx, 1, 10, sdk.NewInt(1000), false)
+		require.Equal(t, tc.exp, rc, tc.policy.String())
+
+		// an event per hook and the combined result
+		events := ctx.EventManager().Events()
+		require.Len(t, events, len(tc.hooks)+1)
+		for i := range tc.hooks {
+			require.Equal(t, EventTypeMintHook, events[i].Type)
+		}
+		last := events[len(events)-1]
+		require.Equal(t, EventTypeMintHooks, last.Type)
+		require.Equal(t, []byte(tc.policy.String()), last.Attributes[0].Value)
+		require.Equal(t, []byte(tc.exp.String()), last.Attributes[1].Value)
+	}
+
+	require.Equal(t, NewMultiMintHooksWithPolicy(MintHooksPolicyFirstNonNegative, hooks...), NewMultiMintHooks(hooks...))
+	require.Panics(t, func() { NewMultiMintHooksWithPolicy(MintHooksPolicy(10), hooks...) })
+}
@@ -79,6 +79,9 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// schedule of the annual provisions targeting a supply, disabled if it has
+	// no provision points
+	SupplySchedule SupplySchedule `protobuf:"bytes,7,opt,name=supply_schedule,json=supplySchedule,proto3" json:"supply_schedule" yaml:"supply_schedule"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,43 +130,220 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetSupplySchedule() SupplySchedule {
+	if m != nil {
+		return m.SupplySchedule
+	}
+	return SupplySchedule{}
+}
+
+// SupplySchedule defines the annual provisions by height, used by the supply
+// target mint hooks instead of the inflation driven provisions.
+type SupplySchedule struct {
+	// points of the piecewise-linear annual provisions, ordered by height
+	Points []ProvisionPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+	// number of blocks after the first point between two halvings of the annual
+	// provisions, zero disables the halvings
+	HalvingBlocks uint64 `protobuf:"varint,2,opt,name=halving_blocks,json=halvingBlocks,proto3" json:"halving_blocks,omitempty" yaml:"halving_blocks"`
+	// maximum amount ever minted, burnt coins included, zero disables the cap
+	SupplyCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=supply_cap,json=supplyCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply_cap" yaml:"supply_cap"`
+}
+
+func (m *SupplySchedule) Reset()         { *m = SupplySchedule{} }
+func (m *SupplySchedule) String() string { return proto.CompactTextString(m) }
+func (*SupplySchedule) ProtoMessage()    {}
+func (*SupplySchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *SupplySchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplySchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplySchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplySchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplySchedule.Merge(m, src)
+}
+func (m *SupplySchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplySchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplySchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplySchedule proto.InternalMessageInfo
+
+func (m *SupplySchedule) GetPoints() []ProvisionPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func (m *SupplySchedule) GetHalvingBlocks() uint64 {
+	if m != nil {
+		return m.HalvingBlocks
+	}
+	return 0
+}
+
+// ProvisionPoint defines the annual provisions at a height, the provisions
+// between two points are linearly interpolated.
+type ProvisionPoint struct {
+	Height           int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions" yaml:"annual_provisions"`
+}
+
+func (m *ProvisionPoint) Reset()         { *m = ProvisionPoint{} }
+func (m *ProvisionPoint) String() string { return proto.CompactTextString(m) }
+func (*ProvisionPoint) ProtoMessage()    {}
+func (*ProvisionPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *ProvisionPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvisionPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvisionPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProvisionPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionPoint.Merge(m, src)
+}
+func (m *ProvisionPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvisionPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvisionPoint proto.InternalMessageInfo
+
+func (m *ProvisionPoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// SupplyProjectionPoint defines the projected supply at a height.
+type SupplyProjectionPoint struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// projected total supply, assuming no more coins are burnt
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// annual provisions of the schedule at the height
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions" yaml:"annual_provisions"`
+}
+
+func (m *SupplyProjectionPoint) Reset()         { *m = SupplyProjectionPoint{} }
+func (m *SupplyProjectionPoint) String() string { return proto.CompactTextString(m) }
+func (*SupplyProjectionPoint) ProtoMessage()    {}
+func (*SupplyProjectionPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{4}
+}
+func (m *SupplyProjectionPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyProjectionPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyProjectionPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyProjectionPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyProjectionPoint.Merge(m, src)
+}
+func (m *SupplyProjectionPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyProjectionPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyProjectionPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyProjectionPoint proto.InternalMessageInfo
+
+func (m *SupplyProjectionPoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*SupplySchedule)(nil), "cosmos.mint.v1beta1.SupplySchedule")
+	proto.RegisterType((*ProvisionPoint)(nil), "cosmos.mint.v1beta1.ProvisionPoint")
+	proto.RegisterType((*SupplyProjectionPoint)(nil), "cosmos.mint.v1beta1.SupplyProjectionPoint")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x63, 0x28, 0x95, 0x6a, 0x98, 0x00, 0x6f, 0xa0, 0x68, 0x82, 0x64, 0xca, 0x01, 0x8d,
-	0x03, 0x89, 0x26, 0x6e, 0x3b, 0x66, 0x15, 0x07, 0xc4, 0x50, 0xe5, 0x1b, 0x5c, 0xa2, 0x7f, 0x52,
-	0x93, 0x59, 0x8d, 0xed, 0xca, 0xf6, 0x46, 0x7b, 0xe5, 0x09, 0x38, 0x72, 0xe4, 0x2d, 0x78, 0x85,
-	0xdd, 0xd8, 0x11, 0x71, 0xa8, 0x50, 0xfb, 0x06, 0x7b, 0x02, 0x14, 0xbb, 0x6a, 0xa1, 0x20, 0xa4,
-	0x4a, 0x9c, 0x92, 0xef, 0xfb, 0xff, 0xf3, 0xfd, 0xbe, 0x58, 0x32, 0x8e, 0x2a, 0x65, 0x84, 0x32,
-	0x99, 0xe0, 0xd2, 0x66, 0x17, 0x47, 0x25, 0xb3, 0x70, 0xe4, 0x44, 0x3a, 0xd6, 0xca, 0x2a, 0xb2,
-	0xeb, 0xe7, 0xa9, 0xb3, 0x96, 0xf3, 0xfd, 0xbd, 0x5a, 0xd5, 0xca, 0xcd, 0xb3, 0xf6, 0xcd, 0xaf,
-	0x26, 0x5f, 0x11, 0xee, 0x9e, 0x72, 0x69, 0x99, 0x26, 0xaf, 0x70, 0x8f, 0xcb, 0x77, 0x0d, 0x58,
-	0xae, 0x64, 0x88, 0x0e, 0xd0, 0x61, 0x2f, 0x4f, 0x2f, 0x67, 0x71, 0xf0, 0x7d, 0x16, 0x3f, 0xa9,
-	0xb9, 0x3d, 0x3b, 0x2f, 0xd3, 0x4a, 0x89, 0x6c, 0xc9, 0xf6, 0x8f, 0x67, 0x66, 0x38, 0xca, 0xec,
-	0x74, 0xcc, 0x4c, 0xda, 0x67, 0x15, 0x5d, 0x07, 0x90, 0xf7, 0xf8, 0x3e, 0x48, 0x79, 0x0e, 0x4d,
-	0x31, 0xd6, 0xea, 0x82, 0x1b, 0xae, 0xa4, 0x09, 0x6f, 0xb8, 0xd4, 0x97, 0xdb, 0xa5, 0x5e, 0xcf,
-	0xe2, 0x70, 0x0a, 0xa2, 0x39, 0x4e, 0xfe, 0x08, 0x4c, 0xe8, 0x3d, 0xef, 0x0d, 0xd6, 0xd6, 0x97,
-	0x0e, 0xee, 0x0e, 0x40, 0x83, 0x30, 0xe4, 0x31, 0xc6, 0xed, 0x11, 0x14, 0x43, 0x26, 0x95, 0xf0,
-	0xbf, 0x44, 0x7b, 0xad, 0xd3, 0x6f, 0x0d, 0xf2, 0x01, 0xe1, 0x07, 0xab, 0xc2, 0x85, 0x06, 0xcb,
-	0x8a, 0xea, 0x0c, 0x64, 0xcd, 0x96, 0x3d, 0x5f, 0x6f, 0xdd, 0xf3, 0x91, 0xef, 0xf9, 0xd7, 0xd0,
-	0x84, 0xee, 0xae, 0x7c, 0x0a, 0x96, 0x9d, 0x38, 0x97, 0x8c, 0xf0, 0xce, 0x7a, 0x5d, 0xc0, 0x24,
-	0xbc, 0xe9, 0xd8, 0x2f, 0xb6, 0x66, 0xef, 0x6d, 0xb2, 0x05, 0x4c, 0x12, 0x7a, 0x67, 0xa5, 0x4f,
-	0x61, 0xb2, 0x01, 0xe3, 0x32, 0xec, 0xfc, 0x37, 0x18, 0x97, 0xbf, 0xc1, 0xb8, 0x24, 0x0c, 0xdf,
-	0xae, 0x15, 0x34, 0x45, 0xa9, 0xe4, 0x90, 0x0d, 0xc3, 0x5b, 0x0e, 0xd5, 0xdf, 0x1a, 0x45, 0x3c,
-	0xea, 0x97, 0xa8, 0x84, 0xe2, 0x56, 0xe5, 0x4e, 0x90, 0x1c, 0xdf, 0x2d, 0x1b, 0x55, 0x8d, 0x4c,
-	0x31, 0x66, 0xba, 0x98, 0x32, 0xd0, 0x61, 0xf7, 0x00, 0x1d, 0x76, 0xf2, 0xfd, 0xeb, 0x59, 0xfc,
-	0xd0, 0x7f, 0xbc, 0xb1, 0x90, 0xd0, 0x1d, 0xef, 0x0c, 0x98, 0x7e, 0xc3, 0x40, 0x1f, 0x77, 0x3e,
-	0x7d, 0x8e, 0x83, 0xfc, 0xe4, 0x72, 0x1e, 0xa1, 0xab, 0x79, 0x84, 0x7e, 0xcc, 0x23, 0xf4, 0x71,
-	0x11, 0x05, 0x57, 0x8b, 0x28, 0xf8, 0xb6, 0x88, 0x82, 0xb7, 0x4f, 0xff, 0xd9, 0x76, 0xe2, 0x2f,
-	0xa2, 0x2b, 0x5d, 0x76, 0xdd, 0xbd, 0x7a, 0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x34, 0x52, 0xcf,
-	0x87, 0xa4, 0x03, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xce, 0x35, 0xa9, 0x7f, 0xca, 0xf5, 0xd7, 0x94, 0x5e, 0xff, 0xc8, 0x54, 0x60, 0x47, 0x46,
+	0x42, 0x65, 0xc0, 0x51, 0x61, 0xeb, 0x04, 0x4e, 0x55, 0xa9, 0x88, 0xa2, 0xe8, 0x3a, 0xc1, 0x62,
+	0x5d, 0x9c, 0xc3, 0x39, 0x6a, 0xdf, 0x59, 0xf6, 0xa5, 0x24, 0x2b, 0x9f, 0x80, 0x91, 0x11, 0x06,
+	0xbe, 0x4b, 0x37, 0x3a, 0x22, 0x86, 0x08, 0xb5, 0x5f, 0x00, 0x65, 0x63, 0x43, 0xbe, 0xb3, 0x12,
+	0x12, 0x02, 0x28, 0x12, 0x88, 0xc9, 0x7e, 0x9f, 0xf7, 0xbd, 0xe7, 0x79, 0x9f, 0xfb, 0x0b, 0xad,
+	0x40, 0x64, 0xb1, 0xc8, 0x1a, 0x31, 0xe3, 0xb2, 0x71, 0xb6, 0xd7, 0xa6, 0x92, 0xec, 0xa9, 0xc0,
+	0x4d, 0x52, 0x21, 0x05, 0xda, 0xd0, 0x79, 0x57, 0x41, 0x45, 0x7e, 0x67, 0x33, 0x14, 0xa1, 0x50,
+	0xf9, 0x46, 0xfe, 0xa7, 0x4b, 0x9d, 0x0f, 0x00, 0x1a, 0xc7, 0x8c, 0x4b, 0x9a, 0xa2, 0xc7, 0xb0,
+	0xca, 0xf8, 0xf3, 0x88, 0x48, 0x26, 0xb8, 0x09, 0xea, 0x60, 0xb7, 0xea, 0xb9, 0xe7, 0x43, 0xbb,
+	0xf4, 0x69, 0x68, 0xdf, 0x0e, 0x99, 0xec, 0xf6, 0xda, 0x6e, 0x20, 0xe2, 0x46, 0xa1, 0xad, 0x3f,
+	0x77, 0xb3, 0xce, 0x69, 0x43, 0x0e, 0x12, 0x9a, 0xb9, 0x07, 0x34, 0xc0, 0x13, 0x02, 0xf4, 0x12,
+	0xae, 0x13, 0xce, 0x7b, 0x24, 0xf2, 0x93, 0x54, 0x9c, 0xb1, 0x8c, 0x09, 0x9e, 0x99, 0x4b, 0x8a,
+	0xf5, 0xd1, 0x62, 0xac, 0xa3, 0xa1, 0x6d, 0x0e, 0x48, 0x1c, 0xed, 0x3b, 0x3f, 0x10, 0x3a, 0xf8,
+	0x9a, 0xc6, 0x5a, 0x13, 0xe8, 0xfd, 0x32, 0x34, 0x5a, 0x24, 0x25, 0x71, 0x86, 0x6e, 0x42, 0x98,
+	0x4f, 0x81, 0xdf, 0xa1, 0x5c, 0xc4, 0xda, 0x12, 0xae, 0xe6, 0xc8, 0x41, 0x0e, 0xa0, 0x57, 0x00,
+	0x6e, 0x8d, 0x1b, 0xf6, 0x53, 0x22, 0xa9, 0x1f, 0x74, 0x09, 0x0f, 0x69, 0xd1, 0xe7, 0x93, 0x85,
+	0xfb, 0xbc, 0xa1, 0xfb, 0x9c, 0x4b, 0xea, 0xe0, 0x8d, 0x31, 0x8e, 0x89, 0xa4, 0x4d, 0x85, 0xa2,
+	0x53, 0xb8, 0x3a, 0x29, 0x8f, 0x49, 0xdf, 0x2c, 0x2b, 0xed, 0xc3, 0x85, 0xb5, 0x37, 0x67, 0xb5,
+	0x63, 0xd2, 0x77, 0xf0, 0xff, 0xe3, 0xf8, 0x98, 0xf4, 0x67, 0xc4, 0x18, 0x37, 0x2b, 0x7f, 0x4c,
+	0x8c, 0xf1, 0x29, 0x31, 0xc6, 0x11, 0x85, 0x2b, 0xa1, 0x20, 0x91, 0xdf, 0x16, 0xbc, 0x43, 0x3b,
+	0xe6, 0xb2, 0x92, 0x3a, 0x58, 0x58, 0x0a, 0x69, 0xa9, 0xef, 0xa8, 0x1c, 0x0c, 0xf3, 0xc8, 0x53,
+	0x01, 0xf2, 0xe0, 0x5a, 0x3b, 0x12, 0xc1, 0x69, 0xe6, 0x27, 0x34, 0xf5, 0x07, 0x94, 0xa4, 0xa6,
+	0x51, 0x07, 0xbb, 0x15, 0x6f, 0x67, 0x34, 0xb4, 0xb7, 0xf5, 0xe0, 0x99, 0x02, 0x07, 0xaf, 0x6a,
+	0xa4, 0x45, 0xd3, 0xa7, 0x94, 0xa4, 0x28, 0x82, 0x6b, 0x59, 0x2f, 0x49, 0xa2, 0x81, 0x9f, 0x05,
+	0x5d, 0xda, 0xe9, 0x45, 0xd4, 0xfc, 0xaf, 0x0e, 0x76, 0x57, 0xee, 0xdd, 0x72, 0xe7, 0x1c, 0x25,
+	0xf7, 0x44, 0xd5, 0x9e, 0x14, 0xa5, 0x9e, 0x95, 0x7b, 0x9a, 0x88, 0xcd, 0x30, 0x39, 0xb8, 0x96,
+	0x4d, 0xd5, 0xef, 0x57, 0xde, 0xbc, 0xb5, 0x4b, 0xce, 0x57, 0x00, 0x6b, 0xd3, 0x44, 0xe8, 0x21,
+	0x34, 0x12, 0xc1, 0xb8, 0xcc, 0x4c, 0x50, 0x2f, 0xff, 0x54, 0x7d, 0xbc, 0xd7, 0x5b, 0x79, 0xad,
+	0x57, 0xc9, 0xd5, 0x71, 0x31, 0x10, 0x3d, 0x80, 0xb5, 0x2e, 0x89, 0xce, 0x18, 0x0f, 0x7d, 0x6d,
+	0x51, 0xed, 0xe5, 0x8a, 0x77, 0x7d, 0x34, 0xb4, 0xb7, 0x74, 0x7f, 0xd3, 0x79, 0x07, 0xaf, 0x16,
+	0x80, 0xa7, 0x62, 0xd4, 0x86, 0xb0, 0x70, 0x10, 0x90, 0xa4, 0xd8, 0x8d, 0xcd, 0x05, 0x56, 0xed,
+	0x88, 0xcb, 0xd1, 0xd0, 0x5e, 0x9f, 0x9a, 0x8b, 0x80, 0x24, 0x0e, 0xae, 0xea, 0xa0, 0x49, 0x12,
+	0xe7, 0x1d, 0x80, 0xb5, 0x69, 0x1b, 0x68, 0x1b, 0x1a, 0x5d, 0xca, 0xc2, 0xae, 0x54, 0xe7, 0xb4,
+	0x8c, 0x8b, 0xe8, 0xdf, 0xdd, 0x23, 0x5f, 0x00, 0xdc, 0xd2, 0xeb, 0xd3, 0x4a, 0xc5, 0x0b, 0x1a,
+	0xc8, 0xdf, 0xb6, 0x7a, 0x08, 0x0d, 0x6d, 0xd1, 0x5c, 0x5a, 0xf8, 0xf6, 0x3c, 0xe2, 0x12, 0x17,
+	0xa3, 0xe7, 0x5b, 0x2e, 0xff, 0x7d, 0xcb, 0x5e, 0xf3, 0xfc, 0xd2, 0x02, 0x17, 0x97, 0x16, 0xf8,
+	0x7c, 0x69, 0x81, 0xd7, 0x57, 0x56, 0xe9, 0xe2, 0xca, 0x2a, 0x7d, 0xbc, 0xb2, 0x4a, 0xcf, 0xee,
+	0xfc, 0x52, 0xaf, 0xaf, 0x5f, 0x22, 0x25, 0xdb, 0x36, 0xd4, 0xc3, 0x72, 0xff, 0xdb, 0x00, 0x50,
+	0x3a, 0xd2, 0x02, 0xa5, 0x06, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -229,6 +409,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SupplySchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -284,6 +474,144 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SupplySchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplySchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplySchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SupplyCap.Size()
+		i -= size
+		if _, err := m.SupplyCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.HalvingBlocks != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvisionPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvisionPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvisionPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyProjectionPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyProjectionPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyProjectionPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -329,6 +657,58 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = m.SupplySchedule.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *SupplySchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.HalvingBlocks != 0 {
+		n += 1 + sovMint(uint64(m.HalvingBlocks))
+	}
+	l = m.SupplyCap.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ProvisionPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *SupplyProjectionPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -672,6 +1052,416 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplySchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplySchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplySchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplySchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplySchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, ProvisionPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingBlocks", wireType)
+			}
+			m.HalvingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProvisionPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProvisionPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyProjectionPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyProjectionPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyProjectionPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
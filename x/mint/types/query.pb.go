@@ -268,6 +268,110 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QuerySupplyProjectionRequest is the request type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionRequest struct {
+	// blocks is the number of blocks to project after the current height.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// interval is the number of blocks between two projected points, it
+	// defaults to one hundredth of the blocks.
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (m *QuerySupplyProjectionRequest) Reset()         { *m = QuerySupplyProjectionRequest{} }
+func (m *QuerySupplyProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionRequest) ProtoMessage()    {}
+func (*QuerySupplyProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionRequest.Merge(m, src)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *QuerySupplyProjectionRequest) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+// QuerySupplyProjectionResponse is the response type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionResponse struct {
+	// projection is the projected supply, starting at the current height.
+	Projection []SupplyProjectionPoint `protobuf:"bytes,1,rep,name=projection,proto3" json:"projection"`
+}
+
+func (m *QuerySupplyProjectionResponse) Reset()         { *m = QuerySupplyProjectionResponse{} }
+func (m *QuerySupplyProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionResponse) ProtoMessage()    {}
+func (*QuerySupplyProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionResponse.Merge(m, src)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionResponse) GetProjection() []SupplyProjectionPoint {
+	if m != nil {
+		return m.Projection
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -275,41 +379,49 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QuerySupplyProjectionRequest)(nil), "cosmos.mint.v1beta1.QuerySupplyProjectionRequest")
+	proto.RegisterType((*QuerySupplyProjectionResponse)(nil), "cosmos.mint.v1beta1.QuerySupplyProjectionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x8e, 0xd3, 0x30,
-	0x18, 0x8c, 0xf9, 0xa9, 0xb4, 0x86, 0xc3, 0xe2, 0x5d, 0x7e, 0x14, 0x8a, 0x03, 0x11, 0xa2, 0xbb,
-	0x20, 0x6c, 0xb5, 0x9c, 0x38, 0x52, 0xb8, 0x20, 0x71, 0x28, 0x3d, 0xc2, 0x01, 0xb9, 0xc1, 0x1b,
-	0x22, 0x12, 0x3b, 0x1b, 0x3b, 0x2b, 0x16, 0x71, 0x81, 0x3b, 0x12, 0x12, 0x6f, 0xc1, 0x93, 0xf4,
-	0xb8, 0x12, 0x17, 0xc4, 0x61, 0x85, 0x5a, 0x1e, 0x04, 0xc5, 0x76, 0x8b, 0x9a, 0x26, 0xfc, 0xec,
-	0xa9, 0x95, 0x67, 0x3c, 0x33, 0xfe, 0xe6, 0x0b, 0x0c, 0x22, 0xa9, 0x32, 0xa9, 0x68, 0x96, 0x08,
-	0x4d, 0x0f, 0xfa, 0x13, 0xae, 0x59, 0x9f, 0xee, 0x97, 0xbc, 0x38, 0x24, 0x79, 0x21, 0xb5, 0x44,
-	0x5b, 0x96, 0x40, 0x2a, 0x02, 0x71, 0x04, 0x7f, 0x3b, 0x96, 0xb1, 0x34, 0x38, 0xad, 0xfe, 0x59,
-	0xaa, 0xdf, 0x8d, 0xa5, 0x8c, 0x53, 0x4e, 0x59, 0x9e, 0x50, 0x26, 0x84, 0xd4, 0x4c, 0x27, 0x52,
-	0x28, 0x87, 0xe2, 0x26, 0x27, 0xa3, 0x6a, 0xf0, 0x70, 0x1b, 0xa2, 0xa7, 0x95, 0xef, 0x88, 0x15,
-	0x2c, 0x53, 0x63, 0xbe, 0x5f, 0x72, 0xa5, 0xc3, 0x11, 0xdc, 0x5a, 0x39, 0x55, 0xb9, 0x14, 0x8a,
-	0xa3, 0xfb, 0xb0, 0x93, 0x9b, 0x93, 0x2b, 0xe0, 0x3a, 0xd8, 0x39, 0x37, 0xb8, 0x4a, 0x1a, 0x62,
-	0x12, 0x7b, 0x69, 0x78, 0x66, 0x7a, 0x1c, 0x78, 0x63, 0x77, 0x21, 0xbc, 0x0c, 0x2f, 0x1a, 0xc5,
-	0xc7, 0x62, 0x2f, 0x35, 0x01, 0x17, 0x56, 0x7b, 0xf0, 0x52, 0x1d, 0x70, 0x6e, 0x4f, 0xe0, 0x46,
-	0xb2, 0x38, 0x34, 0x86, 0xe7, 0x87, 0xa4, 0xd2, 0xfc, 0x7e, 0x1c, 0xdc, 0x8a, 0x13, 0xfd, 0xaa,
-	0x9c, 0x90, 0x48, 0x66, 0xd4, 0x3d, 0xd0, 0xfe, 0xdc, 0x55, 0x2f, 0x5f, 0x53, 0x7d, 0x98, 0x73,
-	0x45, 0x1e, 0xf1, 0x68, 0xfc, 0x5b, 0x20, 0xc4, 0xb0, 0x6b, 0x7c, 0x1e, 0x08, 0x51, 0xb2, 0x74,
-	0x54, 0xc8, 0x83, 0x44, 0x55, 0x73, 0x5a, 0xe4, 0x78, 0x07, 0xaf, 0xb5, 0xe0, 0x2e, 0xce, 0x73,
-	0x78, 0x81, 0x19, 0xec, 0x45, 0xbe, 0x04, 0x4f, 0x18, 0x6b, 0x93, 0xd5, 0x4c, 0x06, 0xd3, 0xd3,
-	0xf0, 0xac, 0xb1, 0x47, 0xef, 0x01, 0xec, 0xd8, 0x09, 0xa2, 0x5e, 0xe3, 0x78, 0xd7, 0xeb, 0xf2,
-	0x77, 0xfe, 0x4e, 0xb4, 0x8f, 0x08, 0x6f, 0x7e, 0xf8, 0xfa, 0xf3, 0xf3, 0x29, 0x8c, 0xba, 0x34,
-	0x89, 0xf2, 0x94, 0xbd, 0x65, 0xab, 0x8b, 0x61, 0xcb, 0x42, 0x1f, 0x01, 0xdc, 0x58, 0xf6, 0x81,
-	0x6e, 0xb7, 0xab, 0xd7, 0xdb, 0xf4, 0xef, 0xfc, 0x13, 0xd7, 0x85, 0xe9, 0x99, 0x30, 0x37, 0x50,
-	0xd0, 0x1c, 0x66, 0xd9, 0x1d, 0xfa, 0x02, 0xe0, 0x66, 0xbd, 0x17, 0xd4, 0x6f, 0xb7, 0x6a, 0xe9,
-	0xd8, 0x1f, 0xfc, 0xcf, 0x15, 0x17, 0x92, 0x9a, 0x90, 0xbb, 0xa8, 0xd7, 0x1c, 0x72, 0x6d, 0x25,
-	0x86, 0x0f, 0xa7, 0x33, 0x0c, 0x8e, 0x66, 0x18, 0xfc, 0x98, 0x61, 0xf0, 0x69, 0x8e, 0xbd, 0xa3,
-	0x39, 0xf6, 0xbe, 0xcd, 0xb1, 0xf7, 0x6c, 0xf7, 0x8f, 0xeb, 0xf1, 0xc6, 0x0a, 0x9b, 0x2d, 0x99,
-	0x74, 0xcc, 0xd7, 0x79, 0xef, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0x26, 0xb9, 0xd2, 0x29,
-	0x04, 0x00, 0x00,
+	// 546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x1c, 0xc6, 0xe3, 0xfe, 0xf2, 0x8b, 0xe8, 0x95, 0xa1, 0x5c, 0x4b, 0x89, 0x4c, 0xea, 0x14, 0x0b,
+	0x91, 0xb4, 0x08, 0x9f, 0x12, 0x26, 0x46, 0x02, 0x0b, 0x12, 0x83, 0x31, 0x1b, 0x0c, 0xd5, 0xc5,
+	0x5c, 0x8d, 0xa9, 0x73, 0x77, 0xf1, 0x9d, 0x23, 0x82, 0x58, 0x60, 0x47, 0x42, 0xe2, 0x5d, 0xf0,
+	0x4a, 0x3a, 0x56, 0x62, 0x41, 0x0c, 0x15, 0x4a, 0x78, 0x0b, 0xec, 0xc8, 0xe7, 0x8b, 0xdb, 0xba,
+	0x76, 0x69, 0x99, 0x12, 0x7f, 0xff, 0x3c, 0xcf, 0xc7, 0xbe, 0xc7, 0x06, 0x6d, 0x9f, 0x89, 0x11,
+	0x13, 0x68, 0x14, 0x52, 0x89, 0x26, 0xbd, 0x21, 0x91, 0xb8, 0x87, 0xc6, 0x09, 0x89, 0xa7, 0x0e,
+	0x8f, 0x99, 0x64, 0x70, 0x2d, 0x1b, 0x70, 0xd2, 0x01, 0x47, 0x0f, 0x98, 0xeb, 0x01, 0x0b, 0x98,
+	0xea, 0xa3, 0xf4, 0x5f, 0x36, 0x6a, 0xb6, 0x02, 0xc6, 0x82, 0x88, 0x20, 0xcc, 0x43, 0x84, 0x29,
+	0x65, 0x12, 0xcb, 0x90, 0x51, 0xa1, 0xbb, 0x56, 0x99, 0x93, 0x52, 0x55, 0x7d, 0x7b, 0x1d, 0xc0,
+	0x67, 0xa9, 0xaf, 0x8b, 0x63, 0x3c, 0x12, 0x1e, 0x19, 0x27, 0x44, 0x48, 0xdb, 0x05, 0x6b, 0xa7,
+	0xaa, 0x82, 0x33, 0x2a, 0x08, 0x7c, 0x00, 0x1a, 0x5c, 0x55, 0x9a, 0xc6, 0x96, 0xd1, 0x5d, 0xe9,
+	0xdf, 0x74, 0x4a, 0x30, 0x9d, 0x6c, 0x69, 0x50, 0x3f, 0x38, 0x6a, 0xd7, 0x3c, 0xbd, 0x60, 0xdf,
+	0x00, 0xd7, 0x95, 0xe2, 0x13, 0xba, 0x17, 0x29, 0xc0, 0x85, 0xd5, 0x1e, 0xd8, 0x28, 0x36, 0xb4,
+	0xdb, 0x53, 0xb0, 0x1c, 0x2e, 0x8a, 0xca, 0xf0, 0xea, 0xc0, 0x49, 0x35, 0x7f, 0x1c, 0xb5, 0xef,
+	0x04, 0xa1, 0x7c, 0x9d, 0x0c, 0x1d, 0x9f, 0x8d, 0x90, 0xbe, 0xc1, 0xec, 0xe7, 0x9e, 0x78, 0xb5,
+	0x8f, 0xe4, 0x94, 0x13, 0xe1, 0x3c, 0x26, 0xbe, 0x77, 0x2c, 0x60, 0x5b, 0xa0, 0xa5, 0x7c, 0x1e,
+	0x52, 0x9a, 0xe0, 0xc8, 0x8d, 0xd9, 0x24, 0x14, 0xe9, 0x73, 0x5a, 0x70, 0xbc, 0x07, 0x9b, 0x15,
+	0x7d, 0x8d, 0xf3, 0x12, 0x5c, 0xc3, 0xaa, 0xb7, 0xcb, 0xf3, 0xe6, 0x3f, 0x62, 0xad, 0xe2, 0x82,
+	0x89, 0xed, 0x69, 0xba, 0xe7, 0x09, 0xe7, 0xd1, 0xd4, 0x8d, 0xd9, 0x1b, 0xe2, 0x9f, 0x78, 0x4a,
+	0x70, 0x03, 0x34, 0x86, 0x11, 0xf3, 0xf7, 0x33, 0xc7, 0xba, 0xa7, 0xaf, 0xa0, 0x09, 0xae, 0x84,
+	0x54, 0x92, 0x78, 0x82, 0xa3, 0xe6, 0x92, 0xea, 0xe4, 0xd7, 0xf6, 0x18, 0x6c, 0x56, 0x68, 0xea,
+	0x3b, 0x72, 0x01, 0xe0, 0x79, 0xb5, 0x69, 0x6c, 0xfd, 0xd7, 0x5d, 0xe9, 0xef, 0x94, 0x1e, 0x69,
+	0x51, 0xc2, 0x65, 0x21, 0x95, 0xfa, 0x84, 0x4f, 0x68, 0xf4, 0x7f, 0xd7, 0xc1, 0xff, 0xca, 0x13,
+	0x7e, 0x30, 0x40, 0x23, 0x0b, 0x02, 0xec, 0x94, 0x4a, 0x9e, 0x4d, 0x9d, 0xd9, 0xfd, 0xfb, 0x60,
+	0x46, 0x6e, 0xdf, 0xfe, 0xf8, 0xed, 0xd7, 0x97, 0x25, 0x0b, 0xb6, 0x50, 0xe8, 0xf3, 0x08, 0xbf,
+	0xc3, 0xa7, 0xf3, 0x9d, 0x65, 0x0e, 0x7e, 0x32, 0xc0, 0x72, 0x1e, 0x2b, 0xb8, 0x53, 0xad, 0x5e,
+	0x0c, 0xa5, 0x79, 0xf7, 0x42, 0xb3, 0x1a, 0xa6, 0xa3, 0x60, 0x6e, 0xc1, 0x76, 0x39, 0x4c, 0x1e,
+	0x41, 0xf8, 0xd5, 0x00, 0xab, 0xc5, 0x78, 0xc1, 0x5e, 0xb5, 0x55, 0x45, 0x54, 0xcd, 0xfe, 0x65,
+	0x56, 0x34, 0x24, 0x52, 0x90, 0xdb, 0xb0, 0x53, 0x0e, 0x79, 0x26, 0xd9, 0x0a, 0xb6, 0x78, 0xec,
+	0xe7, 0xc1, 0x56, 0x24, 0xd7, 0xec, 0x5f, 0x66, 0xe5, 0x62, 0xb0, 0x42, 0xed, 0xed, 0x1e, 0xe7,
+	0x6e, 0xf0, 0xe8, 0x60, 0x66, 0x19, 0x87, 0x33, 0xcb, 0xf8, 0x39, 0xb3, 0x8c, 0xcf, 0x73, 0xab,
+	0x76, 0x38, 0xb7, 0x6a, 0xdf, 0xe7, 0x56, 0xed, 0xc5, 0xf6, 0xb9, 0xaf, 0xe4, 0xdb, 0x4c, 0x58,
+	0xbd, 0x99, 0xc3, 0x86, 0xfa, 0x22, 0xde, 0xff, 0x33, 0x00, 0x3f, 0x70, 0xac, 0xc6, 0x9d, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -330,6 +442,8 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection returns the supply projected by the supply schedule.
+	SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -367,6 +481,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error) {
+	out := new(QuerySupplyProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/SupplyProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -375,6 +498,8 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// SupplyProjection returns the supply projected by the supply schedule.
+	SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -390,6 +515,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) SupplyProjection(ctx context.Context, req *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -449,6 +577,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/SupplyProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyProjection(ctx, req.(*QuerySupplyProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -465,6 +611,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "SupplyProjection",
+			Handler:    _Query_SupplyProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -638,6 +788,76 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySupplyProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projection) > 0 {
+		for iNdEx := len(m.Projection) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projection[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -709,6 +929,36 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySupplyProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	return n
+}
+
+func (m *QuerySupplyProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projection) > 0 {
+		for _, e := range m.Projection {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1114,6 +1364,178 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySupplyProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projection = append(m.Projection, SupplyProjectionPoint{})
+			if err := m.Projection[len(m.Projection)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
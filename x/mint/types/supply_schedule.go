@@ -0,0 +1,161 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSupplyProjectionPoints is the maximum number of points of a supply
+// projection query.
+const MaxSupplyProjectionPoints = 1000
+
+// NewSupplySchedule returns a new SupplySchedule object.
+func NewSupplySchedule(points []ProvisionPoint, halvingBlocks uint64, supplyCap sdk.Int) SupplySchedule {
+	return SupplySchedule{
+		Points:        points,
+		HalvingBlocks: halvingBlocks,
+		SupplyCap:     supplyCap,
+	}
+}
+
+// DefaultSupplySchedule returns a disabled supply schedule, the annual
+// provisions are driven by the inflation.
+func DefaultSupplySchedule() SupplySchedule {
+	return NewSupplySchedule(nil, 0, sdk.ZeroInt())
+}
+
+// NewProvisionPoint returns a new ProvisionPoint object.
+func NewProvisionPoint(height int64, annualProvisions sdk.Dec) ProvisionPoint {
+	return ProvisionPoint{
+		Height:           height,
+		AnnualProvisions: annualProvisions,
+	}
+}
+
+// NewSupplyProjectionPoint returns a new SupplyProjectionPoint object.
+func NewSupplyProjectionPoint(height int64, supply sdk.Int, annualProvisions sdk.Dec) SupplyProjectionPoint {
+	return SupplyProjectionPoint{
+		Height:           height,
+		Supply:           supply,
+		AnnualProvisions: annualProvisions,
+	}
+}
+
+// Enabled returns true if the schedule has provision points.
+func (s SupplySchedule) Enabled() bool {
+	return len(s.Points) > 0
+}
+
+// ScheduledProvisions returns the annual provisions of the schedule at a
+// height, before the supply cap is applied. The provisions are interpolated
+// between the two points around the height and kept constant before the first
+// point and after the last one, then halved once every halving blocks.
+func (s SupplySchedule) ScheduledProvisions(height int64) sdk.Dec {
+	if !s.Enabled() {
+		return sdk.ZeroDec()
+	}
+
+	provisions := s.Points[0].AnnualProvisions
+	for i, point := range s.Points {
+		if height < point.Height {
+			break
+		}
+
+		provisions = point.AnnualProvisions
+		if i+1 < len(s.Points) && height < s.Points[i+1].Height {
+			next := s.Points[i+1]
+			provisions = provisions.Add(
+				next.AnnualProvisions.Sub(point.AnnualProvisions).
+					MulInt64(height - point.Height).
+					QuoInt64(next.Height - point.Height),
+			)
+		}
+	}
+
+	start := s.Points[0].Height
+	if s.HalvingBlocks > 0 && height > start {
+		for halvings := uint64(height-start) / s.HalvingBlocks; halvings > 0 && !provisions.IsZero(); halvings-- {
+			provisions = provisions.QuoInt64(2)
+		}
+	}
+
+	return provisions
+}
+
+// AnnualProvisions returns the annual provisions of the schedule at a height,
+// given the amount ever minted, burnt coins included. The provisions are cut
+// so that the provision of the block does not exceed the supply cap.
+func (s SupplySchedule) AnnualProvisions(height int64, blocksPerYear uint64, totalMinted sdk.Int) sdk.Dec {
+	provisions := s.ScheduledProvisions(height)
+	if !s.SupplyCap.IsPositive() {
+		return provisions
+	}
+
+	remaining := s.SupplyCap.Sub(totalMinted)
+	if !remaining.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	if provisions.QuoInt64(int64(blocksPerYear)).TruncateInt().GT(remaining) {
+		return remaining.MulRaw(int64(blocksPerYear)).ToDec()
+	}
+
+	return provisions
+}
+
+// Project returns the supply projected by the schedule from a height for the
+// given number of blocks, with a point every interval blocks. The provision of
+// every block of an interval is the one of the first block of the interval,
+// and no more coins are expected to be burnt.
+func (s SupplySchedule) Project(height int64, supply, totalMinted sdk.Int, blocksPerYear, blocks, interval uint64) []SupplyProjectionPoint {
+	projection := []SupplyProjectionPoint{
+		NewSupplyProjectionPoint(height, supply, s.AnnualProvisions(height, blocksPerYear, totalMinted)),
+	}
+
+	end := height + int64(blocks)
+	for height < end {
+		step := int64(interval)
+		if end-height < step {
+			step = end - height
+		}
+
+		provisions := s.AnnualProvisions(height+1, blocksPerYear, totalMinted)
+		minted := provisions.QuoInt64(int64(blocksPerYear)).TruncateInt().MulRaw(step)
+		if s.SupplyCap.IsPositive() && minted.GT(s.SupplyCap.Sub(totalMinted)) {
+			minted = sdk.MaxInt(s.SupplyCap.Sub(totalMinted), sdk.ZeroInt())
+		}
+
+		height += step
+		supply = supply.Add(minted)
+		totalMinted = totalMinted.Add(minted)
+		projection = append(projection, NewSupplyProjectionPoint(height, supply, s.AnnualProvisions(height, blocksPerYear, totalMinted)))
+	}
+
+	return projection
+}
+
+func validateSupplySchedule(i interface{}) error {
+	v, ok := i.(SupplySchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, point := range v.Points {
+		if point.Height < 0 {
+			return fmt.Errorf("provision point height cannot be negative: %d", point.Height)
+		}
+		if i > 0 && point.Height <= v.Points[i-1].Height {
+			return fmt.Errorf("provision points must be ordered by strictly increasing height: %d", point.Height)
+		}
+		if point.AnnualProvisions.IsNil() || point.AnnualProvisions.IsNegative() {
+			return fmt.Errorf("provision point annual provisions must be non-negative: %s", point.AnnualProvisions)
+		}
+	}
+
+	if v.SupplyCap.IsNil() || v.SupplyCap.IsNegative() {
+		return fmt.Errorf("supply cap must be non-negative: %s", v.SupplyCap)
+	}
+
+	return nil
+}
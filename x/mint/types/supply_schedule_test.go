@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestScheduledProvisions(t *testing.T) {
+	schedule := NewSupplySchedule([]ProvisionPoint{
+		NewProvisionPoint(100, sdk.NewDec(1000)),
+		NewProvisionPoint(200, sdk.NewDec(2000)),
+		NewProvisionPoint(300, sdk.NewDec(500)),
+	}, 0, sdk.ZeroInt())
+
+	tests := []struct {
+		height int64
+		exp    sdk.Dec
+	}{
+		{0, sdk.NewDec(1000)},
+		{100, sdk.NewDec(1000)},
+		{150, sdk.NewDec(1500)},
+		{200, sdk.NewDec(2000)},
+		{250, sdk.NewDec(1250)},
+		{300, sdk.NewDec(500)},
+		{1000, sdk.NewDec(500)},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.exp, schedule.ScheduledProvisions(tc.height), "height %d", tc.height)
+	}
+
+	require.True(t, DefaultSupplySchedule().ScheduledProvisions(100).IsZero())
+}
+
+func TestScheduledProvisionsHalvings(t *testing.T) {
+	schedule := NewSupplySchedule([]ProvisionPoint{NewProvisionPoint(10, sdk.NewDec(800))}, 100, sdk.ZeroInt())
+
+	require.Equal(t, sdk.NewDec(800), schedule.ScheduledProvisions(10))
+	require.Equal(t, sdk.NewDec(800), schedule.ScheduledProvisions(109))
+	require.Equal(t, sdk.NewDec(400), schedule.ScheduledProvisions(110))
+	require.Equal(t, sdk.NewDec(100), schedule.ScheduledProvisions(310))
+	require.True(t, schedule.ScheduledProvisions(100000).IsZero())
+}
+
+func TestAnnualProvisionsSupplyCap(t *testing.T) {
+	schedule := NewSupplySchedule([]ProvisionPoint{NewProvisionPoint(0, sdk.NewDec(1000))}, 0, sdk.NewInt(10000))
+
+	// 100 per block with 10 blocks per year
+	require.Equal(t, sdk.NewDec(1000), schedule.AnnualProvisions(1, 10, sdk.NewInt(5000)))
+	require.Equal(t, sdk.NewDec(1000), schedule.AnnualProvisions(1, 10, sdk.NewInt(9900)))
+	require.Equal(t, sdk.NewDec(400), schedule.AnnualProvisions(1, 10, sdk.NewInt(9960)))
+	require.True(t, schedule.AnnualProvisions(1, 10, sdk.NewInt(10000)).IsZero())
+	require.True(t, schedule.AnnualProvisions(1, 10, sdk.NewInt(12000)).IsZero())
+}
+
+func TestProject(t *testing.T) {
+	schedule := NewSupplySchedule([]ProvisionPoint{NewProvisionPoint(0, sdk.NewDec(1000))}, 0, sdk.NewInt(10250))
+
+	// 9000 supply and 1000 burnt, 100 minted per block up to the cap
+	projection := schedule.Project(5, sdk.NewInt(9000), sdk.NewInt(10000), 10, 5, 2)
+	require.Equal(t, []SupplyProjectionPoint{
+		NewSupplyProjectionPoint(5, sdk.NewInt(9000), sdk.NewDec(1000)),
+		NewSupplyProjectionPoint(7, sdk.NewInt(9200), sdk.NewDec(500)),
+		NewSupplyProjectionPoint(9, sdk.NewInt(9250), sdk.ZeroDec()),
+		NewSupplyProjectionPoint(10, sdk.NewInt(9250), sdk.ZeroDec()),
+	}, projection)
+}
+
+func TestValidateSupplySchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule SupplySchedule
+		expErr   bool
+	}{
+		{"default", DefaultSupplySchedule(), false},
+		{"valid", NewSupplySchedule([]ProvisionPoint{
+			NewProvisionPoint(0, sdk.NewDec(10)), NewProvisionPoint(10, sdk.ZeroDec()),
+		}, 5, sdk.NewInt(100)), false},
+		{"negative height", NewSupplySchedule([]ProvisionPoint{NewProvisionPoint(-1, sdk.NewDec(10))}, 0, sdk.ZeroInt()), true},
+		{"unordered points", NewSupplySchedule([]ProvisionPoint{
+			NewProvisionPoint(10, sdk.NewDec(10)), NewProvisionPoint(10, sdk.NewDec(5)),
+		}, 0, sdk.ZeroInt()), true},
+		{"negative provisions", NewSupplySchedule([]ProvisionPoint{NewProvisionPoint(0, sdk.NewDec(-1))}, 0, sdk.ZeroInt()), true},
+		{"nil provisions", NewSupplySchedule([]ProvisionPoint{{Height: 0}}, 0, sdk.ZeroInt()), true},
+		{"negative cap", NewSupplySchedule(nil, 0, sdk.NewInt(-1)), true},
+		{"nil cap", SupplySchedule{}, true},
+	}
+	for _, tc := range tests {
+		err := validateSupplySchedule(tc.schedule)
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
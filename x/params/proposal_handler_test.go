@@ -69,8 +69,11 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 			func() {
 				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
 				suite.Require().Equal(govtypes.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: govtypes.DefaultPeriod,
+					MinDeposit:          sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
+					MaxDepositPeriod:    govtypes.DefaultPeriod,
+					ExpeditedMinDeposit: govtypes.DefaultDepositParams().ExpeditedMinDeposit,
+					ProposalCancelRatio: govtypes.DefaultDepositParams().ProposalCancelRatio,
+					MaxMetadataLen:      govtypes.DefaultDepositParams().MaxMetadataLen,
 				}, depositParams)
 			},
 			false,
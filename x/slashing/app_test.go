@@ -64,7 +64,8 @@ func TestSlashingMsgs(t *testing.T) {
 	commission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 
 	createValidatorMsg, err := stakingtypes.NewMsgCreateValidator(
-		sdk.ValAddress(addr1), valKey.PubKey(), bondCoin, description, commission, sdk.OneInt(),
+		sdk.ValAddress(addr1), valKey.PubKey(), bondCoin, description, commission,
+		stakingtypes.NewReallocatedCommissionRule(sdk.OneDec(), sdk.ZeroDec(), 0, nil), addr1, sdk.OneInt(),
 	)
 	require.NoError(t, err)
 
@@ -28,8 +28,12 @@ const (
 	FlagRCommissionValidatorRate = "reallocated-commission-validator-rate"
 	FlagRCommissionRecommandersRate = "reallocated-commission-recommanders-rate"
 	FlagIncentiveDepth = "incentive-depth"
+	FlagRCommissionMaxValidatorRateChange = "reallocated-commission-max-validator-rate-change"
+	FlagRCommissionMaxRecommandersRateChange = "reallocated-commission-max-recommanders-rate-change"
+	FlagRCommissionMaxClassRateChange = "reallocated-commission-max-class-rate-change"
 	FlagFileIncentiveClassRates = "incentive-class-rates-file"
 	FlagAddressIncentiveTeam = "incentive-team"
+	FlagIncentiveTeamRecipients = "incentive-team-recipients"
 
 	FlagAddressRecommander = "recommander"
 
@@ -76,6 +80,9 @@ func FlagSetRellocatedCommissionCreate() *flag.FlagSet {
 	fs.String(FlagRCommissionRecommandersRate, "", "The initial all recommanders commission rate percentage")
 	fs.Uint32(FlagIncentiveDepth, 0, "The incentive depth of current validator")
 	fs.String(FlagFileIncentiveClassRates, "", "The every recommander rate percentage (per depth) in file")
+	fs.String(FlagRCommissionMaxValidatorRateChange, "", "The maximum validator rate change percentage (per day)")
+	fs.String(FlagRCommissionMaxRecommandersRateChange, "", "The maximum all recommanders rate change percentage (per day)")
+	fs.String(FlagRCommissionMaxClassRateChange, "", "The maximum every recommander rate change percentage (per day)")
 
 	return fs
 }
@@ -93,6 +100,7 @@ func flagSetIncentiveEdit() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.String(FlagAddressIncentiveTeam, types.DoNotModifyDesc, "The (optional) Bech32 address of the team")
+	fs.StringSlice(FlagIncentiveTeamRecipients, nil, "The (optional) team recipients sharing the team commission, as address=weight pairs whose weights add up to one")
 
 	return fs
 }
@@ -39,6 +39,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryRecommanderChain(),
+		GetCmdQueryRecommandees(),
 	)
 
 	return stakingQueryCmd
@@ -744,3 +746,120 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryRecommanderChain implements the command to query the recommanders
+// chain of a delegation.
+func GetCmdQueryRecommanderChain() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "recommander-chain [delegator-addr] [validator-addr]",
+		Short: "Query the recommanders chain of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the recommanders which share the recommanders rewards of an individual delegator on an individual validator.
+
+Example:
+$ %s query staking recommander-chain %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRecommanderChainRequest{
+				DelegatorAddr: delAddr.String(),
+				ValidatorAddr: valAddr.String(),
+			}
+
+			res, err := queryClient.RecommanderChain(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRecommandees implements the command to query all the delegations
+// to a validator recommended by one recommander.
+func GetCmdQueryRecommandees() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "recommandees [recommander-addr] [validator-addr]",
+		Short: "Query all delegations to a validator recommended by one recommander",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query delegations on an individual validator which are recommended by an individual recommander.
+
+Example:
+$ %s query staking recommandees %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			recAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRecommandeesRequest{
+				RecommanderAddr: recAddr.String(),
+				ValidatorAddr:   valAddr.String(),
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.Recommandees(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "recommandees")
+
+	return cmd
+}
@@ -50,6 +50,7 @@ func NewTxCmd() *cobra.Command {
 		NewUnbondCmd(),
 		NewEditValidatorRCommissionRuleCmd(),
 		NewEditValidatorRecommanderRuleCmd(),
+		NewSetDelegationRecommanderCmd(),
 	)
 
 	return stakingTxCmd
@@ -114,6 +115,12 @@ func NewEditValidatorCmd() *cobra.Command {
 			description := types.NewDescription(moniker, identity, website, security, details)
 			incentiveTeamAddress, _ := cmd.Flags().GetString(FlagAddressIncentiveTeam)
 
+			recipientStrs, _ := cmd.Flags().GetStringSlice(FlagIncentiveTeamRecipients)
+			incentiveTeamRecipients, err := buildIncentiveTeamRecipients(recipientStrs)
+			if err != nil {
+				return err
+			}
+
 			var newRate *sdk.Dec
 
 			commissionRate, _ := cmd.Flags().GetString(FlagCommissionRate)
@@ -138,7 +145,7 @@ func NewEditValidatorCmd() *cobra.Command {
 				newMinSelfDelegation = &msb
 			}
 
-			msg := types.NewMsgEditValidator(sdk.ValAddress(valAddr), incentiveTeamAddress, description, newRate, newMinSelfDelegation)
+			msg := types.NewMsgEditValidator(sdk.ValAddress(valAddr), incentiveTeamAddress, incentiveTeamRecipients, description, newRate, newMinSelfDelegation)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -207,6 +214,56 @@ $ %s tx staking delegate %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake --f
 	return cmd
 }
 
+func NewSetDelegationRecommanderCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-recommander [validator-addr] [recommander-addr]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Change or clear the recommander of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Change the recommander of an existing delegation to a validator. The pending
+rewards of the delegation are withdrawn first. Omit the recommander to clear it,
+so that no recommander earns rewards from the delegation.
+
+Example:
+$ %s tx staking set-recommander %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var recommanderAddr sdk.AccAddress
+			if len(args) == 2 {
+				recommanderAddr, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetDelegationRecommander(delAddr, valAddr, recommanderAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewRedelegateCmd() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
 
@@ -329,7 +386,7 @@ $ %s tx staking edit-recommission --from=mykey --validator-rate=%s --recommander
 			if err != nil {
 				return err
 			}
-			recommanderRateStr, _ := cmd.Flags().GetString(FlagRCommissionValidatorRate)
+			recommanderRateStr, _ := cmd.Flags().GetString(FlagRCommissionRecommandersRate)
 			if recommanderRateStr == "" {
 				return errors.New("must specify all reallocated commission parameters")
 			}
@@ -456,6 +513,9 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 	recommanderClassRatesPath, _ := fs.GetString(FlagFileIncentiveClassRates)
 	incentiveDepth, _:= fs.GetUint32(FlagIncentiveDepth)
 	incentiveTeamAddress, _ := fs.GetString(FlagAddressIncentiveTeam)
+	maxValidatorRateChange, _ := fs.GetString(FlagRCommissionMaxValidatorRateChange)
+	maxRecommandersRateChange, _ := fs.GetString(FlagRCommissionMaxRecommandersRateChange)
+	maxClassRateChange, _ := fs.GetString(FlagRCommissionMaxClassRateChange)
 
 	recommanderClassRatesJSON, err := parseRecommanderClassRatesJSON(clientCtx.LegacyAmino, recommanderClassRatesPath)
 	if err != nil {
@@ -466,7 +526,8 @@ func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *fl
 		return txf, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "depth is equal to len of rates")
 	}
 
-	reallocatedCommissionRule, err := buildReallocatedCommissionRule(validatorRate, recommandersRate, incentiveDepth, recommanderClassRatesJSON)
+	reallocatedCommissionRule, err := buildReallocatedCommissionRule(validatorRate, recommandersRate, incentiveDepth, recommanderClassRatesJSON,
+		maxValidatorRateChange, maxRecommandersRateChange, maxClassRateChange)
 	if err != nil {
 		return txf, nil, err
 	}
@@ -551,6 +612,11 @@ type TxCreateValidatorConfig struct {
 	RCommissionRecommandersRate string
 	IncentiveDepth              uint32
 	RecommanderClassRatesFile   string
+
+	RCommissionMaxValidatorRateChange    string
+	RCommissionMaxRecommandersRateChange string
+	RCommissionMaxClassRateChange        string
+
 	IncentiveTeam               string
 
 	PubKey cryptotypes.PubKey
@@ -639,6 +705,21 @@ func PrepareConfigForTxCreateValidator(flagSet *flag.FlagSet, moniker, nodeID, c
 		return c, err
 	}
 
+	c.RCommissionMaxValidatorRateChange, err = flagSet.GetString(FlagRCommissionMaxValidatorRateChange)
+	if err != nil {
+		return c, err
+	}
+
+	c.RCommissionMaxRecommandersRateChange, err = flagSet.GetString(FlagRCommissionMaxRecommandersRateChange)
+	if err != nil {
+		return c, err
+	}
+
+	c.RCommissionMaxClassRateChange, err = flagSet.GetString(FlagRCommissionMaxClassRateChange)
+	if err != nil {
+		return c, err
+	}
+
 	c.RecommanderClassRatesFile, err = flagSet.GetString(FlagFileIncentiveClassRates)
 	if err != nil {
 		return c, err
@@ -734,7 +815,8 @@ func BuildCreateValidatorMsg(clientCtx client.Context, config TxCreateValidatorC
 		return txBldr, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "depth is equal to len of rates")
 	}
 
-	reallocatedCommissionRule, err := buildReallocatedCommissionRule(config.RCommissionValidatorRate, config.RCommissionRecommandersRate, config.IncentiveDepth, recommanderClassRatesJSON)
+	reallocatedCommissionRule, err := buildReallocatedCommissionRule(config.RCommissionValidatorRate, config.RCommissionRecommandersRate, config.IncentiveDepth, recommanderClassRatesJSON,
+		config.RCommissionMaxValidatorRateChange, config.RCommissionMaxRecommandersRateChange, config.RCommissionMaxClassRateChange)
 	if err != nil {
 		return txBldr, nil, err
 	}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -47,7 +49,8 @@ func buildCommissionRates(rateStr, maxRateStr, maxChangeRateStr string) (commiss
 	return commission, nil
 }
 
-func buildReallocatedCommissionRule(validatorRateStr, recommandersRateStr string, incentiveDepth uint32, recommanderClassRatesJSON RecommanderClassRatesJSON) (rCommissionRule types.ReallocatedCommissionRule, err error) {
+func buildReallocatedCommissionRule(validatorRateStr, recommandersRateStr string, incentiveDepth uint32, recommanderClassRatesJSON RecommanderClassRatesJSON,
+	maxValidatorRateChangeStr, maxRecommandersRateChangeStr, maxClassRateChangeStr string) (rCommissionRule types.ReallocatedCommissionRule, err error) {
 
 	if validatorRateStr == "" || recommandersRateStr == "" {
 		return rCommissionRule, errors.New("must specify all reallocated commission parameters")
@@ -67,7 +70,26 @@ func buildReallocatedCommissionRule(validatorRateStr, recommandersRateStr string
 
 	rCommissionRule = types.NewReallocatedCommissionRule(validatorRate, recommandersRate, incentiveDepth, recommanderClassRates)
 
-	return rCommissionRule, nil
+	// the max change rates which are not given keep their default value
+	maxValidatorRateChange, maxRecommandersRateChange, maxClassRateChange := rCommissionRule.MaxChangeRates()
+	if maxValidatorRateChange, err = parseDecOrDefault(maxValidatorRateChangeStr, maxValidatorRateChange); err != nil {
+		return rCommissionRule, err
+	}
+	if maxRecommandersRateChange, err = parseDecOrDefault(maxRecommandersRateChangeStr, maxRecommandersRateChange); err != nil {
+		return rCommissionRule, err
+	}
+	if maxClassRateChange, err = parseDecOrDefault(maxClassRateChangeStr, maxClassRateChange); err != nil {
+		return rCommissionRule, err
+	}
+
+	return rCommissionRule.WithMaxChangeRates(maxValidatorRateChange, maxRecommandersRateChange, maxClassRateChange), nil
+}
+
+func parseDecOrDefault(decStr string, defaultDec sdk.Dec) (sdk.Dec, error) {
+	if decStr == "" {
+		return defaultDec, nil
+	}
+	return sdk.NewDecFromStr(decStr)
 }
 
 func buildRecommanderClassRates(recommanderClassRatesJSON RecommanderClassRatesJSON) types.RecommanderClassRates {
@@ -96,3 +118,29 @@ func parseRecommanderClassRatesJSON(cdc *codec.LegacyAmino, ruleFile string) (Re
 
 	return rule, nil
 }
+
+// buildIncentiveTeamRecipients parses incentive team recipients given as
+// address=weight pairs.
+func buildIncentiveTeamRecipients(recipientStrs []string) ([]types.IncentiveTeamRecipient, error) {
+	recipients := make([]types.IncentiveTeamRecipient, 0, len(recipientStrs))
+	for _, recipientStr := range recipientStrs {
+		parts := strings.Split(recipientStr, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid incentive team recipient %s, expected address=weight", recipientStr)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of incentive team recipient %s: %w", recipientStr, err)
+		}
+
+		recipients = append(recipients, types.NewIncentiveTeamRecipient(addr, weight))
+	}
+
+	return recipients, nil
+}
@@ -36,6 +36,11 @@ func InitGenesis(
 	keeper.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
+		// the genesis of older versions has no max change rates, which would be
+		// stored as zero ones
+		rule := validator.ReallocatedCommissionRule
+		validator.ReallocatedCommissionRule = rule.WithMaxChangeRates(rule.MaxChangeRates())
+
 		keeper.SetValidator(ctx, validator)
 
 		// Manually set indices for the first time
@@ -257,6 +262,12 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 			return fmt.Errorf("bonded/unbonded genesis validator cannot have zero delegator shares, validator: %v", val)
 		}
 
+		if len(val.IncentiveTeamRecipients) > 0 {
+			if err := types.IncentiveTeamRecipients(val.IncentiveTeamRecipients).Validate(); err != nil {
+				return fmt.Errorf("invalid incentive team recipients of genesis validator %s: %w", val.OperatorAddress, err)
+			}
+		}
+
 		addrMap[strKey] = true
 	}
 
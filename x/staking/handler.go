@@ -42,6 +42,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.EditValidatorRCommissionRule(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetDelegationRecommander:
+			res, err := msgServer.SetDelegationRecommander(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
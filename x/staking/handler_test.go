@@ -213,6 +213,21 @@ func TestBothPubKeyTypesMsgCreateValidator(t *testing.T) {
 	}
 }
 
+func TestCreateValidatorDefaultMaxChangeRates(t *testing.T) {
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 1, sdk.NewInt(1000))
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// older clients and gentxs don't set the max change rates
+	tstaking.ReallocCommissionRule = tstaking.ReallocCommissionRule.WithMaxChangeRates(sdk.Dec{}, sdk.Dec{}, sdk.Dec{})
+	tstaking.CreateValidator(valAddrs[0], PKs[0], sdk.NewInt(10), true)
+
+	validator := tstaking.CheckValidator(valAddrs[0], types.Unbonded, false)
+	rule := validator.ReallocatedCommissionRule
+	require.Equal(t, types.DefaultMaxRCommissionRuleChange, rule.MaxValidatorRateChange)
+	require.Equal(t, types.DefaultMaxRCommissionRuleChange, rule.MaxRecommandersRateChange)
+	require.Equal(t, types.DefaultMaxRCommissionRuleChange, rule.MaxClassRateChange)
+}
+
 func TestLegacyValidatorDelegations(t *testing.T) {
 	initPower := int64(1000)
 	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction))
@@ -378,7 +393,7 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 		initBond, gotBond, bond)
 
 	newMinSelfDelegation := sdk.OneInt()
-	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.Description{}, nil, &newMinSelfDelegation)
+	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.DoNotModifyDesc, nil, types.Description{}, nil, &newMinSelfDelegation)
 	tstaking.Handle(msgEditValidator, false)
 }
 
@@ -409,7 +424,7 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 		initBond, gotBond, bond)
 
 	newMinSelfDelegation := initBond.Add(sdk.OneInt())
-	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.Description{}, nil, &newMinSelfDelegation)
+	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.DoNotModifyDesc, nil, types.Description{}, nil, &newMinSelfDelegation)
 	tstaking.Handle(msgEditValidator, false)
 }
 
@@ -1185,22 +1200,22 @@ func TestInvalidCoinDenom(t *testing.T) {
 	oneCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
 
 	commission := types.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.ZeroDec())
-	msgCreate, err := types.NewMsgCreateValidator(valA, PKs[0], invalidCoin, types.Description{}, commission, sdk.OneInt())
+	msgCreate, err := types.NewMsgCreateValidator(valA, PKs[0], invalidCoin, types.Description{}, commission, types.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), 0, nil), sdk.AccAddress(valA), sdk.OneInt())
 	require.NoError(t, err)
 	tstaking.Handle(msgCreate, false)
 
-	msgCreate, err = types.NewMsgCreateValidator(valA, PKs[0], validCoin, types.Description{}, commission, sdk.OneInt())
+	msgCreate, err = types.NewMsgCreateValidator(valA, PKs[0], validCoin, types.Description{}, commission, types.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), 0, nil), sdk.AccAddress(valA), sdk.OneInt())
 	require.NoError(t, err)
 	tstaking.Handle(msgCreate, true)
 
-	msgCreate, err = types.NewMsgCreateValidator(valB, PKs[1], validCoin, types.Description{}, commission, sdk.OneInt())
+	msgCreate, err = types.NewMsgCreateValidator(valB, PKs[1], validCoin, types.Description{}, commission, types.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), 0, nil), sdk.AccAddress(valB), sdk.OneInt())
 	require.NoError(t, err)
 	tstaking.Handle(msgCreate, true)
 
-	msgDelegate := types.NewMsgDelegate(delAddr, valA, invalidCoin)
+	msgDelegate := types.NewMsgDelegate(delAddr, valA, sdk.AccAddress(valA), invalidCoin)
 	tstaking.Handle(msgDelegate, false)
 
-	msgDelegate = types.NewMsgDelegate(delAddr, valA, validCoin)
+	msgDelegate = types.NewMsgDelegate(delAddr, valA, sdk.AccAddress(valA), validCoin)
 	tstaking.Handle(msgDelegate, true)
 
 	msgUndelegate := types.NewMsgUndelegate(delAddr, valA, invalidCoin)
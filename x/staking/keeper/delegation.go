@@ -99,6 +99,7 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
+	k.SetDelegationByRecommanderIndex(ctx, delegation)
 }
 
 // RemoveDelegation removes a delegation.
@@ -109,6 +110,7 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
 	k.BeforeDelegationRemoved(ctx, delegatorAddress, delegation.GetValidatorAddr())
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+	k.DeleteDelegationByRecommanderIndex(ctx, delegation)
 }
 
 // GetUnbondingDelegations returns a given amount of all the delegator unbonding-delegations.
@@ -284,6 +286,16 @@ func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDeleg
 		panic(err)
 	}
 	key := types.GetUBDKey(delegatorAddress, addr)
+
+	// keep track of the total balance of the unbonding delegation entries
+	delta := unbondingBalance(ubd)
+	if oldBz := store.Get(key); oldBz != nil {
+		delta = delta.Sub(unbondingBalance(types.MustUnmarshalUBD(k.cdc, oldBz)))
+	}
+	if !delta.IsZero() {
+		k.setTotalUnbondingBalance(ctx, k.getTotalUnbondingBalance(ctx).Add(delta))
+	}
+
 	store.Set(key, bz)
 	store.Set(types.GetUBDByValIndexKey(delegatorAddress, addr), []byte{}) // index, store empty bytes
 }
@@ -297,10 +309,92 @@ func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDe
 		panic(err)
 	}
 	key := types.GetUBDKey(delegatorAddress, addr)
+
+	if oldBz := store.Get(key); oldBz != nil {
+		balance := unbondingBalance(types.MustUnmarshalUBD(k.cdc, oldBz))
+		k.setTotalUnbondingBalance(ctx, k.getTotalUnbondingBalance(ctx).Sub(balance))
+	}
+
 	store.Delete(key)
 	store.Delete(types.GetUBDByValIndexKey(delegatorAddress, addr))
 }
 
+// unbondingBalance returns the total balance of the entries of an unbonding
+// delegation.
+func unbondingBalance(ubd types.UnbondingDelegation) sdk.Int {
+	balance := sdk.ZeroInt()
+	for _, entry := range ubd.Entries {
+		balance = balance.Add(entry.Balance)
+	}
+	return balance
+}
+
+// getTotalUnbondingBalance returns the total balance of the entries of all the
+// unbonding delegations, including the mature entries not completed yet.
+func (k Keeper) getTotalUnbondingBalance(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalUnbondingBalanceKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+func (k Keeper) setTotalUnbondingBalance(ctx sdk.Context, balance sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: balance})
+	store.Set(types.TotalUnbondingBalanceKey, bz)
+}
+
+// TotalUnbondingTokens returns the total balance of the unbonding delegation
+// entries that are not mature yet. The balance of all the entries is tracked in
+// the store, the mature entries, which are completed at the end of the block,
+// are found from the unbonding queue.
+func (k Keeper) TotalUnbondingTokens(ctx sdk.Context) sdk.Int {
+	total := k.getTotalUnbondingBalance(ctx)
+	currTime := ctx.BlockHeader().Time
+
+	unbondingTimesliceIterator := k.UBDQueueIterator(ctx, currTime)
+	defer unbondingTimesliceIterator.Close()
+
+	// an unbonding delegation may have mature entries in several timeslices
+	seen := make(map[types.DVPair]bool)
+	for ; unbondingTimesliceIterator.Valid(); unbondingTimesliceIterator.Next() {
+		timeslice := types.DVPairs{}
+		k.cdc.MustUnmarshal(unbondingTimesliceIterator.Value(), &timeslice)
+
+		for _, dvPair := range timeslice.Pairs {
+			if seen[dvPair] {
+				continue
+			}
+			seen[dvPair] = true
+
+			valAddr, err := sdk.ValAddressFromBech32(dvPair.ValidatorAddress)
+			if err != nil {
+				panic(err)
+			}
+			delegatorAddress := sdk.MustAccAddressFromBech32(dvPair.DelegatorAddress)
+
+			ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddress, valAddr)
+			if !found {
+				continue
+			}
+
+			for _, entry := range ubd.Entries {
+				if entry.IsMature(currTime) {
+					total = total.Sub(entry.Balance)
+				}
+			}
+		}
+	}
+
+	return total
+}
+
 // SetUnbondingDelegationEntry adds an entry to the unbonding delegation at
 // the given addresses. It creates the unbonding delegation if it does not exist.
 func (k Keeper) SetUnbondingDelegationEntry(
@@ -639,8 +733,8 @@ func (k Keeper) Delegate(
 	// Get or create the delegation object
 	delegation, found := k.GetDelegation(ctx, delAddr, validator.GetOperator())
 	if !found {
-		if recommanderAddr.Equals(delAddr) && !sdk.AccAddress(validator.GetOperator()).Equals(delAddr) {
-			return sdk.ZeroDec(), types.ErrInvalidRecommanderAddr
+		if err := k.ValidateRecommander(ctx, delAddr, validator.GetOperator(), recommanderAddr); err != nil {
+			return sdk.ZeroDec(), err
 		}
 		delegation = types.NewDelegation(delAddr, validator.GetOperator(), recommanderAddr, sdk.ZeroDec())
 	}
@@ -751,6 +845,7 @@ func (k Keeper) Unbond(
 
 	// remove the delegation
 	if delegation.Shares.IsZero() {
+		k.reassignRecommandees(ctx, delegation)
 		k.RemoveDelegation(ctx, delegation)
 	} else {
 		k.SetDelegation(ctx, delegation)
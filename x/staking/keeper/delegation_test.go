@@ -34,7 +34,7 @@ func TestDelegation(t *testing.T) {
 	validators[2] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[2], true)
 
 	// first add a validators[0] to delegate too
-	bond1to1 := types.NewDelegation(addrDels[0], valAddrs[0], sdk.NewDec(9))
+	bond1to1 := types.NewDelegation(addrDels[0], valAddrs[0], sdk.AccAddress(valAddrs[0]), sdk.NewDec(9))
 
 	// check the empty keeper first
 	_, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], valAddrs[0])
@@ -54,11 +54,11 @@ func TestDelegation(t *testing.T) {
 	require.Equal(t, bond1to1, resBond)
 
 	// add some more records
-	bond1to2 := types.NewDelegation(addrDels[0], valAddrs[1], sdk.NewDec(9))
-	bond1to3 := types.NewDelegation(addrDels[0], valAddrs[2], sdk.NewDec(9))
-	bond2to1 := types.NewDelegation(addrDels[1], valAddrs[0], sdk.NewDec(9))
-	bond2to2 := types.NewDelegation(addrDels[1], valAddrs[1], sdk.NewDec(9))
-	bond2to3 := types.NewDelegation(addrDels[1], valAddrs[2], sdk.NewDec(9))
+	bond1to2 := types.NewDelegation(addrDels[0], valAddrs[1], sdk.AccAddress(valAddrs[1]), sdk.NewDec(9))
+	bond1to3 := types.NewDelegation(addrDels[0], valAddrs[2], sdk.AccAddress(valAddrs[2]), sdk.NewDec(9))
+	bond2to1 := types.NewDelegation(addrDels[1], valAddrs[0], sdk.AccAddress(valAddrs[0]), sdk.NewDec(9))
+	bond2to2 := types.NewDelegation(addrDels[1], valAddrs[1], sdk.AccAddress(valAddrs[1]), sdk.NewDec(9))
+	bond2to3 := types.NewDelegation(addrDels[1], valAddrs[2], sdk.AccAddress(valAddrs[2]), sdk.NewDec(9))
 	app.StakingKeeper.SetDelegation(ctx, bond1to2)
 	app.StakingKeeper.SetDelegation(ctx, bond1to3)
 	app.StakingKeeper.SetDelegation(ctx, bond2to1)
@@ -186,6 +186,43 @@ func TestUnbondingDelegation(t *testing.T) {
 	require.Equal(t, 0, len(resUnbonds))
 }
 
+func TestTotalUnbondingTokens(t *testing.T) {
+	_, app, ctx := createTestInput()
+	ctx = ctx.WithBlockTime(time.Unix(100, 0).UTC())
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	// the mature entry is still in the store until the end of the block
+	ubd1 := types.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 0, ctx.BlockTime(), sdk.NewInt(5))
+	ubd1.AddEntry(0, ctx.BlockTime().Add(time.Hour), sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd1)
+	app.StakingKeeper.InsertUBDQueue(ctx, ubd1, ctx.BlockTime())
+	app.StakingKeeper.InsertUBDQueue(ctx, ubd1, ctx.BlockTime().Add(time.Hour))
+
+	ubd2 := app.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddrs[1], valAddrs[0], 0, ctx.BlockTime().Add(time.Hour), sdk.NewInt(20))
+	app.StakingKeeper.InsertUBDQueue(ctx, ubd2, ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, sdk.NewInt(30), app.StakingKeeper.TotalUnbondingTokens(ctx))
+
+	// the balance of a slashed entry is updated
+	ubd2.Entries[0].Balance = sdk.NewInt(15)
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd2)
+	require.Equal(t, sdk.NewInt(25), app.StakingKeeper.TotalUnbondingTokens(ctx))
+
+	app.StakingKeeper.RemoveUnbondingDelegation(ctx, ubd2)
+	require.Equal(t, sdk.NewInt(10), app.StakingKeeper.TotalUnbondingTokens(ctx))
+
+	// all the entries are mature an hour later
+	require.True(t, app.StakingKeeper.TotalUnbondingTokens(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))).IsZero())
+
+	// the mature entries are completed
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 5))))
+	_, err := app.StakingKeeper.CompleteUnbonding(ctx, delAddrs[0], valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(10), app.StakingKeeper.TotalUnbondingTokens(ctx))
+}
+
 func TestUnbondDelegation(t *testing.T) {
 	_, app, ctx := createTestInput()
 
@@ -207,7 +244,7 @@ func TestUnbondDelegation(t *testing.T) {
 
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 
-	delegation := types.NewDelegation(delAddrs[0], valAddrs[0], issuedShares)
+	delegation := types.NewDelegation(delAddrs[0], valAddrs[0], sdk.AccAddress(valAddrs[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	bondTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 6)
@@ -249,7 +286,7 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	require.True(sdk.IntEq(t, startTokens, validator.BondedTokens()))
 	require.True(t, validator.IsBonded())
 
-	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	maxEntries := app.StakingKeeper.MaxEntries(ctx)
@@ -331,7 +368,7 @@ func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
 	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
 	require.True(t, validator.IsBonded())
 
-	selfDelegation := types.NewDelegation(sdk.AccAddress(addrVals[0].Bytes()), addrVals[0], issuedShares)
+	selfDelegation := types.NewDelegation(sdk.AccAddress(addrVals[0].Bytes()), addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, selfDelegation)
 
 	// add bonded tokens to pool for delegations
@@ -350,7 +387,7 @@ func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
 	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
 
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
-	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
@@ -390,7 +427,7 @@ func TestUndelegateFromUnbondingValidator(t *testing.T) {
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 	require.True(t, validator.IsBonded())
 
-	selfDelegation := types.NewDelegation(addrVals[0].Bytes(), addrVals[0], issuedShares)
+	selfDelegation := types.NewDelegation(addrVals[0].Bytes(), addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, selfDelegation)
 
 	// add bonded tokens to pool for delegations
@@ -408,7 +445,7 @@ func TestUndelegateFromUnbondingValidator(t *testing.T) {
 	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
 
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
-	delegation := types.NewDelegation(addrDels[1], addrVals[0], issuedShares)
+	delegation := types.NewDelegation(addrDels[1], addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, bondedPool.GetName(), delCoins))
@@ -477,7 +514,7 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 	require.True(t, validator.IsBonded())
 
 	val0AccAddr := sdk.AccAddress(addrVals[0])
-	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, selfDelegation)
 
 	// add bonded tokens to pool for delegations
@@ -491,7 +528,7 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 	require.Equal(t, delTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 	require.True(t, validator.IsBonded())
-	delegation := types.NewDelegation(addrDels[1], addrVals[0], issuedShares)
+	delegation := types.NewDelegation(addrDels[1], addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	ctx = ctx.WithBlockHeight(10)
@@ -559,7 +596,7 @@ func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 	require.True(t, validator.IsBonded())
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
 
-	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, selfDelegation)
 
 	// create a second delegation to this validator
@@ -575,7 +612,7 @@ func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 	require.True(t, validator.IsBonded())
 
-	delegation := types.NewDelegation(addrDels[1], addrVals[0], issuedShares)
+	delegation := types.NewDelegation(addrDels[1], addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	ctx = ctx.WithBlockHeight(10)
@@ -719,7 +756,7 @@ func TestRedelegateToSameValidator(t *testing.T) {
 	require.True(t, validator.IsBonded())
 
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
-	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, selfDelegation)
 
 	_, err := app.StakingKeeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[0], sdk.NewDec(5))
@@ -747,7 +784,7 @@ func TestRedelegationMaxEntries(t *testing.T) {
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
-	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, selfDelegation)
 
 	// create a second validator
@@ -807,7 +844,7 @@ func TestRedelegateSelfDelegation(t *testing.T) {
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 
 	val0AccAddr := sdk.AccAddress(addrVals[0])
-	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, selfDelegation)
 
 	// create a second validator
@@ -823,7 +860,7 @@ func TestRedelegateSelfDelegation(t *testing.T) {
 	require.Equal(t, delTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 
-	delegation := types.NewDelegation(addrDels[0], addrVals[0], issuedShares)
+	delegation := types.NewDelegation(addrDels[0], addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	_, err := app.StakingKeeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], delTokens.ToDec())
@@ -861,7 +898,7 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
-	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, selfDelegation)
 
 	// create a second delegation to this validator
@@ -870,7 +907,7 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
-	delegation := types.NewDelegation(addrDels[1], addrVals[0], issuedShares)
+	delegation := types.NewDelegation(addrDels[1], addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	// create a second validator
@@ -943,7 +980,7 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
-	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, selfDelegation)
 
 	// create a second delegation to this validator
@@ -952,7 +989,7 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
-	delegation := types.NewDelegation(addrDels[1], addrVals[0], issuedShares)
+	delegation := types.NewDelegation(addrDels[1], addrVals[0], sdk.AccAddress(addrVals[0]), issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	// create a second validator
@@ -470,6 +470,96 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// RecommanderChain queries the recommanders chain of given delegator validator pair
+func (k Querier) RecommanderChain(c context.Context, req *types.QueryRecommanderChainRequest) (*types.QueryRecommanderChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"delegation with delegator %s not found for validator %s",
+			req.DelegatorAddr, req.ValidatorAddr)
+	}
+
+	recommanders := k.GetRecommanderChain(ctx, delegation, validator.ReallocatedCommissionRule.IncentiveDepth)
+
+	return &types.QueryRecommanderChainResponse{Recommanders: recommanders}, nil
+}
+
+// Recommandees queries all delegations to given validator recommended by given recommander
+func (k Querier) Recommandees(c context.Context, req *types.QueryRecommandeesRequest) (*types.QueryRecommandeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.RecommanderAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "recommander address cannot be empty")
+	}
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+	var delegations types.Delegations
+	ctx := sdk.UnwrapSDKContext(c)
+
+	recAddr, err := sdk.AccAddressFromBech32(req.RecommanderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	recStore := prefix.NewStore(store, types.GetDelegationsByRecommanderIndexKey(recAddr, valAddr))
+	pageRes, err := query.Paginate(recStore, req.Pagination, func(key []byte, value []byte) error {
+		delAddr := sdk.AccAddress(key[1:]) // remove address length
+		delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+		if !found {
+			return types.ErrNoDelegation
+		}
+		delegations = append(delegations, delegation)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	delResponses, err := DelegationsToDelegationResponses(ctx, k.Keeper, delegations)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecommandeesResponse{DelegationResponses: delResponses, Pagination: pageRes}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
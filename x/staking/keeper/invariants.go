@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "recommander-chains",
+		RecommanderChainsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -38,7 +40,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return RecommanderChainsInvariant(k)(ctx)
 	}
 }
 
@@ -196,3 +203,66 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// RecommanderChainsInvariant checks that the recommander of every delegation,
+// other than the validator operator, has a delegation to the same validator
+// and that no recommander chain loops.
+func RecommanderChainsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		// index the recommander of each delegation by validator and delegator
+		recommanders := map[string]map[string]string{}
+		delegations := k.GetAllDelegations(ctx)
+		for _, delegation := range delegations {
+			if _, ok := recommanders[delegation.ValidatorAddress]; !ok {
+				recommanders[delegation.ValidatorAddress] = map[string]string{}
+			}
+			recommanders[delegation.ValidatorAddress][delegation.DelegatorAddress] = delegation.RecommanderAddress
+		}
+
+		for _, delegation := range delegations {
+			if !hasRecommander(delegation) {
+				continue
+			}
+
+			validatorRecommanders := recommanders[delegation.ValidatorAddress]
+			operator := sdk.AccAddress(delegation.GetValidatorAddr()).String()
+
+			if _, found := validatorRecommanders[delegation.RecommanderAddress]; !found && delegation.RecommanderAddress != operator {
+				count++
+
+				msg += fmt.Sprintf("	recommander %s of delegation %s to %s has no delegation\n",
+					delegation.RecommanderAddress, delegation.DelegatorAddress, delegation.ValidatorAddress)
+			}
+
+			// walk the chain until it ends, a revisited delegator means a loop
+			visited := map[string]bool{delegation.DelegatorAddress: true}
+			current := delegation.RecommanderAddress
+			for {
+				if visited[current] {
+					count++
+
+					msg += fmt.Sprintf("	recommander chain of delegation %s to %s loops at %s\n",
+						delegation.DelegatorAddress, delegation.ValidatorAddress, current)
+					break
+				}
+				visited[current] = true
+
+				next, found := validatorRecommanders[current]
+				if !found || next == current {
+					break
+				}
+				current = next
+			}
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "recommander chains", fmt.Sprintf(
+			"%d invalid recommander chains found\n%s", count, msg)), broken
+	}
+}
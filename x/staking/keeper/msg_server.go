@@ -96,7 +96,7 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		msg.ReallocatedCommissionRule.IncentiveDepth,
 		msg.ReallocatedCommissionRule.RecommanderClassRates,
 		ctx.BlockHeader().Time,
-	)
+	).WithMaxChangeRates(msg.ReallocatedCommissionRule.MaxChangeRates())
 
 	validator, err = validator.SetInitialReallocatedCommissionRule(reallocatedCommissionRule)
 	if err != nil {
@@ -163,12 +163,13 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		return nil, types.ErrNoValidatorFound
 	}
 
-	incentiveTeamAddress, err := validator.UpdateIncentiveTeamAddress(msg.IncentiveTeamAddress)
+	incentiveTeamRecipients, err := validator.UpdateIncentiveTeam(msg.IncentiveTeamAddress, msg.IncentiveTeamRecipients)
 	if err != nil {
 		return nil, err
 	}
 
-	validator.IncentiveTeamAddress = incentiveTeamAddress
+	validator.IncentiveTeamAddress = ""
+	validator.IncentiveTeamRecipients = incentiveTeamRecipients
 
 	// replace all editable fields (clients should autofill existing values)
 	description, err := validator.Description.UpdateDescription(msg.Description)
@@ -429,19 +430,20 @@ func (k msgServer) EditValidatorRCommissionRule(goCtx context.Context, msg *type
 		return nil, types.ErrNoValidatorFound
 	}
 
-	reallocatedCommissionRule := types.NewReallocatedCommissionRuleWithTime(
+	reallocatedCommissionRule, err := k.UpdateValidatorReallocatedCommissionRule(
+		ctx, validator,
 		msg.ValidatorRate,
 		msg.RecommandersRate,
 		validator.ReallocatedCommissionRule.IncentiveDepth,
 		validator.ReallocatedCommissionRule.RecommanderClassRates,
-		ctx.BlockHeader().Time,
 	)
-
-	validator, err = validator.SetInitialReallocatedCommissionRule(reallocatedCommissionRule)
 	if err != nil {
 		return nil, err
 	}
 
+	validator.ReallocatedCommissionRule = reallocatedCommissionRule
+	k.SetValidator(ctx, validator)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeEditValidator,
@@ -454,7 +456,6 @@ func (k msgServer) EditValidatorRCommissionRule(goCtx context.Context, msg *type
 		),
 	})
 
-
 	return &types.MsgEditValidatorRCommissionRuleResponse{}, nil
 }
 
@@ -480,19 +481,20 @@ func (k msgServer) EditValidatorRecommanderRule(goCtx context.Context, msg *type
 		return nil, types.ErrNoValidatorFound
 	}
 
-	reallocatedCommissionRule := types.NewReallocatedCommissionRuleWithTime(
+	reallocatedCommissionRule, err := k.UpdateValidatorReallocatedCommissionRule(
+		ctx, validator,
 		validator.ReallocatedCommissionRule.ValidatorRate,
 		validator.ReallocatedCommissionRule.RecommandersRate,
 		msg.IncentiveDepth,
 		msg.RecommanderClassRates,
-		ctx.BlockHeader().Time,
 	)
-
-	validator, err = validator.SetInitialReallocatedCommissionRule(reallocatedCommissionRule)
 	if err != nil {
 		return nil, err
 	}
 
+	validator.ReallocatedCommissionRule = reallocatedCommissionRule
+	k.SetValidator(ctx, validator)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeEditValidator,
@@ -507,3 +509,49 @@ func (k msgServer) EditValidatorRecommanderRule(goCtx context.Context, msg *type
 
 	return &types.MsgEditValidatorRecommanderRuleResponse{}, nil
 }
+
+// SetDelegationRecommander defines a method for changing the recommander of an existing delegation
+func (k msgServer) SetDelegationRecommander(goCtx context.Context, msg *types.MsgSetDelegationRecommander) (*types.MsgSetDelegationRecommanderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var recommanderAddress sdk.AccAddress
+	if msg.RecommanderAddress != "" {
+		recommanderAddress, err = sdk.AccAddressFromBech32(msg.RecommanderAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.Keeper.SetDelegationRecommander(ctx, delegatorAddress, valAddr, recommanderAddress); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetRecommander,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyRecommander, msg.RecommanderAddress),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgSetDelegationRecommanderResponse{}, nil
+}
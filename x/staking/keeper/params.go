@@ -39,6 +39,13 @@ func (k Keeper) MaxIncentiveDepth(ctx sdk.Context) (res uint32) {
 	return
 }
 
+// MaxRecommandees - Maximum number of delegations to a validator an account
+// other than the validator operator can recommend
+func (k Keeper) MaxRecommandees(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxRecommandees, &res)
+	return
+}
+
 // BondDenom - Bondable coin denomination
 func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
@@ -62,6 +69,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.HistoricalEntries(ctx),
 		k.MaxIncentiveDepth(ctx),
 		k.BondDenom(ctx),
+		k.MaxRecommandees(ctx),
 	)
 }
 
@@ -457,15 +457,10 @@ func DelegationToDelegationResponse(ctx sdk.Context, k Keeper, del types.Delegat
 		return types.DelegationResponse{}, err
 	}
 
-	recommanderAddress, err := sdk.AccAddressFromBech32(del.RecommanderAddress)
-	if err != nil {
-		return types.DelegationResponse{}, err
-	}
-
 	return types.NewDelegationResp(
 		delegatorAddress,
 		del.GetValidatorAddr(),
-		recommanderAddress,
+		del.GetRecommanderAddr(),
 		del.Shares,
 		sdk.NewCoin(k.BondDenom(ctx), val.TokensFromShares(del.Shares).TruncateInt()),
 	), nil
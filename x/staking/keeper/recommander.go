@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// hasRecommander returns true if the delegation was recommended by an account
+// other than the delegator itself.
+func hasRecommander(delegation types.Delegation) bool {
+	return delegation.RecommanderAddress != "" && delegation.RecommanderAddress != delegation.DelegatorAddress
+}
+
+// SetDelegationByRecommanderIndex sets the recommander index of a delegation.
+// Delegations recommended by the delegator itself are not indexed.
+func (k Keeper) SetDelegationByRecommanderIndex(ctx sdk.Context, delegation types.Delegation) {
+	if !hasRecommander(delegation) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetDelegationByRecommanderIndexKey(
+		delegation.GetRecommanderAddr(), delegation.GetValidatorAddr(), delegation.GetDelegatorAddr(),
+	)
+	store.Set(key, []byte{}) // index, store empty bytes
+}
+
+// DeleteDelegationByRecommanderIndex removes the recommander index of a delegation.
+func (k Keeper) DeleteDelegationByRecommanderIndex(ctx sdk.Context, delegation types.Delegation) {
+	if !hasRecommander(delegation) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegationByRecommanderIndexKey(
+		delegation.GetRecommanderAddr(), delegation.GetValidatorAddr(), delegation.GetDelegatorAddr(),
+	))
+}
+
+// GetRecommandeeDelegations returns all delegations to a validator which are
+// recommended by the given recommander.
+func (k Keeper) GetRecommandeeDelegations(ctx sdk.Context, recAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	indexPrefix := types.GetDelegationsByRecommanderIndexKey(recAddr, valAddr)
+
+	iterator := sdk.KVStorePrefixIterator(store, indexPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr := sdk.AccAddress(iterator.Key()[len(indexPrefix)+1:]) // remove prefix bytes and address length
+		delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+		if !found {
+			panic("recommander index points to a non-existent delegation")
+		}
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// countRecommandees returns the number of delegations to a validator which are
+// recommended by the given recommander, counting at most limit of them.
+func (k Keeper) countRecommandees(ctx sdk.Context, recAddr sdk.AccAddress, valAddr sdk.ValAddress, limit int) int {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationsByRecommanderIndexKey(recAddr, valAddr))
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid() && count < limit; iterator.Next() {
+		count++
+	}
+
+	return count
+}
+
+// GetRecommanderChain returns the recommanders of a delegation, ordered by
+// level. The chain is walked the same way the recommanders rewards are
+// reallocated: it follows the delegation of each recommander to the same
+// validator and stops after depth levels, at a self recommended delegation or
+// at a recommander which has no delegation to the validator.
+func (k Keeper) GetRecommanderChain(ctx sdk.Context, delegation types.Delegation, depth uint32) (recommanders []types.Recommander) {
+	valAddr := delegation.GetValidatorAddr()
+	current := delegation
+
+	for level := uint32(1); level <= depth; level++ {
+		if !hasRecommander(current) {
+			break
+		}
+
+		recAddr := current.GetRecommanderAddr()
+		recommanders = append(recommanders, types.NewRecommander(level, recAddr))
+
+		next, found := k.GetDelegation(ctx, recAddr, valAddr)
+		if !found {
+			break
+		}
+		current = next
+	}
+
+	return recommanders
+}
+
+// ValidateRecommander returns an error if recAddr cannot be the recommander of
+// the delegation of delAddr to valAddr. A delegator cannot recommend itself,
+// except for the self delegation of the validator operator. Any recommander
+// other than the validator operator must have a delegation to the validator,
+// must not recommend more than the MaxRecommandees param delegations and must
+// not close a loop in the recommander chain.
+func (k Keeper) ValidateRecommander(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, recAddr sdk.AccAddress) error {
+	isValidatorOperator := sdk.AccAddress(valAddr).Equals(recAddr)
+
+	if recAddr.Equals(delAddr) {
+		if !isValidatorOperator {
+			return types.ErrInvalidRecommanderAddr
+		}
+		return nil
+	}
+
+	if _, found := k.GetDelegation(ctx, recAddr, valAddr); !found && !isValidatorOperator {
+		return sdkerrors.Wrap(types.ErrRecommanderNoDelegation, recAddr.String())
+	}
+
+	maxRecommandees := int(k.MaxRecommandees(ctx))
+	if !isValidatorOperator && k.countRecommandees(ctx, recAddr, valAddr, maxRecommandees) >= maxRecommandees {
+		return sdkerrors.Wrapf(types.ErrTooManyRecommandees, "%s recommends %d delegations", recAddr, maxRecommandees)
+	}
+
+	// walk the chain of the new recommander, a loop back to the delegator
+	// would be a cycle. Chains which already loop elsewhere stop the walk.
+	visited := map[string]bool{}
+	current := recAddr
+	for !visited[current.String()] {
+		if current.Equals(delAddr) {
+			return sdkerrors.Wrapf(types.ErrRecommanderCycle, "%s is recommended by %s", recAddr, delAddr)
+		}
+		visited[current.String()] = true
+
+		delegation, found := k.GetDelegation(ctx, current, valAddr)
+		if !found || !hasRecommander(delegation) {
+			break
+		}
+		current = delegation.GetRecommanderAddr()
+	}
+
+	return nil
+}
+
+// SetDelegationRecommander changes the recommander of an existing delegation,
+// or clears it if recAddr is empty. The pending rewards of the delegation,
+// including the recommanders rewards owed to the current recommander chain,
+// are settled through the hooks before the recommander is changed.
+func (k Keeper) SetDelegationRecommander(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, recAddr sdk.AccAddress) error {
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegation
+	}
+
+	if recAddr.Empty() {
+		if hasRecommander(delegation) {
+			k.setDelegationRecommander(ctx, delegation, nil)
+		}
+		return nil
+	}
+
+	if delegation.GetRecommanderAddr().Equals(recAddr) {
+		return nil
+	}
+
+	if err := k.ValidateRecommander(ctx, delAddr, valAddr, recAddr); err != nil {
+		return err
+	}
+
+	k.setDelegationRecommander(ctx, delegation, recAddr)
+	return nil
+}
+
+// setDelegationRecommander settles the pending rewards of a delegation and
+// updates its recommander, without any validation. An empty recommander
+// clears it.
+func (k Keeper) setDelegationRecommander(ctx sdk.Context, delegation types.Delegation, recAddr sdk.AccAddress) {
+	delAddr, valAddr := delegation.GetDelegatorAddr(), delegation.GetValidatorAddr()
+
+	// settle the rewards accrued under the current recommander chain
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+
+	k.DeleteDelegationByRecommanderIndex(ctx, delegation)
+	delegation.RecommanderAddress = recAddr.String()
+	k.SetDelegation(ctx, delegation)
+
+	k.AfterDelegationModified(ctx, delAddr, valAddr)
+}
+
+// reassignRecommandees moves the delegations recommended by the delegator of a
+// delegation which is about to be removed to its own recommander, so that every
+// recommander keeps a delegation to the validator. The chain is shortened by
+// one level and cannot loop, as the new recommander was already upstream. The
+// recommandees of a delegation without recommander are left without one. Once
+// the new recommander reaches the MaxRecommandees param, the remaining
+// delegations are moved to the validator operator instead. As a recommander
+// other than the operator cannot exceed the highest value the param has had,
+// the work done here is bounded.
+func (k Keeper) reassignRecommandees(ctx sdk.Context, delegation types.Delegation) {
+	valAddr := delegation.GetValidatorAddr()
+	operatorAddr := sdk.AccAddress(valAddr)
+
+	if !hasRecommander(delegation) {
+		// the self delegation of the validator operator stays the recommander
+		// of its recommandees, the operator is always a valid recommander
+		if delegation.GetDelegatorAddr().Equals(operatorAddr) {
+			return
+		}
+
+		for _, recommandee := range k.GetRecommandeeDelegations(ctx, delegation.GetDelegatorAddr(), valAddr) {
+			k.setDelegationRecommander(ctx, recommandee, nil)
+		}
+		return
+	}
+	recAddr := delegation.GetRecommanderAddr()
+	isValidatorOperator := recAddr.Equals(operatorAddr)
+
+	// the delegation about to be removed is still counted
+	maxRecommandees := int(k.MaxRecommandees(ctx))
+	count := 0
+	if !isValidatorOperator {
+		count = k.countRecommandees(ctx, recAddr, valAddr, maxRecommandees)
+	}
+
+	for _, recommandee := range k.GetRecommandeeDelegations(ctx, delegation.GetDelegatorAddr(), valAddr) {
+		newRecAddr := recAddr
+		if !isValidatorOperator {
+			if count < maxRecommandees {
+				count++
+			} else {
+				newRecAddr = operatorAddr
+			}
+		}
+
+		k.setDelegationRecommander(ctx, recommandee, newRecAddr)
+	}
+}
@@ -0,0 +1,336 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupRecommanderChain creates a validator with the given incentive depth and
+// a chain of delegations where addrs[i] is recommended by addrs[i-1].
+func setupRecommanderChain(t *testing.T, depth uint32, length int) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, types.Validator) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, length, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	rates := make([]types.RecommanderClassRate, depth)
+	for i := range rates {
+		rates[i] = types.RecommanderClassRate{Index: uint32(i), Rate: sdk.NewDecWithPrec(1, 1)}
+	}
+
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator.ReallocatedCommissionRule = types.NewReallocatedCommissionRule(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), depth, rates)
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+	app.StakingKeeper.SetNewValidatorByPowerIndex(ctx, validator)
+	app.StakingKeeper.AfterValidatorCreated(ctx, validator.GetOperator())
+
+	for i, addr := range addrs {
+		recommander := addr
+		if i > 0 {
+			recommander = addrs[i-1]
+		}
+
+		validator, _ = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+		_, err := app.StakingKeeper.Delegate(ctx, addr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1), types.Unbonded, validator, recommander, true)
+		require.NoError(t, err)
+	}
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	return app, ctx, addrs, validator
+}
+
+func newRecommanderQueryClient(app *simapp.SimApp, ctx sdk.Context) types.QueryClient {
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, keeper.Querier{Keeper: app.StakingKeeper})
+	return types.NewQueryClient(queryHelper)
+}
+
+func TestGetRecommanderChain(t *testing.T) {
+	app, ctx, addrs, validator := setupRecommanderChain(t, 3, 5)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[4], validator.GetOperator())
+	require.True(t, found)
+
+	// the chain stops at the incentive depth
+	chain := app.StakingKeeper.GetRecommanderChain(ctx, delegation, 3)
+	require.Equal(t, []types.Recommander{
+		types.NewRecommander(1, addrs[3]),
+		types.NewRecommander(2, addrs[2]),
+		types.NewRecommander(3, addrs[1]),
+	}, chain)
+
+	// the chain stops at the self recommended delegation of the validator
+	chain = app.StakingKeeper.GetRecommanderChain(ctx, delegation, 10)
+	require.Len(t, chain, 4)
+	require.Equal(t, types.NewRecommander(4, addrs[0]), chain[3])
+
+	// the chain stops at a recommander without delegation
+	app.StakingKeeper.RemoveDelegation(ctx, types.NewDelegation(addrs[2], validator.GetOperator(), addrs[1], sdk.ZeroDec()))
+	chain = app.StakingKeeper.GetRecommanderChain(ctx, delegation, 10)
+	require.Equal(t, []types.Recommander{
+		types.NewRecommander(1, addrs[3]),
+		types.NewRecommander(2, addrs[2]),
+	}, chain)
+
+	// a self recommended delegation has no recommander
+	selfDelegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[0], validator.GetOperator())
+	require.True(t, found)
+	require.Empty(t, app.StakingKeeper.GetRecommanderChain(ctx, selfDelegation, 10))
+}
+
+func TestGRPCQueryRecommanderChain(t *testing.T) {
+	app, ctx, addrs, validator := setupRecommanderChain(t, 2, 4)
+	queryClient := newRecommanderQueryClient(app, ctx)
+
+	_, err := queryClient.RecommanderChain(gocontext.Background(), &types.QueryRecommanderChainRequest{})
+	require.Error(t, err)
+
+	_, err = queryClient.RecommanderChain(gocontext.Background(), &types.QueryRecommanderChainRequest{
+		DelegatorAddr: sdk.AccAddress(PKs[10].Address()).String(),
+		ValidatorAddr: validator.OperatorAddress,
+	})
+	require.Error(t, err)
+
+	res, err := queryClient.RecommanderChain(gocontext.Background(), &types.QueryRecommanderChainRequest{
+		DelegatorAddr: addrs[3].String(),
+		ValidatorAddr: validator.OperatorAddress,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.Recommander{
+		types.NewRecommander(1, addrs[2]),
+		types.NewRecommander(2, addrs[1]),
+	}, res.Recommanders)
+}
+
+func TestGRPCQueryRecommandees(t *testing.T) {
+	app, ctx, addrs, validator := setupRecommanderChain(t, 2, 3)
+	queryClient := newRecommanderQueryClient(app, ctx)
+
+	// a second delegation recommended by addrs[0]
+	extraAddr := simapp.AddTestAddrsIncremental(app, ctx, 4, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))[3]
+	_, err := app.StakingKeeper.Delegate(ctx, extraAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 1), types.Unbonded, validator, addrs[0], true)
+	require.NoError(t, err)
+
+	_, err = queryClient.Recommandees(gocontext.Background(), &types.QueryRecommandeesRequest{})
+	require.Error(t, err)
+
+	res, err := queryClient.Recommandees(gocontext.Background(), &types.QueryRecommandeesRequest{
+		RecommanderAddr: addrs[0].String(),
+		ValidatorAddr:   validator.OperatorAddress,
+		Pagination:      &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.DelegationResponses, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = queryClient.Recommandees(gocontext.Background(), &types.QueryRecommandeesRequest{
+		RecommanderAddr: addrs[1].String(),
+		ValidatorAddr:   validator.OperatorAddress,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.DelegationResponses, 1)
+	require.Equal(t, addrs[2].String(), res.DelegationResponses[0].Delegation.DelegatorAddress)
+
+	// removing the delegation removes it from the index
+	app.StakingKeeper.RemoveDelegation(ctx, res.DelegationResponses[0].Delegation)
+	require.Empty(t, app.StakingKeeper.GetRecommandeeDelegations(ctx, addrs[1], validator.GetOperator()))
+}
+
+func TestSetDelegationRecommander(t *testing.T) {
+	app, ctx, addrs, validator := setupRecommanderChain(t, 3, 4)
+	valAddr := validator.GetOperator()
+
+	// unknown delegation
+	err := app.StakingKeeper.SetDelegationRecommander(ctx, sdk.AccAddress(PKs[10].Address()), valAddr, addrs[0])
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+
+	// a delegator cannot recommend itself
+	err = app.StakingKeeper.SetDelegationRecommander(ctx, addrs[2], valAddr, addrs[2])
+	require.ErrorIs(t, err, types.ErrInvalidRecommanderAddr)
+
+	// addrs[1] recommends addrs[2] which recommends addrs[3], closing the loop is rejected
+	err = app.StakingKeeper.SetDelegationRecommander(ctx, addrs[1], valAddr, addrs[3])
+	require.ErrorIs(t, err, types.ErrRecommanderCycle)
+	err = app.StakingKeeper.SetDelegationRecommander(ctx, addrs[2], valAddr, addrs[3])
+	require.ErrorIs(t, err, types.ErrRecommanderCycle)
+
+	// move addrs[3] from addrs[2] to addrs[1]
+	require.NoError(t, app.StakingKeeper.SetDelegationRecommander(ctx, addrs[3], valAddr, addrs[1]))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[3], valAddr)
+	require.True(t, found)
+	require.Equal(t, addrs[1].String(), delegation.RecommanderAddress)
+	require.Empty(t, app.StakingKeeper.GetRecommandeeDelegations(ctx, addrs[2], valAddr))
+	require.Len(t, app.StakingKeeper.GetRecommandeeDelegations(ctx, addrs[1], valAddr), 2)
+	require.Equal(t, []types.Recommander{
+		types.NewRecommander(1, addrs[1]),
+		types.NewRecommander(2, addrs[0]),
+	}, app.StakingKeeper.GetRecommanderChain(ctx, delegation, 3))
+
+	// the loop through addrs[2] is gone
+	require.NoError(t, app.StakingKeeper.SetDelegationRecommander(ctx, addrs[2], valAddr, addrs[3]))
+
+	// the validator operator can be the recommander of addrs[3]
+	require.NoError(t, app.StakingKeeper.SetDelegationRecommander(ctx, addrs[3], valAddr, sdk.AccAddress(valAddr)))
+	delegation, _ = app.StakingKeeper.GetDelegation(ctx, addrs[3], valAddr)
+	require.Equal(t, []types.Recommander{types.NewRecommander(1, addrs[0])}, app.StakingKeeper.GetRecommanderChain(ctx, delegation, 3))
+
+	// clearing the recommander of addrs[3] leaves it without recommander
+	require.NoError(t, app.StakingKeeper.SetDelegationRecommander(ctx, addrs[3], valAddr, nil))
+	delegation, _ = app.StakingKeeper.GetDelegation(ctx, addrs[3], valAddr)
+	require.Empty(t, delegation.RecommanderAddress)
+	require.Nil(t, delegation.GetRecommanderAddr())
+	require.Empty(t, app.StakingKeeper.GetRecommanderChain(ctx, delegation, 3))
+	for _, recommandee := range app.StakingKeeper.GetRecommandeeDelegations(ctx, addrs[0], valAddr) {
+		require.NotEqual(t, addrs[3].String(), recommandee.DelegatorAddress)
+	}
+
+	// clearing it again is a no-op
+	require.NoError(t, app.StakingKeeper.SetDelegationRecommander(ctx, addrs[3], valAddr, nil))
+
+	_, stop := keeper.RecommanderChainsInvariant(app.StakingKeeper)(ctx)
+	require.False(t, stop)
+}
+
+func TestDelegateValidatesRecommander(t *testing.T) {
+	app, ctx, addrs, validator := setupRecommanderChain(t, 2, 2)
+	bondAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	newAddrs := simapp.AddTestAddrsIncremental(app, ctx, 4, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))[2:]
+
+	// a delegator cannot recommend itself
+	_, err := app.StakingKeeper.Delegate(ctx, newAddrs[0], bondAmt, types.Unbonded, validator, newAddrs[0], true)
+	require.ErrorIs(t, err, types.ErrInvalidRecommanderAddr)
+
+	// the recommander must have a delegation to the validator
+	_, err = app.StakingKeeper.Delegate(ctx, newAddrs[0], bondAmt, types.Unbonded, validator, newAddrs[1], true)
+	require.ErrorIs(t, err, types.ErrRecommanderNoDelegation)
+
+	// the validator operator is always a valid recommander
+	_, err = app.StakingKeeper.Delegate(ctx, newAddrs[0], bondAmt, types.Unbonded, validator, addrs[0], true)
+	require.NoError(t, err)
+
+	// a delegation recommended by an account without delegation, which would
+	// loop if that account delegated with it as recommander
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrs[1], validator.GetOperator(), newAddrs[1], sdk.OneDec()))
+	validator, _ = app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	_, err = app.StakingKeeper.Delegate(ctx, newAddrs[1], bondAmt, types.Unbonded, validator, addrs[1], true)
+	require.ErrorIs(t, err, types.ErrRecommanderCycle)
+}
+
+func TestUnbondReassignsRecommandees(t *testing.T) {
+	app, ctx, addrs, validator := setupRecommanderChain(t, 3, 4)
+	valAddr := validator.GetOperator()
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddr)
+	require.True(t, found)
+
+	// fully unbonding addrs[2] moves addrs[3] to addrs[1]
+	_, err := app.StakingKeeper.Unbond(ctx, addrs[2], valAddr, delegation.Shares)
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[3], valAddr)
+	require.True(t, found)
+	require.Equal(t, addrs[1].String(), delegation.RecommanderAddress)
+	require.Empty(t, app.StakingKeeper.GetRecommandeeDelegations(ctx, addrs[2], valAddr))
+
+	// fully unbonding addrs[1], whose recommander was cleared, clears the
+	// recommander of addrs[3]
+	require.NoError(t, app.StakingKeeper.SetDelegationRecommander(ctx, addrs[1], valAddr, nil))
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddr)
+	require.True(t, found)
+	_, err = app.StakingKeeper.Unbond(ctx, addrs[1], valAddr, delegation.Shares)
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[3], valAddr)
+	require.True(t, found)
+	require.Empty(t, delegation.RecommanderAddress)
+	require.Empty(t, app.StakingKeeper.GetRecommandeeDelegations(ctx, addrs[1], valAddr))
+
+	_, stop := keeper.RecommanderChainsInvariant(app.StakingKeeper)(ctx)
+	require.False(t, stop)
+}
+
+func TestRecommanderChainsInvariant(t *testing.T) {
+	app, ctx, addrs, validator := setupRecommanderChain(t, 2, 3)
+	valAddr := validator.GetOperator()
+	invariant := keeper.RecommanderChainsInvariant(app.StakingKeeper)
+
+	_, stop := invariant(ctx)
+	require.False(t, stop)
+
+	// a recommander without delegation
+	cacheCtx, _ := ctx.CacheContext()
+	app.StakingKeeper.SetDelegation(cacheCtx, types.NewDelegation(addrs[2], valAddr, sdk.AccAddress(PKs[10].Address()), sdk.OneDec()))
+	_, stop = invariant(cacheCtx)
+	require.True(t, stop)
+
+	// a loop between addrs[1] and addrs[2]
+	cacheCtx, _ = ctx.CacheContext()
+	app.StakingKeeper.SetDelegation(cacheCtx, types.NewDelegation(addrs[1], valAddr, addrs[2], sdk.OneDec()))
+	_, stop = invariant(cacheCtx)
+	require.True(t, stop)
+}
+
+func TestMaxRecommandees(t *testing.T) {
+	app, ctx, addrs, validator := setupRecommanderChain(t, 2, 3)
+	valAddr := validator.GetOperator()
+	bondAmt := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxRecommandees = 5
+	app.StakingKeeper.SetParams(ctx, params)
+	maxRecommandees := int(params.MaxRecommandees)
+	newAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3+maxRecommandees, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))[3:]
+
+	// addrs[1] already recommends addrs[2]
+	for _, addr := range newAddrs[:maxRecommandees-1] {
+		_, err := app.StakingKeeper.Delegate(ctx, addr, bondAmt, types.Unbonded, validator, addrs[1], true)
+		require.NoError(t, err)
+		validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+	}
+
+	last := newAddrs[maxRecommandees-1]
+	_, err := app.StakingKeeper.Delegate(ctx, last, bondAmt, types.Unbonded, validator, addrs[1], true)
+	require.ErrorIs(t, err, types.ErrTooManyRecommandees)
+
+	// the validator operator has no limit
+	_, err = app.StakingKeeper.Delegate(ctx, last, bondAmt, types.Unbonded, validator, addrs[0], true)
+	require.NoError(t, err)
+
+	// lowering the param keeps the existing recommandees
+	params.MaxRecommandees = 1
+	app.StakingKeeper.SetParams(ctx, params)
+	require.Len(t, app.StakingKeeper.GetRecommandeeDelegations(ctx, addrs[1], valAddr), maxRecommandees)
+	require.ErrorIs(t, app.StakingKeeper.SetDelegationRecommander(ctx, last, valAddr, addrs[1]), types.ErrTooManyRecommandees)
+	params.MaxRecommandees = uint32(maxRecommandees)
+	app.StakingKeeper.SetParams(ctx, params)
+	require.NoError(t, app.StakingKeeper.SetDelegationRecommander(ctx, last, valAddr, addrs[2]))
+
+	// fully unbonding addrs[2] moves its recommandee to the validator operator,
+	// as addrs[1] is full
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddr)
+	require.True(t, found)
+	_, err = app.StakingKeeper.Unbond(ctx, addrs[2], valAddr, delegation.Shares)
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, last, valAddr)
+	require.True(t, found)
+	require.Equal(t, addrs[0].String(), delegation.RecommanderAddress)
+
+	_, stop := keeper.RecommanderChainsInvariant(app.StakingKeeper)(ctx)
+	require.False(t, stop)
+}
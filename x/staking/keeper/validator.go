@@ -148,6 +148,26 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	return commission, nil
 }
 
+// UpdateValidatorReallocatedCommissionRule attempts to update a validator's
+// reallocated commission rule. The max change rates of the rule are kept. An
+// error is returned if the new rule is invalid.
+func (k Keeper) UpdateValidatorReallocatedCommissionRule(ctx sdk.Context,
+	validator types.Validator, validatorRate, recommandersRate sdk.Dec, incentiveDepth uint32,
+	recommanderClassRates []types.RecommanderClassRate) (types.ReallocatedCommissionRule, error) {
+	rule := validator.ReallocatedCommissionRule
+	blockTime := ctx.BlockHeader().Time
+
+	newRule := types.NewReallocatedCommissionRuleWithTime(
+		validatorRate, recommandersRate, incentiveDepth, recommanderClassRates, blockTime,
+	).WithMaxChangeRates(rule.MaxChangeRates())
+
+	if err := rule.ValidateNewRule(newRule, blockTime); err != nil {
+		return rule, err
+	}
+
+	return newRule, nil
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 // TODO, this function panics, and it's not good.
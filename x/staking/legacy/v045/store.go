@@ -0,0 +1,161 @@
+package v045
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// hasRecommander returns true if the delegation was recommended by an account
+// other than the delegator itself.
+func hasRecommander(delegation types.Delegation) bool {
+	return delegation.RecommanderAddress != "" && delegation.RecommanderAddress != delegation.DelegatorAddress
+}
+
+// isValidRecommander returns whether the recommander of a delegation is either
+// the validator operator or an account which has a delegation to the validator,
+// and whether following its chain never leads back to the delegator.
+func isValidRecommander(store sdk.KVStore, cdc codec.BinaryCodec, delegation types.Delegation) bool {
+	valAddr := delegation.GetValidatorAddr()
+	recAddr := delegation.GetRecommanderAddr()
+
+	bz := store.Get(types.GetDelegationKey(recAddr, valAddr))
+	if bz == nil {
+		return recAddr.Equals(sdk.AccAddress(valAddr))
+	}
+
+	// chains which loop without going through the delegator stop the walk,
+	// those loops are reset when their own delegations are checked
+	visited := map[string]bool{delegation.DelegatorAddress: true}
+	current := types.MustUnmarshalDelegation(cdc, bz)
+	for hasRecommander(current) {
+		if current.RecommanderAddress == delegation.DelegatorAddress {
+			return false
+		}
+		if visited[current.RecommanderAddress] {
+			break
+		}
+		visited[current.DelegatorAddress] = true
+
+		bz = store.Get(types.GetDelegationKey(current.GetRecommanderAddr(), valAddr))
+		if bz == nil {
+			break
+		}
+		current = types.MustUnmarshalDelegation(cdc, bz)
+	}
+
+	return true
+}
+
+// migrateInvalidRecommanders resets the recommander of the delegations which
+// were recommended by an account without delegation to the validator, or which
+// close a loop in the recommander chain, to the validator operator. Until then,
+// any account was accepted as recommander. The pending rewards are not settled,
+// they are split along the repaired chain when they are withdrawn.
+func migrateInvalidRecommanders(store sdk.KVStore, cdc codec.BinaryCodec) {
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	// the delegations are checked one after the other, so that a loop is
+	// broken once, at the first of its delegations
+	for _, key := range keys {
+		delegation := types.MustUnmarshalDelegation(cdc, store.Get(key))
+		if !hasRecommander(delegation) || isValidRecommander(store, cdc, delegation) {
+			continue
+		}
+
+		delegation.RecommanderAddress = sdk.AccAddress(delegation.GetValidatorAddr()).String()
+		store.Set(key, types.MustMarshalDelegation(cdc, delegation))
+	}
+}
+
+// migrateExcessRecommandees resets the recommander of the delegations which
+// exceed the max recommandees of their recommander, other than the validator
+// operator, to the validator operator. The delegations are kept in store order.
+func migrateExcessRecommandees(store sdk.KVStore, cdc codec.BinaryCodec, maxRecommandees uint32) {
+	var (
+		keys        [][]byte
+		delegations []types.Delegation
+	)
+	counts := map[string]uint32{}
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	for ; iterator.Valid(); iterator.Next() {
+		delegation := types.MustUnmarshalDelegation(cdc, iterator.Value())
+		operator := sdk.AccAddress(delegation.GetValidatorAddr()).String()
+		if !hasRecommander(delegation) || delegation.RecommanderAddress == operator {
+			continue
+		}
+
+		recommander := delegation.RecommanderAddress + "/" + delegation.ValidatorAddress
+		if counts[recommander] < maxRecommandees {
+			counts[recommander]++
+			continue
+		}
+
+		delegation.RecommanderAddress = operator
+		keys = append(keys, iterator.Key())
+		delegations = append(delegations, delegation)
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Set(key, types.MustMarshalDelegation(cdc, delegations[i]))
+	}
+}
+
+// migrateDelegationsByRecommanderIndex builds the recommander index of all the
+// delegations which are recommended by an account other than the delegator.
+func migrateDelegationsByRecommanderIndex(store sdk.KVStore, cdc codec.BinaryCodec) {
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegation := types.MustUnmarshalDelegation(cdc, iterator.Value())
+		if !hasRecommander(delegation) {
+			continue
+		}
+
+		indexKey := types.GetDelegationByRecommanderIndexKey(
+			delegation.GetRecommanderAddr(), delegation.GetValidatorAddr(), delegation.GetDelegatorAddr(),
+		)
+		store.Set(indexKey, []byte{})
+	}
+}
+
+// migrateParams sets the max recommandees param, which did not exist before, to
+// its default value.
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	if paramSpace.Has(ctx, types.KeyMaxRecommandees) {
+		return
+	}
+
+	paramSpace.Set(ctx, types.KeyMaxRecommandees, types.DefaultMaxRecommandees)
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Setting the max recommandees param
+// - Resetting the invalid recommanders to the validator operator
+// - Resetting the recommanders exceeding the max recommandees to the validator
+// operator
+// - Building the delegations by recommander index
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) error {
+	store := ctx.KVStore(storeKey)
+
+	migrateParams(ctx, paramSpace)
+
+	var maxRecommandees uint32
+	paramSpace.Get(ctx, types.KeyMaxRecommandees, &maxRecommandees)
+
+	migrateInvalidRecommanders(store, cdc)
+	migrateExcessRecommandees(store, cdc, maxRecommandees)
+	migrateDelegationsByRecommanderIndex(store, cdc)
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package v045_test
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v045staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func setupMigration(t *testing.T) (sdk.Context, sdk.StoreKey, paramtypes.Subspace) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(stakingKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	return ctx, stakingKey, paramSpace
+}
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	ctx, stakingKey, paramSpace := setupMigration(t)
+	store := ctx.KVStore(stakingKey)
+
+	_, _, valAccAddr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(valAccAddr)
+	_, _, delAddr1 := testdata.KeyTestPubAddr()
+	_, _, delAddr2 := testdata.KeyTestPubAddr()
+
+	selfDel := types.NewDelegation(valAccAddr, valAddr, valAccAddr, sdk.OneDec())
+	del1 := types.NewDelegation(delAddr1, valAddr, valAccAddr, sdk.OneDec())
+	del2 := types.NewDelegation(delAddr2, valAddr, delAddr1, sdk.OneDec())
+	for _, del := range []types.Delegation{selfDel, del1, del2} {
+		store.Set(types.GetDelegationKey(del.GetDelegatorAddr(), valAddr), types.MustMarshalDelegation(encCfg.Marshaler, del))
+	}
+
+	// Run migrations.
+	err := v045staking.MigrateStore(ctx, stakingKey, encCfg.Marshaler, paramSpace)
+	require.NoError(t, err)
+
+	require.False(t, store.Has(types.GetDelegationByRecommanderIndexKey(valAccAddr, valAddr, valAccAddr)))
+	require.True(t, store.Has(types.GetDelegationByRecommanderIndexKey(valAccAddr, valAddr, delAddr1)))
+	require.True(t, store.Has(types.GetDelegationByRecommanderIndexKey(delAddr1, valAddr, delAddr2)))
+	require.False(t, store.Has(types.GetDelegationByRecommanderIndexKey(delAddr2, valAddr, delAddr1)))
+
+	var maxRecommandees uint32
+	paramSpace.Get(ctx, types.KeyMaxRecommandees, &maxRecommandees)
+	require.Equal(t, types.DefaultMaxRecommandees, maxRecommandees)
+}
+
+func TestStoreMigrationResetsInvalidRecommanders(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	ctx, stakingKey, paramSpace := setupMigration(t)
+	store := ctx.KVStore(stakingKey)
+
+	_, _, valAccAddr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(valAccAddr)
+	_, _, noDelAddr := testdata.KeyTestPubAddr()
+	_, _, delAddr1 := testdata.KeyTestPubAddr()
+	_, _, delAddr2 := testdata.KeyTestPubAddr()
+	_, _, delAddr3 := testdata.KeyTestPubAddr()
+	_, _, delAddr4 := testdata.KeyTestPubAddr()
+
+	// delAddr1 is recommended by an account without delegation, delAddr2 and
+	// delAddr3 recommend each other and delAddr4 is recommended by delAddr3
+	for _, del := range []types.Delegation{
+		types.NewDelegation(delAddr1, valAddr, noDelAddr, sdk.OneDec()),
+		types.NewDelegation(delAddr2, valAddr, delAddr3, sdk.OneDec()),
+		types.NewDelegation(delAddr3, valAddr, delAddr2, sdk.OneDec()),
+		types.NewDelegation(delAddr4, valAddr, delAddr3, sdk.OneDec()),
+	} {
+		store.Set(types.GetDelegationKey(del.GetDelegatorAddr(), valAddr), types.MustMarshalDelegation(encCfg.Marshaler, del))
+	}
+
+	// Run migrations.
+	err := v045staking.MigrateStore(ctx, stakingKey, encCfg.Marshaler, paramSpace)
+	require.NoError(t, err)
+
+	recommander := func(delAddr sdk.AccAddress) sdk.AccAddress {
+		bz := store.Get(types.GetDelegationKey(delAddr, valAddr))
+		require.NotNil(t, bz)
+		return types.MustUnmarshalDelegation(encCfg.Marshaler, bz).GetRecommanderAddr()
+	}
+
+	require.Equal(t, valAccAddr, recommander(delAddr1))
+	require.True(t, store.Has(types.GetDelegationByRecommanderIndexKey(valAccAddr, valAddr, delAddr1)))
+	require.False(t, store.Has(types.GetDelegationByRecommanderIndexKey(noDelAddr, valAddr, delAddr1)))
+
+	// the loop is broken at the delegation stored first
+	first, second := delAddr2, delAddr3
+	if bytes.Compare(first, second) > 0 {
+		first, second = second, first
+	}
+	require.Equal(t, valAccAddr, recommander(first))
+	require.Equal(t, first, recommander(second))
+	require.True(t, store.Has(types.GetDelegationByRecommanderIndexKey(valAccAddr, valAddr, first)))
+	require.True(t, store.Has(types.GetDelegationByRecommanderIndexKey(first, valAddr, second)))
+	require.False(t, store.Has(types.GetDelegationByRecommanderIndexKey(second, valAddr, first)))
+
+	require.Equal(t, delAddr3, recommander(delAddr4))
+	require.True(t, store.Has(types.GetDelegationByRecommanderIndexKey(delAddr3, valAddr, delAddr4)))
+}
+
+func TestStoreMigrationCapsRecommandees(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	ctx, stakingKey, paramSpace := setupMigration(t)
+	store := ctx.KVStore(stakingKey)
+
+	// an already set param is kept
+	paramSpace.Set(ctx, types.KeyMaxRecommandees, uint32(2))
+
+	_, _, valAccAddr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(valAccAddr)
+	_, _, recAddr := testdata.KeyTestPubAddr()
+	store.Set(types.GetDelegationKey(recAddr, valAddr),
+		types.MustMarshalDelegation(encCfg.Marshaler, types.NewDelegation(recAddr, valAddr, valAccAddr, sdk.OneDec())))
+
+	// recAddr recommends one delegation more than the param allows
+	recommandees := make([]sdk.AccAddress, 3)
+	for i := range recommandees {
+		_, _, recommandees[i] = testdata.KeyTestPubAddr()
+		del := types.NewDelegation(recommandees[i], valAddr, recAddr, sdk.OneDec())
+		store.Set(types.GetDelegationKey(recommandees[i], valAddr), types.MustMarshalDelegation(encCfg.Marshaler, del))
+	}
+	sort.Slice(recommandees, func(i, j int) bool { return bytes.Compare(recommandees[i], recommandees[j]) < 0 })
+
+	// Run migrations.
+	err := v045staking.MigrateStore(ctx, stakingKey, encCfg.Marshaler, paramSpace)
+	require.NoError(t, err)
+
+	var maxRecommandees uint32
+	paramSpace.Get(ctx, types.KeyMaxRecommandees, &maxRecommandees)
+	require.Equal(t, uint32(2), maxRecommandees)
+
+	// the delegation stored last is moved to the validator operator
+	for i, delAddr := range recommandees {
+		delegation := types.MustUnmarshalDelegation(encCfg.Marshaler, store.Get(types.GetDelegationKey(delAddr, valAddr)))
+		expected := recAddr
+		if i == 2 {
+			expected = valAccAddr
+		}
+		require.Equal(t, expected, delegation.GetRecommanderAddr())
+		require.True(t, store.Has(types.GetDelegationByRecommanderIndexKey(expected, valAddr, delAddr)))
+	}
+	require.False(t, store.Has(types.GetDelegationByRecommanderIndexKey(recAddr, valAddr, recommandees[2])))
+}
@@ -0,0 +1,68 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// migrateIncentiveTeam converts the single incentive team address of a
+// validator into a single recipient which receives the whole team commission.
+func migrateIncentiveTeam(validator *types.Validator) error {
+	if len(validator.IncentiveTeamRecipients) > 0 {
+		return nil
+	}
+
+	teamAddr := sdk.AccAddress(validator.GetOperator())
+	if validator.IncentiveTeamAddress != "" {
+		addr, err := sdk.AccAddressFromBech32(validator.IncentiveTeamAddress)
+		if err != nil {
+			return err
+		}
+		teamAddr = addr
+	}
+
+	validator.IncentiveTeamAddress = ""
+	validator.IncentiveTeamRecipients = types.NewSingleIncentiveTeam(teamAddr)
+
+	return nil
+}
+
+// migrateReallocatedCommissionRule sets the max change rates of the
+// reallocated commission rule of a validator to their default value.
+func migrateReallocatedCommissionRule(validator *types.Validator) {
+	rule := validator.ReallocatedCommissionRule
+	validator.ReallocatedCommissionRule = rule.WithMaxChangeRates(rule.MaxChangeRates())
+}
+
+// migrateValidators migrates all the validators in a single pass, as the
+// missing max change rates could not be told apart from zero ones once the
+// validators are stored again.
+func migrateValidators(store sdk.KVStore, cdc codec.BinaryCodec) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+
+		if err := migrateIncentiveTeam(&validator); err != nil {
+			return err
+		}
+		migrateReallocatedCommissionRule(&validator)
+
+		store.Set(iterator.Key(), types.MustMarshalValidator(cdc, &validator))
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from consensus version 3 to
+// 4. The migration includes:
+//
+// - Converting the incentive team address of the validators into recipients
+// - Setting the max change rates of the reallocated commission rules
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	return migrateValidators(store, cdc)
+}
@@ -0,0 +1,120 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v046"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	store := ctx.KVStore(stakingKey)
+
+	_, pk1, valAccAddr1 := testdata.KeyTestPubAddr()
+	_, pk2, valAccAddr2 := testdata.KeyTestPubAddr()
+	_, pk3, valAccAddr3 := testdata.KeyTestPubAddr()
+	_, _, teamAddr := testdata.KeyTestPubAddr()
+	_, _, otherAddr := testdata.KeyTestPubAddr()
+
+	// a validator of an older version with a single team address
+	val1, err := types.NewValidator(sdk.ValAddress(valAccAddr1), pk1, types.Description{})
+	require.NoError(t, err)
+	val1.IncentiveTeamRecipients = nil
+	val1.IncentiveTeamAddress = teamAddr.String()
+
+	// a validator without any team address
+	val2, err := types.NewValidator(sdk.ValAddress(valAccAddr2), pk2, types.Description{})
+	require.NoError(t, err)
+	val2.IncentiveTeamRecipients = nil
+
+	// a validator which already has recipients
+	val3, err := types.NewValidator(sdk.ValAddress(valAccAddr3), pk3, types.Description{})
+	require.NoError(t, err)
+	recipients := []types.IncentiveTeamRecipient{
+		types.NewIncentiveTeamRecipient(teamAddr, sdk.NewDecWithPrec(6, 1)),
+		types.NewIncentiveTeamRecipient(otherAddr, sdk.NewDecWithPrec(4, 1)),
+	}
+	val3.IncentiveTeamRecipients = recipients
+	val3.ReallocatedCommissionRule = val3.ReallocatedCommissionRule.WithMaxChangeRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2))
+
+	for _, val := range []types.Validator{val1, val2, val3} {
+		val := val
+		store.Set(types.GetValidatorKey(val.GetOperator()), types.MustMarshalValidator(encCfg.Marshaler, &val))
+	}
+	store.Set(types.GetValidatorKey(val1.GetOperator()), stripMaxChangeRates(t, store.Get(types.GetValidatorKey(val1.GetOperator()))))
+
+	// Run migrations.
+	err = v046staking.MigrateStore(ctx, stakingKey, encCfg.Marshaler)
+	require.NoError(t, err)
+
+	getValidator := func(val types.Validator) types.Validator {
+		return types.MustUnmarshalValidator(encCfg.Marshaler, store.Get(types.GetValidatorKey(val.GetOperator())))
+	}
+
+	migrated := getValidator(val1)
+	require.Empty(t, migrated.IncentiveTeamAddress)
+	require.Equal(t, []types.IncentiveTeamRecipient{types.NewIncentiveTeamRecipient(teamAddr, sdk.OneDec())}, migrated.IncentiveTeamRecipients)
+
+	migrated = getValidator(val2)
+	require.Equal(t, []types.IncentiveTeamRecipient{types.NewIncentiveTeamRecipient(valAccAddr2, sdk.OneDec())}, migrated.IncentiveTeamRecipients)
+
+	migrated = getValidator(val3)
+	require.Equal(t, recipients, migrated.IncentiveTeamRecipients)
+
+	// the rules without max change rates get the default ones
+	migrated = getValidator(val1)
+	require.Equal(t, types.DefaultMaxRCommissionRuleChange, migrated.ReallocatedCommissionRule.MaxValidatorRateChange)
+	require.Equal(t, types.DefaultMaxRCommissionRuleChange, migrated.ReallocatedCommissionRule.MaxRecommandersRateChange)
+	require.Equal(t, types.DefaultMaxRCommissionRuleChange, migrated.ReallocatedCommissionRule.MaxClassRateChange)
+
+	migrated = getValidator(val3)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), migrated.ReallocatedCommissionRule.MaxClassRateChange)
+}
+
+// stripMaxChangeRates removes the max change rates from the reallocated
+// commission rule of an encoded validator, as stored by older versions.
+func stripMaxChangeRates(t *testing.T, bz []byte) []byte {
+	return filterFields(t, bz, func(num protowire.Number, value []byte) []byte {
+		if num != 12 { // reallocated_commission_rule
+			return value
+		}
+
+		rule, n := protowire.ConsumeBytes(value)
+		require.True(t, n >= 0)
+		rule = filterFields(t, rule, func(num protowire.Number, value []byte) []byte {
+			if num >= 6 { // max change rates
+				return nil
+			}
+			return value
+		})
+		return protowire.AppendBytes(nil, rule)
+	})
+}
+
+func filterFields(t *testing.T, bz []byte, filter func(protowire.Number, []byte) []byte) []byte {
+	var out []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.True(t, n >= 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.True(t, m >= 0)
+
+		if value := filter(num, bz[n:n+m]); value != nil {
+			out = protowire.AppendTag(out, num, typ)
+			out = append(out, value...)
+		}
+		bz = bz[n+m:]
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// migrateTotalUnbondingBalance sets the total balance of the entries of all the
+// unbonding delegations, which is updated with the unbonding delegations from
+// then on.
+func migrateTotalUnbondingBalance(store sdk.KVStore, cdc codec.BinaryCodec) {
+	total := sdk.ZeroInt()
+
+	iterator := sdk.KVStorePrefixIterator(store, types.UnbondingDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ubd := types.MustUnmarshalUBD(cdc, iterator.Value())
+		for _, entry := range ubd.Entries {
+			total = total.Add(entry.Balance)
+		}
+	}
+
+	store.Set(types.TotalUnbondingBalanceKey, cdc.MustMarshal(&sdk.IntProto{Int: total}))
+}
+
+// MigrateStore performs in-place store migrations from consensus version 4 to
+// 5. The migration includes:
+//
+// - Setting the total balance of the unbonding delegations
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	migrateTotalUnbondingBalance(store, cdc)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v047_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v047"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	store := ctx.KVStore(stakingKey)
+
+	_, _, delAddr1 := testdata.KeyTestPubAddr()
+	_, _, delAddr2 := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(delAddr1)
+
+	ubd1 := types.NewUnbondingDelegation(delAddr1, valAddr, 1, time.Unix(10, 0), sdk.NewInt(10))
+	ubd1.AddEntry(2, time.Unix(20, 0), sdk.NewInt(20))
+	ubd2 := types.NewUnbondingDelegation(delAddr2, valAddr, 1, time.Unix(10, 0), sdk.NewInt(5))
+	store.Set(types.GetUBDKey(delAddr1, valAddr), types.MustMarshalUBD(encCfg.Marshaler, ubd1))
+	store.Set(types.GetUBDKey(delAddr2, valAddr), types.MustMarshalUBD(encCfg.Marshaler, ubd2))
+
+	// Run migrations.
+	err := v047staking.MigrateStore(ctx, stakingKey, encCfg.Marshaler)
+	require.NoError(t, err)
+
+	var total sdk.IntProto
+	encCfg.Marshaler.MustUnmarshal(store.Get(types.TotalUnbondingBalanceKey), &total)
+	require.Equal(t, sdk.NewInt(35), total.Int)
+}
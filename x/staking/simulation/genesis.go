@@ -74,7 +74,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, maxIncentiveDepth, sdk.DefaultBondDenom)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, maxIncentiveDepth, sdk.DefaultBondDenom, types.DefaultMaxRecommandees)
 
 	// validators & delegations
 	var (
@@ -16,11 +16,12 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCreateValidator = "op_weight_msg_create_validator"
-	OpWeightMsgEditValidator   = "op_weight_msg_edit_validator"
-	OpWeightMsgDelegate        = "op_weight_msg_delegate"
-	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
-	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCreateValidator          = "op_weight_msg_create_validator"
+	OpWeightMsgEditValidator            = "op_weight_msg_edit_validator"
+	OpWeightMsgDelegate                 = "op_weight_msg_delegate"
+	OpWeightMsgUndelegate               = "op_weight_msg_undelegate"
+	OpWeightMsgBeginRedelegate          = "op_weight_msg_begin_redelegate"
+	OpWeightMsgSetDelegationRecommander = "op_weight_msg_set_delegation_recommander"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -29,11 +30,12 @@ func WeightedOperations(
 	bk types.BankKeeper, k keeper.Keeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgCreateValidator int
-		weightMsgEditValidator   int
-		weightMsgDelegate        int
-		weightMsgUndelegate      int
-		weightMsgBeginRedelegate int
+		weightMsgCreateValidator          int
+		weightMsgEditValidator            int
+		weightMsgDelegate                 int
+		weightMsgUndelegate               int
+		weightMsgBeginRedelegate          int
+		weightMsgSetDelegationRecommander int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -66,6 +68,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetDelegationRecommander, &weightMsgSetDelegationRecommander, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetDelegationRecommander = simappparams.DefaultWeightMsgSetDelegationRecommander
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -87,6 +95,10 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSetDelegationRecommander,
+			SimulateMsgSetDelegationRecommander(ak, bk, k),
+		),
 	}
 }
 
@@ -214,7 +226,15 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, bk types.BankKeeper, k kee
 			simtypes.RandStringOfLength(r, 10),
 		)
 
-		msg := types.NewMsgEditValidator(address, sdk.AccAddress(address).String(), description, &newCommissionRate, nil)
+		// either pay the team commission to the operator or split it randomly
+		incentiveTeamAddress := sdk.AccAddress(address).String()
+		var incentiveTeamRecipients []types.IncentiveTeamRecipient
+		if r.Intn(2) == 0 {
+			incentiveTeamAddress = types.DoNotModifyDesc
+			incentiveTeamRecipients = randomIncentiveTeamRecipients(r, accs)
+		}
+
+		msg := types.NewMsgEditValidator(address, incentiveTeamAddress, incentiveTeamRecipients, description, &newCommissionRate, nil)
 
 		txCtx := simulation.OperationInput{
 			R:               r,
@@ -256,7 +276,7 @@ func SimulateMsgDelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.K
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDelegate, "validator's invalid echange rate"), nil, nil
 		}
 
-		recommanderAccount, _ := simtypes.RandomAcc(r, accs)
+		recommanderAddr := randomRecommander(r, k, ctx, val.GetOperator())
 
 		amount := bk.GetBalance(ctx, simAccount.Address, denom).Amount
 		if !amount.IsPositive() {
@@ -283,7 +303,7 @@ func SimulateMsgDelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.K
 			}
 		}
 
-		msg := types.NewMsgDelegate(simAccount.Address, val.GetOperator(), recommanderAccount.Address, bondAmt)
+		msg := types.NewMsgDelegate(simAccount.Address, val.GetOperator(), recommanderAddr, bondAmt)
 
 		txCtx := simulation.OperationInput{
 			App:           app,
@@ -481,3 +501,114 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+// SimulateMsgSetDelegationRecommander generates a MsgSetDelegationRecommander with random values
+func SimulateMsgSetDelegationRecommander(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetDelegationRecommander, "validator is not ok"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := k.GetValidatorDelegations(ctx, valAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetDelegationRecommander, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		// clear the recommander once in a while
+		var recommanderAddr sdk.AccAddress
+		if r.Intn(10) != 0 {
+			recommanderAddr = randomRecommander(r, k, ctx, valAddr)
+		}
+		if delegation.GetRecommanderAddr().Equals(recommanderAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetDelegationRecommander, "recommander is unchanged"), nil, nil
+		}
+
+		if !recommanderAddr.Empty() {
+			if err := k.ValidateRecommander(ctx, delAddr, valAddr, recommanderAddr); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetDelegationRecommander, "invalid recommander"), nil, nil
+			}
+		}
+
+		msg := types.NewMsgSetDelegationRecommander(delAddr, valAddr, recommanderAddr)
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "account private key is nil"), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomRecommander picks a random delegator of the validator as recommander,
+// or the validator operator if the validator has no delegations.
+func randomRecommander(r *rand.Rand, k keeper.Keeper, ctx sdk.Context, valAddr sdk.ValAddress) sdk.AccAddress {
+	delegations := k.GetValidatorDelegations(ctx, valAddr)
+	if len(delegations) == 0 {
+		return sdk.AccAddress(valAddr)
+	}
+
+	return delegations[r.Intn(len(delegations))].GetDelegatorAddr()
+}
+
+// randomIncentiveTeamRecipients picks up to three distinct random accounts and
+// splits the team commission between them with random weights.
+func randomIncentiveTeamRecipients(r *rand.Rand, accs []simtypes.Account) []types.IncentiveTeamRecipient {
+	count := 1 + r.Intn(3)
+	if count > len(accs) {
+		count = len(accs)
+	}
+
+	recipients := make([]types.IncentiveTeamRecipient, 0, count)
+	remaining := sdk.OneDec()
+	for _, i := range r.Perm(len(accs))[:count] {
+		weight := remaining
+		if len(recipients) < count-1 {
+			weight = simtypes.RandomDecAmount(r, remaining.QuoInt64(2))
+			if !weight.IsPositive() {
+				weight = remaining.QuoInt64(2)
+			}
+		}
+
+		recipients = append(recipients, types.NewIncentiveTeamRecipient(accs[i].Address, weight))
+		remaining = remaining.Sub(weight)
+	}
+
+	return recipients
+}
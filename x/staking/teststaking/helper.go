@@ -29,7 +29,7 @@ type Helper struct {
 
 // NewHelper creates staking Handler wrapper for tests
 func NewHelper(t *testing.T, ctx sdk.Context, k keeper.Keeper) *Helper {
-	return &Helper{t, staking.NewHandler(k), k, ctx, ZeroCommission(), ZeroReallocCommission(), sdk.DefaultBondDenom}
+	return &Helper{t, staking.NewHandler(k), k, ctx, ZeroCommission(), DefaultReallocCommission(), sdk.DefaultBondDenom}
 }
 
 // CreateValidator calls handler to create a new staking validator
@@ -133,7 +133,13 @@ func ZeroCommission() stakingtypes.CommissionRates {
 	return stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 }
 
+// DefaultReallocCommission constructs a reallocated commission rule which
+// leaves the whole commission to the validator, as for a new validator.
+func DefaultReallocCommission() stakingtypes.ReallocatedCommissionRule {
+	return stakingtypes.NewReallocatedCommissionRule(sdk.OneDec(), sdk.ZeroDec(), 0, nil)
+}
+
 // ZeroReallocCommission constructs a commission rates with all zeros.
 func ZeroReallocCommission() stakingtypes.ReallocatedCommissionRule {
-	return stakingtypes.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), 2, []stakingtypes.RecommanderClassRate{})
+	return stakingtypes.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), 0, nil)
 }
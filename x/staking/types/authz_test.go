@@ -65,7 +65,7 @@ func TestAuthzAuthorizations(t *testing.T) {
 			[]sdk.ValAddress{},
 			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
 			&coin100,
-			stakingtypes.NewMsgDelegate(delAddr, val1, coin100),
+			stakingtypes.NewMsgDelegate(delAddr, val1, sdk.AccAddress(val1), coin100),
 			false,
 			true,
 			nil,
@@ -76,7 +76,7 @@ func TestAuthzAuthorizations(t *testing.T) {
 			[]sdk.ValAddress{},
 			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
 			&coin100,
-			stakingtypes.NewMsgDelegate(delAddr, val1, coin50),
+			stakingtypes.NewMsgDelegate(delAddr, val1, sdk.AccAddress(val1), coin50),
 			false,
 			false,
 			&stakingtypes.StakeAuthorization{
@@ -90,7 +90,7 @@ func TestAuthzAuthorizations(t *testing.T) {
 			[]sdk.ValAddress{},
 			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
 			&coin100,
-			stakingtypes.NewMsgDelegate(delAddr, val3, coin100),
+			stakingtypes.NewMsgDelegate(delAddr, val3, sdk.AccAddress(val3), coin100),
 			true,
 			false,
 			nil,
@@ -101,7 +101,7 @@ func TestAuthzAuthorizations(t *testing.T) {
 			[]sdk.ValAddress{},
 			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
 			nil,
-			stakingtypes.NewMsgDelegate(delAddr, val2, coin100),
+			stakingtypes.NewMsgDelegate(delAddr, val2, sdk.AccAddress(val2), coin100),
 			false,
 			false,
 			&stakingtypes.StakeAuthorization{
@@ -115,7 +115,7 @@ func TestAuthzAuthorizations(t *testing.T) {
 			[]sdk.ValAddress{val1},
 			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
 			nil,
-			stakingtypes.NewMsgDelegate(delAddr, val1, coin100),
+			stakingtypes.NewMsgDelegate(delAddr, val1, sdk.AccAddress(val1), coin100),
 			true,
 			false,
 			nil,
@@ -19,6 +19,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate", nil)
 	cdc.RegisterConcrete(&MsgEditValidatorRCommissionRule{}, "cosmos-sdk/MsgEditValidatorRCommissionRule", nil)
 	cdc.RegisterConcrete(&MsgEditValidatorRecommanderRule{}, "cosmos-sdk/MsgEditValidatorRecommanderRule", nil)
+	cdc.RegisterConcrete(&MsgSetDelegationRecommander{}, "cosmos-sdk/MsgSetDelegationRecommander", nil)
 }
 
 // RegisterInterfaces registers the x/staking interfaces types with the interface registry
@@ -31,6 +32,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBeginRedelegate{},
 		&MsgEditValidatorRCommissionRule{},
 		&MsgEditValidatorRecommanderRule{},
+		&MsgSetDelegationRecommander{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
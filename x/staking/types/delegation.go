@@ -71,7 +71,12 @@ func (d Delegation) GetValidatorAddr() sdk.ValAddress {
 	}
 	return addr
 }
+// GetRecommanderAddr returns the recommander of the delegation, or nil if it
+// has none.
 func (d Delegation) GetRecommanderAddr() sdk.AccAddress {
+	if d.RecommanderAddress == "" {
+		return nil
+	}
 	recAddr, err := sdk.AccAddressFromBech32(d.RecommanderAddress)
 	if err != nil {
 		panic(err)
@@ -97,6 +102,21 @@ func (d Delegations) String() (out string) {
 	return strings.TrimSpace(out)
 }
 
+// NewRecommander creates a new recommander object
+//nolint:interfacer
+func NewRecommander(level uint32, recommanderAddr sdk.AccAddress) Recommander {
+	return Recommander{
+		Level:              level,
+		RecommanderAddress: recommanderAddr.String(),
+	}
+}
+
+// String implements the Stringer interface for a Recommander object.
+func (r Recommander) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
 func NewUnbondingDelegationEntry(creationHeight int64, completionTime time.Time, balance sdk.Int) UnbondingDelegationEntry {
 	return UnbondingDelegationEntry{
 		CreationHeight: creationHeight,
@@ -13,7 +13,7 @@ import (
 )
 
 func TestDelegationEqual(t *testing.T) {
-	d1 := types.NewDelegation(sdk.AccAddress(valAddr1), valAddr2, sdk.NewDec(100))
+	d1 := types.NewDelegation(sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr2), sdk.NewDec(100))
 	d2 := d1
 
 	ok := d1.String() == d2.String()
@@ -27,7 +27,7 @@ func TestDelegationEqual(t *testing.T) {
 }
 
 func TestDelegationString(t *testing.T) {
-	d := types.NewDelegation(sdk.AccAddress(valAddr1), valAddr2, sdk.NewDec(100))
+	d := types.NewDelegation(sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr2), sdk.NewDec(100))
 	require.NotEmpty(t, d.String())
 }
 
@@ -81,9 +81,9 @@ func TestRedelegationString(t *testing.T) {
 
 func TestDelegationResponses(t *testing.T) {
 	cdc := codec.NewLegacyAmino()
-	dr1 := types.NewDelegationResp(sdk.AccAddress(valAddr1), valAddr2, sdk.NewDec(5),
+	dr1 := types.NewDelegationResp(sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr2), sdk.NewDec(5),
 		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5)))
-	dr2 := types.NewDelegationResp(sdk.AccAddress(valAddr1), valAddr3, sdk.NewDec(5),
+	dr2 := types.NewDelegationResp(sdk.AccAddress(valAddr1), valAddr3, sdk.AccAddress(valAddr3), sdk.NewDec(5),
 		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5)))
 	drs := types.DelegationResponses{dr1, dr2}
 
@@ -53,4 +53,14 @@ var (
 	ErrEmptyIncentiveTeamAddr          = sdkerrors.Register(ModuleName, 41, "empty incentive team address")
 	ErrInvalidRecommanderAddr          = sdkerrors.Register(ModuleName, 42, "recommander is invalid with address")
 	ErrMismatchRecommanderClass        = sdkerrors.Register(ModuleName, 43, "mismatch recommander class depth")
+	ErrRecommanderCycle                = sdkerrors.Register(ModuleName, 44, "recommander chain would contain a cycle")
+	ErrRecommanderNoDelegation         = sdkerrors.Register(ModuleName, 45, "recommander has no delegation to the validator")
+	ErrInvalidIncentiveTeamRecipients  = sdkerrors.Register(ModuleName, 46, "invalid incentive team recipients")
+	ErrIncentiveTeamWeightsNotOne      = sdkerrors.Register(ModuleName, 47, "incentive team weights must add up to one")
+	ErrRCommissionRuleUpdateTime       = sdkerrors.Register(ModuleName, 48, "reallocated commission rule cannot be changed more than once in 24h")
+	ErrRCommissionRuleMaxChangeInvalid = sdkerrors.Register(ModuleName, 49, "reallocated commission rule max change rates must be between 0 and 1 (inclusive)")
+	ErrValidatorRateGTMaxChange        = sdkerrors.Register(ModuleName, 50, "validator rate cannot be changed more than the max validator rate change")
+	ErrRecommandersRateGTMaxChange     = sdkerrors.Register(ModuleName, 51, "recommanders rate cannot be changed more than the max recommanders rate change")
+	ErrRecommanderClassRateGTMaxChange = sdkerrors.Register(ModuleName, 52, "recommander class rate cannot be changed more than the max class rate change")
+	ErrTooManyRecommandees             = sdkerrors.Register(ModuleName, 53, "recommander has too many recommandees")
 )
@@ -9,6 +9,7 @@ const (
 	EventTypeDelegate             = "delegate"
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
+	EventTypeSetRecommander       = "set_recommander"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyRecommander       = "recommander"
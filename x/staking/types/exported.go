@@ -39,6 +39,6 @@ type ValidatorI interface {
 	SharesFromTokens(amt sdk.Int) (sdk.Dec, error)          // shares worth of delegator's bond
 	SharesFromTokensTruncated(amt sdk.Int) (sdk.Dec, error) // truncated shares worth of delegator's bond
 	GetReallocatedCommissionRule() (sdk.Dec, sdk.Dec, uint32, []RecommanderClassRate)          // validator commission reallocated rate
-	GetIncentiveTeam() sdk.AccAddress                       // team address to receive remains rewards
+	GetIncentiveTeamRecipients() []IncentiveTeamRecipient   // team addresses to receive remains rewards
 	GetDelegators() sdk.Int                                 // delegators of validator
 }
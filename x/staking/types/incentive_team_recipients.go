@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxIncentiveTeamRecipients is the maximum number of recipients of the team
+// commission of a validator.
+const MaxIncentiveTeamRecipients = 20
+
+// NewIncentiveTeamRecipient returns a new IncentiveTeamRecipient object.
+func NewIncentiveTeamRecipient(addr sdk.AccAddress, weight sdk.Dec) IncentiveTeamRecipient {
+	return IncentiveTeamRecipient{
+		Address: addr.String(),
+		Weight:  weight,
+	}
+}
+
+// GetAddress returns the address of the recipient.
+func (r IncentiveTeamRecipient) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.Address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// IncentiveTeamRecipients is a collection of IncentiveTeamRecipient.
+type IncentiveTeamRecipients []IncentiveTeamRecipient
+
+// NewSingleIncentiveTeam returns the recipients of a team made of a single
+// address, which receives the whole team commission.
+func NewSingleIncentiveTeam(addr sdk.AccAddress) IncentiveTeamRecipients {
+	return IncentiveTeamRecipients{NewIncentiveTeamRecipient(addr, sdk.OneDec())}
+}
+
+// Validate checks that the recipients have valid and distinct addresses, and
+// positive weights which add up to one.
+func (r IncentiveTeamRecipients) Validate() error {
+	if len(r) == 0 {
+		return sdkerrors.Wrap(ErrInvalidIncentiveTeamRecipients, "no recipient")
+	}
+	if len(r) > MaxIncentiveTeamRecipients {
+		return sdkerrors.Wrapf(ErrInvalidIncentiveTeamRecipients, "more than %d recipients", MaxIncentiveTeamRecipients)
+	}
+
+	seen := make(map[string]bool, len(r))
+	totalWeight := sdk.ZeroDec()
+	for _, recipient := range r {
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidIncentiveTeamRecipients, "invalid address %s: %s", recipient.Address, err)
+		}
+		if seen[addr.String()] {
+			return sdkerrors.Wrapf(ErrInvalidIncentiveTeamRecipients, "duplicate address %s", recipient.Address)
+		}
+		seen[addr.String()] = true
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidIncentiveTeamRecipients, "weight of %s must be positive", recipient.Address)
+		}
+		totalWeight = totalWeight.Add(recipient.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrIncentiveTeamWeightsNotOne, "total weight %s", totalWeight)
+	}
+
+	return nil
+}
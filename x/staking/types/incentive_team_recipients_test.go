@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestIncentiveTeamRecipientsValidate(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name       string
+		recipients types.IncentiveTeamRecipients
+		expErr     error
+	}{
+		{"single", types.NewSingleIncentiveTeam(addr1), nil},
+		{"split", types.IncentiveTeamRecipients{
+			types.NewIncentiveTeamRecipient(addr1, sdk.NewDecWithPrec(3, 1)),
+			types.NewIncentiveTeamRecipient(addr2, sdk.NewDecWithPrec(7, 1)),
+		}, nil},
+		{"empty", types.IncentiveTeamRecipients{}, types.ErrInvalidIncentiveTeamRecipients},
+		{"invalid address", types.IncentiveTeamRecipients{{Address: "invalid", Weight: sdk.OneDec()}}, types.ErrInvalidIncentiveTeamRecipients},
+		{"duplicate address", types.IncentiveTeamRecipients{
+			types.NewIncentiveTeamRecipient(addr1, half),
+			types.NewIncentiveTeamRecipient(addr1, half),
+		}, types.ErrInvalidIncentiveTeamRecipients},
+		{"zero weight", types.IncentiveTeamRecipients{
+			types.NewIncentiveTeamRecipient(addr1, sdk.OneDec()),
+			types.NewIncentiveTeamRecipient(addr2, sdk.ZeroDec()),
+		}, types.ErrInvalidIncentiveTeamRecipients},
+		{"weights below one", types.IncentiveTeamRecipients{
+			types.NewIncentiveTeamRecipient(addr1, half),
+			types.NewIncentiveTeamRecipient(addr2, sdk.NewDecWithPrec(4, 1)),
+		}, types.ErrIncentiveTeamWeightsNotOne},
+		{"weights above one", types.IncentiveTeamRecipients{
+			types.NewIncentiveTeamRecipient(addr1, half),
+			types.NewIncentiveTeamRecipient(addr2, sdk.NewDecWithPrec(6, 1)),
+		}, types.ErrIncentiveTeamWeightsNotOne},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recipients.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateIncentiveTeam(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	validator := newValidator(t, valAddr1, pk1)
+	split := []types.IncentiveTeamRecipient{
+		types.NewIncentiveTeamRecipient(addr1, sdk.NewDecWithPrec(3, 1)),
+		types.NewIncentiveTeamRecipient(addr2, sdk.NewDecWithPrec(7, 1)),
+	}
+
+	// the operator receives the team commission by default
+	recipients, err := validator.UpdateIncentiveTeam(types.DoNotModifyDesc, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.IncentiveTeamRecipient(types.NewSingleIncentiveTeam(sdk.AccAddress(valAddr1))), recipients)
+
+	recipients, err = validator.UpdateIncentiveTeam(addr1.String(), nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.IncentiveTeamRecipient(types.NewSingleIncentiveTeam(addr1)), recipients)
+
+	recipients, err = validator.UpdateIncentiveTeam(types.DoNotModifyDesc, split)
+	require.NoError(t, err)
+	require.Equal(t, split, recipients)
+
+	_, err = validator.UpdateIncentiveTeam(addr1.String(), split)
+	require.ErrorIs(t, err, types.ErrInvalidIncentiveTeamRecipients)
+
+	_, err = validator.UpdateIncentiveTeam(types.DoNotModifyDesc, split[:1])
+	require.ErrorIs(t, err, types.ErrIncentiveTeamWeightsNotOne)
+
+	// a validator of an older version pays its single team address
+	validator.IncentiveTeamRecipients = nil
+	validator.IncentiveTeamAddress = addr2.String()
+	require.Equal(t, []types.IncentiveTeamRecipient(types.NewSingleIncentiveTeam(addr2)), validator.GetIncentiveTeamRecipients())
+}
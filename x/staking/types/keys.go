@@ -42,6 +42,8 @@ var (
 	RedelegationKey                  = []byte{0x34} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
+	DelegationByRecommanderIndexKey  = []byte{0x37} // prefix for each key for a delegation, by recommander and validator operator
+	TotalUnbondingBalanceKey         = []byte{0x38} // key for the total balance of the unbonding delegation entries
 
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
@@ -185,6 +187,18 @@ func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, address.MustLengthPrefix(delAddr)...)
 }
 
+// GetDelegationByRecommanderIndexKey creates the index-key for a delegation, stored by recommander-validator-index
+// VALUE: none (key rearrangement used)
+func GetDelegationByRecommanderIndexKey(recAddr sdk.AccAddress, valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	return append(GetDelegationsByRecommanderIndexKey(recAddr, valAddr), address.MustLengthPrefix(delAddr)...)
+}
+
+// GetDelegationsByRecommanderIndexKey creates the prefix keyspace for the indexes of delegations
+// to a validator recommended by a recommander
+func GetDelegationsByRecommanderIndexKey(recAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(DelegationByRecommanderIndexKey, address.MustLengthPrefix(recAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
 // GetUBDKey creates the key for an unbonding delegation by delegator and validator addr
 // VALUE: staking/UnbondingDelegation
 func GetUBDKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
@@ -18,6 +18,7 @@ const (
 	TypeMsgBeginRedelegate = "begin_redelegate"
 	TypeMsgEditValidatorReallocatedCommissionRule = "edit_validator_reallocated_commission_rule"
 	TypeMsgEditValidatorRecommanderRule = "edit_validator_recommander_rule"
+	TypeMsgSetDelegationRecommander = "set_delegation_recommander"
 )
 
 var (
@@ -30,6 +31,7 @@ var (
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgEditValidatorRCommissionRule{}
 	_ sdk.Msg                            = &MsgEditValidatorRecommanderRule{}
+	_ sdk.Msg                            = &MsgSetDelegationRecommander{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -175,9 +177,10 @@ func (msg MsgCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 
 // NewMsgEditValidator creates a new MsgEditValidator instance
 //nolint:interfacer
-func NewMsgEditValidator(valAddr sdk.ValAddress, incentiveTeamAddress string, description Description, newRate *sdk.Dec, newMinSelfDelegation *sdk.Int) *MsgEditValidator {
+func NewMsgEditValidator(valAddr sdk.ValAddress, incentiveTeamAddress string, incentiveTeamRecipients []IncentiveTeamRecipient, description Description, newRate *sdk.Dec, newMinSelfDelegation *sdk.Int) *MsgEditValidator {
 	return &MsgEditValidator{
-		IncentiveTeamAddress:  incentiveTeamAddress,
+		IncentiveTeamAddress:    incentiveTeamAddress,
+		IncentiveTeamRecipients: incentiveTeamRecipients,
 		Description:           description,
 		CommissionRate:        newRate,
 		ValidatorAddress:      valAddr.String(),
@@ -229,6 +232,16 @@ func (msg MsgEditValidator) ValidateBasic() error {
 		}
 	}
 
+	if len(msg.IncentiveTeamRecipients) > 0 {
+		if msg.IncentiveTeamAddress != DoNotModifyDesc && msg.IncentiveTeamAddress != "" {
+			return sdkerrors.Wrap(ErrInvalidIncentiveTeamRecipients, "both incentive team address and recipients provided")
+		}
+
+		if err := IncentiveTeamRecipients(msg.IncentiveTeamRecipients).Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -524,3 +537,62 @@ func (msg MsgEditValidatorRecommanderRule) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetDelegationRecommander creates a new MsgSetDelegationRecommander instance.
+//nolint:interfacer
+func NewMsgSetDelegationRecommander(delAddr sdk.AccAddress, valAddr sdk.ValAddress, recommanderAddr sdk.AccAddress) *MsgSetDelegationRecommander {
+	return &MsgSetDelegationRecommander{
+		DelegatorAddress:   delAddr.String(),
+		ValidatorAddress:   valAddr.String(),
+		RecommanderAddress: recommanderAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetDelegationRecommander) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetDelegationRecommander) Type() string { return TypeMsgSetDelegationRecommander }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetDelegationRecommander) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetDelegationRecommander) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetDelegationRecommander) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address: %s", err)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address: %s", err)
+	}
+
+	// an empty recommander clears the recommander of the delegation
+	if msg.RecommanderAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RecommanderAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recommander address: %s", err)
+		}
+	}
+
+	return nil
+}
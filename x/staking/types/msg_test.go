@@ -37,7 +37,8 @@ func TestMsgDecode(t *testing.T) {
 	// now let's try to serialize the whole message
 
 	commission1 := types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
-	msg, err := types.NewMsgCreateValidator(valAddr1, pk1, coinPos, types.Description{}, commission1, sdk.OneInt())
+	rule1 := types.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), 0, nil)
+	msg, err := types.NewMsgCreateValidator(valAddr1, pk1, coinPos, types.Description{}, commission1, rule1, sdk.AccAddress(valAddr1), sdk.OneInt())
 	require.NoError(t, err)
 	msgSerialized, err := cdc.MarshalInterface(msg)
 	require.NoError(t, err)
@@ -55,6 +56,7 @@ func TestMsgDecode(t *testing.T) {
 func TestMsgCreateValidator(t *testing.T) {
 	commission1 := types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 	commission2 := types.NewCommissionRates(sdk.NewDec(5), sdk.NewDec(5), sdk.NewDec(5))
+	rule1 := types.NewReallocatedCommissionRule(sdk.ZeroDec(), sdk.ZeroDec(), 0, nil)
 
 	tests := []struct {
 		name, moniker, identity, website, securityContact, details string
@@ -79,7 +81,7 @@ func TestMsgCreateValidator(t *testing.T) {
 
 	for _, tc := range tests {
 		description := types.NewDescription(tc.moniker, tc.identity, tc.website, tc.securityContact, tc.details)
-		msg, err := types.NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, description, tc.CommissionRates, tc.minSelfDelegation)
+		msg, err := types.NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, description, tc.CommissionRates, rule1, sdk.AccAddress(tc.validatorAddr), tc.minSelfDelegation)
 		require.NoError(t, err)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
@@ -108,7 +110,7 @@ func TestMsgEditValidator(t *testing.T) {
 		description := types.NewDescription(tc.moniker, tc.identity, tc.website, tc.securityContact, tc.details)
 		newRate := sdk.ZeroDec()
 
-		msg := types.NewMsgEditValidator(tc.validatorAddr, description, &newRate, &tc.minSelfDelegation)
+		msg := types.NewMsgEditValidator(tc.validatorAddr, types.DoNotModifyDesc, nil, description, &newRate, &tc.minSelfDelegation)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -135,7 +137,7 @@ func TestMsgDelegate(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		msg := types.NewMsgDelegate(tc.delegatorAddr, tc.validatorAddr, tc.bond)
+		msg := types.NewMsgDelegate(tc.delegatorAddr, tc.validatorAddr, sdk.AccAddress(tc.validatorAddr), tc.bond)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -197,3 +199,29 @@ func TestMsgUndelegate(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetDelegationRecommander
+func TestMsgSetDelegationRecommander(t *testing.T) {
+	tests := []struct {
+		name            string
+		delegatorAddr   sdk.AccAddress
+		validatorAddr   sdk.ValAddress
+		recommanderAddr sdk.AccAddress
+		expectPass      bool
+	}{
+		{"regular", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr3), true},
+		{"validator as recommander", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(valAddr2), true},
+		{"empty delegator", sdk.AccAddress(emptyAddr), valAddr1, sdk.AccAddress(valAddr3), false},
+		{"empty validator", sdk.AccAddress(valAddr1), emptyAddr, sdk.AccAddress(valAddr3), false},
+		{"cleared recommander", sdk.AccAddress(valAddr1), valAddr2, sdk.AccAddress(emptyAddr), true},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgSetDelegationRecommander(tc.delegatorAddr, tc.validatorAddr, tc.recommanderAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
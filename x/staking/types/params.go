@@ -33,6 +33,9 @@ const (
 
 	// Default maximum depth of incentive
 	DefaultMaxIncentiveDepth uint32 = 50
+
+	// Default maximum number of delegations recommended by an account
+	DefaultMaxRecommandees uint32 = 50
 )
 
 var (
@@ -43,6 +46,7 @@ var (
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
 	KeyPowerReduction    = []byte("PowerReduction")
+	KeyMaxRecommandees   = []byte("MaxRecommandees")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -53,7 +57,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, maxIncentiveDepth uint32, bondDenom string) Params {
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, maxIncentiveDepth uint32, bondDenom string, maxRecommandees uint32) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
 		MaxValidators:     maxValidators,
@@ -61,6 +65,7 @@ func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historica
 		HistoricalEntries: historicalEntries,
 		MaxIncentiveDepth: maxIncentiveDepth,
 		BondDenom:         bondDenom,
+		MaxRecommandees:   maxRecommandees,
 	}
 }
 
@@ -73,6 +78,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyMaxIncentiveDepth, &p.MaxIncentiveDepth, validateMaxIncentiveDepth),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyMaxRecommandees, &p.MaxRecommandees, validateMaxRecommandees),
 	}
 }
 
@@ -85,6 +91,7 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		DefaultMaxIncentiveDepth,
 		sdk.DefaultBondDenom,
+		DefaultMaxRecommandees,
 	)
 }
 
@@ -136,6 +143,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxRecommandees(p.MaxRecommandees); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -201,6 +212,19 @@ func validateMaxIncentiveDepth(i interface{}) error {
 
 }
 
+func validateMaxRecommandees(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max recommandees must be positive: %d", v)
+	}
+
+	return nil
+}
+
 func validateBondDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -1389,6 +1389,195 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryRecommanderChainRequest is request type for the Query/RecommanderChain
+// RPC method.
+type QueryRecommanderChainRequest struct {
+	// delegator_addr defines the delegator address to query for.
+	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryRecommanderChainRequest) Reset()         { *m = QueryRecommanderChainRequest{} }
+func (m *QueryRecommanderChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommanderChainRequest) ProtoMessage()    {}
+func (*QueryRecommanderChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{28}
+}
+func (m *QueryRecommanderChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommanderChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommanderChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommanderChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommanderChainRequest.Merge(m, src)
+}
+func (m *QueryRecommanderChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommanderChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommanderChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommanderChainRequest proto.InternalMessageInfo
+
+// QueryRecommanderChainResponse is response type for the Query/RecommanderChain
+// RPC method.
+type QueryRecommanderChainResponse struct {
+	// recommanders defines the recommanders of the delegation, ordered by level.
+	Recommanders []Recommander `protobuf:"bytes,1,rep,name=recommanders,proto3" json:"recommanders"`
+}
+
+func (m *QueryRecommanderChainResponse) Reset()         { *m = QueryRecommanderChainResponse{} }
+func (m *QueryRecommanderChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommanderChainResponse) ProtoMessage()    {}
+func (*QueryRecommanderChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryRecommanderChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommanderChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommanderChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommanderChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommanderChainResponse.Merge(m, src)
+}
+func (m *QueryRecommanderChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommanderChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommanderChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommanderChainResponse proto.InternalMessageInfo
+
+func (m *QueryRecommanderChainResponse) GetRecommanders() []Recommander {
+	if m != nil {
+		return m.Recommanders
+	}
+	return nil
+}
+
+// QueryRecommandeesRequest is request type for the Query/Recommandees RPC
+// method.
+type QueryRecommandeesRequest struct {
+	// recommander_addr defines the recommander address to query for.
+	RecommanderAddr string `protobuf:"bytes,1,opt,name=recommander_addr,json=recommanderAddr,proto3" json:"recommander_addr,omitempty"`
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecommandeesRequest) Reset()         { *m = QueryRecommandeesRequest{} }
+func (m *QueryRecommandeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommandeesRequest) ProtoMessage()    {}
+func (*QueryRecommandeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{30}
+}
+func (m *QueryRecommandeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommandeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommandeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommandeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommandeesRequest.Merge(m, src)
+}
+func (m *QueryRecommandeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommandeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommandeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommandeesRequest proto.InternalMessageInfo
+
+// QueryRecommandeesResponse is response type for the Query/Recommandees RPC
+// method.
+type QueryRecommandeesResponse struct {
+	// delegation_responses defines the delegations recommended by the recommander.
+	DelegationResponses DelegationResponses `protobuf:"bytes,1,rep,name=delegation_responses,json=delegationResponses,proto3,castrepeated=DelegationResponses" json:"delegation_responses"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecommandeesResponse) Reset()         { *m = QueryRecommandeesResponse{} }
+func (m *QueryRecommandeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommandeesResponse) ProtoMessage()    {}
+func (*QueryRecommandeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{31}
+}
+func (m *QueryRecommandeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommandeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommandeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommandeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommandeesResponse.Merge(m, src)
+}
+func (m *QueryRecommandeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommandeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommandeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommandeesResponse proto.InternalMessageInfo
+
+func (m *QueryRecommandeesResponse) GetDelegationResponses() DelegationResponses {
+	if m != nil {
+		return m.DelegationResponses
+	}
+	return nil
+}
+
+func (m *QueryRecommandeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1418,6 +1607,10 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryRecommanderChainRequest)(nil), "cosmos.staking.v1beta1.QueryRecommanderChainRequest")
+	proto.RegisterType((*QueryRecommanderChainResponse)(nil), "cosmos.staking.v1beta1.QueryRecommanderChainResponse")
+	proto.RegisterType((*QueryRecommandeesRequest)(nil), "cosmos.staking.v1beta1.QueryRecommandeesRequest")
+	proto.RegisterType((*QueryRecommandeesResponse)(nil), "cosmos.staking.v1beta1.QueryRecommandeesResponse")
 }
 
 func init() {
@@ -1425,89 +1618,99 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x4f, 0xdc, 0x56,
-	0x10, 0xdf, 0x07, 0x14, 0x95, 0x89, 0x12, 0xa5, 0x6f, 0x81, 0x52, 0x27, 0xd9, 0x05, 0xb7, 0x24,
-	0x04, 0x88, 0x2d, 0x20, 0x05, 0x12, 0xa5, 0x1f, 0x20, 0x9a, 0xaf, 0xf6, 0x40, 0x36, 0x2a, 0x6a,
-	0xda, 0x03, 0xf2, 0xae, 0x8d, 0xd7, 0xca, 0x62, 0x6f, 0x6c, 0x83, 0x20, 0x88, 0x4b, 0x4e, 0x55,
-	0x4f, 0xad, 0x7a, 0x4b, 0x2f, 0x39, 0xf4, 0x50, 0xb5, 0xd7, 0xfc, 0x05, 0x3d, 0xa1, 0x9e, 0x88,
-	0xaa, 0x4a, 0xed, 0xa5, 0xa9, 0xa0, 0x87, 0x1c, 0x7b, 0x8c, 0x7a, 0x8a, 0xf6, 0x79, 0xec, 0xb5,
-	0xf1, 0xc7, 0x7a, 0x97, 0x45, 0x28, 0x27, 0xd8, 0xb7, 0xf3, 0xf1, 0xfb, 0xcd, 0xbc, 0x99, 0x37,
-	0xa3, 0x05, 0xbe, 0x64, 0x58, 0xab, 0x86, 0x25, 0x5a, 0xb6, 0x74, 0x5f, 0xd3, 0x55, 0x71, 0x7d,
-	0xa2, 0xa8, 0xd8, 0xd2, 0x84, 0xf8, 0x60, 0x4d, 0x31, 0x37, 0x85, 0xaa, 0x69, 0xd8, 0x06, 0xed,
-	0x77, 0x64, 0x04, 0x94, 0x11, 0x50, 0x86, 0x1b, 0x45, 0xdd, 0xa2, 0x64, 0x29, 0x8e, 0x82, 0xa7,
-	0x5e, 0x95, 0x54, 0x4d, 0x97, 0x6c, 0xcd, 0xd0, 0x1d, 0x1b, 0x5c, 0xaf, 0x6a, 0xa8, 0x06, 0xfb,
-	0x57, 0xac, 0xfd, 0x87, 0xa7, 0x67, 0x55, 0xc3, 0x50, 0x2b, 0x8a, 0x28, 0x55, 0x35, 0x51, 0xd2,
-	0x75, 0xc3, 0x66, 0x2a, 0x16, 0x7e, 0xfb, 0x5e, 0x0c, 0x36, 0x17, 0x07, 0x93, 0xe2, 0x37, 0xa0,
-	0xff, 0x4e, 0xcd, 0xf7, 0x92, 0x54, 0xd1, 0x64, 0xc9, 0x36, 0x4c, 0xab, 0xa0, 0x3c, 0x58, 0x53,
-	0x2c, 0x9b, 0xf6, 0x43, 0xb7, 0x65, 0x4b, 0xf6, 0x9a, 0x35, 0x40, 0x06, 0xc9, 0x48, 0x4f, 0x01,
-	0x3f, 0xd1, 0xeb, 0x00, 0x75, 0x7c, 0x03, 0x1d, 0x83, 0x64, 0xe4, 0xc4, 0xe4, 0x79, 0x01, 0x49,
-	0xd6, 0xc8, 0x08, 0x0e, 0x7b, 0xf4, 0x27, 0x2c, 0x4a, 0xaa, 0x82, 0x36, 0x0b, 0x3e, 0x4d, 0xfe,
-	0x17, 0x02, 0x6f, 0x87, 0x5c, 0x5b, 0x55, 0x43, 0xb7, 0x14, 0x7a, 0x03, 0x60, 0xdd, 0x3b, 0x1d,
-	0x20, 0x83, 0x9d, 0x23, 0x27, 0x26, 0x87, 0x84, 0xe8, 0x40, 0x0a, 0x9e, 0xfe, 0x7c, 0xd7, 0xce,
-	0xdf, 0xf9, 0x4c, 0xc1, 0xa7, 0x5a, 0x33, 0x14, 0x02, 0x7b, 0xa1, 0x21, 0x58, 0x07, 0x45, 0x00,
-	0xed, 0x87, 0xd0, 0x17, 0x04, 0xeb, 0x86, 0x69, 0x18, 0x4e, 0x79, 0xfe, 0x96, 0x25, 0x59, 0x36,
-	0x31, 0x5c, 0x27, 0xbd, 0xd3, 0x39, 0x59, 0x36, 0xf9, 0xe5, 0x83, 0x71, 0xf6, 0xb8, 0x7e, 0x02,
-	0x3d, 0x9e, 0x28, 0xd3, 0x6d, 0x82, 0x6a, 0x5d, 0x93, 0xff, 0x8e, 0xc0, 0x60, 0xd0, 0xc3, 0x82,
-	0x52, 0x51, 0x54, 0xe7, 0x4a, 0x34, 0x07, 0xb6, 0x6d, 0x29, 0x7e, 0x41, 0x60, 0x28, 0x01, 0x13,
-	0x06, 0xe0, 0x21, 0xf4, 0xca, 0xde, 0xf1, 0xb2, 0x89, 0xc7, 0x6e, 0xda, 0x47, 0xe3, 0x62, 0x51,
-	0x37, 0xe5, 0x5a, 0x9a, 0x3f, 0x53, 0x0b, 0xca, 0xcf, 0xcf, 0xf3, 0xd9, 0xf0, 0x77, 0x56, 0x21,
-	0x2b, 0x87, 0x0f, 0xdb, 0x77, 0x3f, 0x1e, 0x13, 0xb8, 0x18, 0xa4, 0xfa, 0xb9, 0x5e, 0x34, 0x74,
-	0x59, 0xd3, 0xd5, 0xe3, 0xcf, 0xc3, 0x5f, 0x04, 0x46, 0xd3, 0x80, 0xc3, 0x84, 0x14, 0x21, 0xbb,
-	0xe6, 0x7e, 0x1f, 0xca, 0xc7, 0x58, 0x5c, 0x3e, 0x22, 0x4c, 0xe2, 0x2d, 0xa5, 0x9e, 0xb5, 0x23,
-	0x08, 0x7c, 0x15, 0x0b, 0xcb, 0x9f, 0x72, 0x2f, 0xc8, 0x98, 0xf2, 0x03, 0x41, 0xf6, 0x4e, 0x59,
-	0x90, 0xc3, 0xb9, 0xe8, 0x88, 0xc8, 0xc5, 0xd5, 0x37, 0xbf, 0x7e, 0x92, 0xcf, 0xbc, 0x78, 0x92,
-	0xcf, 0xf0, 0xeb, 0xd8, 0xb7, 0xc2, 0x97, 0x8c, 0x7e, 0x05, 0xd9, 0x88, 0xab, 0x8c, 0x55, 0xdd,
-	0xc4, 0x4d, 0x2e, 0xd0, 0xf0, 0x65, 0xe5, 0x37, 0x21, 0xcf, 0xfc, 0x46, 0x04, 0xfa, 0xa8, 0x29,
-	0xaf, 0x62, 0x6f, 0x89, 0x74, 0x8d, 0xdc, 0x6f, 0x41, 0xb7, 0x93, 0x67, 0xa4, 0xdb, 0xc2, 0x45,
-	0x41, 0x03, 0xfc, 0x0f, 0x6e, 0x2f, 0x5b, 0x70, 0x61, 0x47, 0xd7, 0x50, 0x1a, 0xae, 0x6d, 0xaa,
-	0x21, 0x5f, 0x30, 0x9e, 0xb9, 0x5d, 0x2d, 0x1a, 0x1d, 0x86, 0xa3, 0xd4, 0xb6, 0xae, 0xe6, 0xc4,
-	0xe6, 0x68, 0xdb, 0xd7, 0x8f, 0x6e, 0xfb, 0xf2, 0x38, 0x35, 0x68, 0x5f, 0xc7, 0x13, 0x7a, 0xaf,
-	0x91, 0x35, 0x80, 0xf9, 0x3a, 0x36, 0xb2, 0xff, 0x08, 0xbc, 0xc3, 0xb8, 0x15, 0x14, 0xb9, 0xe5,
-	0x90, 0x8f, 0x03, 0xb5, 0xcc, 0xd2, 0x72, 0x64, 0x75, 0x9f, 0xb6, 0xcc, 0xd2, 0x52, 0xe0, 0x7d,
-	0x19, 0x07, 0x2a, 0x5b, 0xf6, 0x41, 0xe9, 0x4e, 0x47, 0x5a, 0xb6, 0xec, 0xa5, 0x84, 0xd7, 0xa8,
-	0xab, 0x0d, 0xe9, 0xdc, 0x25, 0xc0, 0x45, 0x51, 0xc6, 0xf4, 0x69, 0xd0, 0x6f, 0x2a, 0x09, 0x45,
-	0x34, 0x1e, 0x97, 0x41, 0xbf, 0xb9, 0x03, 0x65, 0xd4, 0x67, 0x2a, 0x47, 0x3d, 0x07, 0xe4, 0x83,
-	0x37, 0x34, 0x3c, 0x59, 0x1f, 0x5b, 0xf9, 0x3c, 0x0d, 0xf5, 0xd5, 0xd7, 0x62, 0xf6, 0xde, 0x80,
-	0x5c, 0x0c, 0xea, 0xa3, 0x7e, 0xf7, 0xca, 0xb1, 0xc9, 0x6c, 0xf7, 0xf8, 0x7e, 0x19, 0x2b, 0xe1,
-	0xa6, 0x66, 0xd9, 0x86, 0xa9, 0x95, 0xa4, 0xca, 0x2d, 0x7d, 0xc5, 0xf0, 0xed, 0x62, 0x65, 0x45,
-	0x53, 0xcb, 0x36, 0xf3, 0xd0, 0x59, 0xc0, 0x4f, 0xfc, 0x3d, 0x38, 0x13, 0xa9, 0x85, 0xd8, 0xae,
-	0x42, 0x57, 0x59, 0xb3, 0x6c, 0x84, 0x75, 0x3e, 0x0e, 0xd6, 0x01, 0x6d, 0xa6, 0xc3, 0x53, 0x38,
-	0xcd, 0x4c, 0x2f, 0x1a, 0x46, 0x05, 0x61, 0xf0, 0x9f, 0xc2, 0x5b, 0xbe, 0x33, 0x74, 0x32, 0x0d,
-	0x5d, 0x55, 0xc3, 0xa8, 0xa0, 0x93, 0xb3, 0x71, 0x4e, 0x6a, 0x3a, 0x48, 0x9b, 0xc9, 0xf3, 0xbd,
-	0x40, 0x1d, 0x63, 0x92, 0x29, 0xad, 0xba, 0xb5, 0xc1, 0xdf, 0x85, 0x6c, 0xe0, 0x14, 0x9d, 0x5c,
-	0x83, 0xee, 0x2a, 0x3b, 0x41, 0x37, 0xb9, 0x58, 0x37, 0x4c, 0xca, 0x9d, 0x27, 0x1c, 0x9d, 0xc9,
-	0x97, 0x7d, 0xf0, 0x06, 0xb3, 0x4a, 0x1f, 0x13, 0x80, 0xfa, 0x9d, 0xa7, 0x42, 0x9c, 0x99, 0xe8,
-	0x9d, 0x98, 0x13, 0x53, 0xcb, 0xe3, 0xcc, 0x36, 0xf6, 0xe8, 0xf7, 0x7f, 0xbf, 0xef, 0x18, 0xa6,
-	0xef, 0x8a, 0x5a, 0xa9, 0x5a, 0x91, 0x1e, 0x4a, 0xa1, 0x75, 0xdc, 0x57, 0x30, 0x3f, 0x11, 0xe8,
-	0xf1, 0x6c, 0xd0, 0x4b, 0xe9, 0x7c, 0xb9, 0xd0, 0x84, 0xb4, 0xe2, 0x88, 0xec, 0x1a, 0x43, 0x36,
-	0x4d, 0x2f, 0xa7, 0x40, 0x26, 0x6e, 0x05, 0xcb, 0x66, 0x9b, 0xfe, 0x41, 0xa0, 0x37, 0x6a, 0xa9,
-	0xa3, 0xb3, 0xe9, 0x60, 0x84, 0x87, 0x0a, 0xee, 0x4a, 0x0b, 0x9a, 0xc8, 0xe5, 0x26, 0xe3, 0x32,
-	0x4f, 0x3f, 0x6e, 0x85, 0x8b, 0xe8, 0x7b, 0x7a, 0xe8, 0xff, 0x04, 0xce, 0x25, 0x2e, 0x49, 0x74,
-	0x2e, 0x1d, 0xcc, 0x84, 0xf1, 0x89, 0x9b, 0x3f, 0x8c, 0x09, 0xa4, 0x5c, 0x60, 0x94, 0x3f, 0xa3,
-	0xb7, 0x5b, 0xa2, 0x5c, 0x9f, 0x8a, 0xfc, 0xe4, 0x77, 0x08, 0x40, 0xdd, 0x57, 0x83, 0xe2, 0x08,
-	0x2d, 0x1f, 0x0d, 0x8a, 0x23, 0x3c, 0xd8, 0xf2, 0xf7, 0x18, 0x87, 0xbb, 0xf4, 0xce, 0x61, 0xd3,
-	0x26, 0x6e, 0x05, 0xbb, 0xff, 0x36, 0x7d, 0x49, 0x20, 0x1b, 0x11, 0x3f, 0x3a, 0x93, 0x88, 0x31,
-	0x7e, 0xb3, 0xe2, 0x66, 0x9b, 0x57, 0x44, 0x96, 0x3a, 0x63, 0x59, 0xa6, 0x2b, 0x6d, 0x67, 0x19,
-	0x99, 0x46, 0xfa, 0x1b, 0x81, 0xde, 0xa8, 0xcd, 0xa4, 0x41, 0x69, 0x26, 0xac, 0x5a, 0x0d, 0x4a,
-	0x33, 0x69, 0x0d, 0xe2, 0x3f, 0x60, 0xec, 0x67, 0xe8, 0xfb, 0xb1, 0xec, 0x13, 0xf3, 0x58, 0xab,
-	0xc7, 0xc4, 0x59, 0xbf, 0x41, 0x3d, 0xa6, 0x59, 0x67, 0x1a, 0xd4, 0x63, 0xaa, 0x55, 0x23, 0x45,
-	0x3d, 0x7a, 0xd4, 0x52, 0x26, 0xd2, 0xa2, 0xbf, 0x12, 0x38, 0x19, 0x98, 0x8c, 0xe9, 0x44, 0x22,
-	0xd2, 0xa8, 0xc5, 0x81, 0x9b, 0x6c, 0x46, 0x05, 0xc9, 0xdc, 0x66, 0x64, 0x16, 0xe8, 0x7c, 0x4b,
-	0x64, 0xcc, 0x00, 0xe4, 0x67, 0x04, 0xb2, 0x11, 0xe3, 0x66, 0x83, 0x4a, 0x8c, 0x9f, 0x9e, 0xb9,
-	0xd9, 0xe6, 0x15, 0x91, 0xd6, 0x0d, 0x46, 0x6b, 0x8e, 0x7e, 0xd4, 0x12, 0x2d, 0xdf, 0x43, 0xfd,
-	0x9c, 0x00, 0x0d, 0x3b, 0xa2, 0xd3, 0x4d, 0x22, 0x73, 0x19, 0xcd, 0x34, 0xad, 0x87, 0x84, 0xbe,
-	0x60, 0x84, 0x0a, 0x74, 0xf1, 0x90, 0x84, 0xc2, 0xef, 0xfb, 0x53, 0x02, 0xa7, 0x82, 0x63, 0x21,
-	0x4d, 0xbe, 0x48, 0x91, 0x73, 0x2b, 0x37, 0xd5, 0x94, 0x0e, 0xb2, 0xba, 0xc2, 0x58, 0x4d, 0xd1,
-	0x89, 0x58, 0x56, 0x65, 0x4f, 0x71, 0x59, 0xd3, 0x57, 0x0c, 0x71, 0xcb, 0x19, 0x87, 0xb7, 0xe9,
-	0x23, 0x02, 0x5d, 0xb5, 0x41, 0x93, 0x8e, 0x24, 0x3a, 0xf6, 0xcd, 0xb4, 0xdc, 0xc5, 0x14, 0x92,
-	0x08, 0x6c, 0x98, 0x01, 0xcb, 0xd3, 0x73, 0xb1, 0xc0, 0x6a, 0x83, 0x2d, 0xfd, 0x86, 0x40, 0xb7,
-	0x33, 0x86, 0xd2, 0xd1, 0x64, 0xe3, 0xfe, 0xc9, 0x97, 0x1b, 0x4b, 0x25, 0x8b, 0x50, 0x2e, 0x30,
-	0x28, 0x43, 0x34, 0x1f, 0x0f, 0xc5, 0x19, 0x84, 0xaf, 0xef, 0xec, 0xe5, 0xc8, 0xee, 0x5e, 0x8e,
-	0xfc, 0xb3, 0x97, 0x23, 0xdf, 0xee, 0xe7, 0x32, 0xbb, 0xfb, 0xb9, 0xcc, 0x9f, 0xfb, 0xb9, 0xcc,
-	0x97, 0xe3, 0xaa, 0x66, 0x97, 0xd7, 0x8a, 0x42, 0xc9, 0x58, 0x15, 0xf1, 0xa7, 0x22, 0xe7, 0xcf,
-	0x25, 0x4b, 0xbe, 0x2f, 0x6e, 0x78, 0x06, 0xed, 0xcd, 0xaa, 0x62, 0x15, 0xbb, 0xd9, 0xcf, 0x45,
-	0x53, 0xaf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x7c, 0x11, 0x2c, 0xf2, 0x1a, 0x00, 0x00,
+	// 1463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4b, 0x6c, 0x1b, 0x55,
+	0x17, 0xf6, 0x6d, 0xf2, 0x47, 0x7f, 0x4f, 0x1f, 0x84, 0xeb, 0x34, 0x4d, 0xa7, 0xad, 0x9d, 0x4e,
+	0x29, 0x4d, 0xd3, 0xd4, 0x43, 0xd2, 0x77, 0x55, 0x1e, 0x35, 0xa5, 0x2f, 0x40, 0x4a, 0x5d, 0x11,
+	0x51, 0x90, 0x08, 0x63, 0xcf, 0xc4, 0x1e, 0xd5, 0x9e, 0x71, 0x67, 0x26, 0x51, 0xd2, 0x28, 0x9b,
+	0xae, 0x10, 0x2b, 0x10, 0xbb, 0xb2, 0xe9, 0x82, 0x05, 0x82, 0x6d, 0xd7, 0x2c, 0x60, 0x13, 0xb1,
+	0x4a, 0x85, 0x90, 0x60, 0xd3, 0x56, 0x09, 0x12, 0x5d, 0xb2, 0x44, 0xac, 0x90, 0xef, 0x9c, 0x19,
+	0xcf, 0x78, 0x1e, 0x9e, 0x71, 0x6c, 0x45, 0x65, 0x95, 0xf8, 0xce, 0x39, 0xf7, 0x7c, 0xdf, 0x39,
+	0xf7, 0x9c, 0xb9, 0x9f, 0x0d, 0x7c, 0x49, 0x33, 0x6a, 0x9a, 0x21, 0x18, 0xa6, 0x78, 0x47, 0x51,
+	0xcb, 0xc2, 0xc2, 0x64, 0x51, 0x36, 0xc5, 0x49, 0xe1, 0xee, 0xbc, 0xac, 0x2f, 0xe5, 0xea, 0xba,
+	0x66, 0x6a, 0x74, 0xd8, 0xb2, 0xc9, 0xa1, 0x4d, 0x0e, 0x6d, 0xb8, 0x71, 0xf4, 0x2d, 0x8a, 0x86,
+	0x6c, 0x39, 0x38, 0xee, 0x75, 0xb1, 0xac, 0xa8, 0xa2, 0xa9, 0x68, 0xaa, 0xb5, 0x07, 0x37, 0x54,
+	0xd6, 0xca, 0x1a, 0xfb, 0x57, 0x68, 0xfc, 0x87, 0xab, 0x07, 0xca, 0x9a, 0x56, 0xae, 0xca, 0x82,
+	0x58, 0x57, 0x04, 0x51, 0x55, 0x35, 0x93, 0xb9, 0x18, 0xf8, 0xf4, 0x95, 0x10, 0x6c, 0x36, 0x0e,
+	0x66, 0xc5, 0x2f, 0xc2, 0xf0, 0xcd, 0x46, 0xec, 0x19, 0xb1, 0xaa, 0x48, 0xa2, 0xa9, 0xe9, 0x46,
+	0x41, 0xbe, 0x3b, 0x2f, 0x1b, 0x26, 0x1d, 0x86, 0x01, 0xc3, 0x14, 0xcd, 0x79, 0x63, 0x84, 0x8c,
+	0x92, 0xb1, 0xed, 0x05, 0xfc, 0x44, 0xaf, 0x00, 0x34, 0xf1, 0x8d, 0x6c, 0x1b, 0x25, 0x63, 0x3b,
+	0xa6, 0x5e, 0xcd, 0x21, 0xc9, 0x06, 0x99, 0x9c, 0xc5, 0x1e, 0xe3, 0xe5, 0xa6, 0xc5, 0xb2, 0x8c,
+	0x7b, 0x16, 0x5c, 0x9e, 0xfc, 0xf7, 0x04, 0xf6, 0xfa, 0x42, 0x1b, 0x75, 0x4d, 0x35, 0x64, 0x7a,
+	0x15, 0x60, 0xc1, 0x59, 0x1d, 0x21, 0xa3, 0x7d, 0x63, 0x3b, 0xa6, 0x0e, 0xe5, 0x82, 0x13, 0x99,
+	0x73, 0xfc, 0xf3, 0xfd, 0xab, 0x4f, 0xb2, 0xa9, 0x82, 0xcb, 0xb5, 0xb1, 0x91, 0x0f, 0xec, 0xd1,
+	0xb6, 0x60, 0x2d, 0x14, 0x1e, 0xb4, 0x6f, 0xc0, 0x1e, 0x2f, 0x58, 0x3b, 0x4d, 0x47, 0x60, 0xb7,
+	0x13, 0x6f, 0x56, 0x94, 0x24, 0x1d, 0xd3, 0xb5, 0xcb, 0x59, 0xbd, 0x24, 0x49, 0x3a, 0x3f, 0xdb,
+	0x9a, 0x67, 0x87, 0xeb, 0x3b, 0xb0, 0xdd, 0x31, 0x65, 0xbe, 0x09, 0xa8, 0x36, 0x3d, 0xf9, 0x2f,
+	0x09, 0x8c, 0x7a, 0x23, 0x5c, 0x96, 0xab, 0x72, 0xd9, 0x3a, 0x12, 0xc9, 0xc0, 0x76, 0xad, 0xc4,
+	0xcf, 0x09, 0x1c, 0x8a, 0xc0, 0x84, 0x09, 0xb8, 0x07, 0x43, 0x92, 0xb3, 0x3c, 0xab, 0xe3, 0xb2,
+	0x5d, 0xf6, 0xf1, 0xb0, 0x5c, 0x34, 0xb7, 0xb2, 0x77, 0xca, 0xef, 0x6f, 0x24, 0xe5, 0xbb, 0xa7,
+	0xd9, 0xb4, 0xff, 0x99, 0x51, 0x48, 0x4b, 0xfe, 0xc5, 0xee, 0x9d, 0x8f, 0x07, 0x04, 0x8e, 0x79,
+	0xa9, 0x7e, 0xa0, 0x16, 0x35, 0x55, 0x52, 0xd4, 0xf2, 0xd6, 0xd7, 0xe1, 0x77, 0x02, 0xe3, 0x71,
+	0xc0, 0x61, 0x41, 0x8a, 0x90, 0x9e, 0xb7, 0x9f, 0xfb, 0xea, 0x71, 0x3c, 0xac, 0x1e, 0x01, 0x5b,
+	0xe2, 0x29, 0xa5, 0xce, 0x6e, 0x3d, 0x48, 0x7c, 0x1d, 0x1b, 0xcb, 0x5d, 0x72, 0x27, 0xc9, 0x58,
+	0xf2, 0x96, 0x24, 0x3b, 0xab, 0x2c, 0xc9, 0xfe, 0x5a, 0x6c, 0x0b, 0xa8, 0xc5, 0x85, 0xff, 0x7f,
+	0xf6, 0x30, 0x9b, 0x7a, 0xfe, 0x30, 0x9b, 0xe2, 0x17, 0x60, 0xaf, 0x2f, 0x22, 0x66, 0xee, 0x63,
+	0x48, 0x07, 0x1c, 0x65, 0xec, 0xea, 0x04, 0x27, 0xb9, 0x40, 0xfd, 0x87, 0x95, 0x5f, 0x82, 0x2c,
+	0x8b, 0x1b, 0x90, 0xe8, 0x5e, 0x53, 0xae, 0xc1, 0x68, 0x78, 0x68, 0xe4, 0x7e, 0x1d, 0x06, 0xac,
+	0x3a, 0x23, 0xdd, 0x0e, 0x0e, 0x0a, 0x6e, 0xc0, 0x7f, 0x6d, 0xcf, 0xb2, 0xcb, 0x36, 0xec, 0xe0,
+	0x1e, 0x8a, 0xc3, 0xb5, 0x4b, 0x3d, 0xe4, 0x4a, 0xc6, 0x63, 0x7b, 0xaa, 0x05, 0xa3, 0xc3, 0x74,
+	0x94, 0xba, 0x36, 0xd5, 0xac, 0xdc, 0xf4, 0x76, 0x7c, 0x7d, 0x63, 0x8f, 0x2f, 0x87, 0x53, 0x9b,
+	0xf1, 0xb5, 0x35, 0xa9, 0x77, 0x06, 0x59, 0x1b, 0x98, 0x2f, 0xe2, 0x20, 0xfb, 0x8b, 0xc0, 0x3e,
+	0xc6, 0xad, 0x20, 0x4b, 0x1d, 0xa7, 0x7c, 0x02, 0xa8, 0xa1, 0x97, 0x66, 0x03, 0xbb, 0x7b, 0xd0,
+	0xd0, 0x4b, 0x33, 0x9e, 0xf7, 0xcb, 0x04, 0x50, 0xc9, 0x30, 0x5b, 0xad, 0xfb, 0x2c, 0x6b, 0xc9,
+	0x30, 0x67, 0x22, 0xde, 0x46, 0xfd, 0x5d, 0x28, 0xe7, 0x1a, 0x01, 0x2e, 0x88, 0x32, 0x96, 0x4f,
+	0x81, 0x61, 0x5d, 0x8e, 0x68, 0xa2, 0x89, 0xb0, 0x0a, 0xba, 0xb7, 0x6b, 0x69, 0xa3, 0x3d, 0xba,
+	0xdc, 0xeb, 0x7b, 0x40, 0xd6, 0x7b, 0x42, 0xfd, 0x37, 0xeb, 0x2d, 0x6b, 0x9f, 0x47, 0xbe, 0xb9,
+	0xfa, 0x42, 0xdc, 0xbd, 0x17, 0x21, 0x13, 0x82, 0xba, 0xd7, 0xef, 0xbd, 0x4a, 0x68, 0x31, 0xbb,
+	0x7d, 0x7d, 0x3f, 0x85, 0x9d, 0x70, 0x4d, 0x31, 0x4c, 0x4d, 0x57, 0x4a, 0x62, 0xf5, 0xba, 0x3a,
+	0xa7, 0xb9, 0xb4, 0x58, 0x45, 0x56, 0xca, 0x15, 0x93, 0x45, 0xe8, 0x2b, 0xe0, 0x27, 0xfe, 0x36,
+	0xec, 0x0f, 0xf4, 0x42, 0x6c, 0x17, 0xa0, 0xbf, 0xa2, 0x18, 0xe6, 0x08, 0xf1, 0x9e, 0x9d, 0x56,
+	0x58, 0x2d, 0xde, 0xcc, 0x87, 0xa7, 0x30, 0xc8, 0xb6, 0x9e, 0xd6, 0xb4, 0x2a, 0xc2, 0xe0, 0xdf,
+	0x85, 0x97, 0x5d, 0x6b, 0x18, 0xe4, 0x0c, 0xf4, 0xd7, 0x35, 0xad, 0x8a, 0x41, 0x0e, 0x84, 0x05,
+	0x69, 0xf8, 0x20, 0x6d, 0x66, 0xcf, 0x0f, 0x01, 0xb5, 0x36, 0x13, 0x75, 0xb1, 0x66, 0xf7, 0x06,
+	0x7f, 0x0b, 0xd2, 0x9e, 0x55, 0x0c, 0x72, 0x11, 0x06, 0xea, 0x6c, 0x05, 0xc3, 0x64, 0x42, 0xc3,
+	0x30, 0x2b, 0xfb, 0x3e, 0x61, 0xf9, 0xf0, 0x0b, 0x70, 0x00, 0xc7, 0x4c, 0x49, 0xab, 0xd5, 0x44,
+	0x55, 0x92, 0xf5, 0xb7, 0x2b, 0xa2, 0xd2, 0xf3, 0x6b, 0x93, 0x0a, 0x07, 0x43, 0xe2, 0x22, 0xad,
+	0xf7, 0x61, 0xa7, 0xde, 0x7c, 0x66, 0x77, 0xdb, 0xe1, 0xf0, 0xb9, 0xe6, 0xd8, 0x22, 0x43, 0x8f,
+	0x3b, 0xff, 0x03, 0x81, 0x91, 0x96, 0x80, 0xb2, 0x33, 0x75, 0x8e, 0xc1, 0xa0, 0xcb, 0xd8, 0x4d,
+	0xf3, 0x25, 0xd7, 0x7a, 0x02, 0xa2, 0x2d, 0x03, 0xaa, 0xaf, 0x0b, 0x03, 0xea, 0x59, 0xf3, 0x1d,
+	0xe8, 0x26, 0xf0, 0x1f, 0x12, 0x8a, 0x53, 0x3f, 0xed, 0x83, 0xff, 0x31, 0x8a, 0xf4, 0x01, 0x01,
+	0x68, 0xce, 0x5f, 0x9a, 0x0b, 0xc3, 0x1f, 0xfc, 0xfd, 0x0c, 0x27, 0xc4, 0xb6, 0x47, 0xfd, 0x70,
+	0xfc, 0xfe, 0x2f, 0x7f, 0x7c, 0xb5, 0xed, 0x08, 0x3d, 0x2c, 0x28, 0xa5, 0x7a, 0x55, 0xbc, 0x27,
+	0xfa, 0xbe, 0x1a, 0x72, 0x0d, 0xef, 0x6f, 0x09, 0x6c, 0x77, 0xf6, 0xa0, 0x27, 0xe2, 0xc5, 0xb2,
+	0xa1, 0xe5, 0xe2, 0x9a, 0x23, 0xb2, 0x8b, 0x0c, 0xd9, 0x19, 0x7a, 0x2a, 0x06, 0x32, 0x61, 0xd9,
+	0x7b, 0x34, 0x57, 0xe8, 0xaf, 0x04, 0x86, 0x82, 0xbe, 0x60, 0xa0, 0xe7, 0xe2, 0xc1, 0xf0, 0x5f,
+	0x70, 0xb9, 0xf3, 0x1d, 0x78, 0x22, 0x97, 0x6b, 0x8c, 0x4b, 0x9e, 0xbe, 0xd5, 0x09, 0x17, 0xc1,
+	0x75, 0x0d, 0xa2, 0xff, 0x10, 0x38, 0x18, 0x29, 0xd8, 0xe9, 0xa5, 0x78, 0x30, 0x23, 0xae, 0xf2,
+	0x5c, 0x7e, 0x33, 0x5b, 0x20, 0xe5, 0x02, 0xa3, 0xfc, 0x1e, 0xbd, 0xd1, 0x11, 0xe5, 0xe6, 0x0d,
+	0xdd, 0x4d, 0x7e, 0x95, 0x00, 0x34, 0x63, 0xb5, 0x69, 0x0e, 0x9f, 0x10, 0xe6, 0x84, 0xd8, 0xf6,
+	0xc8, 0xe1, 0x36, 0xe3, 0x70, 0x8b, 0xde, 0xdc, 0x6c, 0xd9, 0x84, 0x65, 0xef, 0xab, 0x64, 0x85,
+	0xfe, 0x4d, 0x20, 0x1d, 0x90, 0x3f, 0x7a, 0x36, 0x12, 0x63, 0xb8, 0xca, 0xe7, 0xce, 0x25, 0x77,
+	0x44, 0x96, 0x2a, 0x63, 0x59, 0xa1, 0x73, 0x5d, 0x67, 0x19, 0x58, 0x46, 0xfa, 0x33, 0x81, 0xa1,
+	0x20, 0x95, 0xdc, 0xa6, 0x35, 0x23, 0x64, 0x7f, 0x9b, 0xd6, 0x8c, 0x92, 0xe4, 0xfc, 0xeb, 0x8c,
+	0xfd, 0x59, 0x7a, 0x3a, 0x94, 0x7d, 0x64, 0x1d, 0x1b, 0xfd, 0x18, 0xa9, 0x3b, 0xdb, 0xf4, 0x63,
+	0x1c, 0x69, 0xdd, 0xa6, 0x1f, 0x63, 0xc9, 0xde, 0x18, 0xfd, 0xe8, 0x50, 0x8b, 0x59, 0x48, 0x83,
+	0xfe, 0x48, 0x60, 0x97, 0x47, 0xa5, 0xd1, 0xc9, 0x48, 0xa4, 0x41, 0x22, 0x96, 0x9b, 0x4a, 0xe2,
+	0x82, 0x64, 0x6e, 0x30, 0x32, 0x97, 0x69, 0xbe, 0x23, 0x32, 0xba, 0x07, 0xf2, 0x63, 0x02, 0xe9,
+	0x00, 0xe9, 0xd3, 0xa6, 0x13, 0xc3, 0x95, 0x1c, 0x77, 0x2e, 0xb9, 0x23, 0xd2, 0xba, 0xca, 0x68,
+	0x5d, 0xa2, 0x6f, 0x76, 0x44, 0xcb, 0xf5, 0xa2, 0x7e, 0x4a, 0x80, 0xfa, 0x03, 0xd1, 0x33, 0x09,
+	0x91, 0xd9, 0x8c, 0xce, 0x26, 0xf6, 0x43, 0x42, 0x1f, 0x32, 0x42, 0x05, 0x3a, 0xbd, 0x49, 0x42,
+	0xfe, 0xf7, 0xfb, 0x23, 0x02, 0xbb, 0xbd, 0x12, 0x85, 0x46, 0x1f, 0xa4, 0x40, 0x0d, 0xc5, 0x9d,
+	0x4c, 0xe4, 0x83, 0xac, 0xce, 0x33, 0x56, 0x27, 0xe9, 0x64, 0x28, 0xab, 0x8a, 0xe3, 0x38, 0xab,
+	0xa8, 0x73, 0x9a, 0xb0, 0x6c, 0x49, 0xb3, 0x15, 0x7a, 0x9f, 0x40, 0x7f, 0x43, 0xf4, 0xd0, 0xb1,
+	0xc8, 0xc0, 0x2e, 0x7d, 0xc5, 0x1d, 0x8b, 0x61, 0x89, 0xc0, 0x8e, 0x30, 0x60, 0x59, 0x7a, 0x30,
+	0x14, 0x58, 0x43, 0x64, 0xd1, 0xcf, 0x09, 0x0c, 0x58, 0x92, 0x88, 0x8e, 0x47, 0x6f, 0xee, 0x56,
+	0x61, 0xdc, 0xf1, 0x58, 0xb6, 0x08, 0xe5, 0x28, 0x83, 0x72, 0x88, 0x66, 0xc3, 0xa1, 0x58, 0x08,
+	0xfe, 0x24, 0x30, 0xd8, 0x2a, 0x85, 0xe8, 0xa9, 0x36, 0x33, 0x21, 0x50, 0xb1, 0x71, 0xa7, 0x13,
+	0x7a, 0x21, 0xd4, 0x39, 0x06, 0xf5, 0x53, 0xfa, 0x49, 0xf7, 0xdf, 0x7f, 0x6e, 0x21, 0x46, 0x9f,
+	0x10, 0xd8, 0xe9, 0x96, 0x30, 0xf4, 0xb5, 0x98, 0x78, 0x1d, 0xb9, 0xc6, 0x4d, 0x26, 0xf0, 0x40,
+	0x76, 0x0a, 0x63, 0x57, 0xa2, 0x62, 0x47, 0xec, 0xdc, 0x04, 0x84, 0xe5, 0x56, 0xa9, 0xe8, 0x7e,
+	0x2e, 0x1b, 0xf9, 0x2b, 0xab, 0xeb, 0x19, 0xb2, 0xb6, 0x9e, 0x21, 0xcf, 0xd6, 0x33, 0xe4, 0x8b,
+	0x8d, 0x4c, 0x6a, 0x6d, 0x23, 0x93, 0xfa, 0x6d, 0x23, 0x93, 0xfa, 0x68, 0xa2, 0xac, 0x98, 0x95,
+	0xf9, 0x62, 0xae, 0xa4, 0xd5, 0x04, 0xfc, 0x05, 0xda, 0xfa, 0x73, 0xc2, 0x90, 0xee, 0x08, 0x8b,
+	0x0e, 0x24, 0x73, 0xa9, 0x2e, 0x1b, 0xc5, 0x01, 0xf6, 0x2b, 0xf4, 0xc9, 0x7f, 0x07, 0x00, 0x0c,
+	0x80, 0x76, 0xb8, 0x49, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1554,6 +1757,12 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RecommanderChain queries the chain of recommanders which share the
+	// recommanders rewards of a given delegator validator pair.
+	RecommanderChain(ctx context.Context, in *QueryRecommanderChainRequest, opts ...grpc.CallOption) (*QueryRecommanderChainResponse, error)
+	// Recommandees queries all delegations to a given validator which are
+	// recommended by a given recommander.
+	Recommandees(ctx context.Context, in *QueryRecommandeesRequest, opts ...grpc.CallOption) (*QueryRecommandeesResponse, error)
 }
 
 type queryClient struct {
@@ -1690,6 +1899,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RecommanderChain(ctx context.Context, in *QueryRecommanderChainRequest, opts ...grpc.CallOption) (*QueryRecommanderChainResponse, error) {
+	out := new(QueryRecommanderChainResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/RecommanderChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Recommandees(ctx context.Context, in *QueryRecommandeesRequest, opts ...grpc.CallOption) (*QueryRecommandeesResponse, error) {
+	out := new(QueryRecommandeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/Recommandees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1724,6 +1951,12 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RecommanderChain queries the chain of recommanders which share the
+	// recommanders rewards of a given delegator validator pair.
+	RecommanderChain(context.Context, *QueryRecommanderChainRequest) (*QueryRecommanderChainResponse, error)
+	// Recommandees queries all delegations to a given validator which are
+	// recommended by a given recommander.
+	Recommandees(context.Context, *QueryRecommandeesRequest) (*QueryRecommandeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1772,6 +2005,12 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RecommanderChain(ctx context.Context, req *QueryRecommanderChainRequest) (*QueryRecommanderChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommanderChain not implemented")
+}
+func (*UnimplementedQueryServer) Recommandees(ctx context.Context, req *QueryRecommandeesRequest) (*QueryRecommandeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recommandees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2029,6 +2268,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecommanderChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecommanderChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecommanderChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/RecommanderChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecommanderChain(ctx, req.(*QueryRecommanderChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Recommandees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecommandeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Recommandees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/Recommandees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Recommandees(ctx, req.(*QueryRecommandeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2089,6 +2364,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RecommanderChain",
+			Handler:    _Query_RecommanderChain_Handler,
+		},
+		{
+			MethodName: "Recommandees",
+			Handler:    _Query_Recommandees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3195,86 +3478,258 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRecommanderChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryRecommanderChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRecommanderChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRecommanderChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Validator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryRecommanderChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRecommanderChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Recommanders) > 0 {
+		for iNdEx := len(m.Recommanders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recommanders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommandeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommandeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommandeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecommanderAddr) > 0 {
+		i -= len(m.RecommanderAddr)
+		copy(dAtA[i:], m.RecommanderAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecommanderAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommandeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommandeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommandeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegationResponses) > 0 {
+		for iNdEx := len(m.DelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
@@ -3641,6 +4096,78 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRecommanderChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecommanderChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recommanders) > 0 {
+		for _, e := range m.Recommanders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecommandeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecommanderAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecommandeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DelegationResponses) > 0 {
+		for _, e := range m.DelegationResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6557,6 +7084,474 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRecommanderChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecommanderChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecommanderChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecommanderChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecommanderChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecommanderChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recommanders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recommanders = append(m.Recommanders, Recommander{})
+			if err := m.Recommanders[len(m.Recommanders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecommandeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecommandeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecommandeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommanderAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommanderAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecommandeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecommandeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecommandeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationResponses = append(m.DelegationResponses, DelegationResponse{})
+			if err := m.DelegationResponses[len(m.DelegationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
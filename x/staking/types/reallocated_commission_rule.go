@@ -1,13 +1,19 @@
 package types
 
 import (
+	"sort"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// DefaultMaxRCommissionRuleChange is the maximum daily change of the rates of a
+// reallocated commission rule which does not define its own limits.
+var DefaultMaxRCommissionRuleChange = sdk.NewDecWithPrec(1, 2)
+
 // NewReallocatedCommissionRule returns an initialized validator reallocated commission rule.
 func NewReallocatedCommissionRule(validatorRate, recommandersRate sdk.Dec, incentiveDepth uint32, recommanderClassRates []RecommanderClassRate) ReallocatedCommissionRule {
 	return ReallocatedCommissionRule{
@@ -16,6 +22,10 @@ func NewReallocatedCommissionRule(validatorRate, recommandersRate sdk.Dec, incen
 		IncentiveDepth:        incentiveDepth,
 		RecommanderClassRates: recommanderClassRates,
 		UpdateTime:            time.Unix(0, 0).UTC(),
+
+		MaxValidatorRateChange:    DefaultMaxRCommissionRuleChange,
+		MaxRecommandersRateChange: DefaultMaxRCommissionRuleChange,
+		MaxClassRateChange:        DefaultMaxRCommissionRuleChange,
 	}
 }
 
@@ -28,9 +38,22 @@ func NewReallocatedCommissionRuleWithTime(validatorRate, recommandersRate sdk.De
 		IncentiveDepth:        incentiveDepth,
 		RecommanderClassRates: recommanderClassRates,
 		UpdateTime:            updatedAt,
+
+		MaxValidatorRateChange:    DefaultMaxRCommissionRuleChange,
+		MaxRecommandersRateChange: DefaultMaxRCommissionRuleChange,
+		MaxClassRateChange:        DefaultMaxRCommissionRuleChange,
 	}
 }
 
+// WithMaxChangeRates returns the reallocated commission rule with the given
+// maximum daily changes of its rates.
+func (c ReallocatedCommissionRule) WithMaxChangeRates(maxValidatorRateChange, maxRecommandersRateChange, maxClassRateChange sdk.Dec) ReallocatedCommissionRule {
+	c.MaxValidatorRateChange = maxValidatorRateChange
+	c.MaxRecommandersRateChange = maxRecommandersRateChange
+	c.MaxClassRateChange = maxClassRateChange
+	return c
+}
+
 // String implements the Stringer interface for a ReallocatedCommissionRule object.
 func (c ReallocatedCommissionRule) String() string {
 	out, _ := yaml.Marshal(c)
@@ -70,9 +93,95 @@ func (c ReallocatedCommissionRule) Validate() error {
 		return err
 	}
 
+	// unset max change rates fall back to the default ones
+	maxValidatorRateChange, maxRecommandersRateChange, maxClassRateChange := c.MaxChangeRates()
+	for _, maxChange := range []sdk.Dec{maxValidatorRateChange, maxRecommandersRateChange, maxClassRateChange} {
+		if maxChange.IsNegative() || maxChange.GT(sdk.OneDec()) {
+			return ErrRCommissionRuleMaxChangeInvalid
+		}
+	}
+
+	return nil
+}
+
+// ValidateNewRule performs basic sanity validation checks of a new reallocated
+// commission rule, the rule can be changed once every 24h and its rates cannot
+// move, up or down, more than the max change rates of the current rule. The
+// rate of a recommander class missing from a rule is zero. If validation fails,
+// an SDK error is returned.
+func (c ReallocatedCommissionRule) ValidateNewRule(newRule ReallocatedCommissionRule, blockTime time.Time) error {
+	if blockTime.Sub(c.UpdateTime).Hours() < 24 {
+		// new rule cannot be changed more than once within 24 hours
+		return ErrRCommissionRuleUpdateTime
+	}
+
+	if err := newRule.Validate(); err != nil {
+		return err
+	}
+
+	maxValidatorRateChange, maxRecommandersRateChange, maxClassRateChange := c.MaxChangeRates()
+	if newRule.ValidatorRate.Sub(c.ValidatorRate).Abs().GT(maxValidatorRateChange) {
+		return sdkerrors.Wrapf(ErrValidatorRateGTMaxChange, "from %s to %s", c.ValidatorRate, newRule.ValidatorRate)
+	}
+
+	if newRule.RecommandersRate.Sub(c.RecommandersRate).Abs().GT(maxRecommandersRateChange) {
+		return sdkerrors.Wrapf(ErrRecommandersRateGTMaxChange, "from %s to %s", c.RecommandersRate, newRule.RecommandersRate)
+	}
+
+	rates := classRatesByIndex(c.RecommanderClassRates)
+	newRates := classRatesByIndex(newRule.RecommanderClassRates)
+	indexes := make([]uint32, 0, len(rates)+len(newRates))
+	for index := range rates {
+		indexes = append(indexes, index)
+	}
+	for index := range newRates {
+		if _, ok := rates[index]; !ok {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	for _, index := range indexes {
+		rate, ok := rates[index]
+		if !ok {
+			rate = sdk.ZeroDec()
+		}
+		newRate, ok := newRates[index]
+		if !ok {
+			newRate = sdk.ZeroDec()
+		}
+
+		if newRate.Sub(rate).Abs().GT(maxClassRateChange) {
+			return sdkerrors.Wrapf(ErrRecommanderClassRateGTMaxChange, "class %d from %s to %s", index, rate, newRate)
+		}
+	}
+
 	return nil
 }
 
 func (c ReallocatedCommissionRule) validateRecommanderClassRates() error {
 	return validateRecommanderClassRates(c.RecommanderClassRates)
 }
+
+// MaxChangeRates returns the maximum daily changes of the validator rate, the
+// recommanders rate and the recommander class rates of the rule.
+func (c ReallocatedCommissionRule) MaxChangeRates() (sdk.Dec, sdk.Dec, sdk.Dec) {
+	return maxChangeOrDefault(c.MaxValidatorRateChange), maxChangeOrDefault(c.MaxRecommandersRateChange), maxChangeOrDefault(c.MaxClassRateChange)
+}
+
+// maxChangeOrDefault returns the default max change rate for the rules which
+// were created before the max change rates were introduced.
+func maxChangeOrDefault(maxChange sdk.Dec) sdk.Dec {
+	if maxChange.IsNil() {
+		return DefaultMaxRCommissionRuleChange
+	}
+	return maxChange
+}
+
+func classRatesByIndex(classRates []RecommanderClassRate) map[uint32]sdk.Dec {
+	rates := make(map[uint32]sdk.Dec, len(classRates))
+	for _, classRate := range classRates {
+		rates[classRate.Index] = classRate.Rate
+	}
+	return rates
+}
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestReallocatedCommissionRuleValidateNewRule(t *testing.T) {
+	now := time.Now().UTC()
+	classRates := func(rates ...int64) []types.RecommanderClassRate {
+		classRates := make([]types.RecommanderClassRate, len(rates))
+		for i, rate := range rates {
+			classRates[i] = types.RecommanderClassRate{Index: uint32(i), Rate: sdk.NewDecWithPrec(rate, 2)}
+		}
+		return classRates
+	}
+	newRule := func(validatorRate, recommandersRate int64, rates ...int64) types.ReallocatedCommissionRule {
+		return types.NewReallocatedCommissionRuleWithTime(
+			sdk.NewDecWithPrec(validatorRate, 2), sdk.NewDecWithPrec(recommandersRate, 2), uint32(len(rates)), classRates(rates...), now,
+		)
+	}
+
+	rule := types.NewReallocatedCommissionRuleWithTime(
+		sdk.NewDecWithPrec(50, 2), sdk.NewDecWithPrec(50, 2), 2, classRates(30, 20), now.Add(-25*time.Hour),
+	).WithMaxChangeRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 2))
+
+	testCases := []struct {
+		name      string
+		rule      types.ReallocatedCommissionRule
+		newRule   types.ReallocatedCommissionRule
+		blockTime time.Time
+		expErr    error
+	}{
+		{"unchanged", rule, newRule(50, 50, 30, 20), now, nil},
+		{"within limits", rule, newRule(55, 45, 26, 19), now, nil},
+		{"too soon", rule, newRule(50, 50, 30, 20), now.Add(-2 * time.Hour), types.ErrRCommissionRuleUpdateTime},
+		{"validator rate increase", rule, newRule(56, 44, 30, 14), now, types.ErrValidatorRateGTMaxChange},
+		{"validator rate decrease", rule, newRule(44, 50, 30, 20), now, types.ErrValidatorRateGTMaxChange},
+		{"recommanders rate decrease", rule, newRule(50, 44, 30, 14), now, types.ErrRecommandersRateGTMaxChange},
+		{"class rate change", rule, newRule(50, 50, 36, 14), now, types.ErrRecommanderClassRateGTMaxChange},
+		{"class removed", rule, newRule(50, 50, 30), now, types.ErrRecommanderClassRateGTMaxChange},
+		{"class added", rule, newRule(50, 50, 27, 18, 5), now, nil},
+		{"invalid new rule", rule, newRule(50, 54, 30, 20), now, types.ErrCommissionHuge},
+		{
+			"legacy rule uses the default limits", rule.WithMaxChangeRates(sdk.Dec{}, sdk.Dec{}, sdk.Dec{}),
+			newRule(52, 48, 30, 20), now, types.ErrValidatorRateGTMaxChange,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.ValidateNewRule(tc.newRule, tc.blockTime)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReallocatedCommissionRuleValidateMaxChangeRates(t *testing.T) {
+	rule := types.NewReallocatedCommissionRule(sdk.OneDec(), sdk.ZeroDec(), 0, []types.RecommanderClassRate{})
+	require.NoError(t, rule.Validate())
+
+	// rules of older clients and gentxs don't set the max change rates
+	require.NoError(t, rule.WithMaxChangeRates(sdk.Dec{}, sdk.Dec{}, sdk.Dec{}).Validate())
+
+	require.ErrorIs(t, rule.WithMaxChangeRates(sdk.ZeroDec(), sdk.NewDec(-1), sdk.ZeroDec()).Validate(), types.ErrRCommissionRuleMaxChangeInvalid)
+	require.ErrorIs(t, rule.WithMaxChangeRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewDec(2)).Validate(), types.ErrRCommissionRuleMaxChangeInvalid)
+}
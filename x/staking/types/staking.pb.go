@@ -337,9 +337,15 @@ type Validator struct {
 	// reallocated_commission_rule is all the rule of reallocated commission.
 	ReallocatedCommissionRule ReallocatedCommissionRule `protobuf:"bytes,12,opt,name=reallocated_commission_rule,json=reallocatedCommissionRule,proto3" json:"reallocated_commission_rule"`
 	// incentive_team_address define the address of team to receive reward.
+	//
+	// Deprecated: the team commission is paid to the incentive_team_recipients,
+	// the address is only kept to migrate the validators of older versions.
 	IncentiveTeamAddress string `protobuf:"bytes,13,opt,name=incentive_team_address,json=incentiveTeamAddress,proto3" json:"incentive_team_address,omitempty" yaml:"incentive_team_address"`
 	// delegators define the delegator number (incl. self-delegation).
 	Delegators github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=delegators,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegators"`
+	// incentive_team_recipients define the addresses of the team which receive
+	// the team commission, in proportion to their weights.
+	IncentiveTeamRecipients []IncentiveTeamRecipient `protobuf:"bytes,15,rep,name=incentive_team_recipients,json=incentiveTeamRecipients,proto3" json:"incentive_team_recipients" yaml:"incentive_team_recipients"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -854,6 +860,9 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
 	// max_incentive_depth is the max depth of incentive to reallocate commission.
 	MaxIncentiveDepth uint32 `protobuf:"varint,6,opt,name=max_incentive_depth,json=maxIncentiveDepth,proto3" json:"max_incentive_depth,omitempty" yaml:"max_incentive_depth"`
+	// max_recommandees is the max number of delegations to a validator an account
+	// other than the validator operator can recommend.
+	MaxRecommandees uint32 `protobuf:"varint,7,opt,name=max_recommandees,json=maxRecommandees,proto3" json:"max_recommandees,omitempty" yaml:"max_recommandees"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -930,6 +939,13 @@ func (m *Params) GetMaxIncentiveDepth() uint32 {
 	return 0
 }
 
+func (m *Params) GetMaxRecommandees() uint32 {
+	if m != nil {
+		return m.MaxRecommandees
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1172,6 +1188,101 @@ func (m *RecommanderClassRate) GetIndex() uint32 {
 	return 0
 }
 
+// IncentiveTeamRecipient defines an address of the team of a validator and its
+// share of the team commission.
+type IncentiveTeamRecipient struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Weight  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *IncentiveTeamRecipient) Reset()         { *m = IncentiveTeamRecipient{} }
+func (m *IncentiveTeamRecipient) String() string { return proto.CompactTextString(m) }
+func (*IncentiveTeamRecipient) ProtoMessage()    {}
+func (*IncentiveTeamRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *IncentiveTeamRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveTeamRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveTeamRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveTeamRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveTeamRecipient.Merge(m, src)
+}
+func (m *IncentiveTeamRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveTeamRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveTeamRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveTeamRecipient proto.InternalMessageInfo
+
+// Recommander defines a recommander in the recommanders chain of a delegation.
+type Recommander struct {
+	// level is the position of the recommander in the chain, starting at 1 for
+	// the recommander of the delegation itself.
+	Level uint32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// recommander_address is the bech32-encoded address of the recommander.
+	RecommanderAddress string `protobuf:"bytes,2,opt,name=recommander_address,json=recommanderAddress,proto3" json:"recommander_address,omitempty" yaml:"recommander_address"`
+}
+
+func (m *Recommander) Reset()      { *m = Recommander{} }
+func (*Recommander) ProtoMessage() {}
+func (*Recommander) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *Recommander) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recommander) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recommander.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recommander) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recommander.Merge(m, src)
+}
+func (m *Recommander) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recommander) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recommander.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recommander proto.InternalMessageInfo
+
+func (m *Recommander) GetLevel() uint32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *Recommander) GetRecommanderAddress() string {
+	if m != nil {
+		return m.RecommanderAddress
+	}
+	return ""
+}
+
 // ReallocatedCommissionRule is reallocated commission rule
 //
 type ReallocatedCommissionRule struct {
@@ -1183,12 +1294,21 @@ type ReallocatedCommissionRule struct {
 	RecommanderClassRates []RecommanderClassRate                 `protobuf:"bytes,4,rep,name=recommander_class_rates,json=recommanderClassRates,proto3" json:"recommander_class_rates"`
 	// update_time is the last time the commission rate was changed.
 	UpdateTime time.Time `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time" yaml:"update_time"`
+	// max_validator_rate_change defines the maximum daily change of the validator
+	// rate, as a fraction.
+	MaxValidatorRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_validator_rate_change,json=maxValidatorRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_rate_change" yaml:"max_validator_rate_change"`
+	// max_recommanders_rate_change defines the maximum daily change of the
+	// recommanders rate, as a fraction.
+	MaxRecommandersRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_recommanders_rate_change,json=maxRecommandersRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_recommanders_rate_change" yaml:"max_recommanders_rate_change"`
+	// max_class_rate_change defines the maximum daily change of the rate of every
+	// recommander class, as a fraction.
+	MaxClassRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_class_rate_change,json=maxClassRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_class_rate_change" yaml:"max_class_rate_change"`
 }
 
 func (m *ReallocatedCommissionRule) Reset()      { *m = ReallocatedCommissionRule{} }
 func (*ReallocatedCommissionRule) ProtoMessage() {}
 func (*ReallocatedCommissionRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{21}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *ReallocatedCommissionRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1261,6 +1381,8 @@ func init() {
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
 	proto.RegisterType((*RecommanderClassRate)(nil), "cosmos.staking.v1beta1.RecommanderClassRate")
+	proto.RegisterType((*IncentiveTeamRecipient)(nil), "cosmos.staking.v1beta1.IncentiveTeamRecipient")
+	proto.RegisterType((*Recommander)(nil), "cosmos.staking.v1beta1.Recommander")
 	proto.RegisterType((*ReallocatedCommissionRule)(nil), "cosmos.staking.v1beta1.ReallocatedCommissionRule")
 }
 
@@ -1269,137 +1391,151 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2074 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x5c, 0x57,
-	0x19, 0xf7, 0xf5, 0x38, 0x7e, 0x7c, 0x63, 0x7b, 0xec, 0x13, 0xc7, 0x1d, 0x4f, 0xd3, 0xb9, 0x93,
-	0x4b, 0x15, 0x02, 0x4a, 0xc7, 0x24, 0x45, 0x45, 0x78, 0x03, 0x19, 0x8f, 0x83, 0x47, 0x2d, 0xae,
-	0xb9, 0x76, 0x52, 0x89, 0x56, 0x5c, 0x9d, 0xb9, 0xf7, 0x64, 0x7c, 0x9b, 0xfb, 0x18, 0xee, 0x39,
-	0x93, 0x7a, 0xa4, 0x2e, 0x58, 0x86, 0x20, 0x44, 0xd9, 0x75, 0x13, 0x29, 0x52, 0xb7, 0x48, 0x6c,
-	0x10, 0x5b, 0x76, 0xa8, 0x05, 0x09, 0x85, 0x1d, 0x42, 0x68, 0x40, 0xc9, 0x02, 0xc4, 0x0a, 0xcd,
-	0x3f, 0x00, 0x3a, 0x8f, 0xfb, 0x98, 0x57, 0x93, 0x71, 0x8b, 0x54, 0x09, 0x36, 0xf6, 0x3d, 0xdf,
-	0xf9, 0xbe, 0xdf, 0x77, 0xbe, 0xe7, 0x79, 0x0c, 0xbc, 0x6c, 0x87, 0xd4, 0x0f, 0xe9, 0x36, 0x65,
-	0xf8, 0xae, 0x1b, 0xb4, 0xb6, 0xef, 0x5d, 0x6b, 0x12, 0x86, 0xaf, 0xc5, 0xe3, 0x6a, 0x3b, 0x0a,
-	0x59, 0x88, 0x36, 0x25, 0x57, 0x35, 0xa6, 0x2a, 0xae, 0xd2, 0x46, 0x2b, 0x6c, 0x85, 0x82, 0x65,
-	0x9b, 0x7f, 0x49, 0xee, 0xd2, 0x56, 0x2b, 0x0c, 0x5b, 0x1e, 0xd9, 0x16, 0xa3, 0x66, 0xe7, 0xce,
-	0x36, 0x0e, 0xba, 0x6a, 0xaa, 0x3c, 0x3c, 0xe5, 0x74, 0x22, 0xcc, 0xdc, 0x30, 0x50, 0xf3, 0xfa,
-	0xf0, 0x3c, 0x73, 0x7d, 0x42, 0x19, 0xf6, 0xdb, 0x31, 0xb6, 0x5c, 0x89, 0x25, 0x95, 0xaa, 0x65,
-	0x29, 0x6c, 0x65, 0x4a, 0x13, 0x53, 0x92, 0xd8, 0x61, 0x87, 0x6e, 0x8c, 0x7d, 0x91, 0x91, 0xc0,
-	0x21, 0x91, 0xef, 0x06, 0x6c, 0x9b, 0x75, 0xdb, 0x84, 0xca, 0xbf, 0x72, 0xd6, 0xf8, 0xb1, 0x06,
-	0xab, 0xfb, 0x2e, 0x65, 0x61, 0xe4, 0xda, 0xd8, 0x6b, 0x04, 0x77, 0x42, 0xf4, 0x1a, 0xcc, 0x9f,
-	0x10, 0xec, 0x90, 0xa8, 0xa8, 0x55, 0xb4, 0x2b, 0xf9, 0xeb, 0xc5, 0x6a, 0x8a, 0x50, 0x95, 0xb2,
-	0xfb, 0x62, 0xbe, 0x36, 0xf7, 0x71, 0x4f, 0x9f, 0x31, 0x15, 0x37, 0xfa, 0x16, 0xcc, 0xdf, 0xc3,
-	0x1e, 0x25, 0xac, 0x38, 0x5b, 0xc9, 0x5d, 0xc9, 0x5f, 0xbf, 0x54, 0x1d, 0xef, 0xbe, 0xea, 0x6d,
-	0xec, 0xb9, 0x0e, 0x66, 0x61, 0x02, 0x20, 0xc5, 0x8c, 0x5f, 0xce, 0x42, 0x61, 0x37, 0xf4, 0x7d,
-	0x97, 0x52, 0x37, 0x0c, 0x4c, 0xcc, 0x08, 0x45, 0x35, 0x98, 0x8b, 0x30, 0x23, 0x62, 0x29, 0x4b,
-	0xb5, 0x2a, 0xe7, 0xff, 0x73, 0x4f, 0xbf, 0xdc, 0x72, 0xd9, 0x49, 0xa7, 0x59, 0xb5, 0x43, 0x5f,
-	0x39, 0x43, 0xfd, 0x7b, 0x85, 0x3a, 0x77, 0x95, 0x7d, 0x75, 0x62, 0x9b, 0x42, 0x16, 0xbd, 0x03,
-	0x8b, 0x3e, 0x3e, 0xb5, 0x04, 0xce, 0xac, 0xc0, 0xb9, 0x31, 0x1d, 0x4e, 0xbf, 0xa7, 0x17, 0xba,
-	0xd8, 0xf7, 0x76, 0x8c, 0x18, 0xc7, 0x30, 0x17, 0x7c, 0x7c, 0xca, 0x97, 0x88, 0xda, 0x50, 0xe0,
-	0x54, 0xfb, 0x04, 0x07, 0x2d, 0x22, 0x95, 0xe4, 0x84, 0x92, 0xfd, 0xa9, 0x95, 0x6c, 0xa6, 0x4a,
-	0x32, 0x70, 0x86, 0xb9, 0xe2, 0xe3, 0xd3, 0x5d, 0x41, 0xe0, 0x1a, 0x77, 0x16, 0x3f, 0x7c, 0xa4,
-	0xcf, 0xfc, 0xe3, 0x91, 0xae, 0x19, 0x7f, 0xd4, 0x00, 0x52, 0x8f, 0xa1, 0x77, 0x60, 0xcd, 0x4e,
-	0x46, 0x42, 0x96, 0xaa, 0x18, 0x7e, 0x79, 0x52, 0x2c, 0x86, 0xfc, 0x5d, 0x5b, 0xe4, 0x8b, 0x7e,
-	0xdc, 0xd3, 0x35, 0xb3, 0x60, 0x0f, 0x85, 0xe2, 0x6d, 0xc8, 0x77, 0xda, 0x0e, 0x66, 0xc4, 0xe2,
-	0xd9, 0x29, 0x3c, 0x99, 0xbf, 0x5e, 0xaa, 0xca, 0xd4, 0xad, 0xc6, 0xa9, 0x5b, 0x3d, 0x8e, 0x53,
-	0xb7, 0x56, 0xe6, 0x58, 0xfd, 0x9e, 0x8e, 0xa4, 0x59, 0x19, 0x61, 0xe3, 0x83, 0xbf, 0xea, 0x9a,
-	0x09, 0x92, 0xc2, 0x05, 0x32, 0x36, 0x7d, 0xa2, 0x41, 0xbe, 0x4e, 0xa8, 0x1d, 0xb9, 0x6d, 0x5e,
-	0x21, 0xa8, 0x08, 0x0b, 0x7e, 0x18, 0xb8, 0x77, 0x55, 0x3e, 0x2e, 0x99, 0xf1, 0x10, 0x95, 0x60,
-	0xd1, 0x75, 0x48, 0xc0, 0x5c, 0xd6, 0x95, 0x71, 0x35, 0x93, 0x31, 0x97, 0x7a, 0x8f, 0x34, 0xa9,
-	0x1b, 0x47, 0xc3, 0x8c, 0x87, 0xe8, 0x26, 0xac, 0x51, 0x62, 0x77, 0x22, 0x97, 0x75, 0x2d, 0x3b,
-	0x0c, 0x18, 0xb6, 0x59, 0x71, 0x4e, 0x04, 0xec, 0xc5, 0x7e, 0x4f, 0x7f, 0x41, 0xae, 0x75, 0x98,
-	0xc3, 0x30, 0x0b, 0x31, 0x69, 0x57, 0x52, 0xb8, 0x06, 0x87, 0x30, 0xec, 0x7a, 0xb4, 0x78, 0x4e,
-	0x6a, 0x50, 0xc3, 0x8c, 0x2d, 0x7f, 0x58, 0x82, 0xa5, 0x24, 0xdb, 0xb9, 0xe6, 0xb0, 0x4d, 0x22,
-	0xfe, 0x6d, 0x61, 0xc7, 0x89, 0x08, 0xa5, 0x2a, 0xaf, 0x33, 0x9a, 0x87, 0x39, 0x0c, 0xb3, 0x10,
-	0x93, 0x6e, 0x48, 0x0a, 0x62, 0x3c, 0xcc, 0x01, 0x25, 0x01, 0xed, 0x50, 0xab, 0xdd, 0x69, 0xde,
-	0x25, 0x5d, 0x15, 0x8d, 0x8d, 0x91, 0x68, 0xdc, 0x08, 0xba, 0xb5, 0x57, 0x53, 0xf4, 0x61, 0x39,
-	0xe3, 0x77, 0xbf, 0x7a, 0x65, 0x43, 0xa5, 0x86, 0x1d, 0x75, 0xdb, 0x2c, 0xac, 0x1e, 0x76, 0x9a,
-	0xaf, 0x93, 0x2e, 0x0f, 0xbf, 0x62, 0x3d, 0x14, 0x9c, 0x68, 0x13, 0xe6, 0xdf, 0xc5, 0xae, 0x47,
-	0x1c, 0xe1, 0xd0, 0x45, 0x53, 0x8d, 0xd0, 0x0e, 0xcc, 0x53, 0x86, 0x59, 0x87, 0x0a, 0x2f, 0xae,
-	0x5e, 0x37, 0x26, 0xa5, 0x5a, 0x2d, 0x0c, 0x9c, 0x23, 0xc1, 0x69, 0x2a, 0x09, 0x74, 0x13, 0xe6,
-	0x59, 0x78, 0x97, 0x04, 0xca, 0x85, 0x53, 0xd5, 0x77, 0x23, 0x60, 0xa6, 0x92, 0xe6, 0x1e, 0x71,
-	0x88, 0x47, 0x5a, 0xc2, 0x71, 0xf4, 0x04, 0x47, 0x84, 0x16, 0xe7, 0x05, 0x62, 0x63, 0xea, 0x22,
-	0x54, 0x9e, 0x1a, 0xc6, 0x33, 0xcc, 0x42, 0x42, 0x3a, 0x12, 0x14, 0xf4, 0x3a, 0xe4, 0x9d, 0x34,
-	0x51, 0x8b, 0x0b, 0x22, 0x04, 0x5f, 0x9a, 0x64, 0x7e, 0x26, 0xa7, 0x55, 0xdf, 0xcb, 0x4a, 0xf3,
-	0xe4, 0xe8, 0x04, 0xcd, 0x30, 0x70, 0xdc, 0xa0, 0x65, 0x9d, 0x10, 0xb7, 0x75, 0xc2, 0x8a, 0x8b,
-	0x15, 0xed, 0x4a, 0x2e, 0x9b, 0x1c, 0xc3, 0x1c, 0x86, 0x59, 0x48, 0x48, 0xfb, 0x82, 0x82, 0x1c,
-	0x58, 0x4d, 0xb9, 0x44, 0xa1, 0x2e, 0x3d, 0xb3, 0x50, 0x2f, 0xa9, 0x42, 0xbd, 0x30, 0xac, 0x25,
-	0xad, 0xd5, 0x95, 0x84, 0xc8, 0xc5, 0xd0, 0x3e, 0x40, 0xda, 0x1e, 0x8a, 0x20, 0x34, 0x18, 0xcf,
-	0xee, 0x31, 0xca, 0xf0, 0x8c, 0x2c, 0x7a, 0x1f, 0xce, 0xfb, 0x6e, 0x60, 0x51, 0xe2, 0xdd, 0xb1,
-	0x94, 0x83, 0x39, 0x64, 0x5e, 0x44, 0xef, 0x8d, 0xe9, 0xf2, 0xa1, 0xdf, 0xd3, 0x4b, 0xaa, 0x85,
-	0x8e, 0x42, 0x1a, 0xe6, 0xba, 0xef, 0x06, 0x47, 0xc4, 0xbb, 0x53, 0x4f, 0x68, 0xe8, 0x3d, 0x78,
-	0x31, 0x22, 0xd8, 0xf3, 0x42, 0x1b, 0x33, 0xe2, 0x58, 0xd9, 0xee, 0xd9, 0xf1, 0x48, 0x71, 0x59,
-	0x18, 0x76, 0x6d, 0x92, 0x61, 0x66, 0x2a, 0x9a, 0xe9, 0xa3, 0x1d, 0x8f, 0x28, 0x3b, 0xb7, 0xa2,
-	0x49, 0x0c, 0xe8, 0x2d, 0xd8, 0x74, 0x03, 0x9b, 0x37, 0xab, 0x7b, 0xc4, 0x62, 0x04, 0xfb, 0x49,
-	0x47, 0x58, 0x11, 0x96, 0x5f, 0xea, 0xf7, 0xf4, 0x97, 0xa4, 0x2d, 0xe3, 0xf9, 0x0c, 0x73, 0x23,
-	0x99, 0x38, 0x26, 0xd8, 0x8f, 0x9b, 0xc3, 0x01, 0x40, 0x92, 0xa7, 0xb4, 0xb8, 0x7a, 0xa6, 0xb2,
-	0xca, 0x20, 0xec, 0x2c, 0xdf, 0x7f, 0xa4, 0xcf, 0xa8, 0x86, 0x36, 0x63, 0xbc, 0x06, 0xcb, 0xb7,
-	0xb1, 0xa7, 0x74, 0x11, 0x8a, 0x2e, 0xc2, 0x12, 0x8e, 0x07, 0x45, 0xad, 0x92, 0xbb, 0xb2, 0x64,
-	0xa6, 0x04, 0xd9, 0x08, 0x7f, 0xf4, 0x97, 0x8a, 0x66, 0xfc, 0x42, 0x83, 0xf9, 0xfa, 0xed, 0x43,
-	0xec, 0x46, 0xa8, 0x01, 0xeb, 0x69, 0x6d, 0x0d, 0xb6, 0xc1, 0x8b, 0xfd, 0x9e, 0x5e, 0x1c, 0x2e,
-	0xbf, 0xc4, 0xde, 0xb4, 0xc4, 0x63, 0x5b, 0x1b, 0xb0, 0x7e, 0x2f, 0xee, 0xae, 0x09, 0xd4, 0xec,
-	0x30, 0xd4, 0x08, 0x8b, 0x61, 0xae, 0x25, 0x34, 0x05, 0x35, 0x64, 0xe6, 0x1e, 0x2c, 0xc8, 0xd5,
-	0x52, 0xb4, 0x03, 0xe7, 0xda, 0xfc, 0x43, 0x58, 0x97, 0xbf, 0x5e, 0x9e, 0x58, 0xde, 0x82, 0x5f,
-	0x05, 0x5e, 0x8a, 0x18, 0x3f, 0x9f, 0x05, 0xa8, 0xdf, 0xbe, 0x7d, 0x1c, 0xb9, 0x6d, 0x8f, 0xb0,
-	0xcf, 0xd3, 0xf2, 0x63, 0xb8, 0x90, 0x9a, 0x45, 0x23, 0x7b, 0xc8, 0xfa, 0x4a, 0xbf, 0xa7, 0x5f,
-	0x1c, 0xb6, 0x3e, 0xc3, 0x66, 0x98, 0xe7, 0x13, 0xfa, 0x51, 0x64, 0x8f, 0x45, 0x75, 0x28, 0x4b,
-	0x50, 0x73, 0x93, 0x51, 0x33, 0x6c, 0x59, 0xd4, 0x3a, 0x65, 0xe3, 0x5d, 0x7b, 0x04, 0xf9, 0xd4,
-	0x25, 0x14, 0xd5, 0x61, 0x91, 0xa9, 0x6f, 0xe5, 0x61, 0x63, 0xb2, 0x87, 0x63, 0x31, 0xe5, 0xe5,
-	0x44, 0xd2, 0xf8, 0x84, 0x3b, 0x3a, 0xad, 0xea, 0x2f, 0x64, 0x8a, 0xf1, 0xcd, 0x4e, 0x6d, 0x4d,
-	0xb9, 0x33, 0x1d, 0x66, 0x95, 0x34, 0x7a, 0x13, 0xce, 0x47, 0x84, 0x77, 0x2a, 0xcc, 0x4f, 0xe5,
-	0xc9, 0xa2, 0xe4, 0x19, 0xa6, 0x9c, 0xf6, 0xc0, 0x31, 0x4c, 0x86, 0x89, 0x32, 0xd4, 0xf1, 0x01,
-	0xfa, 0xc9, 0x2c, 0x9c, 0xbf, 0x15, 0x37, 0xfb, 0x2f, 0xbc, 0x53, 0x0f, 0x61, 0x81, 0x04, 0x2c,
-	0x72, 0x85, 0x57, 0x79, 0xfa, 0x7c, 0x6d, 0x52, 0xfa, 0x8c, 0xb1, 0x69, 0x2f, 0x60, 0x51, 0x57,
-	0x25, 0x53, 0x0c, 0x33, 0xe4, 0x8d, 0x9f, 0xe5, 0xa0, 0x38, 0x49, 0x12, 0xed, 0x42, 0xc1, 0x8e,
-	0x88, 0x20, 0xc4, 0x5b, 0xb6, 0x26, 0xb6, 0xec, 0x52, 0x7a, 0x98, 0x1f, 0x62, 0x30, 0xcc, 0xd5,
-	0x98, 0xa2, 0x36, 0xec, 0x16, 0xf0, 0x93, 0x36, 0xcf, 0x63, 0xce, 0xf5, 0x9c, 0x47, 0x6b, 0x43,
-	0xed, 0xd8, 0xb1, 0x92, 0x41, 0x00, 0xb9, 0x65, 0xaf, 0xa6, 0x54, 0xb1, 0x67, 0xff, 0x10, 0x0a,
-	0x6e, 0xe0, 0x32, 0x17, 0x7b, 0x56, 0x13, 0x7b, 0x38, 0xb0, 0xcf, 0x72, 0x51, 0x91, 0xbb, 0xec,
-	0x66, 0xbc, 0x33, 0x0d, 0xc0, 0x19, 0xe6, 0xaa, 0xa2, 0xd4, 0x24, 0x01, 0xed, 0xc3, 0x42, 0xac,
-	0x6a, 0xee, 0x4c, 0x3b, 0x51, 0x2c, 0x9e, 0x39, 0x53, 0xff, 0x34, 0x07, 0xeb, 0x26, 0x71, 0xfe,
-	0x1f, 0x8a, 0xe9, 0x42, 0xf1, 0x5d, 0x00, 0xd9, 0x3f, 0x78, 0xc7, 0x3e, 0x43, 0x34, 0x78, 0x07,
-	0x5a, 0x92, 0x08, 0x75, 0xca, 0x32, 0xf1, 0xe8, 0xcd, 0xc2, 0x72, 0x36, 0x1e, 0xff, 0xa3, 0xdb,
-	0x1c, 0x6a, 0xa4, 0x9d, 0x68, 0x4e, 0x74, 0xa2, 0xaf, 0x4c, 0x3e, 0x36, 0x3a, 0xd3, 0xb4, 0xa0,
-	0xdf, 0xe6, 0x60, 0xfe, 0x10, 0x47, 0xd8, 0xa7, 0xc8, 0x1e, 0x39, 0xdc, 0xcb, 0xeb, 0xfd, 0xd6,
-	0x48, 0x7e, 0xd6, 0xd5, 0x03, 0xd3, 0x33, 0xce, 0xf6, 0x1f, 0x8e, 0x39, 0xdb, 0x7f, 0x1b, 0x56,
-	0x7d, 0x7c, 0x6a, 0x25, 0x36, 0x4a, 0x6f, 0xaf, 0xd4, 0xb6, 0x52, 0x94, 0xc1, 0x79, 0xf9, 0x40,
-	0x91, 0xdc, 0x73, 0x29, 0xfa, 0x06, 0xe4, 0x39, 0x47, 0xda, 0x98, 0xb9, 0xf8, 0x66, 0xfa, 0x12,
-	0x90, 0x99, 0x34, 0x4c, 0xf0, 0xf1, 0xe9, 0x9e, 0x1c, 0xa0, 0x37, 0x00, 0x9d, 0x24, 0x8f, 0x51,
-	0x56, 0xea, 0x4e, 0x2e, 0xff, 0x52, 0xbf, 0xa7, 0x6f, 0x49, 0xf9, 0x51, 0x1e, 0xc3, 0x5c, 0x4f,
-	0x89, 0x31, 0xda, 0xd7, 0x01, 0xb8, 0x5d, 0x96, 0x43, 0x82, 0xd0, 0x57, 0x37, 0xcc, 0x0b, 0xfd,
-	0x9e, 0xbe, 0x2e, 0x51, 0xd2, 0x39, 0xc3, 0x5c, 0xe2, 0x83, 0x3a, 0xff, 0x46, 0x07, 0x70, 0x9e,
-	0xaf, 0x2f, 0x3d, 0x75, 0x3b, 0xa4, 0xcd, 0x4e, 0xc4, 0x75, 0x72, 0x25, 0xbb, 0xbd, 0x8e, 0x61,
-	0xe2, 0x57, 0x0c, 0x7c, 0xda, 0x88, 0x89, 0x75, 0x4e, 0xcb, 0x54, 0xca, 0x47, 0x1a, 0xa0, 0x74,
-	0x0b, 0x31, 0x09, 0x6d, 0xf3, 0x2b, 0x36, 0xbf, 0x4b, 0x65, 0x2e, 0x3e, 0xda, 0xa7, 0xdf, 0xa5,
-	0x52, 0xf9, 0xf8, 0x2e, 0x95, 0xa9, 0xbc, 0x6f, 0xa6, 0xed, 0x76, 0x56, 0xe5, 0x85, 0x82, 0x69,
-	0x62, 0x4a, 0x32, 0xf7, 0x31, 0x37, 0x96, 0x1e, 0xe9, 0xaf, 0x33, 0xc6, 0xef, 0x35, 0xd8, 0x1a,
-	0xc9, 0xd0, 0x64, 0xb1, 0x3f, 0x00, 0x14, 0x65, 0x26, 0x85, 0xff, 0xbb, 0x6a, 0xd1, 0x53, 0x27,
-	0xfc, 0x7a, 0x34, 0xd2, 0xc7, 0x3f, 0xbf, 0x1d, 0x63, 0x4e, 0xf8, 0xfc, 0x37, 0x1a, 0x6c, 0x64,
-	0xd5, 0x27, 0x86, 0x1c, 0xc0, 0x72, 0x56, 0xbb, 0x32, 0xe1, 0xe5, 0xe7, 0x31, 0x41, 0xad, 0x7e,
-	0x40, 0x1e, 0x7d, 0x2f, 0x2d, 0x7f, 0xf9, 0xfc, 0x79, 0xed, 0xb9, 0xbd, 0x11, 0xaf, 0x69, 0xb8,
-	0x0d, 0xcc, 0x89, 0x78, 0xfc, 0x5b, 0x83, 0xb9, 0xc3, 0x30, 0xf4, 0x50, 0x08, 0xeb, 0x41, 0xc8,
-	0x2c, 0x9e, 0xa9, 0xc4, 0xb1, 0xd4, 0xbb, 0x89, 0xec, 0xab, 0xbb, 0xd3, 0x39, 0xe9, 0x9f, 0x3d,
-	0x7d, 0x14, 0xca, 0x2c, 0x04, 0x21, 0xab, 0x09, 0xca, 0xb1, 0x7c, 0x55, 0x79, 0x1f, 0x56, 0x06,
-	0x95, 0xc9, 0xae, 0xfb, 0xd6, 0xd4, 0xca, 0x06, 0x61, 0xfa, 0x3d, 0x7d, 0x23, 0xad, 0xc0, 0x84,
-	0x6c, 0x98, 0xcb, 0xcd, 0x8c, 0xf6, 0x9d, 0x45, 0x1e, 0xbf, 0x7f, 0xf1, 0x18, 0xde, 0x17, 0x31,
-	0x4c, 0x8e, 0xad, 0xbb, 0x1e, 0xa6, 0x54, 0x3c, 0xbd, 0x5e, 0x86, 0x73, 0x6e, 0xe0, 0x90, 0x53,
-	0xe1, 0x85, 0x95, 0xda, 0x5a, 0xbf, 0xa7, 0x2f, 0xc7, 0xdb, 0xa1, 0x43, 0x4e, 0x0d, 0x53, 0x4e,
-	0x27, 0x8f, 0xc8, 0xb3, 0x67, 0x7f, 0x44, 0x56, 0xe9, 0xf4, 0xf7, 0x1c, 0x2f, 0x8e, 0x49, 0x97,
-	0xfa, 0x5b, 0xb0, 0x9a, 0xee, 0x18, 0x9f, 0xe1, 0xd9, 0x7a, 0x25, 0x41, 0x11, 0x66, 0xbe, 0x0d,
-	0xeb, 0x99, 0x53, 0x3b, 0xb5, 0x3e, 0x83, 0x2d, 0x6b, 0x59, 0x20, 0x01, 0xbe, 0xcb, 0x8f, 0x22,
-	0x83, 0xad, 0x4e, 0xf6, 0xeb, 0x52, 0xf6, 0x70, 0x31, 0xd4, 0xe6, 0x56, 0xdd, 0x81, 0x1e, 0x87,
-	0xde, 0x85, 0x17, 0xb2, 0xb7, 0x0d, 0x9b, 0x47, 0x48, 0xbd, 0x3f, 0xcb, 0xbd, 0xf0, 0xea, 0xe4,
-	0x62, 0x18, 0x8d, 0xab, 0xaa, 0x83, 0x0b, 0xd1, 0x98, 0xb9, 0x91, 0x67, 0xe8, 0x73, 0xff, 0x9d,
-	0x67, 0xe8, 0xaf, 0xfe, 0x5a, 0x03, 0x48, 0x5f, 0x2c, 0xd1, 0x55, 0x78, 0xa1, 0xf6, 0xe6, 0x41,
-	0xdd, 0x3a, 0x3a, 0xbe, 0x71, 0x7c, 0xeb, 0xc8, 0xba, 0x75, 0x70, 0x74, 0xb8, 0xb7, 0xdb, 0xb8,
-	0xd9, 0xd8, 0xab, 0xaf, 0xcd, 0x94, 0x0a, 0x0f, 0x1e, 0x56, 0xf2, 0xb7, 0x02, 0xda, 0x26, 0xb6,
-	0x7b, 0xc7, 0x25, 0x0e, 0xba, 0x0c, 0x1b, 0x83, 0xdc, 0x7c, 0xb4, 0x57, 0x5f, 0xd3, 0x4a, 0xcb,
-	0x0f, 0x1e, 0x56, 0x16, 0xe5, 0x85, 0x82, 0x38, 0xe8, 0x0a, 0x5c, 0x18, 0xe5, 0x6b, 0x1c, 0x7c,
-	0x67, 0x6d, 0xb6, 0xb4, 0xf2, 0xe0, 0x61, 0x65, 0x29, 0xb9, 0x79, 0x20, 0x03, 0x50, 0x96, 0x53,
-	0xe1, 0xe5, 0x4a, 0xf0, 0xe0, 0x61, 0x65, 0x5e, 0x56, 0x6d, 0x69, 0xee, 0xfe, 0x47, 0xe5, 0x99,
-	0xda, 0xcd, 0x8f, 0x9f, 0x94, 0xb5, 0xc7, 0x4f, 0xca, 0xda, 0xdf, 0x9e, 0x94, 0xb5, 0x0f, 0x9e,
-	0x96, 0x67, 0x1e, 0x3f, 0x2d, 0xcf, 0xfc, 0xe9, 0x69, 0x79, 0xe6, 0xfb, 0x57, 0x3f, 0x35, 0x49,
-	0x4e, 0x93, 0x1f, 0xc3, 0x44, 0xba, 0x34, 0xe7, 0x85, 0x2b, 0x5f, 0xfd, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x79, 0x66, 0xa3, 0x37, 0x2b, 0x1b, 0x00, 0x00,
+	// 2300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x39, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x5a, 0x89, 0x96, 0xa8, 0x47, 0x89, 0x94, 0xc6, 0x92, 0x4c, 0x31, 0x0a, 0xc9, 0x6c, 0x82,
+	0xfc, 0xf4, 0x2b, 0x1c, 0xaa, 0x76, 0x8a, 0x14, 0xd5, 0xa5, 0x35, 0x45, 0xb9, 0x12, 0x92, 0x2a,
+	0xea, 0x48, 0x72, 0x80, 0x26, 0x28, 0xb1, 0xdc, 0x1d, 0x53, 0x1b, 0x2f, 0x77, 0xd9, 0x9d, 0xa1,
+	0x2d, 0x02, 0x41, 0xd1, 0xde, 0x5c, 0x17, 0x69, 0x53, 0x20, 0x28, 0x7c, 0x31, 0x60, 0x20, 0xd7,
+	0x02, 0xbd, 0x14, 0xbd, 0xf6, 0x9a, 0xb4, 0x17, 0xf7, 0x56, 0x14, 0x05, 0x5b, 0xd8, 0x28, 0x50,
+	0xf4, 0x54, 0xf0, 0x1f, 0x68, 0x31, 0x1f, 0xfb, 0xc1, 0x25, 0x19, 0x9b, 0x8a, 0x0f, 0x01, 0xda,
+	0x8b, 0xc4, 0x79, 0xf3, 0xde, 0x9b, 0xf7, 0x35, 0xef, 0x63, 0x16, 0x5e, 0x31, 0x3d, 0xda, 0xf2,
+	0xe8, 0x16, 0x65, 0xc6, 0x2d, 0xdb, 0x6d, 0x6e, 0xdd, 0xbe, 0xd2, 0x20, 0xcc, 0xb8, 0x12, 0xac,
+	0x2b, 0x6d, 0xdf, 0x63, 0x1e, 0x5a, 0x93, 0x58, 0x95, 0x00, 0xaa, 0xb0, 0x0a, 0x2b, 0x4d, 0xaf,
+	0xe9, 0x09, 0x94, 0x2d, 0xfe, 0x4b, 0x62, 0x17, 0xd6, 0x9b, 0x9e, 0xd7, 0x74, 0xc8, 0x96, 0x58,
+	0x35, 0x3a, 0x37, 0xb7, 0x0c, 0xb7, 0xab, 0xb6, 0x8a, 0xc9, 0x2d, 0xab, 0xe3, 0x1b, 0xcc, 0xf6,
+	0x5c, 0xb5, 0x5f, 0x4a, 0xee, 0x33, 0xbb, 0x45, 0x28, 0x33, 0x5a, 0xed, 0x80, 0xb7, 0x94, 0xa4,
+	0x2e, 0x0f, 0x55, 0x62, 0x29, 0xde, 0x4a, 0x95, 0x86, 0x41, 0x49, 0xa8, 0x87, 0xe9, 0xd9, 0x01,
+	0xef, 0x0d, 0x46, 0x5c, 0x8b, 0xf8, 0x2d, 0xdb, 0x65, 0x5b, 0xac, 0xdb, 0x26, 0x54, 0xfe, 0x95,
+	0xbb, 0xfa, 0x4f, 0x34, 0xc8, 0xee, 0xd9, 0x94, 0x79, 0xbe, 0x6d, 0x1a, 0xce, 0xbe, 0x7b, 0xd3,
+	0x43, 0x6f, 0xc0, 0xec, 0x29, 0x31, 0x2c, 0xe2, 0xe7, 0xb5, 0xb2, 0xb6, 0x99, 0xb9, 0x9a, 0xaf,
+	0x44, 0x1c, 0x2a, 0x92, 0x76, 0x4f, 0xec, 0x57, 0x53, 0x9f, 0xf6, 0x4a, 0x53, 0x58, 0x61, 0xa3,
+	0x6f, 0xc2, 0xec, 0x6d, 0xc3, 0xa1, 0x84, 0xe5, 0xa7, 0xcb, 0x33, 0x9b, 0x99, 0xab, 0x2f, 0x55,
+	0x46, 0x9b, 0xaf, 0x72, 0xc3, 0x70, 0x6c, 0xcb, 0x60, 0x5e, 0xc8, 0x40, 0x92, 0xe9, 0xbf, 0x9e,
+	0x86, 0xdc, 0x8e, 0xd7, 0x6a, 0xd9, 0x94, 0xda, 0x9e, 0x8b, 0x0d, 0x46, 0x28, 0xaa, 0x42, 0xca,
+	0x37, 0x18, 0x11, 0xa2, 0xcc, 0x57, 0x2b, 0x1c, 0xff, 0xcf, 0xbd, 0xd2, 0xab, 0x4d, 0x9b, 0x9d,
+	0x76, 0x1a, 0x15, 0xd3, 0x6b, 0x29, 0x63, 0xa8, 0x7f, 0xaf, 0x51, 0xeb, 0x96, 0xd2, 0xaf, 0x46,
+	0x4c, 0x2c, 0x68, 0xd1, 0x7b, 0x90, 0x6e, 0x19, 0x67, 0x75, 0xc1, 0x67, 0x5a, 0xf0, 0xb9, 0x36,
+	0x19, 0x9f, 0x7e, 0xaf, 0x94, 0xeb, 0x1a, 0x2d, 0x67, 0x5b, 0x0f, 0xf8, 0xe8, 0x78, 0xae, 0x65,
+	0x9c, 0x71, 0x11, 0x51, 0x1b, 0x72, 0x1c, 0x6a, 0x9e, 0x1a, 0x6e, 0x93, 0xc8, 0x43, 0x66, 0xc4,
+	0x21, 0x7b, 0x13, 0x1f, 0xb2, 0x16, 0x1d, 0x12, 0x63, 0xa7, 0xe3, 0xc5, 0x96, 0x71, 0xb6, 0x23,
+	0x00, 0xfc, 0xc4, 0xed, 0xf4, 0xfd, 0x87, 0xa5, 0xa9, 0x7f, 0x3c, 0x2c, 0x69, 0xfa, 0x1f, 0x35,
+	0x80, 0xc8, 0x62, 0xe8, 0x3d, 0x58, 0x32, 0xc3, 0x95, 0xa0, 0xa5, 0xca, 0x87, 0xff, 0x37, 0xce,
+	0x17, 0x09, 0x7b, 0x57, 0xd3, 0x5c, 0xe8, 0x47, 0xbd, 0x92, 0x86, 0x73, 0x66, 0xc2, 0x15, 0xef,
+	0x42, 0xa6, 0xd3, 0xb6, 0x0c, 0x46, 0xea, 0x3c, 0x3a, 0x85, 0x25, 0x33, 0x57, 0x0b, 0x15, 0x19,
+	0xba, 0x95, 0x20, 0x74, 0x2b, 0xc7, 0x41, 0xe8, 0x56, 0x8b, 0x9c, 0x57, 0xbf, 0x57, 0x42, 0x52,
+	0xad, 0x18, 0xb1, 0xfe, 0xd1, 0x5f, 0x4b, 0x1a, 0x06, 0x09, 0xe1, 0x04, 0x31, 0x9d, 0x3e, 0xd3,
+	0x20, 0x53, 0x23, 0xd4, 0xf4, 0xed, 0x36, 0xbf, 0x21, 0x28, 0x0f, 0x73, 0x2d, 0xcf, 0xb5, 0x6f,
+	0xa9, 0x78, 0x9c, 0xc7, 0xc1, 0x12, 0x15, 0x20, 0x6d, 0x5b, 0xc4, 0x65, 0x36, 0xeb, 0x4a, 0xbf,
+	0xe2, 0x70, 0xcd, 0xa9, 0xee, 0x90, 0x06, 0xb5, 0x03, 0x6f, 0xe0, 0x60, 0x89, 0xae, 0xc3, 0x12,
+	0x25, 0x66, 0xc7, 0xb7, 0x59, 0xb7, 0x6e, 0x7a, 0x2e, 0x33, 0x4c, 0x96, 0x4f, 0x09, 0x87, 0xbd,
+	0xd0, 0xef, 0x95, 0x2e, 0x49, 0x59, 0x93, 0x18, 0x3a, 0xce, 0x05, 0xa0, 0x1d, 0x09, 0xe1, 0x27,
+	0x58, 0x84, 0x19, 0xb6, 0x43, 0xf3, 0x17, 0xe4, 0x09, 0x6a, 0x19, 0xd3, 0xe5, 0xef, 0x00, 0xf3,
+	0x61, 0xb4, 0xf3, 0x93, 0xbd, 0x36, 0xf1, 0xf9, 0xef, 0xba, 0x61, 0x59, 0x3e, 0xa1, 0x34, 0xaf,
+	0x25, 0x4f, 0x4e, 0x62, 0xe8, 0x38, 0x17, 0x80, 0xae, 0x49, 0x08, 0x62, 0xdc, 0xcd, 0x2e, 0x25,
+	0x2e, 0xed, 0xd0, 0x7a, 0xbb, 0xd3, 0xb8, 0x45, 0xba, 0xca, 0x1b, 0x2b, 0x43, 0xde, 0xb8, 0xe6,
+	0x76, 0xab, 0xaf, 0x47, 0xdc, 0x93, 0x74, 0xfa, 0xef, 0x7f, 0xf3, 0xda, 0x8a, 0x0a, 0x0d, 0xd3,
+	0xef, 0xb6, 0x99, 0x57, 0x39, 0xec, 0x34, 0xde, 0x24, 0x5d, 0x9c, 0x0b, 0x51, 0x0f, 0x05, 0x26,
+	0x5a, 0x83, 0xd9, 0xf7, 0x0d, 0xdb, 0x21, 0x96, 0x30, 0x68, 0x1a, 0xab, 0x15, 0xda, 0x86, 0x59,
+	0xca, 0x0c, 0xd6, 0xa1, 0xc2, 0x8a, 0xd9, 0xab, 0xfa, 0xb8, 0x50, 0xab, 0x7a, 0xae, 0x75, 0x24,
+	0x30, 0xb1, 0xa2, 0x40, 0xd7, 0x61, 0x96, 0x79, 0xb7, 0x88, 0xab, 0x4c, 0x38, 0xd1, 0xfd, 0xde,
+	0x77, 0x19, 0x56, 0xd4, 0xdc, 0x22, 0x16, 0x71, 0x48, 0x53, 0x18, 0x8e, 0x9e, 0x1a, 0x3e, 0xa1,
+	0xf9, 0x59, 0xc1, 0x71, 0x7f, 0xe2, 0x4b, 0xa8, 0x2c, 0x95, 0xe4, 0xa7, 0xe3, 0x5c, 0x08, 0x3a,
+	0x12, 0x10, 0xf4, 0x26, 0x64, 0xac, 0x28, 0x50, 0xf3, 0x73, 0xc2, 0x05, 0x2f, 0x8f, 0x53, 0x3f,
+	0x16, 0xd3, 0x2a, 0xef, 0xc5, 0xa9, 0x79, 0x70, 0x74, 0xdc, 0x86, 0xe7, 0x5a, 0xb6, 0xdb, 0xac,
+	0x9f, 0x12, 0xbb, 0x79, 0xca, 0xf2, 0xe9, 0xb2, 0xb6, 0x39, 0x13, 0x0f, 0x8e, 0x24, 0x86, 0x8e,
+	0x73, 0x21, 0x68, 0x4f, 0x40, 0x90, 0x05, 0xd9, 0x08, 0x4b, 0x5c, 0xd4, 0xf9, 0xa7, 0x5e, 0xd4,
+	0x97, 0xd4, 0x45, 0x5d, 0x4d, 0x9e, 0x12, 0xdd, 0xd5, 0xc5, 0x10, 0xc8, 0xc9, 0xd0, 0x1e, 0x40,
+	0x94, 0x1e, 0xf2, 0x20, 0x4e, 0xd0, 0x9f, 0x9e, 0x63, 0x94, 0xe2, 0x31, 0x5a, 0xf4, 0x01, 0x5c,
+	0x6c, 0xd9, 0x6e, 0x9d, 0x12, 0xe7, 0x66, 0x5d, 0x19, 0x98, 0xb3, 0xcc, 0x08, 0xef, 0xbd, 0x35,
+	0x59, 0x3c, 0xf4, 0x7b, 0xa5, 0x82, 0x4a, 0xa1, 0xc3, 0x2c, 0x75, 0xbc, 0xdc, 0xb2, 0xdd, 0x23,
+	0xe2, 0xdc, 0xac, 0x85, 0x30, 0x74, 0x07, 0x5e, 0xf0, 0x89, 0xe1, 0x38, 0x9e, 0x69, 0x30, 0x62,
+	0xd5, 0xe3, 0xd9, 0xb3, 0xe3, 0x90, 0xfc, 0x82, 0x50, 0xec, 0xca, 0x38, 0xc5, 0x70, 0x44, 0x1a,
+	0xcb, 0xa3, 0x1d, 0x87, 0x28, 0x3d, 0xd7, 0xfd, 0x71, 0x08, 0xe8, 0x1d, 0x58, 0xb3, 0x5d, 0x93,
+	0x27, 0xab, 0xdb, 0xa4, 0xce, 0x88, 0xd1, 0x0a, 0x33, 0xc2, 0xa2, 0xd0, 0xfc, 0xa5, 0x7e, 0xaf,
+	0xf4, 0xa2, 0xd4, 0x65, 0x34, 0x9e, 0x8e, 0x57, 0xc2, 0x8d, 0x63, 0x62, 0xb4, 0x82, 0xe4, 0x70,
+	0x00, 0x10, 0xc6, 0x29, 0xcd, 0x67, 0xcf, 0x75, 0xad, 0x62, 0x1c, 0xd0, 0xc7, 0x1a, 0xac, 0x27,
+	0x24, 0xf0, 0x89, 0x69, 0xb7, 0x6d, 0xe2, 0x32, 0x9a, 0xcf, 0x89, 0x4a, 0x5f, 0x19, 0x67, 0xa0,
+	0xfd, 0xb8, 0x84, 0x38, 0x20, 0xab, 0x6e, 0xaa, 0x78, 0x2b, 0x8f, 0x54, 0x30, 0x62, 0xaf, 0xe3,
+	0x4b, 0xf6, 0x48, 0x0e, 0x74, 0x7b, 0xe1, 0xee, 0xc3, 0xd2, 0x94, 0xca, 0xb3, 0x53, 0xfa, 0x1b,
+	0xb0, 0x70, 0xc3, 0x70, 0x94, 0x09, 0x08, 0x45, 0x1b, 0x30, 0x6f, 0x04, 0x8b, 0xbc, 0x56, 0x9e,
+	0xd9, 0x9c, 0xc7, 0x11, 0x40, 0xe6, 0xe7, 0x1f, 0xfd, 0xa5, 0xac, 0xe9, 0xbf, 0xd2, 0x60, 0xb6,
+	0x76, 0xe3, 0xd0, 0xb0, 0x7d, 0xb4, 0x0f, 0xcb, 0xd1, 0x95, 0x1f, 0xcc, 0xce, 0x1b, 0xfd, 0x5e,
+	0x29, 0x9f, 0xcc, 0x0a, 0xa1, 0x1b, 0xa2, 0xcc, 0x13, 0xb8, 0x60, 0x1f, 0x96, 0x6f, 0x07, 0x49,
+	0x3f, 0x64, 0x35, 0x9d, 0x64, 0x35, 0x84, 0xa2, 0xe3, 0xa5, 0x10, 0xa6, 0x58, 0x25, 0xd4, 0xdc,
+	0x85, 0x39, 0x29, 0x2d, 0x45, 0xdb, 0x70, 0xa1, 0xcd, 0x7f, 0x08, 0xed, 0x32, 0x57, 0x8b, 0x63,
+	0xb3, 0x8e, 0xc0, 0x57, 0xf1, 0x28, 0x49, 0xf4, 0x5f, 0x4c, 0x03, 0xd4, 0x6e, 0xdc, 0x38, 0xf6,
+	0xed, 0xb6, 0x43, 0xd8, 0xf3, 0xd4, 0xfc, 0x18, 0x56, 0x23, 0xb5, 0xa8, 0x6f, 0x26, 0xb4, 0x2f,
+	0xf7, 0x7b, 0xa5, 0x8d, 0xa4, 0xf6, 0x31, 0x34, 0x1d, 0x5f, 0x0c, 0xe1, 0x47, 0xbe, 0x39, 0x92,
+	0xab, 0x45, 0x59, 0xc8, 0x75, 0x66, 0x3c, 0xd7, 0x18, 0x5a, 0x9c, 0x6b, 0x8d, 0xb2, 0xd1, 0xa6,
+	0x3d, 0x82, 0x4c, 0x64, 0x12, 0x8a, 0x6a, 0x90, 0x66, 0xea, 0xb7, 0xb2, 0xb0, 0x3e, 0xde, 0xc2,
+	0x01, 0x99, 0xb2, 0x72, 0x48, 0xa9, 0x7f, 0xc6, 0x0d, 0x1d, 0x25, 0x9b, 0x2f, 0x65, 0x88, 0xf1,
+	0x1a, 0xac, 0x2a, 0xe6, 0xcc, 0xb9, 0x7a, 0x6c, 0x45, 0x8d, 0xde, 0x86, 0x8b, 0x3e, 0xe1, 0x09,
+	0xd4, 0xe0, 0xc3, 0x42, 0x28, 0x94, 0x6c, 0xad, 0x8a, 0x51, 0x6a, 0x1e, 0x81, 0xa4, 0x63, 0x14,
+	0x83, 0x8e, 0x76, 0xd0, 0x4f, 0xa7, 0xe1, 0xe2, 0x49, 0x50, 0x83, 0xbe, 0xf4, 0x46, 0x3d, 0x84,
+	0x39, 0xe2, 0x32, 0xdf, 0x16, 0x56, 0xe5, 0xe1, 0xf3, 0xd5, 0x71, 0xe1, 0x33, 0x42, 0xa7, 0x5d,
+	0x97, 0xf9, 0x5d, 0x15, 0x4c, 0x01, 0x9b, 0x84, 0x35, 0x7e, 0x3e, 0x03, 0xf9, 0x71, 0x94, 0x68,
+	0x07, 0x72, 0xa6, 0x4f, 0x04, 0x20, 0xe8, 0x24, 0x34, 0xd1, 0x49, 0x14, 0xa2, 0x19, 0x23, 0x81,
+	0xa0, 0xe3, 0x6c, 0x00, 0x51, 0x7d, 0x44, 0x13, 0xf8, 0x00, 0xc0, 0xe3, 0x98, 0x63, 0x3d, 0x63,
+	0xc7, 0xaf, 0xab, 0xc4, 0x1e, 0x1c, 0x32, 0xc8, 0x40, 0x76, 0x12, 0xd9, 0x08, 0xca, 0x09, 0xd1,
+	0x0f, 0x20, 0x67, 0xbb, 0x36, 0xb3, 0x0d, 0xa7, 0xde, 0x30, 0x1c, 0xc3, 0x35, 0xcf, 0x33, 0x3f,
+	0xc9, 0xe2, 0xbf, 0x16, 0xd4, 0x93, 0x01, 0x76, 0x3a, 0xce, 0x2a, 0x48, 0x55, 0x02, 0xd0, 0x1e,
+	0xcc, 0x05, 0x47, 0xa5, 0xce, 0x55, 0x20, 0x03, 0xf2, 0x58, 0xab, 0xff, 0xe1, 0x0c, 0x2c, 0x63,
+	0x62, 0xfd, 0xcf, 0x15, 0x93, 0xb9, 0xe2, 0x3b, 0x00, 0x32, 0x7f, 0xf0, 0x8c, 0x9d, 0x4f, 0x9d,
+	0x2b, 0x03, 0xcd, 0x4b, 0x0e, 0x35, 0xca, 0x62, 0xfe, 0xe8, 0x4d, 0xc3, 0x42, 0xdc, 0x1f, 0xff,
+	0xa5, 0x65, 0x0e, 0xed, 0x47, 0x99, 0x28, 0x25, 0x32, 0xd1, 0xff, 0x8f, 0xef, 0x66, 0xad, 0x49,
+	0x52, 0xd0, 0xcf, 0x52, 0x30, 0x7b, 0x68, 0xf8, 0x46, 0x8b, 0x22, 0x73, 0x68, 0xe6, 0x90, 0xaf,
+	0x0e, 0xeb, 0x43, 0xf1, 0x59, 0x53, 0xef, 0x5e, 0x4f, 0x19, 0x39, 0xee, 0x8f, 0x18, 0x39, 0xbe,
+	0x05, 0x59, 0xfe, 0x30, 0x12, 0xea, 0x28, 0xad, 0xbd, 0x58, 0x5d, 0x8f, 0xb8, 0x0c, 0xee, 0xcb,
+	0x77, 0x93, 0x70, 0xfc, 0xa6, 0xe8, 0xeb, 0x90, 0xe1, 0x18, 0x51, 0x62, 0xe6, 0xe4, 0x6b, 0xd1,
+	0x03, 0x45, 0x6c, 0x53, 0xc7, 0xd0, 0x32, 0xce, 0x76, 0xe5, 0x02, 0xbd, 0x05, 0xe8, 0x34, 0x7c,
+	0x23, 0xab, 0x47, 0xe6, 0xe4, 0xf4, 0x2f, 0xf6, 0x7b, 0xa5, 0x75, 0x49, 0x3f, 0x8c, 0xa3, 0xe3,
+	0xe5, 0x08, 0x18, 0x70, 0xfb, 0x1a, 0x00, 0xd7, 0xab, 0x6e, 0x11, 0xd7, 0x6b, 0xa9, 0xc1, 0x77,
+	0xb5, 0xdf, 0x2b, 0x2d, 0x4b, 0x2e, 0xd1, 0x9e, 0x8e, 0xe7, 0xf9, 0xa2, 0xc6, 0x7f, 0xa3, 0x03,
+	0xb8, 0xc8, 0xe5, 0x8b, 0x7a, 0x65, 0x8b, 0xb4, 0xd9, 0xa9, 0x98, 0x72, 0x17, 0xe3, 0xe5, 0x75,
+	0x04, 0x12, 0x9f, 0x7c, 0x8c, 0xb3, 0xb0, 0x17, 0xaf, 0x71, 0x18, 0x9f, 0x37, 0x39, 0x6a, 0x54,
+	0x77, 0x09, 0x15, 0x13, 0xec, 0x62, 0x7c, 0xde, 0x4c, 0x62, 0xe8, 0x98, 0xbf, 0x75, 0xe1, 0x18,
+	0x24, 0x76, 0xe3, 0x3e, 0xd1, 0x00, 0x45, 0xa5, 0x08, 0x13, 0xda, 0xf6, 0x5c, 0x2a, 0x46, 0xc5,
+	0xd8, 0x5c, 0xa7, 0x7d, 0xfe, 0xa8, 0x18, 0xd1, 0x07, 0xa3, 0x62, 0xec, 0x06, 0x7f, 0x23, 0x4a,
+	0xdb, 0xd3, 0x2a, 0xbe, 0x14, 0x9b, 0x86, 0x41, 0x49, 0x6c, 0xdc, 0xb4, 0x03, 0xea, 0xa1, 0x3c,
+	0x3d, 0xa5, 0xff, 0x41, 0x83, 0xf5, 0xa1, 0x48, 0x0f, 0x85, 0xfd, 0x3e, 0x20, 0x3f, 0xb6, 0x29,
+	0xfc, 0xd8, 0x55, 0x42, 0x4f, 0x7c, 0x71, 0x96, 0xfd, 0xe4, 0xc6, 0x73, 0xac, 0x3c, 0x29, 0x61,
+	0xf3, 0xdf, 0x69, 0xb0, 0x12, 0x3f, 0x3e, 0x54, 0xe4, 0x00, 0x16, 0xe2, 0xa7, 0x2b, 0x15, 0x5e,
+	0x79, 0x16, 0x15, 0x94, 0xf4, 0x03, 0xf4, 0xe8, 0xbb, 0x51, 0x1a, 0x91, 0xaf, 0xbb, 0x57, 0x9e,
+	0xd9, 0x1a, 0x81, 0x4c, 0xc9, 0x74, 0x92, 0x12, 0xfe, 0xf8, 0xb7, 0x06, 0xa9, 0x43, 0xcf, 0x73,
+	0x90, 0x07, 0xcb, 0xae, 0xc7, 0xea, 0x3c, 0xe2, 0x89, 0x55, 0x57, 0xcf, 0x42, 0x32, 0x3f, 0xef,
+	0x4c, 0x66, 0xa4, 0x7f, 0xf6, 0x4a, 0xc3, 0xac, 0x70, 0xce, 0xf5, 0x58, 0x55, 0x40, 0x8e, 0x05,
+	0x00, 0x7d, 0x00, 0x8b, 0x83, 0x87, 0xc9, 0xec, 0xfd, 0xce, 0xc4, 0x87, 0x0d, 0xb2, 0xe9, 0xf7,
+	0x4a, 0x2b, 0xd1, 0x4d, 0x0e, 0xc1, 0x3a, 0x5e, 0x68, 0xc4, 0x4e, 0xdf, 0x4e, 0x73, 0xff, 0xfd,
+	0x8b, 0xfb, 0xf0, 0xae, 0xf0, 0x61, 0xd8, 0xfe, 0xee, 0x38, 0x06, 0xa5, 0xe2, 0x65, 0xf9, 0x55,
+	0xb8, 0x60, 0xbb, 0x16, 0x39, 0x13, 0x56, 0x58, 0xac, 0x2e, 0xf5, 0x7b, 0xa5, 0x85, 0xa0, 0xac,
+	0x5a, 0xe4, 0x4c, 0xc7, 0x72, 0x3b, 0x7c, 0x23, 0x9f, 0x3e, 0xff, 0x1b, 0xb9, 0x0a, 0xa7, 0xfb,
+	0x1a, 0xac, 0x8d, 0x9e, 0xd9, 0xd1, 0x65, 0x98, 0x1b, 0x2c, 0x9a, 0xa8, 0xdf, 0x2b, 0x65, 0xa5,
+	0x38, 0x61, 0x05, 0x9a, 0x33, 0xa2, 0xa1, 0xe2, 0x8e, 0x6c, 0x77, 0xce, 0x27, 0x94, 0xa2, 0xde,
+	0x4e, 0xdf, 0x0d, 0xb2, 0xcb, 0x0f, 0x21, 0x13, 0x33, 0x12, 0x5a, 0x81, 0x0b, 0x0e, 0xb9, 0x4d,
+	0x1c, 0x69, 0x1b, 0x2c, 0x17, 0xe3, 0x66, 0x90, 0xe9, 0x73, 0xcf, 0x20, 0x51, 0xde, 0xf8, 0x78,
+	0x8e, 0xe7, 0x8d, 0x71, 0xcf, 0x39, 0x27, 0x90, 0x8d, 0x8a, 0xf2, 0x17, 0xf8, 0x60, 0xb1, 0x18,
+	0x72, 0x11, 0x11, 0xf0, 0x2e, 0x2c, 0xc7, 0x84, 0xa2, 0xf5, 0x2f, 0xe0, 0xe6, 0xa5, 0x38, 0x23,
+	0xc1, 0x7c, 0x87, 0x77, 0x7b, 0x83, 0xd5, 0x44, 0x96, 0xc4, 0x42, 0xbc, 0x7f, 0x4b, 0x54, 0x92,
+	0xac, 0x3d, 0x58, 0x46, 0xde, 0x87, 0x4b, 0x71, 0x63, 0x9a, 0x3c, 0x78, 0xd5, 0x97, 0x07, 0xd9,
+	0x6e, 0x5c, 0x1e, 0x9f, 0x27, 0x86, 0x43, 0x5e, 0xa5, 0x88, 0x55, 0x7f, 0xc4, 0xde, 0xd0, 0x07,
+	0x88, 0x0b, 0xcf, 0xf3, 0x03, 0x04, 0xfa, 0x50, 0x83, 0xf5, 0x81, 0xfe, 0x41, 0x68, 0xa1, 0xbe,
+	0xc3, 0xa8, 0xc7, 0x64, 0x3c, 0xf1, 0x63, 0x72, 0x79, 0x44, 0x63, 0x12, 0x67, 0xac, 0xe3, 0xb5,
+	0x78, 0x8f, 0xc2, 0xf5, 0x94, 0x1f, 0x7a, 0xd0, 0x2f, 0x35, 0xd8, 0x18, 0x2c, 0xbf, 0x3e, 0x8d,
+	0x53, 0x8a, 0x62, 0x3d, 0x5f, 0x3d, 0x99, 0x58, 0xa4, 0x97, 0x47, 0x95, 0xf6, 0x41, 0xde, 0x3a,
+	0x5e, 0x1f, 0x28, 0xf3, 0x3e, 0x8d, 0x09, 0xf6, 0x63, 0x0d, 0x56, 0x39, 0x71, 0xe4, 0xea, 0x40,
+	0xa2, 0xb4, 0x90, 0xe8, 0x60, 0x62, 0x89, 0x36, 0x22, 0x89, 0x86, 0x98, 0xea, 0x18, 0xf1, 0x8f,
+	0x5f, 0x41, 0x10, 0x48, 0x19, 0xa2, 0xa6, 0xe3, 0x2b, 0xbf, 0xd5, 0x00, 0xa2, 0x0f, 0x0b, 0xe8,
+	0x32, 0x5c, 0xaa, 0xbe, 0x7d, 0x50, 0xab, 0x1f, 0x1d, 0x5f, 0x3b, 0x3e, 0x39, 0xaa, 0x9f, 0x1c,
+	0x1c, 0x1d, 0xee, 0xee, 0xec, 0x5f, 0xdf, 0xdf, 0xad, 0x2d, 0x4d, 0x15, 0x72, 0xf7, 0x1e, 0x94,
+	0x33, 0x27, 0x2e, 0x6d, 0x13, 0xd3, 0xbe, 0x69, 0x13, 0x0b, 0xbd, 0x0a, 0x2b, 0x83, 0xd8, 0x7c,
+	0xb5, 0x5b, 0x5b, 0xd2, 0x0a, 0x0b, 0xf7, 0x1e, 0x94, 0xd3, 0x72, 0xc0, 0x26, 0x16, 0xda, 0x84,
+	0xd5, 0x61, 0xbc, 0xfd, 0x83, 0x6f, 0x2f, 0x4d, 0x17, 0x16, 0xef, 0x3d, 0x28, 0xcf, 0x87, 0x93,
+	0x38, 0xd2, 0x01, 0xc5, 0x31, 0x15, 0xbf, 0x99, 0x02, 0xdc, 0x7b, 0x50, 0x9e, 0x95, 0xd5, 0xa7,
+	0x90, 0xba, 0xfb, 0x49, 0x71, 0xaa, 0x7a, 0xfd, 0xd3, 0xc7, 0x45, 0xed, 0xd1, 0xe3, 0xa2, 0xf6,
+	0xb7, 0xc7, 0x45, 0xed, 0xa3, 0x27, 0xc5, 0xa9, 0x47, 0x4f, 0x8a, 0x53, 0x7f, 0x7a, 0x52, 0x9c,
+	0xfa, 0xde, 0xe5, 0xcf, 0x35, 0xdc, 0x59, 0xf8, 0xcd, 0x5a, 0x98, 0xb0, 0x31, 0x2b, 0xe2, 0xfe,
+	0xf5, 0xff, 0x0c, 0x00, 0x3c, 0xcd, 0xf9, 0x2f, 0xd2, 0x1e, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1408,647 +1544,673 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10228 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x5c, 0xd7,
-		0x79, 0x18, 0xee, 0xee, 0x02, 0xd8, 0xfd, 0xb0, 0x00, 0x16, 0x07, 0x20, 0xb9, 0x5c, 0x92, 0x58,
-		0xf0, 0xea, 0x45, 0x51, 0x12, 0x20, 0x51, 0x22, 0x29, 0x2e, 0x6d, 0xcb, 0x58, 0xec, 0x12, 0x04,
-		0x85, 0x97, 0x2e, 0x00, 0xea, 0x61, 0xa7, 0x3b, 0x17, 0xbb, 0x07, 0x8b, 0x2b, 0xec, 0xde, 0x7b,
-		0x75, 0xef, 0x5d, 0x12, 0xa0, 0xed, 0x19, 0xc5, 0x76, 0x5d, 0x5b, 0x69, 0x6a, 0x3b, 0xc9, 0xa4,
-		0x8a, 0x6c, 0x3a, 0x72, 0x9c, 0xd6, 0xae, 0x9d, 0x36, 0x4e, 0xe2, 0x3a, 0x4d, 0xdb, 0x99, 0xba,
-		0x99, 0x49, 0x6a, 0x3b, 0xad, 0xc7, 0xea, 0x33, 0xcd, 0xa4, 0x74, 0x2a, 0x7b, 0x1a, 0xd5, 0x75,
-		0x1b, 0x87, 0x75, 0x67, 0x3c, 0xf5, 0x74, 0xda, 0x39, 0xaf, 0xfb, 0xd8, 0xf7, 0x42, 0xa0, 0xe5,
-		0x34, 0xf9, 0x85, 0x3d, 0xe7, 0x7c, 0xdf, 0x77, 0xbe, 0xf3, 0x9d, 0xef, 0x75, 0x5e, 0x17, 0xf0,
-		0x89, 0x8b, 0x30, 0x55, 0x36, 0x8c, 0x72, 0x05, 0xcf, 0x98, 0x96, 0xe1, 0x18, 0x9b, 0xb5, 0xad,
-		0x99, 0x12, 0xb6, 0x8b, 0x96, 0x66, 0x3a, 0x86, 0x35, 0x4d, 0xeb, 0xd0, 0x28, 0x83, 0x98, 0x16,
-		0x10, 0xf2, 0x12, 0x8c, 0x5d, 0xd2, 0x2a, 0x38, 0xe7, 0x02, 0xae, 0x61, 0x07, 0x3d, 0x0e, 0x91,
-		0x2d, 0xad, 0x82, 0x93, 0xd2, 0x54, 0xf8, 0xd4, 0xd0, 0x99, 0xbb, 0xa7, 0xeb, 0x90, 0xa6, 0x83,
-		0x18, 0xab, 0xa4, 0x5a, 0xa1, 0x18, 0xf2, 0x77, 0x22, 0x30, 0xde, 0xa4, 0x15, 0x21, 0x88, 0xe8,
-		0x6a, 0x95, 0x50, 0x94, 0x4e, 0xc5, 0x14, 0xfa, 0x1b, 0x25, 0x61, 0xd0, 0x54, 0x8b, 0x3b, 0x6a,
-		0x19, 0x27, 0x43, 0xb4, 0x5a, 0x14, 0xd1, 0x24, 0x40, 0x09, 0x9b, 0x58, 0x2f, 0x61, 0xbd, 0xb8,
-		0x97, 0x0c, 0x4f, 0x85, 0x4f, 0xc5, 0x14, 0x5f, 0x0d, 0x7a, 0x00, 0xc6, 0xcc, 0xda, 0x66, 0x45,
-		0x2b, 0x16, 0x7c, 0x60, 0x30, 0x15, 0x3e, 0xd5, 0xaf, 0x24, 0x58, 0x43, 0xce, 0x03, 0xbe, 0x0f,
-		0x46, 0xaf, 0x63, 0x75, 0xc7, 0x0f, 0x3a, 0x44, 0x41, 0x47, 0x48, 0xb5, 0x0f, 0x70, 0x0e, 0xe2,
-		0x55, 0x6c, 0xdb, 0x6a, 0x19, 0x17, 0x9c, 0x3d, 0x13, 0x27, 0x23, 0x74, 0xf4, 0x53, 0x0d, 0xa3,
-		0xaf, 0x1f, 0xf9, 0x10, 0xc7, 0x5a, 0xdf, 0x33, 0x31, 0x9a, 0x85, 0x18, 0xd6, 0x6b, 0x55, 0x46,
-		0xa1, 0xbf, 0x85, 0xfc, 0xf2, 0x7a, 0xad, 0x5a, 0x4f, 0x25, 0x4a, 0xd0, 0x38, 0x89, 0x41, 0x1b,
-		0x5b, 0xd7, 0xb4, 0x22, 0x4e, 0x0e, 0x50, 0x02, 0xf7, 0x35, 0x10, 0x58, 0x63, 0xed, 0xf5, 0x34,
-		0x04, 0x1e, 0x9a, 0x83, 0x18, 0xde, 0x75, 0xb0, 0x6e, 0x6b, 0x86, 0x9e, 0x1c, 0xa4, 0x44, 0xee,
-		0x69, 0x32, 0x8b, 0xb8, 0x52, 0xaa, 0x27, 0xe1, 0xe1, 0xa1, 0x73, 0x30, 0x68, 0x98, 0x8e, 0x66,
-		0xe8, 0x76, 0x32, 0x3a, 0x25, 0x9d, 0x1a, 0x3a, 0x73, 0xbc, 0xa9, 0x22, 0xac, 0x30, 0x18, 0x45,
-		0x00, 0xa3, 0x05, 0x48, 0xd8, 0x46, 0xcd, 0x2a, 0xe2, 0x42, 0xd1, 0x28, 0xe1, 0x82, 0xa6, 0x6f,
-		0x19, 0xc9, 0x18, 0x25, 0x90, 0x6e, 0x1c, 0x08, 0x05, 0x9c, 0x33, 0x4a, 0x78, 0x41, 0xdf, 0x32,
-		0x94, 0x11, 0x3b, 0x50, 0x46, 0x87, 0x61, 0xc0, 0xde, 0xd3, 0x1d, 0x75, 0x37, 0x19, 0xa7, 0x1a,
-		0xc2, 0x4b, 0xf2, 0xef, 0x0c, 0xc0, 0x68, 0x37, 0x2a, 0x76, 0x11, 0xfa, 0xb7, 0xc8, 0x28, 0x93,
-		0xa1, 0x5e, 0x64, 0xc0, 0x70, 0x82, 0x42, 0x1c, 0xd8, 0xa7, 0x10, 0x67, 0x61, 0x48, 0xc7, 0xb6,
-		0x83, 0x4b, 0x4c, 0x23, 0xc2, 0x5d, 0xea, 0x14, 0x30, 0xa4, 0x46, 0x95, 0x8a, 0xec, 0x4b, 0xa5,
-		0x9e, 0x81, 0x51, 0x97, 0xa5, 0x82, 0xa5, 0xea, 0x65, 0xa1, 0x9b, 0x33, 0x9d, 0x38, 0x99, 0xce,
-		0x0b, 0x3c, 0x85, 0xa0, 0x29, 0x23, 0x38, 0x50, 0x46, 0x39, 0x00, 0x43, 0xc7, 0xc6, 0x56, 0xa1,
-		0x84, 0x8b, 0x95, 0x64, 0xb4, 0x85, 0x94, 0x56, 0x08, 0x48, 0x83, 0x94, 0x0c, 0x56, 0x5b, 0xac,
-		0xa0, 0x0b, 0x9e, 0xaa, 0x0d, 0xb6, 0xd0, 0x94, 0x25, 0x66, 0x64, 0x0d, 0xda, 0xb6, 0x01, 0x23,
-		0x16, 0x26, 0x7a, 0x8f, 0x4b, 0x7c, 0x64, 0x31, 0xca, 0xc4, 0x74, 0xc7, 0x91, 0x29, 0x1c, 0x8d,
-		0x0d, 0x6c, 0xd8, 0xf2, 0x17, 0xd1, 0x5d, 0xe0, 0x56, 0x14, 0xa8, 0x5a, 0x01, 0xf5, 0x42, 0x71,
-		0x51, 0xb9, 0xac, 0x56, 0x71, 0xea, 0x06, 0x8c, 0x04, 0xc5, 0x83, 0x26, 0xa0, 0xdf, 0x76, 0x54,
-		0xcb, 0xa1, 0x5a, 0xd8, 0xaf, 0xb0, 0x02, 0x4a, 0x40, 0x18, 0xeb, 0x25, 0xea, 0xe5, 0xfa, 0x15,
-		0xf2, 0x13, 0xbd, 0xd3, 0x1b, 0x70, 0x98, 0x0e, 0xf8, 0xde, 0xc6, 0x19, 0x0d, 0x50, 0xae, 0x1f,
-		0x77, 0xea, 0x3c, 0x0c, 0x07, 0x06, 0xd0, 0x6d, 0xd7, 0xf2, 0x7b, 0xe1, 0x50, 0x53, 0xd2, 0xe8,
-		0x19, 0x98, 0xa8, 0xe9, 0x9a, 0xee, 0x60, 0xcb, 0xb4, 0x30, 0xd1, 0x58, 0xd6, 0x55, 0xf2, 0x4f,
-		0x07, 0x5b, 0xe8, 0xdc, 0x86, 0x1f, 0x9a, 0x51, 0x51, 0xc6, 0x6b, 0x8d, 0x95, 0xa7, 0x63, 0xd1,
-		0x37, 0x06, 0x13, 0x2f, 0xbe, 0xf8, 0xe2, 0x8b, 0x21, 0xf9, 0xe5, 0x01, 0x98, 0x68, 0x66, 0x33,
-		0x4d, 0xcd, 0xf7, 0x30, 0x0c, 0xe8, 0xb5, 0xea, 0x26, 0xb6, 0xa8, 0x90, 0xfa, 0x15, 0x5e, 0x42,
-		0xb3, 0xd0, 0x5f, 0x51, 0x37, 0x71, 0x25, 0x19, 0x99, 0x92, 0x4e, 0x8d, 0x9c, 0x79, 0xa0, 0x2b,
-		0xab, 0x9c, 0x5e, 0x24, 0x28, 0x0a, 0xc3, 0x44, 0xef, 0x80, 0x08, 0x77, 0xd1, 0x84, 0xc2, 0xe9,
-		0xee, 0x28, 0x10, 0x5b, 0x52, 0x28, 0x1e, 0x3a, 0x06, 0x31, 0xf2, 0x97, 0xe9, 0xc6, 0x00, 0xe5,
-		0x39, 0x4a, 0x2a, 0x88, 0x5e, 0xa0, 0x14, 0x44, 0xa9, 0x99, 0x94, 0xb0, 0x08, 0x6d, 0x6e, 0x99,
-		0x28, 0x56, 0x09, 0x6f, 0xa9, 0xb5, 0x8a, 0x53, 0xb8, 0xa6, 0x56, 0x6a, 0x98, 0x2a, 0x7c, 0x4c,
-		0x89, 0xf3, 0xca, 0xab, 0xa4, 0x0e, 0xa5, 0x61, 0x88, 0x59, 0x95, 0xa6, 0x97, 0xf0, 0x2e, 0xf5,
-		0x9e, 0xfd, 0x0a, 0x33, 0xb4, 0x05, 0x52, 0x43, 0xba, 0x7f, 0xde, 0x36, 0x74, 0xa1, 0x9a, 0xb4,
-		0x0b, 0x52, 0x41, 0xbb, 0x3f, 0x5f, 0xef, 0xb8, 0x4f, 0x34, 0x1f, 0x5e, 0xbd, 0x4e, 0xc9, 0x5f,
-		0x0e, 0x41, 0x84, 0xfa, 0x8b, 0x51, 0x18, 0x5a, 0x7f, 0x76, 0x35, 0x5f, 0xc8, 0xad, 0x6c, 0x64,
-		0x17, 0xf3, 0x09, 0x09, 0x8d, 0x00, 0xd0, 0x8a, 0x4b, 0x8b, 0x2b, 0xb3, 0xeb, 0x89, 0x90, 0x5b,
-		0x5e, 0x58, 0x5e, 0x3f, 0xf7, 0x58, 0x22, 0xec, 0x22, 0x6c, 0xb0, 0x8a, 0x88, 0x1f, 0xe0, 0xd1,
-		0x33, 0x89, 0x7e, 0x94, 0x80, 0x38, 0x23, 0xb0, 0xf0, 0x4c, 0x3e, 0x77, 0xee, 0xb1, 0xc4, 0x40,
-		0xb0, 0xe6, 0xd1, 0x33, 0x89, 0x41, 0x34, 0x0c, 0x31, 0x5a, 0x93, 0x5d, 0x59, 0x59, 0x4c, 0x44,
-		0x5d, 0x9a, 0x6b, 0xeb, 0xca, 0xc2, 0xf2, 0x7c, 0x22, 0xe6, 0xd2, 0x9c, 0x57, 0x56, 0x36, 0x56,
-		0x13, 0xe0, 0x52, 0x58, 0xca, 0xaf, 0xad, 0xcd, 0xce, 0xe7, 0x13, 0x43, 0x2e, 0x44, 0xf6, 0xd9,
-		0xf5, 0xfc, 0x5a, 0x22, 0x1e, 0x60, 0xeb, 0xd1, 0x33, 0x89, 0x61, 0xb7, 0x8b, 0xfc, 0xf2, 0xc6,
-		0x52, 0x62, 0x04, 0x8d, 0xc1, 0x30, 0xeb, 0x42, 0x30, 0x31, 0x5a, 0x57, 0x75, 0xee, 0xb1, 0x44,
-		0xc2, 0x63, 0x84, 0x51, 0x19, 0x0b, 0x54, 0x9c, 0x7b, 0x2c, 0x81, 0xe4, 0x39, 0xe8, 0xa7, 0xda,
-		0x85, 0x10, 0x8c, 0x2c, 0xce, 0x66, 0xf3, 0x8b, 0x85, 0x95, 0xd5, 0xf5, 0x85, 0x95, 0xe5, 0xd9,
-		0xc5, 0x84, 0xe4, 0xd5, 0x29, 0xf9, 0xa7, 0x36, 0x16, 0x94, 0x7c, 0x2e, 0x11, 0xf2, 0xd7, 0xad,
-		0xe6, 0x67, 0xd7, 0xf3, 0xb9, 0x44, 0x58, 0x2e, 0xc2, 0x44, 0x33, 0x3f, 0xd9, 0xd4, 0x32, 0x7c,
-		0x53, 0x1c, 0x6a, 0x31, 0xc5, 0x94, 0x56, 0xc3, 0x14, 0x7f, 0x3b, 0x04, 0xe3, 0x4d, 0x62, 0x45,
-		0xd3, 0x4e, 0x9e, 0x80, 0x7e, 0xa6, 0xa2, 0x2c, 0x7a, 0xde, 0xdf, 0x34, 0xe8, 0x50, 0x85, 0x6d,
-		0x88, 0xa0, 0x14, 0xcf, 0x9f, 0x41, 0x84, 0x5b, 0x64, 0x10, 0x84, 0x44, 0x83, 0x4f, 0xff, 0xa9,
-		0x06, 0x9f, 0xce, 0xc2, 0xde, 0xb9, 0x6e, 0xc2, 0x1e, 0xad, 0xeb, 0xcd, 0xb7, 0xf7, 0x37, 0xf1,
-		0xed, 0x17, 0x61, 0xac, 0x81, 0x50, 0xd7, 0x3e, 0xf6, 0x03, 0x12, 0x24, 0x5b, 0x09, 0xa7, 0x83,
-		0xa7, 0x0b, 0x05, 0x3c, 0xdd, 0xc5, 0x7a, 0x09, 0x9e, 0x6c, 0x3d, 0x09, 0x0d, 0x73, 0xfd, 0x59,
-		0x09, 0x0e, 0x37, 0xcf, 0x14, 0x9b, 0xf2, 0xf0, 0x0e, 0x18, 0xa8, 0x62, 0x67, 0xdb, 0x10, 0xd9,
-		0xd2, 0xbd, 0x4d, 0x62, 0x30, 0x69, 0xae, 0x9f, 0x6c, 0x8e, 0xe5, 0x0f, 0xe2, 0xe1, 0x56, 0xe9,
-		0x1e, 0xe3, 0xa6, 0x81, 0xd3, 0x8f, 0x84, 0xe0, 0x50, 0x53, 0xe2, 0x4d, 0x19, 0x3d, 0x01, 0xa0,
-		0xe9, 0x66, 0xcd, 0x61, 0x19, 0x11, 0x73, 0xb0, 0x31, 0x5a, 0x43, 0x9d, 0x17, 0x71, 0x9e, 0x35,
-		0xc7, 0x6d, 0x0f, 0xd3, 0x76, 0x60, 0x55, 0x14, 0xe0, 0x71, 0x8f, 0xd1, 0x08, 0x65, 0x74, 0xb2,
-		0xc5, 0x48, 0x1b, 0x14, 0xf3, 0x61, 0x48, 0x14, 0x2b, 0x1a, 0xd6, 0x9d, 0x82, 0xed, 0x58, 0x58,
-		0xad, 0x6a, 0x7a, 0x99, 0x46, 0x90, 0x68, 0xa6, 0x7f, 0x4b, 0xad, 0xd8, 0x58, 0x19, 0x65, 0xcd,
-		0x6b, 0xa2, 0x95, 0x60, 0x50, 0x05, 0xb2, 0x7c, 0x18, 0x03, 0x01, 0x0c, 0xd6, 0xec, 0x62, 0xc8,
-		0x3f, 0x17, 0x83, 0x21, 0x5f, 0x5e, 0x8d, 0x4e, 0x42, 0xfc, 0x79, 0xf5, 0x9a, 0x5a, 0x10, 0x6b,
-		0x25, 0x26, 0x89, 0x21, 0x52, 0xb7, 0xca, 0xd7, 0x4b, 0x0f, 0xc3, 0x04, 0x05, 0x31, 0x6a, 0x0e,
-		0xb6, 0x0a, 0xc5, 0x8a, 0x6a, 0xdb, 0x54, 0x68, 0x51, 0x0a, 0x8a, 0x48, 0xdb, 0x0a, 0x69, 0x9a,
-		0x13, 0x2d, 0xe8, 0x2c, 0x8c, 0x53, 0x8c, 0x6a, 0xad, 0xe2, 0x68, 0x66, 0x05, 0x17, 0xc8, 0xea,
-		0xcd, 0xa6, 0x91, 0xc4, 0xe5, 0x6c, 0x8c, 0x40, 0x2c, 0x71, 0x00, 0xc2, 0x91, 0x8d, 0x72, 0x70,
-		0x82, 0xa2, 0x95, 0xb1, 0x8e, 0x2d, 0xd5, 0xc1, 0x05, 0xfc, 0x42, 0x4d, 0xad, 0xd8, 0x05, 0x55,
-		0x2f, 0x15, 0xb6, 0x55, 0x7b, 0x3b, 0x39, 0x41, 0x08, 0x64, 0x43, 0x49, 0x49, 0x39, 0x4a, 0x00,
-		0xe7, 0x39, 0x5c, 0x9e, 0x82, 0xcd, 0xea, 0xa5, 0xcb, 0xaa, 0xbd, 0x8d, 0x32, 0x70, 0x98, 0x52,
-		0xb1, 0x1d, 0x4b, 0xd3, 0xcb, 0x85, 0xe2, 0x36, 0x2e, 0xee, 0x14, 0x6a, 0xce, 0xd6, 0xe3, 0xc9,
-		0x63, 0xfe, 0xfe, 0x29, 0x87, 0x6b, 0x14, 0x66, 0x8e, 0x80, 0x6c, 0x38, 0x5b, 0x8f, 0xa3, 0x35,
-		0x88, 0x93, 0xc9, 0xa8, 0x6a, 0x37, 0x70, 0x61, 0xcb, 0xb0, 0x68, 0x68, 0x1c, 0x69, 0xe2, 0x9a,
-		0x7c, 0x12, 0x9c, 0x5e, 0xe1, 0x08, 0x4b, 0x46, 0x09, 0x67, 0xfa, 0xd7, 0x56, 0xf3, 0xf9, 0x9c,
-		0x32, 0x24, 0xa8, 0x5c, 0x32, 0x2c, 0xa2, 0x50, 0x65, 0xc3, 0x15, 0xf0, 0x10, 0x53, 0xa8, 0xb2,
-		0x21, 0xc4, 0x7b, 0x16, 0xc6, 0x8b, 0x45, 0x36, 0x66, 0xad, 0x58, 0xe0, 0x6b, 0x2c, 0x3b, 0x99,
-		0x08, 0x08, 0xab, 0x58, 0x9c, 0x67, 0x00, 0x5c, 0xc7, 0x6d, 0x74, 0x01, 0x0e, 0x79, 0xc2, 0xf2,
-		0x23, 0x8e, 0x35, 0x8c, 0xb2, 0x1e, 0xf5, 0x2c, 0x8c, 0x9b, 0x7b, 0x8d, 0x88, 0x28, 0xd0, 0xa3,
-		0xb9, 0x57, 0x8f, 0x76, 0x1e, 0x26, 0xcc, 0x6d, 0xb3, 0x11, 0xef, 0xb4, 0x1f, 0x0f, 0x99, 0xdb,
-		0x66, 0x3d, 0xe2, 0x3d, 0x74, 0xc1, 0x6d, 0xe1, 0xa2, 0xea, 0xe0, 0x52, 0xf2, 0x88, 0x1f, 0xdc,
-		0xd7, 0x80, 0x66, 0x20, 0x51, 0x2c, 0x16, 0xb0, 0xae, 0x6e, 0x56, 0x70, 0x41, 0xb5, 0xb0, 0xae,
-		0xda, 0xc9, 0xb4, 0x1f, 0x78, 0xa4, 0x58, 0xcc, 0xd3, 0xd6, 0x59, 0xda, 0x88, 0x4e, 0xc3, 0x98,
-		0xb1, 0xf9, 0x7c, 0x91, 0xa9, 0x64, 0xc1, 0xb4, 0xf0, 0x96, 0xb6, 0x9b, 0xbc, 0x9b, 0xca, 0x77,
-		0x94, 0x34, 0x50, 0x85, 0x5c, 0xa5, 0xd5, 0xe8, 0x7e, 0x48, 0x14, 0xed, 0x6d, 0xd5, 0x32, 0xa9,
-		0x4f, 0xb6, 0x4d, 0xb5, 0x88, 0x93, 0xf7, 0x30, 0x50, 0x56, 0xbf, 0x2c, 0xaa, 0x89, 0x49, 0xd8,
-		0xd7, 0xb5, 0x2d, 0x47, 0x50, 0xbc, 0x8f, 0x99, 0x04, 0xad, 0xe3, 0xd4, 0x4e, 0x41, 0x82, 0x88,
-		0x22, 0xd0, 0xf1, 0x29, 0x0a, 0x36, 0x62, 0x6e, 0x9b, 0xfe, 0x7e, 0xef, 0x82, 0x61, 0x02, 0xe9,
-		0x75, 0x7a, 0x3f, 0x4b, 0xc8, 0xcc, 0x6d, 0x5f, 0x8f, 0x8f, 0xc1, 0x61, 0x02, 0x54, 0xc5, 0x8e,
-		0x5a, 0x52, 0x1d, 0xd5, 0x07, 0xfd, 0x20, 0x85, 0x26, 0x72, 0x5f, 0xe2, 0x8d, 0x01, 0x3e, 0xad,
-		0xda, 0xe6, 0x9e, 0xab, 0x59, 0x0f, 0x31, 0x3e, 0x49, 0x9d, 0xd0, 0xad, 0x3b, 0x96, 0x74, 0xcb,
-		0x19, 0x88, 0xfb, 0x15, 0x1f, 0xc5, 0x80, 0xa9, 0x7e, 0x42, 0x22, 0x59, 0xd0, 0xdc, 0x4a, 0x8e,
-		0xe4, 0x2f, 0xcf, 0xe5, 0x13, 0x21, 0x92, 0x47, 0x2d, 0x2e, 0xac, 0xe7, 0x0b, 0xca, 0xc6, 0xf2,
-		0xfa, 0xc2, 0x52, 0x3e, 0x11, 0xf6, 0x25, 0xec, 0x57, 0x22, 0xd1, 0x7b, 0x13, 0xf7, 0xc9, 0xaf,
-		0x85, 0x60, 0x24, 0xb8, 0x02, 0x43, 0x6f, 0x83, 0x23, 0x62, 0xbb, 0xc4, 0xc6, 0x4e, 0xe1, 0xba,
-		0x66, 0x51, 0x8b, 0xac, 0xaa, 0x2c, 0x3a, 0xba, 0x3a, 0x31, 0xc1, 0xa1, 0xd6, 0xb0, 0xf3, 0xb4,
-		0x66, 0x11, 0x7b, 0xab, 0xaa, 0x0e, 0x5a, 0x84, 0xb4, 0x6e, 0x14, 0x6c, 0x47, 0xd5, 0x4b, 0xaa,
-		0x55, 0x2a, 0x78, 0x1b, 0x55, 0x05, 0xb5, 0x58, 0xc4, 0xb6, 0x6d, 0xb0, 0x48, 0xe8, 0x52, 0x39,
-		0xae, 0x1b, 0x6b, 0x1c, 0xd8, 0x0b, 0x11, 0xb3, 0x1c, 0xb4, 0x4e, 0x7f, 0xc3, 0xad, 0xf4, 0xf7,
-		0x18, 0xc4, 0xaa, 0xaa, 0x59, 0xc0, 0xba, 0x63, 0xed, 0xd1, 0xbc, 0x3b, 0xaa, 0x44, 0xab, 0xaa,
-		0x99, 0x27, 0xe5, 0x1f, 0xcb, 0xf2, 0xe7, 0x4a, 0x24, 0x1a, 0x4d, 0xc4, 0xae, 0x44, 0xa2, 0xb1,
-		0x04, 0xc8, 0xaf, 0x87, 0x21, 0xee, 0xcf, 0xc3, 0xc9, 0xb2, 0xa6, 0x48, 0x43, 0x96, 0x44, 0x9d,
-		0xda, 0x5d, 0x6d, 0xb3, 0xf6, 0xe9, 0x39, 0x12, 0xcb, 0x32, 0x03, 0x2c, 0x3b, 0x56, 0x18, 0x26,
-		0xc9, 0x23, 0x88, 0xb2, 0x61, 0x96, 0x8d, 0x44, 0x15, 0x5e, 0x42, 0xf3, 0x30, 0xf0, 0xbc, 0x4d,
-		0x69, 0x0f, 0x50, 0xda, 0x77, 0xb7, 0xa7, 0x7d, 0x65, 0x8d, 0x12, 0x8f, 0x5d, 0x59, 0x2b, 0x2c,
-		0xaf, 0x28, 0x4b, 0xb3, 0x8b, 0x0a, 0x47, 0x47, 0x47, 0x21, 0x52, 0x51, 0x6f, 0xec, 0x05, 0xa3,
-		0x1e, 0xad, 0xea, 0x76, 0x12, 0x8e, 0x42, 0xe4, 0x3a, 0x56, 0x77, 0x82, 0xb1, 0x86, 0x56, 0xdd,
-		0x41, 0x63, 0x98, 0x81, 0x7e, 0x2a, 0x2f, 0x04, 0xc0, 0x25, 0x96, 0xe8, 0x43, 0x51, 0x88, 0xcc,
-		0xad, 0x28, 0xc4, 0x20, 0x12, 0x10, 0x67, 0xb5, 0x85, 0xd5, 0x85, 0xfc, 0x5c, 0x3e, 0x11, 0x92,
-		0xcf, 0xc2, 0x00, 0x13, 0x02, 0x31, 0x16, 0x57, 0x0c, 0x89, 0x3e, 0x5e, 0xe4, 0x34, 0x24, 0xd1,
-		0xba, 0xb1, 0x94, 0xcd, 0x2b, 0x89, 0x50, 0x70, 0xaa, 0x23, 0x89, 0x7e, 0xd9, 0x86, 0xb8, 0x3f,
-		0x11, 0xff, 0xf1, 0x2c, 0xb2, 0xbf, 0x22, 0xc1, 0x90, 0x2f, 0xb1, 0x26, 0x19, 0x91, 0x5a, 0xa9,
-		0x18, 0xd7, 0x0b, 0x6a, 0x45, 0x53, 0x6d, 0xae, 0x1a, 0x40, 0xab, 0x66, 0x49, 0x4d, 0xb7, 0x53,
-		0xf7, 0x63, 0x32, 0x91, 0xfe, 0xc4, 0x80, 0xfc, 0x29, 0x09, 0x12, 0xf5, 0x99, 0x6d, 0x1d, 0x9b,
-		0xd2, 0x5b, 0xc9, 0xa6, 0xfc, 0x49, 0x09, 0x46, 0x82, 0xe9, 0x6c, 0x1d, 0x7b, 0x27, 0xdf, 0x52,
-		0xf6, 0xfe, 0x24, 0x04, 0xc3, 0x81, 0x24, 0xb6, 0x5b, 0xee, 0x5e, 0x80, 0x31, 0xad, 0x84, 0xab,
-		0xa6, 0xe1, 0x60, 0xbd, 0xb8, 0x57, 0xa8, 0xe0, 0x6b, 0xb8, 0x92, 0x94, 0xa9, 0xd3, 0x98, 0x69,
-		0x9f, 0x26, 0x4f, 0x2f, 0x78, 0x78, 0x8b, 0x04, 0x2d, 0x33, 0xbe, 0x90, 0xcb, 0x2f, 0xad, 0xae,
-		0xac, 0xe7, 0x97, 0xe7, 0x9e, 0x2d, 0x6c, 0x2c, 0x3f, 0xb9, 0xbc, 0xf2, 0xf4, 0xb2, 0x92, 0xd0,
-		0xea, 0xc0, 0xee, 0xa0, 0xd9, 0xaf, 0x42, 0xa2, 0x9e, 0x29, 0x74, 0x04, 0x9a, 0xb1, 0x95, 0xe8,
-		0x43, 0xe3, 0x30, 0xba, 0xbc, 0x52, 0x58, 0x5b, 0xc8, 0xe5, 0x0b, 0xf9, 0x4b, 0x97, 0xf2, 0x73,
-		0xeb, 0x6b, 0x6c, 0xe3, 0xc3, 0x85, 0x5e, 0x0f, 0x18, 0xb8, 0xfc, 0x4a, 0x18, 0xc6, 0x9b, 0x70,
-		0x82, 0x66, 0xf9, 0x92, 0x85, 0xad, 0xa2, 0x1e, 0xea, 0x86, 0xfb, 0x69, 0x92, 0x33, 0xac, 0xaa,
-		0x96, 0xc3, 0x57, 0x38, 0xf7, 0x03, 0x91, 0x92, 0xee, 0x68, 0x5b, 0x1a, 0xb6, 0xf8, 0x3e, 0x11,
-		0x5b, 0xc7, 0x8c, 0x7a, 0xf5, 0x6c, 0xab, 0xe8, 0x41, 0x40, 0xa6, 0x61, 0x6b, 0x8e, 0x76, 0x0d,
-		0x17, 0x34, 0x5d, 0x6c, 0x2a, 0x91, 0x75, 0x4d, 0x44, 0x49, 0x88, 0x96, 0x05, 0xdd, 0x71, 0xa1,
-		0x75, 0x5c, 0x56, 0xeb, 0xa0, 0x89, 0x33, 0x0f, 0x2b, 0x09, 0xd1, 0xe2, 0x42, 0x9f, 0x84, 0x78,
-		0xc9, 0xa8, 0x91, 0x64, 0x8f, 0xc1, 0x91, 0xd8, 0x21, 0x29, 0x43, 0xac, 0xce, 0x05, 0xe1, 0x69,
-		0xbc, 0xb7, 0x9b, 0x15, 0x57, 0x86, 0x58, 0x1d, 0x03, 0xb9, 0x0f, 0x46, 0xd5, 0x72, 0xd9, 0x22,
-		0xc4, 0x05, 0x21, 0xb6, 0x30, 0x19, 0x71, 0xab, 0x29, 0x60, 0xea, 0x0a, 0x44, 0x85, 0x1c, 0x48,
-		0xa8, 0x26, 0x92, 0x28, 0x98, 0x6c, 0xb5, 0x1d, 0x3a, 0x15, 0x53, 0xa2, 0xba, 0x68, 0x3c, 0x09,
-		0x71, 0xcd, 0x2e, 0x78, 0x9b, 0xf3, 0xa1, 0xa9, 0xd0, 0xa9, 0xa8, 0x32, 0xa4, 0xd9, 0xee, 0xc6,
-		0xa6, 0xfc, 0xd9, 0x10, 0x8c, 0x04, 0x0f, 0x17, 0x50, 0x0e, 0xa2, 0x15, 0xa3, 0xa8, 0x52, 0xd5,
-		0x62, 0x27, 0x5b, 0xa7, 0x3a, 0x9c, 0x47, 0x4c, 0x2f, 0x72, 0x78, 0xc5, 0xc5, 0x4c, 0x7d, 0x43,
-		0x82, 0xa8, 0xa8, 0x46, 0x87, 0x21, 0x62, 0xaa, 0xce, 0x36, 0x25, 0xd7, 0x9f, 0x0d, 0x25, 0x24,
-		0x85, 0x96, 0x49, 0xbd, 0x6d, 0xaa, 0x3a, 0x55, 0x01, 0x5e, 0x4f, 0xca, 0x64, 0x5e, 0x2b, 0x58,
-		0x2d, 0xd1, 0x55, 0x8f, 0x51, 0xad, 0x62, 0xdd, 0xb1, 0xc5, 0xbc, 0xf2, 0xfa, 0x39, 0x5e, 0x8d,
-		0x1e, 0x80, 0x31, 0xc7, 0x52, 0xb5, 0x4a, 0x00, 0x36, 0x42, 0x61, 0x13, 0xa2, 0xc1, 0x05, 0xce,
-		0xc0, 0x51, 0x41, 0xb7, 0x84, 0x1d, 0xb5, 0xb8, 0x8d, 0x4b, 0x1e, 0xd2, 0x00, 0xdd, 0xdd, 0x38,
-		0xc2, 0x01, 0x72, 0xbc, 0x5d, 0xe0, 0xca, 0xaf, 0x49, 0x30, 0x26, 0xd6, 0x69, 0x25, 0x57, 0x58,
-		0x4b, 0x00, 0xaa, 0xae, 0x1b, 0x8e, 0x5f, 0x5c, 0x8d, 0xaa, 0xdc, 0x80, 0x37, 0x3d, 0xeb, 0x22,
-		0x29, 0x3e, 0x02, 0xa9, 0x2a, 0x80, 0xd7, 0xd2, 0x52, 0x6c, 0x69, 0x18, 0xe2, 0x27, 0x47, 0xf4,
-		0xf8, 0x91, 0xad, 0xec, 0x81, 0x55, 0x91, 0x05, 0x1d, 0x9a, 0x80, 0xfe, 0x4d, 0x5c, 0xd6, 0x74,
-		0xbe, 0x1f, 0xcc, 0x0a, 0x62, 0xff, 0x25, 0xe2, 0xee, 0xbf, 0x64, 0x3f, 0x2a, 0xc1, 0x78, 0xd1,
-		0xa8, 0xd6, 0xf3, 0x9b, 0x4d, 0xd4, 0x6d, 0x2f, 0xd8, 0x97, 0xa5, 0xe7, 0xde, 0x51, 0xd6, 0x9c,
-		0xed, 0xda, 0xe6, 0x74, 0xd1, 0xa8, 0xce, 0x94, 0x8d, 0x8a, 0xaa, 0x97, 0xbd, 0xf3, 0x53, 0xfa,
-		0xa3, 0xf8, 0x50, 0x19, 0xeb, 0x0f, 0x95, 0x0d, 0xdf, 0x69, 0xea, 0x45, 0xef, 0xe7, 0x0f, 0x25,
-		0xe9, 0x57, 0x42, 0xe1, 0xf9, 0xd5, 0xec, 0xe7, 0x43, 0xa9, 0x79, 0xd6, 0xdd, 0xaa, 0x10, 0x8f,
-		0x82, 0xb7, 0x2a, 0xb8, 0x48, 0x86, 0x0c, 0xdf, 0x7d, 0x00, 0x26, 0xca, 0x46, 0xd9, 0xa0, 0x14,
-		0x67, 0xc8, 0x2f, 0x7e, 0x22, 0x1b, 0x73, 0x6b, 0x53, 0x1d, 0x8f, 0x6f, 0x33, 0xcb, 0x30, 0xce,
-		0x81, 0x0b, 0xf4, 0x48, 0x88, 0x2d, 0x6c, 0x50, 0xdb, 0x6d, 0xb5, 0xe4, 0x6f, 0x7c, 0x87, 0x06,
-		0x74, 0x65, 0x8c, 0xa3, 0x92, 0x36, 0xb6, 0xf6, 0xc9, 0x28, 0x70, 0x28, 0x40, 0x8f, 0x99, 0x2d,
-		0xb6, 0x3a, 0x50, 0xfc, 0x3d, 0x4e, 0x71, 0xdc, 0x47, 0x71, 0x8d, 0xa3, 0x66, 0xe6, 0x60, 0xb8,
-		0x17, 0x5a, 0xbf, 0xcf, 0x69, 0xc5, 0xb1, 0x9f, 0xc8, 0x3c, 0x8c, 0x52, 0x22, 0xc5, 0x9a, 0xed,
-		0x18, 0x55, 0xea, 0x13, 0xdb, 0x93, 0xf9, 0x17, 0xdf, 0x61, 0x76, 0x34, 0x42, 0xd0, 0xe6, 0x5c,
-		0xac, 0x4c, 0x06, 0xe8, 0x29, 0x58, 0x09, 0x17, 0x2b, 0x1d, 0x28, 0x7c, 0x95, 0x33, 0xe2, 0xc2,
-		0x67, 0xae, 0xc2, 0x04, 0xf9, 0x4d, 0x5d, 0x96, 0x9f, 0x93, 0xce, 0x7b, 0x70, 0xc9, 0xd7, 0x3e,
-		0xc0, 0x4c, 0x75, 0xdc, 0x25, 0xe0, 0xe3, 0xc9, 0x37, 0x8b, 0x65, 0xec, 0x38, 0xd8, 0xb2, 0x0b,
-		0x6a, 0xa5, 0x19, 0x7b, 0xbe, 0x4d, 0x8c, 0xe4, 0x2f, 0x7d, 0x2f, 0x38, 0x8b, 0xf3, 0x0c, 0x73,
-		0xb6, 0x52, 0xc9, 0x6c, 0xc0, 0x91, 0x26, 0x5a, 0xd1, 0x05, 0xcd, 0x57, 0x38, 0xcd, 0x89, 0x06,
-		0xcd, 0x20, 0x64, 0x57, 0x41, 0xd4, 0xbb, 0x73, 0xd9, 0x05, 0xcd, 0x4f, 0x70, 0x9a, 0x88, 0xe3,
-		0x8a, 0x29, 0x25, 0x14, 0xaf, 0xc0, 0xd8, 0x35, 0x6c, 0x6d, 0x1a, 0x36, 0xdf, 0x38, 0xea, 0x82,
-		0xdc, 0x27, 0x39, 0xb9, 0x51, 0x8e, 0x48, 0x77, 0x92, 0x08, 0xad, 0x0b, 0x10, 0xdd, 0x52, 0x8b,
-		0xb8, 0x0b, 0x12, 0x37, 0x39, 0x89, 0x41, 0x02, 0x4f, 0x50, 0x67, 0x21, 0x5e, 0x36, 0x78, 0xd4,
-		0xea, 0x8c, 0xfe, 0x29, 0x8e, 0x3e, 0x24, 0x70, 0x38, 0x09, 0xd3, 0x30, 0x6b, 0x15, 0x12, 0xd2,
-		0x3a, 0x93, 0xf8, 0x65, 0x41, 0x42, 0xe0, 0x70, 0x12, 0x3d, 0x88, 0xf5, 0x55, 0x41, 0xc2, 0xf6,
-		0xc9, 0xf3, 0x09, 0x18, 0x32, 0xf4, 0xca, 0x9e, 0xa1, 0x77, 0xc3, 0xc4, 0xa7, 0x39, 0x05, 0xe0,
-		0x28, 0x84, 0xc0, 0x45, 0x88, 0x75, 0x3b, 0x11, 0x7f, 0xe7, 0x7b, 0xc2, 0x3c, 0xc4, 0x0c, 0xcc,
-		0xc3, 0xa8, 0x70, 0x50, 0x9a, 0xa1, 0x77, 0x41, 0xe2, 0xef, 0x72, 0x12, 0x23, 0x3e, 0x34, 0x3e,
-		0x0c, 0x07, 0xdb, 0x4e, 0x19, 0x77, 0x43, 0xe4, 0xb3, 0x62, 0x18, 0x1c, 0x85, 0x8b, 0x72, 0x13,
-		0xeb, 0xc5, 0xed, 0xee, 0x28, 0x7c, 0x4e, 0x88, 0x52, 0xe0, 0x10, 0x12, 0x73, 0x30, 0x5c, 0x55,
-		0x2d, 0x7b, 0x5b, 0xad, 0x74, 0x35, 0x1d, 0x7f, 0x8f, 0xd3, 0x88, 0xbb, 0x48, 0x5c, 0x22, 0x35,
-		0xbd, 0x17, 0x32, 0x9f, 0x17, 0x12, 0xf1, 0xa1, 0x71, 0xd3, 0xb3, 0x1d, 0xba, 0xcb, 0xd6, 0x0b,
-		0xb5, 0x2f, 0x08, 0xd3, 0x63, 0xb8, 0x4b, 0x7e, 0x8a, 0x17, 0x21, 0x66, 0x6b, 0x37, 0xba, 0x22,
-		0xf3, 0x6b, 0x62, 0xa6, 0x29, 0x02, 0x41, 0x7e, 0x16, 0x8e, 0x36, 0x0d, 0x13, 0x5d, 0x10, 0xfb,
-		0xfb, 0x9c, 0xd8, 0xe1, 0x26, 0xa1, 0x82, 0xbb, 0x84, 0x5e, 0x49, 0xfe, 0x03, 0xe1, 0x12, 0x70,
-		0x1d, 0xad, 0x55, 0xb2, 0x8e, 0xb0, 0xd5, 0xad, 0xde, 0xa4, 0xf6, 0xeb, 0x42, 0x6a, 0x0c, 0x37,
-		0x20, 0xb5, 0x75, 0x38, 0xcc, 0x29, 0xf6, 0x36, 0xaf, 0x5f, 0x14, 0x8e, 0x95, 0x61, 0x6f, 0x04,
-		0x67, 0xf7, 0x5d, 0x90, 0x72, 0xc5, 0x29, 0x12, 0x56, 0xbb, 0x50, 0x55, 0xcd, 0x2e, 0x28, 0xff,
-		0x06, 0xa7, 0x2c, 0x3c, 0xbe, 0x9b, 0xf1, 0xda, 0x4b, 0xaa, 0x49, 0x88, 0x3f, 0x03, 0x49, 0x41,
-		0xbc, 0xa6, 0x5b, 0xb8, 0x68, 0x94, 0x75, 0xed, 0x06, 0x2e, 0x75, 0x41, 0xfa, 0x37, 0xeb, 0xa6,
-		0x6a, 0xc3, 0x87, 0x4e, 0x28, 0x2f, 0x40, 0xc2, 0xcd, 0x55, 0x0a, 0x5a, 0xd5, 0x34, 0x2c, 0xa7,
-		0x03, 0xc5, 0xdf, 0x12, 0x33, 0xe5, 0xe2, 0x2d, 0x50, 0xb4, 0x4c, 0x1e, 0x46, 0x68, 0xb1, 0x5b,
-		0x95, 0xfc, 0x12, 0x27, 0x34, 0xec, 0x61, 0x71, 0xc7, 0x51, 0x34, 0xaa, 0xa6, 0x6a, 0x75, 0xe3,
-		0xff, 0xfe, 0xa1, 0x70, 0x1c, 0x1c, 0x85, 0x3b, 0x0e, 0x67, 0xcf, 0xc4, 0x24, 0xda, 0x77, 0x41,
-		0xe1, 0xcb, 0xc2, 0x71, 0x08, 0x1c, 0x4e, 0x42, 0x24, 0x0c, 0x5d, 0x90, 0xf8, 0x6d, 0x41, 0x42,
-		0xe0, 0x10, 0x12, 0x4f, 0x79, 0x81, 0xd6, 0xc2, 0x65, 0xcd, 0x76, 0x2c, 0x96, 0x26, 0xb7, 0x27,
-		0xf5, 0x8f, 0xbe, 0x17, 0x4c, 0xc2, 0x14, 0x1f, 0x2a, 0xf1, 0x44, 0x7c, 0xdb, 0x95, 0xae, 0xa2,
-		0x3a, 0x33, 0xf6, 0x3b, 0xc2, 0x13, 0xf9, 0xd0, 0x08, 0x6f, 0xbe, 0x0c, 0x91, 0x88, 0xbd, 0x48,
-		0xd6, 0x0e, 0x5d, 0x90, 0xfb, 0xc7, 0x75, 0xcc, 0xad, 0x09, 0x5c, 0x42, 0xd3, 0x97, 0xff, 0xd4,
-		0xf4, 0x1d, 0xbc, 0xd7, 0x95, 0x76, 0xfe, 0x93, 0xba, 0xfc, 0x67, 0x83, 0x61, 0x32, 0x1f, 0x32,
-		0x5a, 0x97, 0x4f, 0xa1, 0x4e, 0xf7, 0x87, 0x92, 0x3f, 0xfd, 0x03, 0x3e, 0xde, 0x60, 0x3a, 0x95,
-		0x59, 0x24, 0x4a, 0x1e, 0x4c, 0x7a, 0x3a, 0x13, 0xfb, 0xc0, 0x0f, 0x5c, 0x3d, 0x0f, 0xe4, 0x3c,
-		0x99, 0x4b, 0x30, 0x1c, 0x48, 0x78, 0x3a, 0x93, 0xfa, 0x20, 0x27, 0x15, 0xf7, 0xe7, 0x3b, 0x99,
-		0xb3, 0x10, 0x21, 0xc9, 0x4b, 0x67, 0xf4, 0xbf, 0xce, 0xd1, 0x29, 0x78, 0xe6, 0xed, 0x10, 0x15,
-		0x49, 0x4b, 0x67, 0xd4, 0x0f, 0x71, 0x54, 0x17, 0x85, 0xa0, 0x8b, 0x84, 0xa5, 0x33, 0xfa, 0xdf,
-		0x10, 0xe8, 0x02, 0x85, 0xa0, 0x77, 0x2f, 0xc2, 0xaf, 0xfc, 0x4c, 0x84, 0x07, 0x1d, 0x21, 0xbb,
-		0x8b, 0x30, 0xc8, 0x33, 0x95, 0xce, 0xd8, 0x1f, 0xe1, 0x9d, 0x0b, 0x8c, 0xcc, 0x79, 0xe8, 0xef,
-		0x52, 0xe0, 0x3f, 0xcb, 0x51, 0x19, 0x7c, 0x66, 0x0e, 0x86, 0x7c, 0xd9, 0x49, 0x67, 0xf4, 0xbf,
-		0xc5, 0xd1, 0xfd, 0x58, 0x84, 0x75, 0x9e, 0x9d, 0x74, 0x26, 0xf0, 0x51, 0xc1, 0x3a, 0xc7, 0x20,
-		0x62, 0x13, 0x89, 0x49, 0x67, 0xec, 0x8f, 0x09, 0xa9, 0x0b, 0x94, 0xcc, 0x13, 0x10, 0x73, 0x83,
-		0x4d, 0x67, 0xfc, 0x8f, 0x73, 0x7c, 0x0f, 0x87, 0x48, 0xc0, 0x17, 0xec, 0x3a, 0x93, 0xf8, 0x39,
-		0x21, 0x01, 0x1f, 0x16, 0x31, 0xa3, 0xfa, 0x04, 0xa6, 0x33, 0xa5, 0x9f, 0x17, 0x66, 0x54, 0x97,
-		0xbf, 0x90, 0xd9, 0xa4, 0x3e, 0xbf, 0x33, 0x89, 0x5f, 0x10, 0xb3, 0x49, 0xe1, 0x09, 0x1b, 0xf5,
-		0x19, 0x41, 0x67, 0x1a, 0x7f, 0x5b, 0xb0, 0x51, 0x97, 0x10, 0x64, 0x56, 0x01, 0x35, 0x66, 0x03,
-		0x9d, 0xe9, 0xbd, 0xcc, 0xe9, 0x8d, 0x35, 0x24, 0x03, 0x99, 0xa7, 0xe1, 0x70, 0xf3, 0x4c, 0xa0,
-		0x33, 0xd5, 0x5f, 0xfa, 0x41, 0xdd, 0xda, 0xcd, 0x9f, 0x08, 0x64, 0xd6, 0xbd, 0x90, 0xe2, 0xcf,
-		0x02, 0x3a, 0x93, 0x7d, 0xe5, 0x07, 0x41, 0xc7, 0xed, 0x4f, 0x02, 0x32, 0xb3, 0x00, 0x5e, 0x00,
-		0xee, 0x4c, 0xeb, 0x93, 0x9c, 0x96, 0x0f, 0x89, 0x98, 0x06, 0x8f, 0xbf, 0x9d, 0xf1, 0x6f, 0x0a,
-		0xd3, 0xe0, 0x18, 0xc4, 0x34, 0x44, 0xe8, 0xed, 0x8c, 0xfd, 0x29, 0x61, 0x1a, 0x02, 0x85, 0x68,
-		0xb6, 0x2f, 0xba, 0x75, 0xa6, 0xf0, 0x69, 0xa1, 0xd9, 0x3e, 0xac, 0xcc, 0x32, 0x8c, 0x35, 0x04,
-		0xc4, 0xce, 0xa4, 0x7e, 0x85, 0x93, 0x4a, 0xd4, 0xc7, 0x43, 0x7f, 0xf0, 0xe2, 0xc1, 0xb0, 0x33,
-		0xb5, 0xcf, 0xd4, 0x05, 0x2f, 0x1e, 0x0b, 0x33, 0x17, 0x21, 0xaa, 0xd7, 0x2a, 0x15, 0x62, 0x3c,
-		0xa8, 0xfd, 0x9d, 0xbf, 0xe4, 0x7f, 0xfd, 0x11, 0x97, 0x8e, 0x40, 0xc8, 0x9c, 0x85, 0x7e, 0x5c,
-		0xdd, 0xc4, 0xa5, 0x4e, 0x98, 0xdf, 0xfd, 0x91, 0x70, 0x98, 0x04, 0x3a, 0xf3, 0x04, 0x00, 0xdb,
-		0x1a, 0xa1, 0xc7, 0x83, 0x1d, 0x70, 0xff, 0xdb, 0x8f, 0xf8, 0x6d, 0x1c, 0x0f, 0xc5, 0x23, 0xc0,
-		0xee, 0xf6, 0xb4, 0x27, 0xf0, 0xbd, 0x20, 0x01, 0x3a, 0x23, 0x17, 0x60, 0xf0, 0x79, 0xdb, 0xd0,
-		0x1d, 0xb5, 0xdc, 0x09, 0xfb, 0xbf, 0x73, 0x6c, 0x01, 0x4f, 0x04, 0x56, 0x35, 0x2c, 0xec, 0xa8,
-		0x65, 0xbb, 0x13, 0xee, 0xff, 0xe0, 0xb8, 0x2e, 0x02, 0x41, 0x2e, 0xaa, 0xb6, 0xd3, 0xcd, 0xb8,
-		0xff, 0x4c, 0x20, 0x0b, 0x04, 0xc2, 0x34, 0xf9, 0xbd, 0x83, 0xf7, 0x3a, 0xe1, 0x7e, 0x5f, 0x30,
-		0xcd, 0xe1, 0x33, 0x6f, 0x87, 0x18, 0xf9, 0xc9, 0xae, 0xd8, 0x75, 0x40, 0xfe, 0x73, 0x8e, 0xec,
-		0x61, 0x90, 0x9e, 0x6d, 0xa7, 0xe4, 0x68, 0x9d, 0x85, 0x7d, 0x9b, 0xcf, 0xb4, 0x80, 0xcf, 0xcc,
-		0xc2, 0x90, 0xed, 0x94, 0x4a, 0x35, 0x9e, 0x9f, 0x76, 0x40, 0xff, 0x9f, 0x3f, 0x72, 0xb7, 0x2c,
-		0x5c, 0x1c, 0x32, 0xdb, 0xd7, 0x77, 0x1c, 0xd3, 0xa0, 0x47, 0x20, 0x9d, 0x28, 0xfc, 0x80, 0x53,
-		0xf0, 0xa1, 0x64, 0xe6, 0x20, 0x4e, 0xc6, 0x62, 0x61, 0x13, 0xd3, 0xf3, 0xaa, 0x0e, 0x24, 0xfe,
-		0x17, 0x17, 0x40, 0x00, 0x29, 0xfb, 0x53, 0x5f, 0x7d, 0x7d, 0x52, 0xfa, 0xe6, 0xeb, 0x93, 0xd2,
-		0x9f, 0xbc, 0x3e, 0x29, 0x7d, 0xec, 0xdb, 0x93, 0x7d, 0xdf, 0xfc, 0xf6, 0x64, 0xdf, 0x1f, 0x7e,
-		0x7b, 0xb2, 0xaf, 0xf9, 0xb6, 0x31, 0xcc, 0x1b, 0xf3, 0x06, 0xdb, 0x30, 0x7e, 0x4e, 0x0e, 0x6c,
-		0x17, 0x97, 0x0d, 0x6f, 0xb7, 0xd6, 0x5d, 0xe4, 0xc0, 0x4f, 0x87, 0xc8, 0x82, 0x39, 0xb8, 0x97,
-		0xab, 0xea, 0x7b, 0x2d, 0xde, 0xe0, 0xa4, 0x9a, 0x6e, 0x0c, 0xcb, 0x97, 0x21, 0x3c, 0xab, 0xef,
-		0xa1, 0xa3, 0xcc, 0xe7, 0x15, 0x6a, 0x56, 0x85, 0x5f, 0xfd, 0x1a, 0x24, 0xe5, 0x0d, 0xab, 0x82,
-		0x26, 0xbc, 0xfb, 0x99, 0xd2, 0xa9, 0x38, 0xbf, 0x74, 0x99, 0x49, 0xbc, 0xfc, 0x6a, 0xba, 0xef,
-		0x8b, 0xaf, 0xa6, 0xfb, 0xbe, 0xff, 0xe9, 0x74, 0xdf, 0x8b, 0x7f, 0x3c, 0xd5, 0x97, 0xdd, 0xa9,
-		0x1f, 0xed, 0x57, 0x3a, 0x8e, 0x38, 0x3a, 0xab, 0xef, 0xd1, 0x01, 0xaf, 0x4a, 0xcf, 0xf5, 0x93,
-		0xfe, 0x6c, 0xb1, 0xc9, 0x3d, 0x59, 0xbf, 0xc9, 0xfd, 0x34, 0xae, 0x54, 0x9e, 0xd4, 0x8d, 0xeb,
-		0xfa, 0x3a, 0x01, 0xdb, 0x1c, 0xa0, 0x34, 0x1e, 0x85, 0xbf, 0x19, 0x82, 0xc9, 0x86, 0xfd, 0x6c,
-		0xae, 0x05, 0xad, 0x1e, 0x23, 0x65, 0x20, 0x9a, 0x13, 0xca, 0x95, 0x84, 0x41, 0x1b, 0x17, 0x0d,
-		0xbd, 0x64, 0xd3, 0x61, 0x87, 0x15, 0x51, 0x24, 0xc3, 0xd6, 0x55, 0xdd, 0xb0, 0xf9, 0x55, 0x49,
-		0x56, 0xc8, 0xfe, 0x82, 0xd4, 0xdb, 0x9c, 0x0e, 0x8b, 0x9e, 0xc4, 0x30, 0x4f, 0xb7, 0x3b, 0x07,
-		0xa0, 0x22, 0x70, 0xf9, 0xf7, 0xed, 0xf9, 0x77, 0x2b, 0x8e, 0x8f, 0x85, 0x20, 0x5d, 0x2f, 0x0e,
-		0x62, 0x53, 0xb6, 0xa3, 0x56, 0xcd, 0x56, 0xf2, 0xb8, 0x08, 0xb1, 0x75, 0x01, 0xd3, 0xb3, 0x40,
-		0x7e, 0xb1, 0x47, 0x81, 0x8c, 0xb8, 0x5d, 0x09, 0x89, 0x3c, 0xd0, 0x59, 0x22, 0xee, 0x10, 0xf6,
-		0x21, 0x92, 0xf7, 0x87, 0xe1, 0x68, 0xd1, 0xb0, 0xab, 0x86, 0x5d, 0x60, 0xca, 0xcf, 0x0a, 0x5c,
-		0x18, 0x71, 0x7f, 0x53, 0x17, 0x47, 0x23, 0x97, 0x61, 0x84, 0x3a, 0x08, 0xba, 0x29, 0x4c, 0x7d,
-		0x72, 0xc7, 0x30, 0xfa, 0xb5, 0x7f, 0xdf, 0x4f, 0x0d, 0x6a, 0xd8, 0x45, 0xa4, 0xb7, 0x5e, 0xd6,
-		0x61, 0x42, 0xab, 0x9a, 0x15, 0x4c, 0x8f, 0xc7, 0x0a, 0x6e, 0x5b, 0x67, 0x7a, 0x5f, 0xe7, 0xf4,
-		0xc6, 0x3d, 0xf4, 0x05, 0x81, 0x9d, 0x59, 0x84, 0x31, 0xb5, 0x58, 0xc4, 0x66, 0x80, 0x64, 0x07,
-		0xe7, 0x25, 0x18, 0x4c, 0x70, 0x4c, 0x97, 0x5a, 0xf6, 0x89, 0x56, 0x73, 0xfb, 0xdc, 0x3d, 0xbe,
-		0x49, 0xb3, 0x70, 0x19, 0xeb, 0x0f, 0xe9, 0xd8, 0xb9, 0x6e, 0x58, 0x3b, 0x5c, 0xbc, 0x0f, 0xb1,
-		0xae, 0xc4, 0x24, 0x7c, 0x30, 0x0c, 0x93, 0xac, 0x61, 0x66, 0x53, 0xb5, 0xf1, 0xcc, 0xb5, 0x47,
-		0x36, 0xb1, 0xa3, 0x3e, 0x32, 0x53, 0x34, 0x34, 0x61, 0xa6, 0xe3, 0x7c, 0x5e, 0x48, 0xfb, 0x34,
-		0x6f, 0x6f, 0xe1, 0xb3, 0xe6, 0x21, 0x32, 0x67, 0x68, 0x3a, 0xd1, 0xc8, 0x12, 0xd6, 0x8d, 0x2a,
-		0xf7, 0x58, 0xac, 0x80, 0xee, 0x82, 0x01, 0xb5, 0x6a, 0xd4, 0x74, 0x87, 0x9d, 0xec, 0x65, 0x87,
-		0xbe, 0x7a, 0x2b, 0xdd, 0xf7, 0x47, 0xb7, 0xd2, 0xe1, 0x05, 0xdd, 0x51, 0x78, 0x53, 0x26, 0xf2,
-		0xc6, 0xab, 0x69, 0x49, 0xbe, 0x02, 0x83, 0x39, 0x5c, 0xdc, 0x0f, 0xad, 0x1c, 0x2e, 0xd6, 0xd1,
-		0xba, 0x1f, 0xa2, 0x0b, 0xba, 0xc3, 0xae, 0x13, 0x9f, 0x80, 0xb0, 0xa6, 0xb3, 0x0b, 0x6a, 0x75,
-		0xfd, 0x93, 0x7a, 0x02, 0x9a, 0xc3, 0x45, 0x17, 0xb4, 0x84, 0x8b, 0xf5, 0xa0, 0x84, 0x3c, 0xa9,
-		0xcf, 0xe6, 0xfe, 0xf0, 0x3f, 0x4f, 0xf6, 0xbd, 0xf8, 0xfa, 0x64, 0x5f, 0xcb, 0x99, 0xf0, 0x47,
-		0x0a, 0x2e, 0x62, 0x3e, 0x05, 0x76, 0x69, 0x67, 0xc6, 0x09, 0xd8, 0xc2, 0xe7, 0x23, 0x70, 0x82,
-		0x3e, 0x10, 0xb1, 0xaa, 0x9a, 0xee, 0xcc, 0x14, 0xad, 0x3d, 0xd3, 0xa1, 0xa1, 0xc5, 0xd8, 0xe2,
-		0xb3, 0x30, 0xe6, 0x35, 0x4f, 0xb3, 0xe6, 0x16, 0x73, 0xb0, 0x05, 0xfd, 0xab, 0x04, 0x8f, 0x08,
-		0xce, 0x31, 0x1c, 0xb5, 0xc2, 0xdd, 0x05, 0x2b, 0x90, 0x5a, 0xf6, 0xa8, 0x24, 0xc4, 0x6a, 0x35,
-		0xf1, 0x9e, 0xa4, 0x82, 0xd5, 0x2d, 0x76, 0x89, 0x37, 0x4c, 0xc3, 0x49, 0x94, 0x54, 0xd0, 0xfb,
-		0xba, 0x13, 0xd0, 0xaf, 0xd6, 0xd8, 0xf1, 0x73, 0x98, 0xc4, 0x19, 0x5a, 0x90, 0x9f, 0x84, 0x41,
-		0x7e, 0xe4, 0x85, 0x12, 0x10, 0xde, 0xc1, 0x7b, 0xb4, 0x9f, 0xb8, 0x42, 0x7e, 0xa2, 0x69, 0xe8,
-		0xa7, 0xcc, 0xf3, 0xd7, 0x09, 0xc9, 0xe9, 0x06, 0xee, 0xa7, 0x29, 0x93, 0x0a, 0x03, 0x93, 0xaf,
-		0x40, 0x34, 0x67, 0x54, 0x35, 0xdd, 0x08, 0x52, 0x8b, 0x31, 0x6a, 0x94, 0x67, 0xb3, 0xc6, 0xe7,
-		0x5a, 0x61, 0x05, 0x74, 0x18, 0x06, 0xd8, 0xa5, 0x6e, 0x7e, 0x84, 0xce, 0x4b, 0xf2, 0x1c, 0x0c,
-		0x52, 0xda, 0x2b, 0x26, 0x42, 0xfc, 0x95, 0x0f, 0xbf, 0x3d, 0x4e, 0xdd, 0x02, 0x27, 0x1f, 0xf2,
-		0x98, 0x45, 0x10, 0x29, 0xa9, 0x8e, 0xca, 0xc7, 0x4d, 0x7f, 0xcb, 0xef, 0x80, 0x28, 0x27, 0x62,
-		0xa3, 0x33, 0x10, 0x36, 0x4c, 0x9b, 0x1f, 0x82, 0xa7, 0x5a, 0x0d, 0x65, 0xc5, 0xcc, 0x46, 0x88,
-		0x96, 0x28, 0x04, 0x38, 0xab, 0xb4, 0x54, 0x8b, 0xc7, 0x7d, 0x6a, 0xe1, 0x9b, 0x72, 0xdf, 0x4f,
-		0x36, 0xa5, 0x0d, 0xea, 0xe0, 0x2a, 0xcb, 0xa7, 0x43, 0x30, 0xe9, 0x6b, 0xbd, 0x86, 0x2d, 0xb2,
-		0xee, 0x63, 0x1a, 0xc5, 0xb5, 0x05, 0xf9, 0x98, 0xe4, 0xed, 0x2d, 0xd4, 0xe5, 0xed, 0x10, 0x9e,
-		0x35, 0x4d, 0x94, 0x82, 0x28, 0x3b, 0xec, 0x36, 0x98, 0xbe, 0x44, 0x14, 0xb7, 0x4c, 0xda, 0x6c,
-		0x63, 0xcb, 0xb9, 0xae, 0x5a, 0xee, 0x73, 0x26, 0x51, 0x96, 0x2f, 0x40, 0x6c, 0xce, 0xd0, 0x6d,
-		0xac, 0xdb, 0x35, 0x1a, 0x88, 0x36, 0x2b, 0x46, 0x71, 0x87, 0x53, 0x60, 0x05, 0x22, 0x70, 0xd5,
-		0x34, 0x29, 0x66, 0x44, 0x21, 0x3f, 0x99, 0x5d, 0x66, 0xd7, 0x5a, 0x8a, 0xe8, 0x42, 0xef, 0x22,
-		0xe2, 0x83, 0x74, 0x65, 0xf4, 0x7f, 0x24, 0x38, 0xde, 0x68, 0x50, 0x3b, 0x78, 0xcf, 0xee, 0xd5,
-		0x9e, 0x9e, 0x81, 0xd8, 0x2a, 0x7d, 0x53, 0xfc, 0x24, 0xde, 0x43, 0x29, 0x18, 0xc4, 0xa5, 0x33,
-		0x67, 0xcf, 0x3e, 0x72, 0x81, 0x69, 0xfb, 0xe5, 0x3e, 0x45, 0x54, 0xa0, 0x49, 0x88, 0xd9, 0xb8,
-		0x68, 0x9e, 0x39, 0x7b, 0x6e, 0xe7, 0x11, 0xa6, 0x5e, 0x97, 0xfb, 0x14, 0xaf, 0x2a, 0x13, 0x25,
-		0xa3, 0x7e, 0xe3, 0xd3, 0x69, 0x29, 0xdb, 0x0f, 0x61, 0xbb, 0x56, 0xbd, 0xa3, 0x3a, 0xf2, 0x4a,
-		0x3f, 0x4c, 0xf9, 0x31, 0x69, 0xb4, 0xbe, 0xa6, 0x56, 0xb4, 0x92, 0xea, 0xbd, 0x06, 0x4f, 0xf8,
-		0x64, 0x40, 0x21, 0x9a, 0x8b, 0x20, 0xd5, 0x56, 0x92, 0xf2, 0x6f, 0x4a, 0x10, 0xbf, 0x2a, 0x28,
-		0xaf, 0x61, 0x07, 0x5d, 0x04, 0x70, 0x7b, 0x12, 0x66, 0x73, 0x6c, 0xba, 0xbe, 0xaf, 0x69, 0x17,
-		0x47, 0xf1, 0x81, 0xa3, 0xf3, 0x54, 0x11, 0x4d, 0xc3, 0xe6, 0x6f, 0x61, 0x3a, 0xa0, 0xba, 0xc0,
-		0xe8, 0x41, 0x40, 0xd4, 0xc3, 0x15, 0xae, 0x19, 0x8e, 0xa6, 0x97, 0x0b, 0xa6, 0x71, 0x9d, 0x3f,
-		0x1c, 0x0c, 0x2b, 0x09, 0xda, 0x72, 0x95, 0x36, 0xac, 0x92, 0x7a, 0xc2, 0x74, 0xcc, 0xa5, 0x42,
-		0x72, 0x2b, 0xb5, 0x54, 0xb2, 0xb0, 0x6d, 0x73, 0x27, 0x26, 0x8a, 0xe8, 0x22, 0x0c, 0x9a, 0xb5,
-		0xcd, 0x82, 0xf0, 0x18, 0x43, 0x67, 0x8e, 0x37, 0xb3, 0x7f, 0xa1, 0x1f, 0xdc, 0x03, 0x0c, 0x98,
-		0xb5, 0x4d, 0xa2, 0x2d, 0x27, 0x21, 0xde, 0x84, 0x99, 0xa1, 0x6b, 0x1e, 0x1f, 0xf4, 0x29, 0x3b,
-		0x1f, 0x41, 0xc1, 0xb4, 0x34, 0xc3, 0xd2, 0x9c, 0x3d, 0x7a, 0x93, 0x25, 0xac, 0x24, 0x44, 0xc3,
-		0x2a, 0xaf, 0x97, 0x77, 0x60, 0x74, 0x8d, 0xe6, 0x16, 0x1e, 0xe7, 0x67, 0x3d, 0xfe, 0xa4, 0xce,
-		0xfc, 0xb5, 0xe4, 0x2c, 0xd4, 0xc0, 0x59, 0xf6, 0xa9, 0x96, 0xda, 0x79, 0xbe, 0x77, 0xed, 0x0c,
-		0x46, 0xbb, 0x3f, 0x3b, 0x1a, 0x30, 0x4e, 0x9e, 0x4a, 0xfa, 0xdc, 0x57, 0xb7, 0x8a, 0xd9, 0x29,
-		0xa5, 0x4e, 0xb5, 0x0f, 0xaa, 0xa9, 0x0e, 0x6e, 0x34, 0xd5, 0xd1, 0x84, 0xe4, 0x0b, 0x30, 0xbc,
-		0xaa, 0x5a, 0xce, 0x1a, 0x76, 0x2e, 0x63, 0xb5, 0x84, 0xad, 0x60, 0xd4, 0x1d, 0x16, 0x51, 0x17,
-		0x41, 0x84, 0x86, 0x56, 0x16, 0x75, 0xe8, 0x6f, 0x79, 0x1b, 0x22, 0xf4, 0x36, 0x9b, 0x1b, 0x91,
-		0x39, 0x06, 0x8b, 0xc8, 0xc4, 0x97, 0xee, 0x39, 0xd8, 0x16, 0x8b, 0x3b, 0x5a, 0x40, 0x8f, 0x89,
-		0xb8, 0x1a, 0x6e, 0x1f, 0x57, 0xb9, 0x22, 0xf2, 0xe8, 0x5a, 0x81, 0xc1, 0x2c, 0x71, 0xc5, 0x0b,
-		0x39, 0x97, 0x11, 0xc9, 0x63, 0x04, 0x2d, 0xc1, 0xa8, 0xa9, 0x5a, 0x0e, 0xbd, 0xc6, 0xbf, 0x4d,
-		0x47, 0xc1, 0x75, 0x3d, 0xdd, 0x68, 0x79, 0x81, 0xc1, 0xf2, 0x5e, 0x86, 0x4d, 0x7f, 0xa5, 0xfc,
-		0x5f, 0x22, 0x30, 0xc0, 0x85, 0xf1, 0x76, 0x18, 0xe4, 0x62, 0xe5, 0xda, 0x79, 0x62, 0xba, 0x31,
-		0x30, 0x4d, 0xbb, 0x01, 0x84, 0xd3, 0x13, 0x38, 0xe8, 0x5e, 0x88, 0x16, 0xb7, 0x55, 0x4d, 0x2f,
-		0x68, 0x25, 0x91, 0xe6, 0xbd, 0x7e, 0x2b, 0x3d, 0x38, 0x47, 0xea, 0x16, 0x72, 0xca, 0x20, 0x6d,
-		0x5c, 0x28, 0x91, 0x4c, 0x60, 0x1b, 0x6b, 0xe5, 0x6d, 0x87, 0x5b, 0x18, 0x2f, 0xa1, 0xc7, 0x21,
-		0x42, 0x14, 0x82, 0xbf, 0xf2, 0x4a, 0x35, 0x24, 0xdb, 0xee, 0x8a, 0x27, 0x1b, 0x25, 0x1d, 0x7f,
-		0xec, 0x5b, 0x69, 0x49, 0xa1, 0x18, 0x68, 0x0e, 0x86, 0x2b, 0xaa, 0xed, 0x14, 0x68, 0x04, 0x23,
-		0xdd, 0xf7, 0x53, 0x12, 0x47, 0x1b, 0x05, 0xc2, 0x05, 0xcb, 0x59, 0x1f, 0x22, 0x58, 0xac, 0xaa,
-		0x84, 0x4e, 0x41, 0x82, 0x12, 0x29, 0x1a, 0xd5, 0xaa, 0xe6, 0xb0, 0xdc, 0x6a, 0x80, 0xca, 0x7d,
-		0x84, 0xd4, 0xcf, 0xd1, 0x6a, 0x9a, 0x61, 0x1d, 0x83, 0x18, 0x7d, 0x56, 0x42, 0x41, 0xd8, 0x15,
-		0xca, 0x28, 0xa9, 0xa0, 0x8d, 0xf7, 0xc1, 0xa8, 0xe7, 0x1f, 0x19, 0x48, 0x94, 0x51, 0xf1, 0xaa,
-		0x29, 0xe0, 0xc3, 0x30, 0xa1, 0xe3, 0x5d, 0x7a, 0xa9, 0x33, 0x00, 0x1d, 0xa3, 0xd0, 0x88, 0xb4,
-		0x5d, 0x0d, 0x62, 0xdc, 0x03, 0x23, 0x45, 0x21, 0x7c, 0x06, 0x0b, 0x14, 0x76, 0xd8, 0xad, 0xa5,
-		0x60, 0x47, 0x21, 0xaa, 0x9a, 0x26, 0x03, 0x18, 0xe2, 0xfe, 0xd1, 0x34, 0x69, 0xd3, 0x69, 0x18,
-		0xa3, 0x63, 0xb4, 0xb0, 0x5d, 0xab, 0x38, 0x9c, 0x48, 0x9c, 0xc2, 0x8c, 0x92, 0x06, 0x85, 0xd5,
-		0x53, 0xd8, 0xbb, 0x60, 0x18, 0x5f, 0xd3, 0x4a, 0x58, 0x2f, 0x62, 0x06, 0x37, 0x4c, 0xe1, 0xe2,
-		0xa2, 0x92, 0x02, 0xdd, 0x0f, 0xae, 0xdf, 0x2b, 0x08, 0x9f, 0x3c, 0xc2, 0xe8, 0x89, 0xfa, 0x59,
-		0x56, 0x2d, 0x27, 0x21, 0x92, 0x53, 0x1d, 0x95, 0x24, 0x18, 0xce, 0x2e, 0x0b, 0x34, 0x71, 0x85,
-		0xfc, 0x94, 0xdf, 0x08, 0x41, 0xe4, 0xaa, 0xe1, 0x60, 0xf4, 0xa8, 0x2f, 0x01, 0x1c, 0x69, 0xa6,
-		0xcf, 0x6b, 0x5a, 0x59, 0xc7, 0xa5, 0x25, 0xbb, 0xec, 0x7b, 0xdb, 0xed, 0xa9, 0x53, 0x28, 0xa0,
-		0x4e, 0x13, 0xd0, 0x6f, 0x19, 0x35, 0xbd, 0x24, 0x6e, 0x1f, 0xd2, 0x02, 0xca, 0x43, 0xd4, 0xd5,
-		0x92, 0x48, 0x27, 0x2d, 0x19, 0x25, 0x5a, 0x42, 0x74, 0x98, 0x57, 0x28, 0x83, 0x9b, 0x5c, 0x59,
-		0xb2, 0x10, 0x73, 0x9d, 0x17, 0xd7, 0xb6, 0xee, 0x14, 0xd6, 0x43, 0x23, 0xc1, 0xc4, 0x9d, 0x7b,
-		0x57, 0x78, 0x4c, 0xe3, 0x12, 0x6e, 0x03, 0x97, 0x5e, 0x40, 0xad, 0xf8, 0x3b, 0xf3, 0x41, 0x3a,
-		0x2e, 0x4f, 0xad, 0xd8, 0x5b, 0xf3, 0xe3, 0x10, 0xb3, 0xb5, 0xb2, 0xae, 0x3a, 0x35, 0x0b, 0x73,
-		0xcd, 0xf3, 0x2a, 0xe4, 0xaf, 0x48, 0x30, 0xc0, 0x34, 0xd9, 0x27, 0x37, 0xa9, 0xb9, 0xdc, 0x42,
-		0xad, 0xe4, 0x16, 0xde, 0xbf, 0xdc, 0x66, 0x01, 0x5c, 0x66, 0x6c, 0xfe, 0x4e, 0xb8, 0x49, 0xc6,
-		0xc0, 0x58, 0x5c, 0xd3, 0xca, 0xdc, 0x50, 0x7d, 0x48, 0xf2, 0x7f, 0x92, 0x48, 0x12, 0xcb, 0xdb,
-		0xd1, 0x2c, 0x0c, 0x0b, 0xbe, 0x0a, 0x5b, 0x15, 0xb5, 0xcc, 0x75, 0xe7, 0x44, 0x4b, 0xe6, 0x2e,
-		0x55, 0xd4, 0xb2, 0x32, 0xc4, 0xf9, 0x21, 0x85, 0xe6, 0xf3, 0x10, 0x6a, 0x31, 0x0f, 0x81, 0x89,
-		0x0f, 0xef, 0x6f, 0xe2, 0x03, 0x53, 0x14, 0xa9, 0x9f, 0xa2, 0xdf, 0x0a, 0xd1, 0xc5, 0x8c, 0x69,
-		0xd8, 0x6a, 0xe5, 0xc7, 0x61, 0x11, 0xc7, 0x20, 0x66, 0x1a, 0x95, 0x02, 0x6b, 0x61, 0xb7, 0x72,
-		0xa3, 0xa6, 0x51, 0x51, 0x1a, 0xa6, 0xbd, 0xff, 0x80, 0xcc, 0x65, 0xe0, 0x00, 0xa4, 0x36, 0x58,
-		0x2f, 0x35, 0x0b, 0xe2, 0x4c, 0x14, 0x3c, 0x96, 0x3d, 0x4c, 0x64, 0x40, 0x83, 0xa3, 0xd4, 0x18,
-		0x7b, 0x19, 0xdb, 0x0c, 0x52, 0xe1, 0x70, 0x04, 0x83, 0xb9, 0xfe, 0x66, 0xab, 0x60, 0xbf, 0x5a,
-		0x2a, 0x1c, 0x4e, 0xfe, 0x45, 0x09, 0x60, 0x91, 0x48, 0x96, 0x8e, 0x97, 0x44, 0x21, 0x9b, 0xb2,
-		0x50, 0x08, 0xf4, 0x3c, 0xd9, 0x6a, 0xd2, 0x78, 0xff, 0x71, 0xdb, 0xcf, 0xf7, 0x1c, 0x0c, 0x7b,
-		0xca, 0x68, 0x63, 0xc1, 0xcc, 0x64, 0x9b, 0xac, 0x7a, 0x0d, 0x3b, 0x4a, 0xfc, 0x9a, 0xaf, 0x24,
-		0xff, 0xae, 0x04, 0x31, 0xca, 0xd3, 0x12, 0x76, 0xd4, 0xc0, 0x1c, 0x4a, 0xfb, 0x9f, 0xc3, 0x13,
-		0x00, 0x8c, 0x8c, 0xad, 0xdd, 0xc0, 0x5c, 0xb3, 0x62, 0xb4, 0x66, 0x4d, 0xbb, 0x81, 0xd1, 0x39,
-		0x57, 0xe0, 0xe1, 0xf6, 0x02, 0x17, 0x59, 0x37, 0x17, 0xfb, 0x11, 0x18, 0xa4, 0x9f, 0xcb, 0xd9,
-		0xb5, 0x79, 0x22, 0x3d, 0xa0, 0xd7, 0xaa, 0xeb, 0xbb, 0xb6, 0xfc, 0x3c, 0x0c, 0xae, 0xef, 0xb2,
-		0xbd, 0x91, 0x63, 0x10, 0xb3, 0x0c, 0x83, 0xc7, 0x64, 0x96, 0x0b, 0x45, 0x49, 0x05, 0x0d, 0x41,
-		0x62, 0x3f, 0x20, 0xe4, 0xed, 0x07, 0x78, 0x1b, 0x1a, 0xe1, 0xae, 0x36, 0x34, 0x4e, 0xff, 0x07,
-		0x09, 0x86, 0x7c, 0xfe, 0x01, 0x3d, 0x02, 0x87, 0xb2, 0x8b, 0x2b, 0x73, 0x4f, 0x16, 0x16, 0x72,
-		0x85, 0x4b, 0x8b, 0xb3, 0xf3, 0xde, 0xbb, 0x93, 0xd4, 0xe1, 0x97, 0x6e, 0x4e, 0x21, 0x1f, 0xec,
-		0x86, 0xbe, 0xa3, 0x1b, 0xd7, 0x75, 0x34, 0x03, 0x13, 0x41, 0x94, 0xd9, 0xec, 0x5a, 0x7e, 0x79,
-		0x3d, 0x21, 0xa5, 0x0e, 0xbd, 0x74, 0x73, 0x6a, 0xcc, 0x87, 0x31, 0xbb, 0x69, 0x63, 0xdd, 0x69,
-		0x44, 0x98, 0x5b, 0x59, 0x5a, 0x5a, 0x58, 0x4f, 0x84, 0x1a, 0x10, 0xb8, 0xc3, 0xbe, 0x1f, 0xc6,
-		0x82, 0x08, 0xcb, 0x0b, 0x8b, 0x89, 0x70, 0x0a, 0xbd, 0x74, 0x73, 0x6a, 0xc4, 0x07, 0xbd, 0xac,
-		0x55, 0x52, 0xd1, 0x0f, 0x7f, 0x66, 0xb2, 0xef, 0x73, 0xbf, 0x3a, 0x29, 0x91, 0x91, 0x0d, 0x07,
-		0x7c, 0x04, 0x7a, 0x10, 0x8e, 0xac, 0x2d, 0xcc, 0x2f, 0xe7, 0x73, 0x85, 0xa5, 0xb5, 0xf9, 0x02,
-		0xfb, 0xe0, 0x86, 0x3b, 0xba, 0xd1, 0x97, 0x6e, 0x4e, 0x0d, 0xf1, 0x21, 0xb5, 0x82, 0x5e, 0x55,
-		0xf2, 0x57, 0x57, 0xd6, 0xf3, 0x09, 0x89, 0x41, 0xaf, 0x5a, 0xf8, 0x9a, 0xe1, 0xb0, 0xef, 0x69,
-		0x3d, 0x0c, 0x47, 0x9b, 0x40, 0xbb, 0x03, 0x1b, 0x7b, 0xe9, 0xe6, 0xd4, 0xf0, 0xaa, 0x85, 0x99,
-		0xfd, 0x50, 0x8c, 0x69, 0x48, 0x36, 0x62, 0xac, 0xac, 0xae, 0xac, 0xcd, 0x2e, 0x26, 0xa6, 0x52,
-		0x89, 0x97, 0x6e, 0x4e, 0xc5, 0x85, 0x33, 0x24, 0xf0, 0xde, 0xc8, 0xee, 0xe4, 0x8a, 0xe7, 0x0b,
-		0xe7, 0xe0, 0x6e, 0xbe, 0x07, 0x68, 0x3b, 0xea, 0x8e, 0xa6, 0x97, 0xdd, 0x9d, 0x56, 0x5e, 0xe6,
-		0x2b, 0x9f, 0xc3, 0x7c, 0xb3, 0x55, 0xd4, 0xb6, 0xdd, 0x6f, 0x4d, 0xb5, 0x3e, 0x65, 0x4a, 0x75,
-		0x38, 0x7c, 0xe9, 0xbc, 0x74, 0x6a, 0xbd, 0x37, 0x9f, 0xea, 0xb0, 0x63, 0x9c, 0x6a, 0xbb, 0xb8,
-		0x93, 0x3f, 0x22, 0xc1, 0xc8, 0x65, 0xcd, 0x76, 0x0c, 0x4b, 0x2b, 0xaa, 0x15, 0xfa, 0xda, 0xe4,
-		0x5c, 0xb7, 0xbe, 0xb5, 0xce, 0xd4, 0x9f, 0x80, 0x81, 0x6b, 0x6a, 0x85, 0x39, 0xb5, 0x30, 0xfd,
-		0x3a, 0x46, 0x73, 0xf1, 0x79, 0xae, 0x4d, 0x10, 0x60, 0x68, 0xf2, 0xaf, 0x87, 0x60, 0x94, 0x1a,
-		0x83, 0xcd, 0x3e, 0x87, 0x44, 0xd6, 0x58, 0x59, 0x88, 0x58, 0xaa, 0xc3, 0x37, 0x0d, 0xb3, 0xd3,
-		0x7c, 0xe7, 0xf7, 0xde, 0xce, 0xbb, 0xb9, 0xd3, 0x39, 0x5c, 0x54, 0x28, 0x2e, 0x7a, 0x37, 0x44,
-		0xab, 0xea, 0x6e, 0x81, 0xd2, 0x61, 0x2b, 0x97, 0xd9, 0xde, 0xe8, 0xdc, 0xbe, 0x95, 0x1e, 0xdd,
-		0x53, 0xab, 0x95, 0x8c, 0x2c, 0xe8, 0xc8, 0xca, 0x60, 0x55, 0xdd, 0x25, 0x2c, 0x22, 0x13, 0x46,
-		0x49, 0x6d, 0x71, 0x5b, 0xd5, 0xcb, 0x98, 0x75, 0x42, 0xb7, 0x40, 0xb3, 0x97, 0x7b, 0xee, 0xe4,
-		0xb0, 0xd7, 0x89, 0x8f, 0x9c, 0xac, 0x0c, 0x57, 0xd5, 0xdd, 0x39, 0x5a, 0x41, 0x7a, 0xcc, 0x44,
-		0x5f, 0x7e, 0x35, 0xdd, 0x47, 0x77, 0xd3, 0x5f, 0x93, 0x00, 0x3c, 0x89, 0xa1, 0x77, 0x43, 0xa2,
-		0xe8, 0x96, 0x28, 0xae, 0xcd, 0xe7, 0xf0, 0xbe, 0x56, 0x73, 0x51, 0x27, 0x6f, 0x16, 0x9b, 0xbf,
-		0x79, 0x2b, 0x2d, 0x29, 0xa3, 0xc5, 0xba, 0xa9, 0x78, 0x17, 0x0c, 0xd5, 0xcc, 0x92, 0xea, 0xe0,
-		0x02, 0x5d, 0xc7, 0x85, 0x3a, 0xc6, 0xf9, 0x49, 0x42, 0xeb, 0xf6, 0xad, 0x34, 0x62, 0xc3, 0xf2,
-		0x21, 0xcb, 0x34, 0xfa, 0x03, 0xab, 0x21, 0x08, 0xbe, 0x31, 0x7d, 0x4d, 0x82, 0xa1, 0x9c, 0xef,
-		0xd6, 0x57, 0x12, 0x06, 0xab, 0x86, 0xae, 0xed, 0x70, 0x7d, 0x8c, 0x29, 0xa2, 0x88, 0x52, 0x10,
-		0x65, 0x0f, 0xf0, 0x9c, 0x3d, 0xb1, 0x15, 0x2a, 0xca, 0x04, 0xeb, 0x3a, 0xde, 0xb4, 0x35, 0x31,
-		0x1b, 0x8a, 0x28, 0xa2, 0x4b, 0x90, 0xb0, 0x71, 0xb1, 0x66, 0x69, 0xce, 0x5e, 0xa1, 0x68, 0xe8,
-		0x8e, 0x5a, 0x74, 0xd8, 0x53, 0xae, 0xec, 0xb1, 0xdb, 0xb7, 0xd2, 0x47, 0x18, 0xaf, 0xf5, 0x10,
-		0xb2, 0x32, 0x2a, 0xaa, 0xe6, 0x58, 0x0d, 0xe9, 0xa1, 0x84, 0x1d, 0x55, 0xab, 0xd8, 0x49, 0x76,
-		0x30, 0x24, 0x8a, 0xbe, 0xb1, 0x7c, 0x23, 0xe6, 0xdf, 0xd8, 0xba, 0x04, 0x09, 0xc3, 0xc4, 0x56,
-		0x20, 0x11, 0x95, 0xea, 0x7b, 0xae, 0x87, 0x90, 0x95, 0x51, 0x51, 0x25, 0x92, 0x54, 0x87, 0x4c,
-		0xb3, 0x58, 0x28, 0x9a, 0xb5, 0x4d, 0x6f, 0x3f, 0x6c, 0xa2, 0x61, 0x36, 0x66, 0xf5, 0xbd, 0xec,
-		0xa3, 0x1e, 0xf5, 0x7a, 0x3c, 0xf9, 0xeb, 0x5f, 0x7a, 0x68, 0x82, 0xab, 0x86, 0xb7, 0x3f, 0xf5,
-		0x24, 0xde, 0x23, 0xd3, 0xcf, 0x41, 0x57, 0x29, 0x24, 0x49, 0x3b, 0x9f, 0x57, 0xb5, 0x8a, 0x78,
-		0x92, 0xac, 0xf0, 0x12, 0xca, 0xc0, 0x80, 0xed, 0xa8, 0x4e, 0xcd, 0xe6, 0x1f, 0x00, 0x93, 0x5b,
-		0xa9, 0x5a, 0xd6, 0xd0, 0x4b, 0x6b, 0x14, 0x52, 0xe1, 0x18, 0xe8, 0x12, 0x0c, 0x38, 0xc6, 0x0e,
-		0xd6, 0xb9, 0x08, 0x7b, 0xb2, 0x6f, 0x7a, 0x4e, 0xc5, 0xb0, 0x89, 0x44, 0x4a, 0xb8, 0x82, 0xcb,
-		0x2c, 0xad, 0xda, 0x56, 0xc9, 0xea, 0x83, 0x7e, 0x07, 0x2c, 0xbb, 0xd0, 0xb3, 0x11, 0x72, 0x49,
-		0xd5, 0xd3, 0x93, 0x95, 0x51, 0xb7, 0x6a, 0x8d, 0xd6, 0xa0, 0x27, 0x03, 0xd7, 0x13, 0xf9, 0xc7,
-		0xf2, 0xee, 0x6a, 0x35, 0x7c, 0x9f, 0x4e, 0x8b, 0xfd, 0x09, 0xff, 0xe5, 0xc6, 0x4b, 0x90, 0xa8,
-		0xe9, 0x9b, 0x86, 0x4e, 0xdf, 0x0d, 0xf2, 0xfc, 0x9e, 0xac, 0xef, 0xc2, 0x7e, 0xe5, 0xa8, 0x87,
-		0x90, 0x95, 0x51, 0xb7, 0xea, 0x32, 0x5b, 0x05, 0x94, 0x60, 0xc4, 0x83, 0xa2, 0x86, 0x1a, 0xeb,
-		0x68, 0xa8, 0x27, 0xb9, 0xa1, 0x1e, 0xaa, 0xef, 0xc5, 0xb3, 0xd5, 0x61, 0xb7, 0x92, 0xa0, 0xa1,
-		0xcb, 0x00, 0x9e, 0x7b, 0xa0, 0xfb, 0x14, 0x43, 0xad, 0x27, 0xde, 0xf3, 0x31, 0x62, 0xbd, 0xe7,
-		0xe1, 0xa2, 0xf7, 0xc2, 0x78, 0x55, 0xd3, 0x0b, 0x36, 0xae, 0x6c, 0x15, 0xb8, 0x80, 0x09, 0x49,
-		0xfa, 0xdd, 0x97, 0xec, 0x62, 0x6f, 0xfa, 0x70, 0xfb, 0x56, 0x3a, 0xc5, 0x5d, 0x68, 0x23, 0x49,
-		0x59, 0x19, 0xab, 0x6a, 0xfa, 0x1a, 0xae, 0x6c, 0xe5, 0xdc, 0x3a, 0x74, 0x1d, 0x8e, 0x59, 0x58,
-		0xad, 0xd0, 0x07, 0xa5, 0xfc, 0x99, 0xa6, 0xf0, 0x9e, 0xb5, 0x0a, 0xa6, 0x7b, 0x27, 0x43, 0x67,
-		0x1e, 0x69, 0x35, 0x30, 0xc5, 0x43, 0xf5, 0xf9, 0xd1, 0x5a, 0x05, 0xf3, 0x71, 0x1e, 0xb5, 0x5a,
-		0x01, 0xa0, 0xa7, 0xe1, 0xb0, 0xa6, 0x17, 0x89, 0xb3, 0xba, 0x86, 0x0b, 0x0e, 0x56, 0xab, 0xae,
-		0x47, 0x18, 0xa6, 0x23, 0x3f, 0x79, 0xfb, 0x56, 0xfa, 0x04, 0x1b, 0x4b, 0x73, 0x38, 0x59, 0x99,
-		0x70, 0x1b, 0xd6, 0xb1, 0x5a, 0x15, 0xce, 0x61, 0x19, 0xc0, 0xd5, 0x53, 0xb6, 0x59, 0xd3, 0xbb,
-		0x59, 0xf9, 0x28, 0x64, 0xe2, 0x1f, 0x7e, 0x35, 0xdd, 0xc7, 0x1d, 0x5a, 0x9f, 0x7c, 0x8e, 0x9e,
-		0x2e, 0xf0, 0xbe, 0xb0, 0x4d, 0x56, 0x6d, 0xaa, 0x28, 0xd0, 0x3d, 0x9f, 0x98, 0xe2, 0x55, 0x30,
-		0x47, 0xf8, 0xe2, 0x1f, 0x4f, 0x49, 0xf2, 0xaf, 0x49, 0x30, 0x90, 0xbb, 0xba, 0xaa, 0x6a, 0x16,
-		0x5a, 0x80, 0x31, 0xcf, 0xb6, 0x82, 0x6e, 0xf0, 0xf8, 0xed, 0x5b, 0xe9, 0x64, 0xbd, 0xf9, 0xb9,
-		0xe3, 0xf5, 0x4c, 0x5c, 0x8c, 0x75, 0xa1, 0xd5, 0xd2, 0x3e, 0x40, 0xaa, 0x01, 0x44, 0x6e, 0x5c,
-		0xf8, 0xd7, 0x0d, 0x33, 0x0f, 0x83, 0x8c, 0x5b, 0x1b, 0x65, 0xa0, 0xdf, 0x24, 0x3f, 0xf8, 0xd1,
-		0xc9, 0x64, 0x4b, 0xf3, 0xa6, 0xf0, 0xee, 0x56, 0x2f, 0x41, 0x91, 0x3f, 0x1e, 0x02, 0xc8, 0x5d,
-		0xbd, 0xba, 0x6e, 0x69, 0x66, 0x05, 0x3b, 0x07, 0x39, 0xf2, 0x75, 0x38, 0xe4, 0x5b, 0x47, 0x5a,
-		0xc5, 0xba, 0xd1, 0x4f, 0xdd, 0xbe, 0x95, 0x3e, 0x5e, 0x3f, 0x7a, 0x1f, 0x98, 0xac, 0x8c, 0x7b,
-		0x2b, 0x4a, 0xab, 0xd8, 0x94, 0x6a, 0xc9, 0x76, 0x5c, 0xaa, 0xe1, 0xd6, 0x54, 0x7d, 0x60, 0x7e,
-		0xaa, 0x39, 0xdb, 0x69, 0x2e, 0xda, 0x35, 0x18, 0xf2, 0x44, 0x62, 0xa3, 0x1c, 0x44, 0x1d, 0xfe,
-		0x9b, 0x4b, 0x58, 0x6e, 0x2d, 0x61, 0x81, 0xc6, 0xa5, 0xec, 0x62, 0xca, 0x5f, 0x23, 0x82, 0xf6,
-		0xac, 0xfa, 0x27, 0x52, 0xc5, 0x48, 0xb0, 0xe3, 0xa1, 0x29, 0xbc, 0xaf, 0x64, 0x96, 0x63, 0xa3,
-		0x15, 0x18, 0x67, 0x6b, 0x2c, 0x95, 0x64, 0xe5, 0x2e, 0x53, 0x2c, 0x87, 0x99, 0xf4, 0x7c, 0x60,
-		0x13, 0x20, 0x59, 0x41, 0xbe, 0xda, 0xe6, 0x13, 0xf4, 0x33, 0x21, 0x18, 0xdf, 0x10, 0xce, 0xfe,
-		0x27, 0x5e, 0xa8, 0xab, 0x30, 0x88, 0x75, 0xc7, 0xd2, 0xa8, 0x54, 0x89, 0xfa, 0x3c, 0xdc, 0x4a,
-		0x7d, 0x9a, 0x8c, 0x89, 0x7e, 0x6b, 0x48, 0x9c, 0x73, 0x70, 0x32, 0x75, 0xd2, 0xf8, 0x68, 0x18,
-		0x92, 0xad, 0x30, 0xd1, 0x1c, 0x8c, 0x16, 0x2d, 0x4c, 0x2b, 0x0a, 0xfe, 0xcd, 0xd6, 0x6c, 0xca,
-		0x4b, 0xe6, 0xeb, 0x00, 0x64, 0x65, 0x44, 0xd4, 0xf0, 0x80, 0x5d, 0x06, 0x92, 0x69, 0x13, 0x3d,
-		0x26, 0x50, 0x5d, 0xa6, 0xd6, 0x32, 0x8f, 0xd8, 0xa2, 0x93, 0x20, 0x01, 0x16, 0xb2, 0x47, 0xbc,
-		0x5a, 0x1a, 0xb3, 0x5f, 0x80, 0x51, 0x4d, 0xd7, 0x1c, 0x4d, 0xad, 0x14, 0x36, 0xd5, 0x8a, 0xaa,
-		0x17, 0xf7, 0xb3, 0x50, 0x61, 0x51, 0xf6, 0xb0, 0x88, 0x4c, 0x01, 0x72, 0xb2, 0x32, 0xc2, 0x6b,
-		0xb2, 0xac, 0x02, 0x5d, 0x86, 0x41, 0xd1, 0x55, 0x64, 0x5f, 0x91, 0x48, 0xa0, 0xfb, 0x72, 0xea,
-		0x9f, 0x0d, 0xc3, 0x98, 0x82, 0x4b, 0x7f, 0x35, 0x15, 0xbd, 0x4d, 0xc5, 0x12, 0x00, 0xf3, 0x1f,
-		0xc4, 0x63, 0xef, 0x63, 0x36, 0x88, 0x07, 0x8a, 0x31, 0x0a, 0x39, 0xdb, 0xf1, 0xcd, 0xc7, 0xad,
-		0x10, 0xc4, 0xfd, 0xf3, 0xf1, 0x97, 0x34, 0xcc, 0xa1, 0x05, 0xcf, 0x13, 0x45, 0xf8, 0x27, 0x5a,
-		0x5b, 0xa6, 0x8d, 0xa5, 0x5e, 0x5c, 0xd0, 0xef, 0x87, 0x61, 0x60, 0x55, 0xb5, 0xd4, 0xaa, 0x8d,
-		0x8a, 0x0d, 0xc9, 0xbd, 0xd8, 0xf1, 0x6d, 0xf8, 0xbe, 0x36, 0xdf, 0x60, 0xea, 0x90, 0xdb, 0xbf,
-		0xdc, 0x24, 0xb7, 0x7f, 0x27, 0x8c, 0x54, 0xd5, 0x5d, 0xdf, 0xc1, 0x25, 0x95, 0xf6, 0x70, 0xf6,
-		0xa8, 0x47, 0x25, 0xd8, 0xce, 0x36, 0x28, 0xae, 0xfa, 0xaf, 0x8d, 0x0c, 0x11, 0x08, 0xcf, 0x31,
-		0x13, 0xf4, 0xc3, 0xde, 0x4e, 0x80, 0xaf, 0x51, 0x56, 0xa0, 0xaa, 0xee, 0xe6, 0x59, 0x01, 0x2d,
-		0x02, 0xda, 0x76, 0x37, 0xa3, 0x0a, 0x9e, 0x38, 0x09, 0xfe, 0x89, 0xdb, 0xb7, 0xd2, 0x47, 0x19,
-		0x7e, 0x23, 0x8c, 0xac, 0x8c, 0x79, 0x95, 0x82, 0xda, 0x63, 0x00, 0x64, 0x5c, 0x05, 0x76, 0x63,
-		0x91, 0xad, 0x30, 0x0f, 0xdd, 0xbe, 0x95, 0x1e, 0x63, 0x54, 0xbc, 0x36, 0x59, 0x89, 0x91, 0x42,
-		0x8e, 0x5e, 0x66, 0x5c, 0x86, 0x71, 0xc2, 0x9f, 0x97, 0x75, 0x97, 0xb0, 0xe9, 0xb0, 0xb3, 0xe2,
-		0x61, 0x7f, 0x78, 0x6d, 0x02, 0x44, 0x96, 0x18, 0xea, 0xee, 0x82, 0xa8, 0xcc, 0x91, 0x3a, 0x9f,
-		0xa5, 0x7c, 0x46, 0x02, 0xe4, 0x85, 0x10, 0x05, 0xdb, 0x26, 0x59, 0x62, 0x93, 0xb5, 0x94, 0x6f,
-		0xe1, 0x23, 0xb5, 0x5f, 0x4b, 0x79, 0xf8, 0x62, 0x2d, 0xe5, 0xb3, 0xbc, 0x0b, 0x9e, 0xbb, 0x0d,
-		0x71, 0xbd, 0x68, 0x72, 0x5d, 0x74, 0x7a, 0xce, 0xd0, 0x04, 0x76, 0x83, 0x7f, 0xed, 0x93, 0xff,
-		0x40, 0x82, 0xa3, 0x0d, 0x1a, 0xea, 0x32, 0xfb, 0xd7, 0x00, 0x59, 0xbe, 0x46, 0xfe, 0xf9, 0x3e,
-		0xc6, 0x74, 0xcf, 0x0a, 0x3f, 0x66, 0x35, 0xf8, 0xf1, 0x83, 0x8b, 0x18, 0xec, 0xbe, 0xe9, 0x3f,
-		0x93, 0x60, 0xc2, 0xdf, 0xbd, 0x3b, 0x90, 0x65, 0x88, 0xfb, 0x7b, 0xe7, 0x43, 0xb8, 0xbb, 0x9b,
-		0x21, 0x70, 0xee, 0x03, 0xf8, 0xe8, 0x29, 0xcf, 0xfc, 0xd9, 0xf6, 0xe7, 0x23, 0x5d, 0x4b, 0x43,
-		0xf0, 0x54, 0xef, 0x06, 0x22, 0x74, 0x3e, 0xfe, 0xaf, 0x04, 0x91, 0x55, 0xc3, 0xa8, 0x20, 0x03,
-		0xc6, 0x74, 0xc3, 0x29, 0x10, 0x4d, 0xc5, 0xa5, 0x02, 0xdf, 0x37, 0x61, 0x7e, 0x75, 0xae, 0x37,
-		0x21, 0x7d, 0xf7, 0x56, 0xba, 0x91, 0x94, 0x32, 0xaa, 0x1b, 0x4e, 0x96, 0xd6, 0xac, 0xb3, 0x5d,
-		0x95, 0xf7, 0xc2, 0x70, 0xb0, 0x33, 0xe6, 0x75, 0x9f, 0xee, 0xb9, 0xb3, 0x20, 0x99, 0xdb, 0xb7,
-		0xd2, 0x13, 0x9e, 0x05, 0xba, 0xd5, 0xb2, 0x12, 0xdf, 0xf4, 0xf5, 0xce, 0x6e, 0xe8, 0x7d, 0x9f,
-		0xcc, 0xe1, 0x87, 0xe9, 0x1c, 0xba, 0x69, 0x2b, 0xfd, 0x5e, 0x28, 0xdd, 0x7a, 0xbd, 0x37, 0x70,
-		0x59, 0x27, 0x9b, 0xb8, 0x7d, 0x2b, 0x1d, 0x17, 0xe1, 0xb0, 0x84, 0x77, 0x65, 0x71, 0x7d, 0x47,
-		0x6c, 0x22, 0x87, 0xf6, 0xbf, 0x89, 0xcc, 0xd5, 0xe9, 0x4f, 0xc3, 0xc4, 0x38, 0x5a, 0x2d, 0xea,
-		0x37, 0xc0, 0x3b, 0xae, 0x2f, 0xbc, 0x89, 0x6d, 0x6b, 0xef, 0x8c, 0x90, 0x0e, 0xf3, 0x5d, 0x30,
-		0xe6, 0xcb, 0xda, 0xed, 0xc2, 0x9b, 0x18, 0x4b, 0xc2, 0x4f, 0x88, 0x12, 0x9f, 0x23, 0xa9, 0x48,
-		0xd0, 0xd5, 0x31, 0x7f, 0x9d, 0xf2, 0x27, 0x17, 0x75, 0x6e, 0x6e, 0x44, 0x0b, 0xf8, 0x38, 0xf4,
-		0x3c, 0x1c, 0xf1, 0xaf, 0x36, 0xd8, 0x87, 0x5e, 0xd9, 0xfe, 0x33, 0x8b, 0x85, 0x0f, 0xb6, 0x36,
-		0x86, 0xc6, 0x79, 0xe5, 0x76, 0x70, 0xc8, 0x6a, 0xd2, 0xd6, 0xb0, 0x0d, 0xdd, 0x7f, 0x67, 0xb6,
-		0xa1, 0x4f, 0x7f, 0x59, 0x02, 0xf0, 0x76, 0x2c, 0xd1, 0x83, 0x70, 0x24, 0xbb, 0xb2, 0x9c, 0x2b,
-		0xac, 0xad, 0xcf, 0xae, 0x6f, 0xac, 0x15, 0x36, 0x96, 0xd7, 0x56, 0xf3, 0x73, 0x0b, 0x97, 0x16,
-		0xf2, 0x39, 0xef, 0x58, 0xcd, 0x36, 0x71, 0x51, 0xdb, 0xd2, 0x70, 0x09, 0xdd, 0x0b, 0x13, 0x41,
-		0x68, 0x52, 0xca, 0xe7, 0x12, 0x52, 0x2a, 0xfe, 0xd2, 0xcd, 0xa9, 0x28, 0x5b, 0x50, 0xe0, 0x12,
-		0x3a, 0x05, 0x87, 0x1a, 0xe1, 0x16, 0x96, 0xe7, 0x13, 0xa1, 0xd4, 0xf0, 0x4b, 0x37, 0xa7, 0x62,
-		0xee, 0xca, 0x03, 0xc9, 0x80, 0xfc, 0x90, 0x9c, 0x5e, 0x38, 0x05, 0x2f, 0xdd, 0x9c, 0x1a, 0x60,
-		0x56, 0x9b, 0x8a, 0x7c, 0xf8, 0x33, 0x93, 0x7d, 0xd9, 0x4b, 0x2d, 0x0f, 0xce, 0x1e, 0x6c, 0xab,
-		0x24, 0xbb, 0xee, 0x61, 0x58, 0xf0, 0xb4, 0xec, 0xcf, 0x07, 0x5b, 0x9e, 0x96, 0x95, 0xb1, 0x8e,
-		0x6d, 0xcd, 0xde, 0xd7, 0x69, 0x59, 0x57, 0x27, 0x70, 0xf2, 0xbf, 0xed, 0x87, 0xf8, 0x3c, 0xeb,
-		0x85, 0x4c, 0x04, 0x46, 0x6f, 0x83, 0x01, 0x93, 0xe6, 0x42, 0xee, 0xf1, 0x7b, 0x0b, 0xc5, 0x62,
-		0x19, 0x93, 0x7b, 0x07, 0x94, 0xe5, 0x4f, 0x36, 0xbf, 0x04, 0xc6, 0xee, 0xa6, 0x7a, 0xb7, 0x2d,
-		0xe3, 0x3d, 0xed, 0x13, 0xb3, 0xc4, 0x9b, 0x6f, 0xc9, 0xd6, 0xd3, 0x93, 0xd9, 0x7d, 0xb2, 0x75,
-		0x52, 0xc3, 0x6e, 0x95, 0x7e, 0x50, 0x82, 0x43, 0x14, 0xca, 0xf3, 0x0d, 0x14, 0x52, 0xac, 0x58,
-		0x4f, 0xb7, 0x1a, 0xc2, 0xa2, 0x6a, 0x7b, 0x77, 0xc4, 0xd8, 0x3d, 0xd0, 0xbb, 0xb9, 0x2e, 0x1f,
-		0xf7, 0x75, 0x5e, 0x4f, 0x56, 0x56, 0xc6, 0x2b, 0x0d, 0x98, 0x36, 0x9a, 0x0f, 0x5c, 0x04, 0x8e,
-		0xf4, 0x76, 0x44, 0xe7, 0xbf, 0x14, 0x7c, 0x05, 0x86, 0xbc, 0x00, 0x66, 0xf3, 0xff, 0x5d, 0xd3,
-		0x7d, 0xc2, 0xe2, 0x47, 0x46, 0x1f, 0x92, 0xe0, 0x90, 0x97, 0x92, 0xfa, 0xc9, 0xb2, 0xff, 0xf1,
-		0xf3, 0x40, 0x0f, 0xab, 0xf9, 0x7a, 0xe1, 0x34, 0xa5, 0x2b, 0x2b, 0x13, 0xb5, 0x46, 0x54, 0x1b,
-		0xad, 0xc2, 0xb0, 0x3f, 0x9c, 0xdb, 0x49, 0xf1, 0xb9, 0xcb, 0xee, 0xf3, 0x81, 0x20, 0x01, 0xf6,
-		0x7f, 0x47, 0x4c, 0xc3, 0x72, 0x70, 0x89, 0x6e, 0xe4, 0x47, 0x15, 0xb7, 0x2c, 0x2f, 0x03, 0x6a,
-		0x9c, 0xdc, 0xfa, 0x8b, 0xcf, 0x31, 0xef, 0xe2, 0xf3, 0x04, 0xf4, 0xfb, 0xaf, 0x06, 0xb3, 0x42,
-		0x26, 0xfa, 0x61, 0x9e, 0xb3, 0x1d, 0xb8, 0xcd, 0x7f, 0x2b, 0x04, 0xa7, 0xfd, 0xc7, 0xca, 0x2f,
-		0xd4, 0xb0, 0xb5, 0xe7, 0x9a, 0xa8, 0xa9, 0x96, 0x35, 0xdd, 0xff, 0x76, 0xf0, 0xa8, 0x3f, 0xcb,
-		0xa4, 0xb0, 0x42, 0x4e, 0x24, 0x76, 0x0f, 0xad, 0xaa, 0x65, 0xac, 0xe0, 0x17, 0x6a, 0xd8, 0x76,
-		0x9a, 0xbc, 0x4e, 0x39, 0x0c, 0x03, 0xc6, 0xd6, 0x96, 0xb8, 0x0b, 0x13, 0x51, 0x78, 0x89, 0x8c,
-		0xb9, 0xa2, 0x55, 0x35, 0x76, 0x8d, 0x34, 0xa2, 0xb0, 0x02, 0x4a, 0xc3, 0x50, 0xd1, 0xa8, 0xe9,
-		0xdc, 0xe4, 0x92, 0x11, 0xf1, 0x15, 0x99, 0x9a, 0xce, 0x4c, 0x8e, 0x08, 0xd1, 0xc2, 0xd7, 0xb0,
-		0x65, 0xb3, 0xd0, 0x10, 0x55, 0x44, 0x51, 0x7e, 0x02, 0xe2, 0x8c, 0x13, 0x9e, 0x01, 0x1e, 0x85,
-		0x28, 0xbd, 0xa1, 0xe9, 0xf1, 0x33, 0x48, 0xca, 0x4f, 0xb2, 0x37, 0x2e, 0x8c, 0x3e, 0x63, 0x89,
-		0x15, 0xb2, 0xd9, 0x96, 0x52, 0x3e, 0xd5, 0xd9, 0x6b, 0x30, 0x19, 0xba, 0x12, 0xfe, 0xbd, 0x7e,
-		0x38, 0xc4, 0x0f, 0xfd, 0x55, 0x53, 0x9b, 0xd9, 0x76, 0x1c, 0xf1, 0xf0, 0x10, 0x78, 0x24, 0x53,
-		0x4d, 0x4d, 0xde, 0x83, 0xc8, 0x65, 0xc7, 0x31, 0xd1, 0x69, 0xe8, 0xb7, 0x6a, 0x15, 0x2c, 0xb6,
-		0x48, 0xdd, 0x63, 0x3e, 0xd5, 0xd4, 0xa6, 0x09, 0x00, 0xc9, 0x3e, 0x14, 0x06, 0x82, 0xf2, 0x90,
-		0xde, 0xaa, 0x55, 0x2a, 0x7b, 0x85, 0x12, 0xa6, 0xff, 0xf2, 0xcb, 0xfd, 0xef, 0x1a, 0x78, 0xd7,
-		0x54, 0xc5, 0x27, 0x3a, 0x89, 0x60, 0x8e, 0x53, 0xb0, 0x1c, 0x85, 0x12, 0xff, 0x59, 0x23, 0x2f,
-		0x60, 0xe4, 0x3f, 0x0a, 0x41, 0x54, 0x90, 0xa6, 0x8f, 0x4e, 0x70, 0x05, 0x17, 0x1d, 0x43, 0x1c,
-		0xc2, 0xba, 0x65, 0x84, 0x20, 0x5c, 0xe6, 0x93, 0x17, 0xbb, 0xdc, 0xa7, 0x90, 0x02, 0xa9, 0x73,
-		0x9f, 0x02, 0x91, 0x3a, 0xb3, 0x46, 0xe6, 0x33, 0x62, 0x1a, 0x62, 0xeb, 0xe1, 0x72, 0x9f, 0x42,
-		0x4b, 0x28, 0x09, 0x03, 0xc4, 0x68, 0x1c, 0x36, 0x5b, 0xa4, 0x9e, 0x97, 0xd1, 0x61, 0xe8, 0x37,
-		0x55, 0xa7, 0xc8, 0x56, 0x5e, 0xa4, 0x81, 0x15, 0xd1, 0x79, 0x18, 0x60, 0xef, 0xcd, 0xeb, 0xff,
-		0x9f, 0x0e, 0x11, 0x06, 0xfb, 0xb0, 0x1f, 0xe1, 0x7b, 0x55, 0x75, 0x1c, 0x6c, 0xe9, 0x84, 0x20,
-		0x03, 0x47, 0x08, 0x22, 0x9b, 0x46, 0x69, 0x8f, 0xff, 0x8f, 0x1f, 0xfa, 0x9b, 0xff, 0xf7, 0x11,
-		0xaa, 0x0f, 0x05, 0xda, 0xc8, 0xfe, 0xb5, 0x59, 0x5c, 0x54, 0x66, 0x09, 0x50, 0x1e, 0xc6, 0xd5,
-		0x52, 0x49, 0x23, 0x0a, 0xaf, 0x56, 0x0a, 0x9b, 0x1a, 0x75, 0x1e, 0x36, 0xfd, 0xc7, 0x75, 0xad,
-		0xe6, 0x02, 0x79, 0x08, 0x59, 0x0e, 0x9f, 0x8d, 0xc1, 0xa0, 0xc9, 0x98, 0x92, 0x2f, 0xc2, 0x58,
-		0x03, 0xa7, 0x84, 0xbf, 0x1d, 0x4d, 0x2f, 0x89, 0xf7, 0x51, 0xe4, 0x37, 0xa9, 0xa3, 0x1f, 0xe7,
-		0x64, 0xc7, 0xdb, 0xf4, 0x77, 0xf6, 0xfd, 0xad, 0xdf, 0x92, 0x8e, 0xf8, 0xde, 0x92, 0xaa, 0xa6,
-		0x96, 0x8d, 0x51, 0xfa, 0xfc, 0x05, 0xe9, 0x2c, 0x6f, 0x60, 0xaf, 0x47, 0xa7, 0x0d, 0xab, 0x4c,
-		0x02, 0xb8, 0x08, 0xcc, 0xa4, 0x49, 0x35, 0x35, 0x9b, 0xaa, 0xa3, 0xf7, 0xb1, 0x50, 0xfb, 0xa2,
-		0xef, 0x37, 0x7d, 0x57, 0x1a, 0x99, 0x9f, 0x5d, 0x5d, 0x70, 0xf5, 0xf8, 0x9f, 0x87, 0xe0, 0xb8,
-		0x4f, 0x8f, 0x7d, 0xc0, 0x8d, 0xea, 0x9c, 0x6a, 0xae, 0xf1, 0x5d, 0xbc, 0x27, 0x7d, 0x12, 0x22,
-		0x04, 0x1e, 0x75, 0xf8, 0xdf, 0x20, 0xc9, 0x2f, 0x7e, 0xfd, 0x9f, 0xca, 0xc1, 0x83, 0xf0, 0xc0,
-		0xac, 0x50, 0x22, 0xd9, 0x0f, 0x75, 0x2f, 0xbf, 0x84, 0xf7, 0x9d, 0x54, 0xfb, 0xe0, 0xc4, 0x58,
-		0x2f, 0xc3, 0xdb, 0x67, 0x41, 0x6e, 0x91, 0x0d, 0x31, 0x67, 0xda, 0x3e, 0xbf, 0xea, 0xc1, 0x53,
-		0xb7, 0x7a, 0x52, 0xd4, 0x6e, 0x06, 0xbb, 0xcc, 0xd4, 0x76, 0xe1, 0xf0, 0x53, 0xa4, 0x6f, 0x6f,
-		0x1b, 0x48, 0xb8, 0xfc, 0xc3, 0xee, 0x05, 0x01, 0x89, 0xff, 0xdf, 0x40, 0x71, 0xf8, 0x0f, 0x1e,
-		0x7f, 0x7c, 0xc3, 0xe2, 0xde, 0xe9, 0x96, 0xa1, 0x64, 0xda, 0x17, 0x46, 0x14, 0x1f, 0xa6, 0xfc,
-		0x05, 0x09, 0x8e, 0x34, 0x74, 0xcd, 0x7d, 0xfc, 0x7c, 0x93, 0xd7, 0x4f, 0xfb, 0x4a, 0x7a, 0xe6,
-		0x9b, 0x30, 0x7b, 0x5f, 0x47, 0x66, 0x19, 0x17, 0x01, 0x6e, 0xdf, 0x01, 0x87, 0x82, 0xcc, 0x0a,
-		0x31, 0xdd, 0xe3, 0x5f, 0x3c, 0x92, 0xc0, 0xcf, 0xc5, 0x35, 0x1c, 0x38, 0xf3, 0x90, 0x0b, 0xf5,
-		0x72, 0x76, 0xc7, 0x9a, 0x87, 0x98, 0x0b, 0xca, 0xb3, 0xe3, 0xae, 0x87, 0xea, 0x61, 0xca, 0x1f,
-		0x97, 0x60, 0x2a, 0xd8, 0x83, 0x2f, 0x4f, 0xea, 0x8d, 0xd9, 0x03, 0x9b, 0xe2, 0x37, 0x24, 0x38,
-		0xd9, 0x86, 0x27, 0x2e, 0x80, 0x1b, 0x30, 0xe1, 0xdb, 0x99, 0x12, 0x2e, 0x5c, 0x4c, 0xfb, 0xe9,
-		0xce, 0x19, 0xaa, 0xbb, 0x11, 0x73, 0x8c, 0x08, 0xe5, 0xf3, 0xdf, 0x4a, 0x8f, 0x37, 0xb6, 0xd9,
-		0xca, 0x78, 0xe3, 0x6e, 0xd2, 0x01, 0xea, 0xc7, 0x2b, 0x12, 0xdc, 0x1f, 0x1c, 0x6a, 0x93, 0x54,
-		0xf7, 0xad, 0x9a, 0x87, 0xff, 0x28, 0xc1, 0xe9, 0x6e, 0x98, 0xe3, 0x13, 0xb2, 0x09, 0xe3, 0x5e,
-		0x12, 0x5e, 0x3f, 0x1f, 0x3d, 0xa5, 0xf6, 0x4c, 0x4b, 0x91, 0x4b, 0xed, 0x0e, 0x08, 0xde, 0xe4,
-		0x86, 0xe5, 0x9f, 0x72, 0x57, 0xc8, 0xc1, 0xd3, 0x0a, 0x21, 0xe4, 0xc0, 0x79, 0x45, 0x93, 0xb9,
-		0x08, 0x35, 0x99, 0x0b, 0x2f, 0x6b, 0x97, 0xaf, 0x71, 0xbf, 0xd5, 0x64, 0x4f, 0xf8, 0x5d, 0x30,
-		0xde, 0x44, 0x95, 0xb9, 0x55, 0xf7, 0xa0, 0xc9, 0x0a, 0x6a, 0x54, 0x56, 0x79, 0x0f, 0xd2, 0xb4,
-		0xdf, 0x26, 0x82, 0xbe, 0xd3, 0x43, 0xae, 0x72, 0xdf, 0xd2, 0xb4, 0x6b, 0x3e, 0xf6, 0x05, 0x18,
-		0x60, 0xf3, 0xcc, 0x87, 0xbb, 0x0f, 0x45, 0xe1, 0x04, 0xe4, 0x4f, 0x08, 0x5f, 0x96, 0x13, 0x6c,
-		0x37, 0xb7, 0xa1, 0x6e, 0xc6, 0x7a, 0x40, 0x36, 0xe4, 0x13, 0xc6, 0x6b, 0xc2, 0xab, 0x35, 0xe7,
-		0x8e, 0x8b, 0xa3, 0x78, 0x60, 0x5e, 0x8d, 0xc9, 0xe6, 0xce, 0xba, 0xaf, 0x5f, 0x15, 0xee, 0xcb,
-		0x1d, 0x53, 0x07, 0xf7, 0xf5, 0xd6, 0x88, 0xde, 0x75, 0x64, 0x1d, 0xd8, 0xfc, 0x8b, 0xe8, 0xc8,
-		0xbe, 0x2f, 0xc1, 0x51, 0x3a, 0x36, 0xff, 0x1e, 0x45, 0xaf, 0x22, 0x7f, 0x10, 0x90, 0x6d, 0x15,
-		0x0b, 0x4d, 0xad, 0x3b, 0x61, 0x5b, 0xc5, 0xab, 0x81, 0xf8, 0xf2, 0x20, 0xa0, 0x52, 0x60, 0x27,
-		0x8a, 0x42, 0xb3, 0x8b, 0xb7, 0x89, 0x92, 0x6f, 0xa3, 0xa3, 0xc9, 0x74, 0x46, 0x0e, 0x60, 0x3a,
-		0xbf, 0x29, 0x41, 0xaa, 0xd9, 0x90, 0xf9, 0xf4, 0x69, 0x70, 0x38, 0x70, 0x68, 0x55, 0x3f, 0x83,
-		0x0f, 0x76, 0xb3, 0xcb, 0x53, 0x67, 0x46, 0x87, 0x2c, 0x7c, 0xa7, 0xf3, 0x80, 0x74, 0x50, 0x43,
-		0x1b, 0x33, 0xeb, 0xb7, 0xcc, 0x7c, 0xbe, 0xd4, 0xe0, 0x57, 0xff, 0x42, 0xe4, 0xde, 0xbb, 0x30,
-		0xd9, 0x82, 0xeb, 0x3b, 0x1d, 0xf7, 0xb6, 0x5b, 0x4e, 0xe6, 0x41, 0xa7, 0xef, 0x8f, 0x71, 0x4b,
-		0x08, 0x3e, 0xea, 0xf0, 0xad, 0xc5, 0x9a, 0xbd, 0x0a, 0x95, 0x9f, 0x85, 0x63, 0x4d, 0xb1, 0x38,
-		0x6f, 0x19, 0x88, 0x6c, 0x6b, 0xb6, 0xc3, 0xd9, 0xba, 0xb7, 0x15, 0x5b, 0x75, 0xd8, 0x14, 0x47,
-		0x46, 0x90, 0xa0, 0xa4, 0x57, 0x0d, 0xa3, 0xc2, 0xd9, 0x90, 0x9f, 0x84, 0x31, 0x5f, 0x1d, 0xef,
-		0xe4, 0x1c, 0x44, 0x4c, 0x83, 0x7f, 0xf1, 0x64, 0xe8, 0xcc, 0xf1, 0x96, 0x1b, 0xfb, 0x86, 0x51,
-		0xe1, 0xc3, 0xa6, 0xf0, 0xf2, 0x04, 0x20, 0x46, 0x8c, 0xee, 0xf1, 0x8b, 0x2e, 0xd6, 0x60, 0x3c,
-		0x50, 0xcb, 0x3b, 0x79, 0x53, 0xe7, 0x07, 0x67, 0x7e, 0x78, 0x08, 0xfa, 0x29, 0x55, 0xf4, 0x8a,
-		0x04, 0xe0, 0xbb, 0xf1, 0x30, 0xdd, 0x8a, 0x4c, 0xf3, 0x35, 0x71, 0x6a, 0xa6, 0x6b, 0x78, 0x9e,
-		0xb3, 0x3d, 0xf0, 0xfe, 0x7f, 0xf3, 0x9d, 0x9f, 0x0f, 0xdd, 0x83, 0xee, 0x9a, 0xd1, 0x8a, 0x66,
-		0x45, 0xbd, 0xa1, 0x36, 0x2c, 0xc7, 0x7d, 0x06, 0xf3, 0xb9, 0xc0, 0xf7, 0x34, 0x1e, 0xea, 0xae,
-		0x2f, 0xc1, 0xda, 0x74, 0xb7, 0xe0, 0x9c, 0xb3, 0xb7, 0x51, 0xce, 0xce, 0xa1, 0xc7, 0xba, 0xe0,
-		0x6c, 0xe6, 0x3d, 0x41, 0xb3, 0x79, 0x1f, 0xfa, 0x77, 0x12, 0x4c, 0x34, 0x5b, 0xd4, 0xa1, 0xc7,
-		0xbb, 0x63, 0xa3, 0x31, 0xa9, 0x48, 0x5d, 0xd8, 0x07, 0x26, 0x1f, 0xcb, 0x65, 0x3a, 0x96, 0x2c,
-		0x7a, 0xe7, 0x7e, 0xc6, 0x32, 0xe3, 0xdf, 0xfd, 0xff, 0xdf, 0x12, 0x9c, 0x68, 0xbb, 0x48, 0x42,
-		0xb3, 0xdd, 0xb1, 0xd9, 0x26, 0x7d, 0x4a, 0x65, 0xdf, 0x0c, 0x09, 0x3e, 0x64, 0x85, 0x0e, 0x79,
-		0x11, 0x5d, 0xd9, 0xd7, 0x90, 0x9b, 0x9e, 0xb1, 0xa0, 0xaf, 0x4a, 0x81, 0xeb, 0xb8, 0xed, 0x35,
-		0xaa, 0x61, 0xf1, 0xd1, 0xc1, 0x38, 0x1a, 0x13, 0x5b, 0xf9, 0x59, 0x3a, 0x86, 0x35, 0xf4, 0xd4,
-		0x9b, 0x9d, 0xb6, 0x99, 0xf7, 0x04, 0xbd, 0xff, 0xfb, 0xd0, 0x0f, 0xa5, 0xe6, 0xb7, 0x61, 0xcf,
-		0xb7, 0xe5, 0xb1, 0xf5, 0xca, 0x2a, 0xf5, 0x78, 0xef, 0x88, 0x7c, 0x94, 0x3a, 0x1d, 0xe5, 0x36,
-		0xda, 0x3a, 0xf0, 0x51, 0x36, 0x9d, 0x46, 0xf4, 0x75, 0x09, 0x26, 0x9a, 0xad, 0x4c, 0x3a, 0x98,
-		0x66, 0x9b, 0xa5, 0x56, 0x07, 0xd3, 0x6c, 0xb7, 0x0c, 0x92, 0xdf, 0x4e, 0x47, 0x7f, 0x1e, 0x9d,
-		0x6d, 0x39, 0xfa, 0xb6, 0xf3, 0x48, 0xec, 0xb1, 0x6d, 0xae, 0xdf, 0xc1, 0x1e, 0xbb, 0x59, 0xce,
-		0x74, 0xb0, 0xc7, 0xae, 0x96, 0x1a, 0x5d, 0xd8, 0xa3, 0xf7, 0x38, 0xa3, 0xbb, 0x89, 0xb4, 0xd1,
-		0xef, 0x4a, 0x30, 0x1c, 0xc8, 0x8c, 0xd1, 0x23, 0x6d, 0x39, 0x6d, 0xb6, 0x70, 0x48, 0x9d, 0xe9,
-		0x05, 0x85, 0x0f, 0xe6, 0x0a, 0x1d, 0x4c, 0x0e, 0x65, 0xf7, 0x35, 0x98, 0xe0, 0x79, 0xea, 0x6b,
-		0x12, 0x8c, 0x37, 0x49, 0x37, 0x3b, 0x58, 0x62, 0xeb, 0xec, 0x39, 0xf5, 0x78, 0xef, 0x88, 0x7c,
-		0x58, 0xf3, 0x74, 0x58, 0xb3, 0xe8, 0x89, 0x7d, 0x0d, 0xcb, 0x17, 0xa8, 0xbf, 0xe5, 0xdd, 0x08,
-		0xf4, 0x75, 0x84, 0xce, 0xf5, 0xc8, 0x99, 0x18, 0xd1, 0xf9, 0x9e, 0xf1, 0xf8, 0x80, 0x9e, 0xa1,
-		0x03, 0x52, 0xd0, 0xea, 0x9b, 0x1c, 0x50, 0x63, 0x7c, 0xff, 0x52, 0xe3, 0xfb, 0xe2, 0xf6, 0x8a,
-		0xd4, 0x34, 0x6f, 0x4d, 0x3d, 0xda, 0x13, 0x0e, 0x1f, 0xd5, 0x05, 0x3a, 0xaa, 0x47, 0xd1, 0x23,
-		0x2d, 0x47, 0xe5, 0xbb, 0x48, 0xaa, 0xe9, 0x5b, 0xc6, 0xcc, 0x7b, 0x58, 0x3a, 0xfc, 0x3e, 0xf4,
-		0x7e, 0x71, 0xe9, 0xee, 0x54, 0xdb, 0x8e, 0x7d, 0x39, 0x6d, 0xea, 0xfe, 0x2e, 0x20, 0x39, 0x63,
-		0xf7, 0x50, 0xc6, 0xd2, 0xe8, 0x44, 0x4b, 0xc6, 0x48, 0x62, 0x8b, 0x5e, 0x92, 0xdc, 0x8b, 0xbf,
-		0xa7, 0xdb, 0x13, 0xf7, 0x67, 0xbe, 0xa9, 0x07, 0xba, 0x82, 0xe5, 0xac, 0xdc, 0x47, 0x59, 0x39,
-		0x89, 0xd2, 0xad, 0x59, 0x61, 0x89, 0xf0, 0x41, 0x5f, 0x31, 0xf8, 0x83, 0x93, 0x90, 0x6e, 0x71,
-		0xb0, 0xe4, 0xec, 0x76, 0x38, 0xf1, 0x6a, 0xf3, 0xd2, 0xbe, 0xe3, 0x4b, 0xfa, 0x16, 0x6f, 0xf7,
-		0xf7, 0xff, 0xbe, 0xbe, 0xbb, 0xe3, 0xb1, 0x5f, 0x1e, 0x00, 0xb4, 0x64, 0x97, 0xe7, 0x2c, 0xcc,
-		0xfe, 0xc3, 0x26, 0xb7, 0xf4, 0xba, 0x27, 0xa4, 0xd2, 0x9b, 0x7a, 0x42, 0xba, 0x14, 0x78, 0x94,
-		0x19, 0xea, 0xed, 0xe1, 0x77, 0xd7, 0x2f, 0x33, 0xc3, 0x3f, 0x9e, 0x97, 0x99, 0x4d, 0x5f, 0x11,
-		0x44, 0x0e, 0xee, 0xb9, 0x51, 0xff, 0x7e, 0xdf, 0x70, 0xf1, 0x07, 0xd7, 0x03, 0x6d, 0x1e, 0x5c,
-		0x27, 0x5b, 0xbe, 0xaa, 0xe6, 0xd8, 0xe8, 0xac, 0xf8, 0x5a, 0xf8, 0x60, 0x77, 0xf7, 0xb4, 0x19,
-		0x74, 0xa7, 0xe7, 0xaa, 0xd1, 0xb7, 0xe0, 0xb9, 0x6a, 0xec, 0x4d, 0x3d, 0x57, 0xf5, 0x6d, 0x91,
-		0x1c, 0x87, 0x54, 0xa3, 0x81, 0xb8, 0x7e, 0xea, 0xf5, 0x30, 0x24, 0x96, 0xec, 0x72, 0xbe, 0xa4,
-		0x39, 0x77, 0xc8, 0x7a, 0x9e, 0x68, 0xfd, 0x28, 0x0d, 0xdd, 0xbe, 0x95, 0x1e, 0x61, 0xa3, 0x6b,
-		0xa3, 0x1b, 0x55, 0x18, 0xad, 0xfb, 0xfa, 0x02, 0xb7, 0x95, 0xdc, 0x7e, 0x3e, 0x02, 0x51, 0x47,
-		0x4a, 0xa6, 0x6f, 0x88, 0x7c, 0x16, 0x8b, 0x76, 0x9b, 0x9b, 0x27, 0x33, 0x91, 0xcb, 0x77, 0xd2,
-		0x34, 0x97, 0x5a, 0x2a, 0x03, 0x33, 0xaa, 0x23, 0xb7, 0x6f, 0xa5, 0xc7, 0x19, 0xc9, 0xde, 0x54,
-		0x20, 0x05, 0xc9, 0xfa, 0x39, 0x76, 0x15, 0xe0, 0xb7, 0x43, 0x30, 0xb4, 0x64, 0x8b, 0x94, 0x19,
-		0xff, 0x84, 0x3e, 0x47, 0x3c, 0xef, 0x7e, 0x09, 0x3b, 0xdc, 0x9d, 0x61, 0x73, 0xf0, 0x83, 0x7f,
-		0xd4, 0xe9, 0x49, 0xf5, 0x10, 0x8c, 0xfb, 0x04, 0xe7, 0x0a, 0xf4, 0x1b, 0x21, 0x1a, 0x91, 0xb2,
-		0xb8, 0xac, 0xe9, 0x6e, 0xf6, 0x8e, 0xff, 0xb2, 0xbe, 0xde, 0xf2, 0x26, 0x2e, 0xd2, 0xd3, 0xc4,
-		0xf9, 0xe4, 0xbc, 0x43, 0x1d, 0x58, 0x9d, 0x3c, 0xdd, 0x8d, 0xc7, 0xa5, 0xc6, 0xb7, 0x85, 0x52,
-		0x0f, 0x5f, 0x4a, 0xab, 0x7b, 0x41, 0x28, 0xbf, 0x21, 0xc1, 0xf0, 0x92, 0x5d, 0xde, 0xd0, 0x4b,
-		0xff, 0x7f, 0x1a, 0x84, 0x4f, 0xae, 0x5b, 0x70, 0x28, 0x30, 0xd2, 0x3b, 0x25, 0xd2, 0x57, 0x43,
-		0x90, 0x6e, 0x70, 0x3f, 0x75, 0x71, 0xb0, 0x69, 0x90, 0x90, 0x7a, 0x08, 0x12, 0x8d, 0x4f, 0x44,
-		0x42, 0x77, 0xec, 0x89, 0x48, 0xf8, 0x60, 0x9e, 0x88, 0xf8, 0xa6, 0xe2, 0x7e, 0xb8, 0xaf, 0x83,
-		0x84, 0x5c, 0xf7, 0x72, 0xb3, 0x99, 0x34, 0x3d, 0xca, 0x07, 0x23, 0xcd, 0x26, 0x8f, 0x57, 0x42,
-		0x07, 0xf9, 0x78, 0x25, 0x7c, 0xc0, 0x8f, 0x57, 0x3a, 0x88, 0x32, 0x28, 0x1e, 0x21, 0xca, 0x33,
-		0xdf, 0x1c, 0x80, 0xf0, 0x92, 0x5d, 0x46, 0x2f, 0xc0, 0x68, 0xfd, 0xfa, 0xa1, 0xe5, 0xba, 0xb0,
-		0x31, 0x95, 0x6a, 0xbd, 0x81, 0xd3, 0x3a, 0xed, 0x42, 0x3b, 0x30, 0x1c, 0x4c, 0xb9, 0x4e, 0xb5,
-		0x21, 0x12, 0x80, 0x4c, 0x3d, 0xdc, 0x2d, 0xa4, 0xdb, 0xd9, 0xbb, 0x21, 0xea, 0x86, 0xf7, 0xbb,
-		0xda, 0x60, 0x0b, 0xa0, 0xd6, 0x2b, 0xdd, 0x26, 0xf1, 0x8e, 0x48, 0xaf, 0x3e, 0xd6, 0xb5, 0x93,
-		0x5e, 0x1d, 0x6c, 0x5b, 0xe9, 0xb5, 0xf2, 0xf9, 0x9b, 0x00, 0x3e, 0x07, 0x7d, 0x4f, 0x1b, 0x0a,
-		0x1e, 0x58, 0xea, 0xa1, 0xae, 0xc0, 0xdc, 0x3e, 0x5e, 0x96, 0xe0, 0x78, 0x5b, 0x97, 0x75, 0xbe,
-		0xeb, 0x79, 0x08, 0x22, 0xa6, 0x9e, 0xd8, 0x27, 0x62, 0x1b, 0xd6, 0xea, 0xec, 0xbf, 0x7b, 0xd6,
-		0x82, 0x88, 0x3d, 0xb0, 0xd6, 0xdc, 0xa4, 0x0e, 0x7c, 0x37, 0xe3, 0x5f, 0x0d, 0xb4, 0xbc, 0xc2,
-		0xab, 0xd6, 0x9c, 0xed, 0x1b, 0xfb, 0xfb, 0xa0, 0xe0, 0xbe, 0x37, 0x25, 0xe4, 0x7f, 0x19, 0x06,
-		0xb4, 0xe6, 0xa8, 0x3b, 0x78, 0xb6, 0xe6, 0x6c, 0x1b, 0x96, 0x76, 0x83, 0x65, 0xee, 0x18, 0xa0,
-		0xaa, 0xee, 0xfa, 0xdf, 0x8e, 0xb6, 0x8d, 0xc8, 0x0f, 0x7c, 0xfe, 0x5b, 0xe9, 0xfb, 0xba, 0x08,
-		0x1b, 0x04, 0x58, 0x89, 0x55, 0xd5, 0x5d, 0xfe, 0x70, 0xf4, 0x2a, 0x00, 0x59, 0x48, 0x5e, 0x2f,
-		0x54, 0x34, 0x5b, 0x7c, 0xe2, 0xf4, 0x6c, 0xab, 0x29, 0x6b, 0x64, 0x73, 0xda, 0xf7, 0x61, 0xec,
-		0x3e, 0x25, 0x46, 0x49, 0x2d, 0x6a, 0xb6, 0x83, 0xd6, 0x21, 0x56, 0xc2, 0xfa, 0x1e, 0x23, 0x1b,
-		0x7e, 0x73, 0x64, 0xa3, 0x84, 0x12, 0xa5, 0xfa, 0x0c, 0x20, 0xd5, 0x0f, 0x47, 0xff, 0x61, 0x11,
-		0xff, 0x98, 0x59, 0xcb, 0x4d, 0xbd, 0x00, 0x65, 0xfa, 0x71, 0xde, 0x31, 0xb5, 0xbe, 0x2a, 0x75,
-		0x6f, 0xe0, 0x8c, 0x38, 0xf0, 0xbc, 0x27, 0xec, 0x7b, 0xde, 0x93, 0x19, 0xfb, 0xd7, 0x5f, 0x7a,
-		0x68, 0x38, 0x40, 0x31, 0x1b, 0xf7, 0x5f, 0x9e, 0x38, 0xfd, 0x29, 0x09, 0xc6, 0x1a, 0x7a, 0x44,
-		0x32, 0x4c, 0xce, 0x6e, 0xac, 0x5f, 0x5e, 0x51, 0x16, 0x9e, 0x9b, 0x5d, 0x5f, 0x58, 0x59, 0x16,
-		0x5f, 0xfb, 0xf4, 0x3d, 0x4d, 0x44, 0x69, 0x38, 0xd6, 0x04, 0x26, 0x97, 0x5f, 0xcc, 0xcf, 0xcf,
-		0xae, 0xe7, 0x13, 0x12, 0x3a, 0x09, 0x27, 0x9a, 0x12, 0x71, 0x41, 0x42, 0x2d, 0x40, 0x94, 0xbc,
-		0x0b, 0x12, 0x3e, 0x68, 0x7b, 0xfa, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x68, 0x6a, 0x25, 0x95,
-		0x40, 0xa1, 0x00, 0x00,
+		// 10652 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x74, 0x1c, 0xe7,
+		0x75, 0x18, 0x67, 0x77, 0x01, 0xec, 0x5e, 0xbc, 0x16, 0x1f, 0x40, 0x72, 0xb1, 0x24, 0x01, 0x68,
+		0x28, 0x89, 0x0f, 0x49, 0xa0, 0x48, 0x89, 0xa4, 0xb8, 0x8c, 0xcc, 0x60, 0x81, 0x25, 0x08, 0x0a,
+		0x2f, 0x0d, 0x00, 0xea, 0xe1, 0x24, 0xdb, 0xc1, 0xee, 0x87, 0xc5, 0x88, 0xbb, 0x33, 0xa3, 0x99,
+		0x59, 0x92, 0x90, 0xed, 0x1c, 0xc5, 0x76, 0x5d, 0x5b, 0xa9, 0x1d, 0x3b, 0xf6, 0x49, 0x1c, 0xd9,
+		0x74, 0xec, 0x38, 0xad, 0x1d, 0x27, 0x6d, 0x5e, 0xae, 0xd3, 0xb4, 0x3d, 0x6d, 0x92, 0x9e, 0xb4,
+		0xb6, 0xdb, 0xe6, 0xd8, 0xe9, 0x2b, 0xcd, 0x69, 0x29, 0xd7, 0x76, 0x1b, 0xd7, 0x75, 0x1b, 0x87,
+		0x71, 0xcf, 0x49, 0xeb, 0xd3, 0xd3, 0x9e, 0xef, 0x35, 0xaf, 0x9d, 0x7d, 0x41, 0xa0, 0x65, 0xa7,
+		0xfd, 0x05, 0xcc, 0xfd, 0xee, 0xbd, 0xdf, 0xbd, 0xf7, 0xbb, 0xdf, 0xfd, 0xee, 0xf7, 0x5c, 0xf8,
+		0xf3, 0x8b, 0x30, 0x55, 0x31, 0x8c, 0x4a, 0x15, 0x9f, 0x32, 0x2d, 0xc3, 0x31, 0x36, 0xeb, 0x5b,
+		0xa7, 0xca, 0xd8, 0x2e, 0x59, 0x9a, 0xe9, 0x18, 0xd6, 0x34, 0x85, 0xa1, 0x61, 0x86, 0x31, 0x2d,
+		0x30, 0xe4, 0x25, 0x18, 0xb9, 0xac, 0x55, 0xf1, 0x9c, 0x8b, 0xb8, 0x86, 0x1d, 0xf4, 0x04, 0x24,
+		0xb6, 0xb4, 0x2a, 0xce, 0x48, 0x53, 0xf1, 0xe3, 0xfd, 0x67, 0xee, 0x9f, 0x0e, 0x11, 0x4d, 0x07,
+		0x29, 0x56, 0x09, 0x58, 0xa1, 0x14, 0xf2, 0xd7, 0x13, 0x30, 0x1a, 0x51, 0x8a, 0x10, 0x24, 0x74,
+		0xb5, 0x46, 0x38, 0x4a, 0xc7, 0x53, 0x0a, 0xfd, 0x1f, 0x65, 0xa0, 0xcf, 0x54, 0x4b, 0xd7, 0xd5,
+		0x0a, 0xce, 0xc4, 0x28, 0x58, 0x7c, 0xa2, 0x09, 0x80, 0x32, 0x36, 0xb1, 0x5e, 0xc6, 0x7a, 0x69,
+		0x27, 0x13, 0x9f, 0x8a, 0x1f, 0x4f, 0x29, 0x3e, 0x08, 0x7a, 0x08, 0x46, 0xcc, 0xfa, 0x66, 0x55,
+		0x2b, 0x15, 0x7d, 0x68, 0x30, 0x15, 0x3f, 0xde, 0xa3, 0xa4, 0x59, 0xc1, 0x9c, 0x87, 0x7c, 0x0c,
+		0x86, 0x6f, 0x62, 0xf5, 0xba, 0x1f, 0xb5, 0x9f, 0xa2, 0x0e, 0x11, 0xb0, 0x0f, 0x71, 0x16, 0x06,
+		0x6a, 0xd8, 0xb6, 0xd5, 0x0a, 0x2e, 0x3a, 0x3b, 0x26, 0xce, 0x24, 0xa8, 0xf6, 0x53, 0x0d, 0xda,
+		0x87, 0x35, 0xef, 0xe7, 0x54, 0xeb, 0x3b, 0x26, 0x46, 0x33, 0x90, 0xc2, 0x7a, 0xbd, 0xc6, 0x38,
+		0xf4, 0x34, 0xb1, 0x5f, 0x41, 0xaf, 0xd7, 0xc2, 0x5c, 0x92, 0x84, 0x8c, 0xb3, 0xe8, 0xb3, 0xb1,
+		0x75, 0x43, 0x2b, 0xe1, 0x4c, 0x2f, 0x65, 0x70, 0xac, 0x81, 0xc1, 0x1a, 0x2b, 0x0f, 0xf3, 0x10,
+		0x74, 0x68, 0x16, 0x52, 0xf8, 0x96, 0x83, 0x75, 0x5b, 0x33, 0xf4, 0x4c, 0x1f, 0x65, 0xf2, 0x40,
+		0x44, 0x2b, 0xe2, 0x6a, 0x39, 0xcc, 0xc2, 0xa3, 0x43, 0xe7, 0xa0, 0xcf, 0x30, 0x1d, 0xcd, 0xd0,
+		0xed, 0x4c, 0x72, 0x4a, 0x3a, 0xde, 0x7f, 0xe6, 0x70, 0xa4, 0x23, 0xac, 0x30, 0x1c, 0x45, 0x20,
+		0xa3, 0x05, 0x48, 0xdb, 0x46, 0xdd, 0x2a, 0xe1, 0x62, 0xc9, 0x28, 0xe3, 0xa2, 0xa6, 0x6f, 0x19,
+		0x99, 0x14, 0x65, 0x30, 0xd9, 0xa8, 0x08, 0x45, 0x9c, 0x35, 0xca, 0x78, 0x41, 0xdf, 0x32, 0x94,
+		0x21, 0x3b, 0xf0, 0x8d, 0x0e, 0x40, 0xaf, 0xbd, 0xa3, 0x3b, 0xea, 0xad, 0xcc, 0x00, 0xf5, 0x10,
+		0xfe, 0x25, 0xff, 0x76, 0x2f, 0x0c, 0x77, 0xe2, 0x62, 0x17, 0xa1, 0x67, 0x8b, 0x68, 0x99, 0x89,
+		0x75, 0x63, 0x03, 0x46, 0x13, 0x34, 0x62, 0xef, 0x2e, 0x8d, 0x38, 0x03, 0xfd, 0x3a, 0xb6, 0x1d,
+		0x5c, 0x66, 0x1e, 0x11, 0xef, 0xd0, 0xa7, 0x80, 0x11, 0x35, 0xba, 0x54, 0x62, 0x57, 0x2e, 0xf5,
+		0x2c, 0x0c, 0xbb, 0x22, 0x15, 0x2d, 0x55, 0xaf, 0x08, 0xdf, 0x3c, 0xd5, 0x4e, 0x92, 0xe9, 0x82,
+		0xa0, 0x53, 0x08, 0x99, 0x32, 0x84, 0x03, 0xdf, 0x68, 0x0e, 0xc0, 0xd0, 0xb1, 0xb1, 0x55, 0x2c,
+		0xe3, 0x52, 0x35, 0x93, 0x6c, 0x62, 0xa5, 0x15, 0x82, 0xd2, 0x60, 0x25, 0x83, 0x41, 0x4b, 0x55,
+		0x74, 0xc1, 0x73, 0xb5, 0xbe, 0x26, 0x9e, 0xb2, 0xc4, 0x3a, 0x59, 0x83, 0xb7, 0x6d, 0xc0, 0x90,
+		0x85, 0x89, 0xdf, 0xe3, 0x32, 0xd7, 0x2c, 0x45, 0x85, 0x98, 0x6e, 0xab, 0x99, 0xc2, 0xc9, 0x98,
+		0x62, 0x83, 0x96, 0xff, 0x13, 0x1d, 0x05, 0x17, 0x50, 0xa4, 0x6e, 0x05, 0x34, 0x0a, 0x0d, 0x08,
+		0xe0, 0xb2, 0x5a, 0xc3, 0xd9, 0x97, 0x60, 0x28, 0x68, 0x1e, 0x34, 0x06, 0x3d, 0xb6, 0xa3, 0x5a,
+		0x0e, 0xf5, 0xc2, 0x1e, 0x85, 0x7d, 0xa0, 0x34, 0xc4, 0xb1, 0x5e, 0xa6, 0x51, 0xae, 0x47, 0x21,
+		0xff, 0xa2, 0x1f, 0xf6, 0x14, 0x8e, 0x53, 0x85, 0x1f, 0x6c, 0x6c, 0xd1, 0x00, 0xe7, 0xb0, 0xde,
+		0xd9, 0xf3, 0x30, 0x18, 0x50, 0xa0, 0xd3, 0xaa, 0xe5, 0xb7, 0xc2, 0xfe, 0x48, 0xd6, 0xe8, 0x59,
+		0x18, 0xab, 0xeb, 0x9a, 0xee, 0x60, 0xcb, 0xb4, 0x30, 0xf1, 0x58, 0x56, 0x55, 0xe6, 0x4f, 0xfa,
+		0x9a, 0xf8, 0xdc, 0x86, 0x1f, 0x9b, 0x71, 0x51, 0x46, 0xeb, 0x8d, 0xc0, 0x93, 0xa9, 0xe4, 0x37,
+		0xfa, 0xd2, 0x2f, 0xbf, 0xfc, 0xf2, 0xcb, 0x31, 0xf9, 0x77, 0x7b, 0x61, 0x2c, 0xaa, 0xcf, 0x44,
+		0x76, 0xdf, 0x03, 0xd0, 0xab, 0xd7, 0x6b, 0x9b, 0xd8, 0xa2, 0x46, 0xea, 0x51, 0xf8, 0x17, 0x9a,
+		0x81, 0x9e, 0xaa, 0xba, 0x89, 0xab, 0x99, 0xc4, 0x94, 0x74, 0x7c, 0xe8, 0xcc, 0x43, 0x1d, 0xf5,
+		0xca, 0xe9, 0x45, 0x42, 0xa2, 0x30, 0x4a, 0xf4, 0x26, 0x48, 0xf0, 0x10, 0x4d, 0x38, 0x9c, 0xec,
+		0x8c, 0x03, 0xe9, 0x4b, 0x0a, 0xa5, 0x43, 0x87, 0x20, 0x45, 0xfe, 0x32, 0xdf, 0xe8, 0xa5, 0x32,
+		0x27, 0x09, 0x80, 0xf8, 0x05, 0xca, 0x42, 0x92, 0x76, 0x93, 0x32, 0x16, 0x43, 0x9b, 0xfb, 0x4d,
+		0x1c, 0xab, 0x8c, 0xb7, 0xd4, 0x7a, 0xd5, 0x29, 0xde, 0x50, 0xab, 0x75, 0x4c, 0x1d, 0x3e, 0xa5,
+		0x0c, 0x70, 0xe0, 0x35, 0x02, 0x43, 0x93, 0xd0, 0xcf, 0x7a, 0x95, 0xa6, 0x97, 0xf1, 0x2d, 0x1a,
+		0x3d, 0x7b, 0x14, 0xd6, 0xd1, 0x16, 0x08, 0x84, 0x54, 0xff, 0x82, 0x6d, 0xe8, 0xc2, 0x35, 0x69,
+		0x15, 0x04, 0x40, 0xab, 0x3f, 0x1f, 0x0e, 0xdc, 0x47, 0xa2, 0xd5, 0x6b, 0xe8, 0x4b, 0xc7, 0x60,
+		0x98, 0x62, 0x3c, 0xc6, 0x9b, 0x5e, 0xad, 0x66, 0x46, 0xa6, 0xa4, 0xe3, 0x49, 0x65, 0x88, 0x81,
+		0x57, 0x38, 0x54, 0xfe, 0x5c, 0x0c, 0x12, 0x34, 0xb0, 0x0c, 0x43, 0xff, 0xfa, 0x73, 0xab, 0x85,
+		0xe2, 0xdc, 0xca, 0x46, 0x7e, 0xb1, 0x90, 0x96, 0xd0, 0x10, 0x00, 0x05, 0x5c, 0x5e, 0x5c, 0x99,
+		0x59, 0x4f, 0xc7, 0xdc, 0xef, 0x85, 0xe5, 0xf5, 0x73, 0x8f, 0xa7, 0xe3, 0x2e, 0xc1, 0x06, 0x03,
+		0x24, 0xfc, 0x08, 0x8f, 0x9d, 0x49, 0xf7, 0xa0, 0x34, 0x0c, 0x30, 0x06, 0x0b, 0xcf, 0x16, 0xe6,
+		0xce, 0x3d, 0x9e, 0xee, 0x0d, 0x42, 0x1e, 0x3b, 0x93, 0xee, 0x43, 0x83, 0x90, 0xa2, 0x90, 0xfc,
+		0xca, 0xca, 0x62, 0x3a, 0xe9, 0xf2, 0x5c, 0x5b, 0x57, 0x16, 0x96, 0xe7, 0xd3, 0x29, 0x97, 0xe7,
+		0xbc, 0xb2, 0xb2, 0xb1, 0x9a, 0x06, 0x97, 0xc3, 0x52, 0x61, 0x6d, 0x6d, 0x66, 0xbe, 0x90, 0xee,
+		0x77, 0x31, 0xf2, 0xcf, 0xad, 0x17, 0xd6, 0xd2, 0x03, 0x01, 0xb1, 0x1e, 0x3b, 0x93, 0x1e, 0x74,
+		0xab, 0x28, 0x2c, 0x6f, 0x2c, 0xa5, 0x87, 0xd0, 0x08, 0x0c, 0xb2, 0x2a, 0x84, 0x10, 0xc3, 0x21,
+		0xd0, 0xb9, 0xc7, 0xd3, 0x69, 0x4f, 0x10, 0xc6, 0x65, 0x24, 0x00, 0x38, 0xf7, 0x78, 0x1a, 0xc9,
+		0xb3, 0xd0, 0x43, 0xdd, 0x10, 0x21, 0x18, 0x5a, 0x9c, 0xc9, 0x17, 0x16, 0x8b, 0x2b, 0xab, 0xeb,
+		0x0b, 0x2b, 0xcb, 0x33, 0x8b, 0x69, 0xc9, 0x83, 0x29, 0x85, 0xa7, 0x37, 0x16, 0x94, 0xc2, 0x5c,
+		0x3a, 0xe6, 0x87, 0xad, 0x16, 0x66, 0xd6, 0x0b, 0x73, 0xe9, 0xb8, 0x5c, 0x82, 0xb1, 0xa8, 0x80,
+		0x1a, 0xd9, 0x85, 0x7c, 0xbe, 0x10, 0x6b, 0xe2, 0x0b, 0x94, 0x57, 0xd8, 0x17, 0xe4, 0xaf, 0xc5,
+		0x60, 0x34, 0x62, 0x50, 0x89, 0xac, 0xe4, 0x12, 0xf4, 0x30, 0x5f, 0x66, 0xc3, 0xec, 0x89, 0xc8,
+		0xd1, 0x89, 0x7a, 0x76, 0xc3, 0x50, 0x4b, 0xe9, 0xfc, 0xa9, 0x46, 0xbc, 0x49, 0xaa, 0x41, 0x58,
+		0x34, 0x38, 0xec, 0x8f, 0x36, 0x04, 0x7f, 0x36, 0x3e, 0x9e, 0xeb, 0x64, 0x7c, 0xa4, 0xb0, 0xee,
+		0x06, 0x81, 0x9e, 0x88, 0x41, 0xe0, 0x22, 0x8c, 0x34, 0x30, 0xea, 0x38, 0x18, 0xbf, 0x43, 0x82,
+		0x4c, 0x33, 0xe3, 0xb4, 0x09, 0x89, 0xb1, 0x40, 0x48, 0xbc, 0x18, 0xb6, 0xe0, 0x7d, 0xcd, 0x1b,
+		0xa1, 0xa1, 0xad, 0x3f, 0x25, 0xc1, 0x81, 0xe8, 0x94, 0x32, 0x52, 0x86, 0x37, 0x41, 0x6f, 0x0d,
+		0x3b, 0xdb, 0x86, 0x48, 0xab, 0x1e, 0x8c, 0x18, 0xac, 0x49, 0x71, 0xb8, 0xb1, 0x39, 0x15, 0xba,
+		0x10, 0x96, 0x75, 0xb2, 0x59, 0x82, 0xdb, 0x20, 0xe9, 0x7b, 0x62, 0xb0, 0x3f, 0x92, 0x79, 0xa4,
+		0xa0, 0x47, 0x00, 0x34, 0xdd, 0xac, 0x3b, 0x2c, 0x75, 0x62, 0x91, 0x38, 0x45, 0x21, 0x34, 0x78,
+		0x91, 0x28, 0x5b, 0x77, 0xdc, 0xf2, 0x38, 0x2d, 0x07, 0x06, 0xa2, 0x08, 0x4f, 0x78, 0x82, 0x26,
+		0xa8, 0xa0, 0x13, 0x4d, 0x34, 0x6d, 0x70, 0xcc, 0x47, 0x21, 0x5d, 0xaa, 0x6a, 0x58, 0x77, 0x8a,
+		0xb6, 0x63, 0x61, 0xb5, 0xa6, 0xe9, 0x15, 0x3a, 0xd4, 0x24, 0x73, 0x3d, 0x5b, 0x6a, 0xd5, 0xc6,
+		0xca, 0x30, 0x2b, 0x5e, 0x13, 0xa5, 0x84, 0x82, 0x3a, 0x90, 0xe5, 0xa3, 0xe8, 0x0d, 0x50, 0xb0,
+		0x62, 0x97, 0x42, 0xfe, 0x40, 0x0a, 0xfa, 0x7d, 0x09, 0x38, 0xba, 0x0f, 0x06, 0x5e, 0x50, 0x6f,
+		0xa8, 0x45, 0x31, 0xa9, 0x62, 0x96, 0xe8, 0x27, 0xb0, 0x55, 0x06, 0x42, 0x8f, 0xc2, 0x18, 0x45,
+		0x31, 0xea, 0x0e, 0xb6, 0x8a, 0xa5, 0xaa, 0x6a, 0xdb, 0xd4, 0x68, 0x49, 0x8a, 0x8a, 0x48, 0xd9,
+		0x0a, 0x29, 0x9a, 0x15, 0x25, 0xe8, 0x2c, 0x8c, 0x52, 0x8a, 0x5a, 0xbd, 0xea, 0x68, 0x66, 0x15,
+		0x17, 0xc9, 0x34, 0xcf, 0xce, 0x80, 0x5f, 0xb2, 0x11, 0x82, 0xb1, 0xc4, 0x11, 0x88, 0x44, 0x36,
+		0x9a, 0x83, 0x23, 0x94, 0xac, 0x82, 0x75, 0x6c, 0xa9, 0x0e, 0x2e, 0xe2, 0x17, 0xeb, 0x6a, 0xd5,
+		0x2e, 0xaa, 0x7a, 0xb9, 0xb8, 0xad, 0xda, 0xdb, 0x99, 0x31, 0xc2, 0x20, 0x1f, 0xcb, 0x48, 0xca,
+		0x38, 0x41, 0x9c, 0xe7, 0x78, 0x05, 0x8a, 0x36, 0xa3, 0x97, 0xaf, 0xa8, 0xf6, 0x36, 0xca, 0xc1,
+		0x01, 0xca, 0xc5, 0x76, 0x2c, 0x4d, 0xaf, 0x14, 0x4b, 0xdb, 0xb8, 0x74, 0xbd, 0x58, 0x77, 0xb6,
+		0x9e, 0xc8, 0x1c, 0xf2, 0xd7, 0x4f, 0x25, 0x5c, 0xa3, 0x38, 0xb3, 0x04, 0x65, 0xc3, 0xd9, 0x7a,
+		0x02, 0xad, 0xc1, 0x00, 0x69, 0x8c, 0x9a, 0xf6, 0x12, 0x2e, 0x6e, 0x19, 0x16, 0x1d, 0x43, 0x87,
+		0x22, 0x42, 0x93, 0xcf, 0x82, 0xd3, 0x2b, 0x9c, 0x60, 0xc9, 0x28, 0xe3, 0x5c, 0xcf, 0xda, 0x6a,
+		0xa1, 0x30, 0xa7, 0xf4, 0x0b, 0x2e, 0x97, 0x0d, 0x8b, 0x38, 0x54, 0xc5, 0x70, 0x0d, 0xdc, 0xcf,
+		0x1c, 0xaa, 0x62, 0x08, 0xf3, 0x9e, 0x85, 0xd1, 0x52, 0x89, 0xe9, 0xac, 0x95, 0x8a, 0x7c, 0x32,
+		0x66, 0x67, 0xd2, 0x01, 0x63, 0x95, 0x4a, 0xf3, 0x0c, 0x81, 0xfb, 0xb8, 0x8d, 0x2e, 0xc0, 0x7e,
+		0xcf, 0x58, 0x7e, 0xc2, 0x91, 0x06, 0x2d, 0xc3, 0xa4, 0x67, 0x61, 0xd4, 0xdc, 0x69, 0x24, 0x44,
+		0x81, 0x1a, 0xcd, 0x9d, 0x30, 0xd9, 0x79, 0x18, 0x33, 0xb7, 0xcd, 0x46, 0xba, 0x93, 0x7e, 0x3a,
+		0x64, 0x6e, 0x9b, 0x61, 0xc2, 0x07, 0xe8, 0xcc, 0xdc, 0xc2, 0x25, 0xd5, 0xc1, 0xe5, 0xcc, 0x41,
+		0x3f, 0xba, 0xaf, 0x00, 0x4d, 0x43, 0xba, 0x54, 0x2a, 0x62, 0x5d, 0xdd, 0xac, 0xe2, 0xa2, 0x6a,
+		0x61, 0x5d, 0xb5, 0x33, 0x93, 0x14, 0x39, 0xe1, 0x58, 0x75, 0xac, 0x0c, 0x95, 0x4a, 0x05, 0x5a,
+		0x38, 0x43, 0xcb, 0xd0, 0x49, 0x18, 0x31, 0x36, 0x5f, 0x28, 0x31, 0x8f, 0x2c, 0x9a, 0x16, 0xde,
+		0xd2, 0x6e, 0x65, 0xee, 0xa7, 0xe6, 0x1d, 0x26, 0x05, 0xd4, 0x1f, 0x57, 0x29, 0x18, 0x9d, 0x80,
+		0x74, 0xc9, 0xde, 0x56, 0x2d, 0x93, 0x86, 0x64, 0xdb, 0x54, 0x4b, 0x38, 0xf3, 0x00, 0x43, 0x65,
+		0xf0, 0x65, 0x01, 0x26, 0x3d, 0xc2, 0xbe, 0xa9, 0x6d, 0x39, 0x82, 0xe3, 0x31, 0xd6, 0x23, 0x28,
+		0x8c, 0x73, 0x3b, 0x0e, 0x69, 0x62, 0x89, 0x40, 0xc5, 0xc7, 0x29, 0xda, 0x90, 0xb9, 0x6d, 0xfa,
+		0xeb, 0x3d, 0x0a, 0x83, 0xe6, 0xb6, 0xbf, 0xd2, 0x13, 0x2c, 0x71, 0x33, 0xb7, 0x7d, 0x35, 0x3e,
+		0x0e, 0x07, 0x08, 0x52, 0x0d, 0x3b, 0x6a, 0x59, 0x75, 0x54, 0x1f, 0xf6, 0xc3, 0x14, 0x9b, 0x98,
+		0x7d, 0x89, 0x17, 0x06, 0xe4, 0xb4, 0xea, 0x9b, 0x3b, 0xae, 0x63, 0x3d, 0xc2, 0xe4, 0x24, 0x30,
+		0xe1, 0x5a, 0xf7, 0x2c, 0x39, 0x97, 0x73, 0x30, 0xe0, 0xf7, 0x7b, 0x94, 0x02, 0xe6, 0xf9, 0x69,
+		0x89, 0x24, 0x41, 0xb3, 0x2b, 0x73, 0x24, 0x7d, 0x79, 0xbe, 0x90, 0x8e, 0x91, 0x34, 0x6a, 0x71,
+		0x61, 0xbd, 0x50, 0x54, 0x36, 0x96, 0xd7, 0x17, 0x96, 0x0a, 0xe9, 0xb8, 0x2f, 0xb1, 0xbf, 0x9a,
+		0x48, 0x3e, 0x98, 0x3e, 0x46, 0xb2, 0x86, 0xa1, 0xe0, 0x4c, 0x0d, 0xfd, 0x10, 0x1c, 0x14, 0xcb,
+		0x2a, 0x36, 0x76, 0x8a, 0x37, 0x35, 0x8b, 0x76, 0xc8, 0x9a, 0xca, 0x06, 0x47, 0xd7, 0x7f, 0xc6,
+		0x38, 0xd6, 0x1a, 0x76, 0x9e, 0xd1, 0x2c, 0xd2, 0xdd, 0x6a, 0xaa, 0x83, 0x16, 0x61, 0x52, 0x37,
+		0x8a, 0xb6, 0xa3, 0xea, 0x65, 0xd5, 0x2a, 0x17, 0xbd, 0x05, 0xad, 0xa2, 0x5a, 0x2a, 0x61, 0xdb,
+		0x36, 0xd8, 0x40, 0xe8, 0x72, 0x39, 0xac, 0x1b, 0x6b, 0x1c, 0xd9, 0x1b, 0x21, 0x66, 0x38, 0x6a,
+		0xc8, 0x7d, 0xe3, 0xcd, 0xdc, 0xf7, 0x10, 0xa4, 0x6a, 0xaa, 0x59, 0xc4, 0xba, 0x63, 0xed, 0xd0,
+		0xfc, 0x3c, 0xa9, 0x24, 0x6b, 0xaa, 0x59, 0x20, 0xdf, 0xdf, 0x93, 0x69, 0xd2, 0xd5, 0x44, 0x32,
+		0x91, 0xee, 0xb9, 0x9a, 0x48, 0xf6, 0xa4, 0x7b, 0xaf, 0x26, 0x92, 0xbd, 0xe9, 0xbe, 0xab, 0x89,
+		0x64, 0x32, 0x9d, 0xba, 0x9a, 0x48, 0xa6, 0xd2, 0x20, 0xff, 0x74, 0x02, 0x06, 0xfc, 0x19, 0x3c,
+		0x99, 0x10, 0x95, 0xe8, 0x18, 0x26, 0xd1, 0x28, 0x77, 0xb4, 0x65, 0xbe, 0x3f, 0x3d, 0x4b, 0x06,
+		0xb7, 0x5c, 0x2f, 0x4b, 0x97, 0x15, 0x46, 0x49, 0x12, 0x0b, 0xe2, 0x7e, 0x98, 0xa5, 0x27, 0x49,
+		0x85, 0x7f, 0xa1, 0x79, 0xe8, 0x7d, 0xc1, 0xa6, 0xbc, 0x7b, 0x29, 0xef, 0xfb, 0x5b, 0xf3, 0xbe,
+		0xba, 0x46, 0x99, 0xa7, 0xae, 0xae, 0x15, 0x97, 0x57, 0x94, 0xa5, 0x99, 0x45, 0x85, 0x93, 0xa3,
+		0x71, 0x48, 0x54, 0xd5, 0x97, 0x76, 0x82, 0xc3, 0x20, 0x05, 0xa1, 0x69, 0x18, 0xae, 0xeb, 0x37,
+		0xb0, 0xa5, 0x6d, 0x69, 0xb8, 0x5c, 0xa4, 0x58, 0xc3, 0x7e, 0xac, 0x21, 0xaf, 0x74, 0x91, 0xe0,
+		0x77, 0xd8, 0x8c, 0xe3, 0x90, 0x20, 0x4b, 0x7c, 0xc1, 0xc1, 0x8a, 0x82, 0xee, 0x61, 0x77, 0x3a,
+		0x05, 0x3d, 0xd4, 0xbe, 0x08, 0x80, 0x5b, 0x38, 0xbd, 0x0f, 0x25, 0x21, 0x31, 0xbb, 0xa2, 0x90,
+		0x2e, 0x95, 0x86, 0x01, 0x06, 0x2d, 0xae, 0x2e, 0x14, 0x66, 0x0b, 0xe9, 0x98, 0x7c, 0x16, 0x7a,
+		0x99, 0xd1, 0x48, 0x77, 0x73, 0xcd, 0x96, 0xde, 0xc7, 0x3f, 0x39, 0x0f, 0x49, 0x94, 0x6e, 0x2c,
+		0xe5, 0x0b, 0x4a, 0x3a, 0xd6, 0xe0, 0x2c, 0xb2, 0x0d, 0x03, 0xfe, 0x4c, 0xfe, 0x7b, 0x33, 0x9d,
+		0xff, 0x1d, 0x09, 0xfa, 0x7d, 0x99, 0x39, 0x49, 0xa9, 0xd4, 0x6a, 0xd5, 0xb8, 0x59, 0x54, 0xab,
+		0x9a, 0x6a, 0x73, 0x57, 0x02, 0x0a, 0x9a, 0x21, 0x90, 0x4e, 0x9b, 0xee, 0x7b, 0xd4, 0xc9, 0x7a,
+		0xd2, 0xbd, 0xf2, 0xc7, 0x24, 0x48, 0x87, 0x53, 0xe3, 0x90, 0x98, 0xd2, 0x1b, 0x29, 0xa6, 0xfc,
+		0x51, 0x09, 0x86, 0x82, 0xf9, 0x70, 0x48, 0xbc, 0xfb, 0xde, 0x50, 0xf1, 0xbe, 0x12, 0x83, 0xc1,
+		0x40, 0x16, 0xdc, 0xa9, 0x74, 0x2f, 0xc2, 0x88, 0x56, 0xc6, 0x35, 0xd3, 0x70, 0xc8, 0xf2, 0x7b,
+		0xb1, 0x8a, 0x6f, 0xe0, 0x6a, 0x46, 0xa6, 0x41, 0xe6, 0x54, 0xeb, 0x3c, 0x7b, 0x7a, 0xc1, 0xa3,
+		0x5b, 0x24, 0x64, 0xb9, 0xd1, 0x85, 0xb9, 0xc2, 0xd2, 0xea, 0xca, 0x7a, 0x61, 0x79, 0xf6, 0xb9,
+		0xe2, 0xc6, 0xf2, 0x53, 0xcb, 0x2b, 0xcf, 0x2c, 0x2b, 0x69, 0x2d, 0x84, 0x76, 0x0f, 0xbb, 0xfd,
+		0x2a, 0xa4, 0xc3, 0x42, 0xa1, 0x83, 0x10, 0x25, 0x56, 0x7a, 0x1f, 0x1a, 0x85, 0xe1, 0xe5, 0x95,
+		0xe2, 0xda, 0xc2, 0x5c, 0xa1, 0x58, 0xb8, 0x7c, 0xb9, 0x30, 0xbb, 0xbe, 0xc6, 0x56, 0x4e, 0x5c,
+		0xec, 0xf5, 0x40, 0x07, 0x97, 0x5f, 0x8d, 0xc3, 0x68, 0x84, 0x24, 0x68, 0x86, 0xcf, 0x79, 0xd8,
+		0x34, 0xec, 0x91, 0x4e, 0xa4, 0x9f, 0x26, 0x59, 0xc7, 0xaa, 0x6a, 0x39, 0x7c, 0x8a, 0x74, 0x02,
+		0x88, 0x95, 0x74, 0x87, 0x04, 0x57, 0x8b, 0xaf, 0x48, 0xb1, 0x89, 0xd0, 0xb0, 0x07, 0x67, 0x8b,
+		0x52, 0x0f, 0x03, 0x32, 0x0d, 0x5b, 0x73, 0xb4, 0x1b, 0x64, 0x51, 0x5f, 0x2c, 0x5f, 0x91, 0x89,
+		0x51, 0x42, 0x49, 0x8b, 0x92, 0x05, 0xdd, 0x71, 0xb1, 0x75, 0x5c, 0x51, 0x43, 0xd8, 0x24, 0xf8,
+		0xc7, 0x95, 0xb4, 0x28, 0x71, 0xb1, 0xef, 0x83, 0x81, 0xb2, 0x51, 0x27, 0xd9, 0x22, 0xc3, 0x23,
+		0x63, 0x8d, 0xa4, 0xf4, 0x33, 0x98, 0x8b, 0xc2, 0xe7, 0x01, 0xde, 0xba, 0xd9, 0x80, 0xd2, 0xcf,
+		0x60, 0x0c, 0xe5, 0x18, 0x0c, 0xab, 0x95, 0x8a, 0x45, 0x98, 0x0b, 0x46, 0x6c, 0x66, 0x33, 0xe4,
+		0x82, 0x29, 0x62, 0xf6, 0x2a, 0x24, 0x85, 0x1d, 0xc8, 0x60, 0x4f, 0x2c, 0x51, 0x34, 0xd9, 0x74,
+		0x3d, 0x46, 0x96, 0xd2, 0x74, 0x51, 0x78, 0x1f, 0x0c, 0x68, 0x76, 0xd1, 0xdb, 0x06, 0x88, 0x4d,
+		0xc5, 0x8e, 0x27, 0x95, 0x7e, 0xcd, 0x76, 0x97, 0x50, 0xe5, 0x4f, 0xc5, 0x60, 0x28, 0xb8, 0x8d,
+		0x81, 0xe6, 0x20, 0x59, 0x35, 0x4a, 0x2a, 0x75, 0x2d, 0xb6, 0x87, 0x76, 0xbc, 0xcd, 0xce, 0xc7,
+		0xf4, 0x22, 0xc7, 0x57, 0x5c, 0xca, 0xec, 0x1f, 0x48, 0x90, 0x14, 0x60, 0x74, 0x00, 0x12, 0xa6,
+		0xea, 0x6c, 0x53, 0x76, 0x3d, 0xf9, 0x58, 0x5a, 0x52, 0xe8, 0x37, 0x81, 0xdb, 0xa6, 0xaa, 0x67,
+		0x62, 0x1e, 0x9c, 0x7c, 0x93, 0x76, 0xad, 0x62, 0xb5, 0x4c, 0xa7, 0x4d, 0x46, 0xad, 0x86, 0x75,
+		0xc7, 0x16, 0xed, 0xca, 0xe1, 0xb3, 0x1c, 0x4c, 0x76, 0xd3, 0x1c, 0x4b, 0xd5, 0xaa, 0x01, 0xdc,
+		0x04, 0xc5, 0x4d, 0x8b, 0x02, 0x17, 0x39, 0x07, 0xe3, 0x82, 0x6f, 0x19, 0x3b, 0x6a, 0x69, 0x1b,
+		0x97, 0x3d, 0xa2, 0x5e, 0xba, 0x3c, 0x72, 0x90, 0x23, 0xcc, 0xf1, 0x72, 0x41, 0x2b, 0x7f, 0x59,
+		0x82, 0x11, 0x31, 0xd1, 0x2b, 0xbb, 0xc6, 0x5a, 0x02, 0x50, 0x75, 0xdd, 0x70, 0xfc, 0xe6, 0x6a,
+		0x74, 0xe5, 0x06, 0xba, 0xe9, 0x19, 0x97, 0x48, 0xf1, 0x31, 0xc8, 0xd6, 0x00, 0xbc, 0x92, 0xa6,
+		0x66, 0x9b, 0x84, 0x7e, 0xbe, 0x47, 0x45, 0x37, 0x3a, 0xd9, 0xd2, 0x00, 0x30, 0x10, 0x99, 0x11,
+		0x92, 0x05, 0x9c, 0x4d, 0x5c, 0xd1, 0x74, 0xbe, 0xf2, 0xcc, 0x3e, 0xc4, 0x02, 0x4e, 0xc2, 0x5d,
+		0xc0, 0xc9, 0xff, 0x38, 0x8c, 0x96, 0x8c, 0x5a, 0x58, 0xdc, 0x7c, 0x3a, 0xb4, 0x3c, 0x61, 0x5f,
+		0x91, 0x9e, 0x7f, 0x84, 0x23, 0x55, 0x8c, 0xaa, 0xaa, 0x57, 0xa6, 0x0d, 0xab, 0xe2, 0x6d, 0xd4,
+		0x92, 0x0c, 0xc9, 0xf6, 0x6d, 0xd7, 0x9a, 0x9b, 0x7f, 0x21, 0x49, 0xbf, 0x10, 0x8b, 0xcf, 0xaf,
+		0xe6, 0x3f, 0x13, 0xcb, 0xce, 0x33, 0xc2, 0x55, 0x61, 0x0c, 0x05, 0x6f, 0x55, 0x71, 0x89, 0x28,
+		0x08, 0xdf, 0x7c, 0x08, 0xc6, 0x2a, 0x46, 0xc5, 0xa0, 0x9c, 0x4e, 0x91, 0xff, 0xf8, 0x4e, 0x6f,
+		0xca, 0x85, 0x66, 0xdb, 0x6e, 0x0b, 0xe7, 0x96, 0x61, 0x94, 0x23, 0x17, 0xe9, 0x56, 0x13, 0x9b,
+		0x08, 0xa1, 0x96, 0xab, 0x70, 0x99, 0x5f, 0xff, 0x3a, 0x1d, 0xbe, 0x95, 0x11, 0x4e, 0x4a, 0xca,
+		0xd8, 0x5c, 0x29, 0xa7, 0xc0, 0xfe, 0x00, 0x3f, 0xd6, 0x49, 0xb1, 0xd5, 0x86, 0xe3, 0xef, 0x73,
+		0x8e, 0xa3, 0x3e, 0x8e, 0x6b, 0x9c, 0x34, 0x37, 0x0b, 0x83, 0xdd, 0xf0, 0xfa, 0x27, 0x9c, 0xd7,
+		0x00, 0xf6, 0x33, 0x99, 0x87, 0x61, 0xca, 0xa4, 0x54, 0xb7, 0x1d, 0xa3, 0x46, 0x23, 0x60, 0x6b,
+		0x36, 0xff, 0xf4, 0xeb, 0xac, 0xd7, 0x0c, 0x11, 0xb2, 0x59, 0x97, 0x2a, 0x97, 0x03, 0xba, 0xbb,
+		0x46, 0x76, 0xbd, 0xda, 0x70, 0xf8, 0x3c, 0x17, 0xc4, 0xc5, 0xcf, 0x5d, 0x83, 0x31, 0xf2, 0x3f,
+		0x0d, 0x50, 0x7e, 0x49, 0xda, 0x2f, 0xd9, 0x65, 0xbe, 0xfc, 0x0e, 0xd6, 0x31, 0x47, 0x5d, 0x06,
+		0x3e, 0x99, 0x7c, 0xad, 0x58, 0xc1, 0x8e, 0x83, 0x2d, 0xbb, 0xa8, 0x56, 0xa3, 0xc4, 0xf3, 0xad,
+		0x79, 0x64, 0x7e, 0xee, 0x5b, 0xc1, 0x56, 0x9c, 0x67, 0x94, 0x33, 0xd5, 0x6a, 0x6e, 0x03, 0x0e,
+		0x46, 0x78, 0x45, 0x07, 0x3c, 0x5f, 0xe5, 0x3c, 0xc7, 0x1a, 0x3c, 0x83, 0xb0, 0x5d, 0x05, 0x01,
+		0x77, 0xdb, 0xb2, 0x03, 0x9e, 0x1f, 0xe1, 0x3c, 0x11, 0xa7, 0x15, 0x4d, 0x4a, 0x38, 0x5e, 0x85,
+		0x91, 0x1b, 0xd8, 0xda, 0x34, 0x6c, 0xbe, 0xce, 0xd4, 0x01, 0xbb, 0x8f, 0x72, 0x76, 0xc3, 0x9c,
+		0x90, 0x2e, 0x3c, 0x11, 0x5e, 0x17, 0x20, 0xb9, 0xa5, 0x96, 0x70, 0x07, 0x2c, 0x6e, 0x73, 0x16,
+		0x7d, 0x04, 0x9f, 0x90, 0xce, 0xc0, 0x40, 0xc5, 0xe0, 0x63, 0x54, 0x7b, 0xf2, 0x8f, 0x71, 0xf2,
+		0x7e, 0x41, 0xc3, 0x59, 0x98, 0x86, 0x59, 0xaf, 0x92, 0x01, 0xac, 0x3d, 0x8b, 0x9f, 0x17, 0x2c,
+		0x04, 0x0d, 0x67, 0xd1, 0x85, 0x59, 0x3f, 0x2e, 0x58, 0xd8, 0x3e, 0x7b, 0x5e, 0x22, 0xdb, 0x4f,
+		0xd5, 0x1d, 0x43, 0xef, 0x44, 0x88, 0x4f, 0x70, 0x0e, 0xc0, 0x49, 0x08, 0x83, 0x8b, 0x90, 0xea,
+		0xb4, 0x21, 0xfe, 0xc6, 0xb7, 0x44, 0xf7, 0x10, 0x2d, 0x30, 0x0f, 0xc3, 0x22, 0x40, 0x91, 0xed,
+		0xea, 0xf6, 0x2c, 0xfe, 0x26, 0x67, 0x31, 0xe4, 0x23, 0xe3, 0x6a, 0x38, 0xd8, 0x76, 0x2a, 0xb8,
+		0x13, 0x26, 0x9f, 0x12, 0x6a, 0x70, 0x12, 0x6e, 0xca, 0x4d, 0xac, 0x97, 0xb6, 0x3b, 0xe3, 0xf0,
+		0x69, 0x61, 0x4a, 0x41, 0x43, 0x58, 0xcc, 0xc2, 0x60, 0x4d, 0xb5, 0xec, 0x6d, 0xb5, 0xda, 0x51,
+		0x73, 0xfc, 0x12, 0xe7, 0x31, 0xe0, 0x12, 0x71, 0x8b, 0xd4, 0xf5, 0x6e, 0xd8, 0x7c, 0x46, 0x58,
+		0xa4, 0xae, 0x07, 0x18, 0xad, 0xc2, 0x98, 0xed, 0xd0, 0x45, 0xb9, 0x6e, 0xb8, 0xfd, 0xb2, 0xe8,
+		0x7a, 0x8c, 0x76, 0xc9, 0xcf, 0xf1, 0x22, 0xa4, 0x6c, 0xed, 0xa5, 0x8e, 0xd8, 0xfc, 0x8a, 0x68,
+		0x69, 0x4a, 0x40, 0x88, 0x9f, 0x83, 0xf1, 0xc8, 0x61, 0xa2, 0x03, 0x66, 0x7f, 0x8b, 0x33, 0x3b,
+		0x10, 0x31, 0x54, 0xf0, 0x90, 0xd0, 0x2d, 0xcb, 0xbf, 0x2d, 0x42, 0x02, 0x0e, 0xf1, 0x5a, 0x25,
+		0xb3, 0x06, 0x5b, 0xdd, 0xea, 0xce, 0x6a, 0xbf, 0x2a, 0xac, 0xc6, 0x68, 0x03, 0x56, 0x5b, 0x87,
+		0x03, 0x9c, 0x63, 0x77, 0xed, 0xfa, 0x6b, 0x22, 0xb0, 0x32, 0xea, 0x8d, 0x60, 0xeb, 0xbe, 0x19,
+		0xb2, 0xae, 0x39, 0x45, 0x7a, 0x6a, 0x17, 0xc9, 0x4a, 0x56, 0x7b, 0xce, 0xbf, 0xce, 0x39, 0x8b,
+		0x88, 0xef, 0xe6, 0xb7, 0xf6, 0x92, 0x6a, 0x12, 0xe6, 0xcf, 0x42, 0x46, 0x30, 0xaf, 0xeb, 0x16,
+		0x2e, 0x19, 0x15, 0x5d, 0x7b, 0x09, 0x97, 0x3b, 0x60, 0xfd, 0x1b, 0xa1, 0xa6, 0xda, 0xf0, 0x91,
+		0x13, 0xce, 0x0b, 0x90, 0x76, 0x73, 0x95, 0xa2, 0x56, 0x33, 0x0d, 0xcb, 0x69, 0xc3, 0xf1, 0x37,
+		0x45, 0x4b, 0xb9, 0x74, 0x0b, 0x94, 0x2c, 0x57, 0x00, 0xb6, 0x53, 0xdd, 0xa9, 0x4b, 0x7e, 0x96,
+		0x33, 0x1a, 0xf4, 0xa8, 0x78, 0xe0, 0x28, 0x19, 0x35, 0x53, 0xb5, 0x3a, 0x89, 0x7f, 0x7f, 0x47,
+		0x04, 0x0e, 0x4e, 0xc2, 0x03, 0x07, 0xc9, 0xe8, 0xc8, 0x68, 0xdf, 0x01, 0x87, 0xcf, 0x89, 0xc0,
+		0x21, 0x68, 0x38, 0x0b, 0x91, 0x30, 0x74, 0xc0, 0xe2, 0xb7, 0x04, 0x0b, 0x41, 0x43, 0x58, 0x3c,
+		0xed, 0x0d, 0xb4, 0x16, 0xae, 0x68, 0xb6, 0x63, 0xb1, 0xa4, 0xb8, 0x35, 0xab, 0xbf, 0xfb, 0xad,
+		0x60, 0x12, 0xa6, 0xf8, 0x48, 0x49, 0x24, 0xe2, 0xcb, 0xb4, 0x74, 0xce, 0xd4, 0x5e, 0xb0, 0xdf,
+		0x16, 0x91, 0xc8, 0x47, 0x46, 0x64, 0xf3, 0x65, 0x88, 0xc4, 0xec, 0x25, 0x32, 0x53, 0xe8, 0x80,
+		0xdd, 0xdf, 0x0b, 0x09, 0xb7, 0x26, 0x68, 0x09, 0x4f, 0x5f, 0xfe, 0x53, 0xd7, 0xaf, 0xe3, 0x9d,
+		0x8e, 0xbc, 0xf3, 0xef, 0x87, 0xf2, 0x9f, 0x0d, 0x46, 0xc9, 0x62, 0xc8, 0x70, 0x28, 0x9f, 0x42,
+		0xed, 0xce, 0x25, 0x65, 0x7e, 0xe2, 0x3b, 0x5c, 0xdf, 0x60, 0x3a, 0x95, 0x5b, 0x84, 0x34, 0x87,
+		0x78, 0x09, 0x6c, 0x5b, 0x66, 0xef, 0xf8, 0x8e, 0xeb, 0xe7, 0x81, 0x9c, 0x27, 0x77, 0x19, 0x06,
+		0x03, 0x09, 0x4f, 0x7b, 0x56, 0xef, 0xe4, 0xac, 0x06, 0xfc, 0xf9, 0x4e, 0xee, 0x2c, 0x24, 0x48,
+		0xf2, 0xd2, 0x9e, 0xfc, 0xaf, 0x72, 0x72, 0x8a, 0x9e, 0x7b, 0x12, 0x92, 0x22, 0x69, 0x69, 0x4f,
+		0xfa, 0x2e, 0x4e, 0xea, 0x92, 0x10, 0x72, 0x91, 0xb0, 0xb4, 0x27, 0xff, 0x6b, 0x82, 0x5c, 0x90,
+		0x10, 0xf2, 0xce, 0x4d, 0xf8, 0x3b, 0x3f, 0x99, 0x60, 0xe4, 0x82, 0x24, 0x47, 0x76, 0xca, 0x59,
+		0xa6, 0xd2, 0x9e, 0xfa, 0x3d, 0xbc, 0x72, 0x41, 0x91, 0x3b, 0x0f, 0x3d, 0x1d, 0x1a, 0xfc, 0xbd,
+		0x9c, 0x94, 0xe1, 0xe7, 0x66, 0xa1, 0xdf, 0x97, 0x9d, 0xb4, 0x27, 0x7f, 0x1f, 0x27, 0xf7, 0x53,
+		0x11, 0xd1, 0x79, 0x76, 0xd2, 0x9e, 0xc1, 0x4f, 0x09, 0xd1, 0x39, 0x05, 0x31, 0x9b, 0x48, 0x4c,
+		0xda, 0x53, 0xbf, 0x5f, 0x58, 0x5d, 0x90, 0xe4, 0x2e, 0x41, 0xca, 0x1d, 0x6c, 0xda, 0xd3, 0x7f,
+		0x80, 0xd3, 0x7b, 0x34, 0xc4, 0x02, 0x75, 0xbd, 0x0b, 0x16, 0x3f, 0x2d, 0x2c, 0xe0, 0xa3, 0x22,
+		0xdd, 0x28, 0x9c, 0xc0, 0xb4, 0xe7, 0xf4, 0x41, 0xd1, 0x8d, 0x42, 0xf9, 0x0b, 0x69, 0x4d, 0x1a,
+		0xf3, 0xdb, 0xb3, 0xf8, 0x90, 0x68, 0x4d, 0x8a, 0x4f, 0xc4, 0x08, 0x67, 0x04, 0xed, 0x79, 0xfc,
+		0xac, 0x10, 0x23, 0x94, 0x10, 0xe4, 0x56, 0x01, 0x35, 0x66, 0x03, 0xed, 0xf9, 0x7d, 0x98, 0xf3,
+		0x1b, 0x69, 0x48, 0x06, 0x72, 0xcf, 0xc0, 0x81, 0xe8, 0x4c, 0xa0, 0x3d, 0xd7, 0x9f, 0xfb, 0x4e,
+		0x68, 0xee, 0xe6, 0x4f, 0x04, 0x72, 0xeb, 0x30, 0x16, 0x95, 0x05, 0xb4, 0x67, 0xfb, 0xea, 0x77,
+		0x82, 0x81, 0xdb, 0x9f, 0x04, 0xe4, 0x66, 0x00, 0xbc, 0x01, 0xb8, 0x3d, 0xaf, 0x8f, 0x72, 0x5e,
+		0x3e, 0x22, 0xd2, 0x35, 0xf8, 0xf8, 0xdb, 0x9e, 0xfe, 0xb6, 0xe8, 0x1a, 0x9c, 0x82, 0x74, 0x0d,
+		0x31, 0xf4, 0xb6, 0xa7, 0xfe, 0x98, 0xe8, 0x1a, 0x82, 0x84, 0x78, 0xb6, 0x6f, 0x74, 0x6b, 0xcf,
+		0xe1, 0x13, 0xc2, 0xb3, 0x7d, 0x54, 0xb9, 0x65, 0x18, 0x69, 0x18, 0x10, 0xdb, 0xb3, 0xfa, 0x05,
+		0xce, 0x2a, 0x1d, 0x1e, 0x0f, 0xfd, 0x83, 0x17, 0x1f, 0x0c, 0xdb, 0x73, 0xfb, 0x64, 0x68, 0xf0,
+		0xe2, 0x63, 0x61, 0xee, 0x22, 0x24, 0xf5, 0x7a, 0xb5, 0x4a, 0x3a, 0x0f, 0x6a, 0x7d, 0x96, 0x30,
+		0xf3, 0x5f, 0xbe, 0xcb, 0xad, 0x23, 0x08, 0x72, 0x67, 0xa1, 0x07, 0xd7, 0x36, 0x71, 0xb9, 0x1d,
+		0xe5, 0x37, 0xbf, 0x2b, 0x02, 0x26, 0xc1, 0xce, 0x5d, 0x02, 0x60, 0x4b, 0x23, 0x74, 0xf3, 0xb0,
+		0x0d, 0xed, 0x7f, 0xfd, 0x2e, 0x3f, 0xbc, 0xe3, 0x91, 0x78, 0x0c, 0xd8, 0x51, 0xa0, 0xd6, 0x0c,
+		0xbe, 0x15, 0x64, 0x40, 0x5b, 0xe4, 0x02, 0xf4, 0x91, 0x23, 0x95, 0x8e, 0x5a, 0x69, 0x47, 0xfd,
+		0xdf, 0x38, 0xb5, 0xc0, 0x27, 0x06, 0xab, 0x19, 0x16, 0x76, 0xd4, 0x8a, 0xdd, 0x8e, 0xf6, 0xbf,
+		0x73, 0x5a, 0x97, 0x80, 0x10, 0x97, 0x54, 0xdb, 0xe9, 0x44, 0xef, 0x3f, 0x15, 0xc4, 0x82, 0x80,
+		0x08, 0x4d, 0xfe, 0xbf, 0x8e, 0x77, 0xda, 0xd1, 0x7e, 0x5b, 0x08, 0xcd, 0xf1, 0x73, 0x4f, 0x42,
+		0x8a, 0xfc, 0xcb, 0x4e, 0xe4, 0xb5, 0x21, 0xfe, 0x33, 0x4e, 0xec, 0x51, 0x90, 0x9a, 0x6d, 0xa7,
+		0xec, 0x68, 0xed, 0x8d, 0x7d, 0x97, 0xb7, 0xb4, 0xc0, 0xcf, 0xcd, 0x40, 0xbf, 0xed, 0x94, 0xcb,
+		0x75, 0x9e, 0x9f, 0xb6, 0x21, 0xff, 0xf3, 0xef, 0xba, 0x4b, 0x16, 0x2e, 0x0d, 0x69, 0xed, 0x9b,
+		0xd7, 0x1d, 0xd3, 0xa0, 0x1b, 0x1e, 0xed, 0x38, 0x7c, 0x87, 0x73, 0xf0, 0x91, 0xe4, 0x66, 0x61,
+		0x80, 0xe8, 0x62, 0x61, 0x13, 0xd3, 0xdd, 0xa9, 0x36, 0x2c, 0xfe, 0x07, 0x37, 0x40, 0x80, 0x28,
+		0xff, 0xa3, 0x9f, 0xff, 0xea, 0x84, 0xf4, 0xa5, 0xaf, 0x4e, 0x48, 0x5f, 0xf9, 0xea, 0x84, 0xf4,
+		0xfe, 0xaf, 0x4d, 0xec, 0xfb, 0xd2, 0xd7, 0x26, 0xf6, 0xfd, 0xd1, 0xd7, 0x26, 0xf6, 0x45, 0xaf,
+		0x12, 0xc3, 0xbc, 0x31, 0x6f, 0xb0, 0xf5, 0xe1, 0xe7, 0xe5, 0x8a, 0xe6, 0x6c, 0xd7, 0x37, 0xa7,
+		0x4b, 0x46, 0x8d, 0x2e, 0xe3, 0x7a, 0xab, 0xb5, 0xee, 0x24, 0x07, 0xde, 0x1e, 0x87, 0xf1, 0x92,
+		0x61, 0xd7, 0x0c, 0xbb, 0xc8, 0xd6, 0x7b, 0xd9, 0x07, 0x63, 0x88, 0x06, 0xfc, 0x45, 0x1d, 0x2c,
+		0xfa, 0x5e, 0x81, 0x21, 0xaa, 0x3a, 0x5d, 0xee, 0xa2, 0xde, 0xd6, 0x36, 0x40, 0x7c, 0xe1, 0xdf,
+		0xf4, 0x50, 0xad, 0x07, 0x5d, 0x42, 0xba, 0xdb, 0xbf, 0x0e, 0x63, 0x5a, 0xcd, 0xac, 0x62, 0xba,
+		0xcc, 0x5f, 0x74, 0xcb, 0xda, 0xf3, 0xfb, 0x22, 0xe7, 0x37, 0xea, 0x91, 0x2f, 0x08, 0xea, 0xdc,
+		0x22, 0x8c, 0x90, 0x33, 0x1e, 0x66, 0x80, 0x65, 0x9b, 0x66, 0x11, 0x02, 0xa6, 0x39, 0xa5, 0xcb,
+		0x2d, 0x7f, 0xa9, 0x59, 0xd3, 0x3c, 0xff, 0x80, 0xcf, 0xf2, 0x16, 0xae, 0x60, 0xfd, 0x11, 0x1d,
+		0x3b, 0x37, 0x0d, 0xeb, 0x3a, 0x37, 0xef, 0x23, 0xac, 0xaa, 0x5e, 0xfa, 0xe7, 0x31, 0x78, 0x67,
+		0x1c, 0x26, 0x58, 0xc1, 0xa9, 0x4d, 0xd5, 0xc6, 0xa7, 0x6e, 0x9c, 0xde, 0xc4, 0x8e, 0x7a, 0xfa,
+		0x54, 0xc9, 0xd0, 0x74, 0xde, 0x12, 0xa3, 0xbc, 0x5d, 0x48, 0xf9, 0x34, 0x2f, 0xcf, 0x46, 0x2e,
+		0xd3, 0xcb, 0xf3, 0x90, 0x98, 0x35, 0x34, 0x9d, 0xec, 0x37, 0x94, 0xb1, 0x6e, 0xd4, 0xf8, 0xa9,
+		0x3d, 0xf6, 0x81, 0x8e, 0x42, 0xaf, 0x5a, 0x33, 0xea, 0xba, 0xc3, 0x76, 0x28, 0xf2, 0xfd, 0x9f,
+		0xbf, 0x33, 0xb9, 0xef, 0x8f, 0xef, 0x4c, 0xc6, 0x17, 0x74, 0x47, 0xe1, 0x45, 0xb9, 0xc4, 0x37,
+		0x3e, 0x3e, 0x29, 0xc9, 0x57, 0xa1, 0x6f, 0x0e, 0x97, 0x76, 0xc3, 0x6b, 0x0e, 0x97, 0x42, 0xbc,
+		0x4e, 0x40, 0x72, 0x41, 0x77, 0xd8, 0xb9, 0xca, 0x23, 0x10, 0xd7, 0x74, 0x76, 0x54, 0x27, 0x54,
+		0x3f, 0x81, 0x13, 0xd4, 0x39, 0x5c, 0x72, 0x51, 0xcb, 0xb8, 0x94, 0x91, 0x1a, 0xd9, 0x13, 0x78,
+		0x7e, 0xee, 0x8f, 0xfe, 0xe3, 0xc4, 0xbe, 0x97, 0xbf, 0x3a, 0xb1, 0xaf, 0x69, 0x4b, 0xf8, 0xfb,
+		0x00, 0x37, 0x31, 0x6f, 0x02, 0xbb, 0x7c, 0x9d, 0xed, 0x91, 0xb8, 0xcd, 0xf0, 0x2f, 0x7a, 0x41,
+		0xe6, 0x38, 0xb6, 0xa3, 0x5e, 0xd7, 0xf4, 0x8a, 0xdb, 0x12, 0x6a, 0xdd, 0xd9, 0x7e, 0x89, 0x37,
+		0xc5, 0x01, 0xde, 0x14, 0x1c, 0xa7, 0x75, 0x6b, 0x64, 0x9b, 0xf7, 0xae, 0x6c, 0x9b, 0x36, 0x97,
+		0xff, 0x79, 0x1c, 0xd0, 0x9a, 0xa3, 0x5e, 0xc7, 0x33, 0x75, 0x67, 0xdb, 0xb0, 0xb4, 0x97, 0x58,
+		0x2c, 0xc3, 0x00, 0x35, 0xf5, 0x56, 0xd1, 0x31, 0xae, 0x63, 0xdd, 0xa6, 0xa6, 0xe9, 0x3f, 0x33,
+		0x3e, 0x1d, 0xe1, 0x1f, 0xd3, 0xa4, 0xe9, 0xf2, 0x0f, 0x7d, 0xe6, 0xb5, 0xc9, 0x63, 0xed, 0xad,
+		0x40, 0x91, 0x49, 0x72, 0x7d, 0x6b, 0x9d, 0x32, 0x46, 0xd7, 0x80, 0x1d, 0xb2, 0x28, 0x56, 0x35,
+		0xdb, 0xe1, 0x27, 0xbd, 0xcf, 0x4e, 0x47, 0xeb, 0x3e, 0xdd, 0x28, 0xe6, 0xf4, 0x35, 0xb5, 0xaa,
+		0x95, 0x55, 0xc7, 0xb0, 0xec, 0x2b, 0xfb, 0x94, 0x14, 0x65, 0xb5, 0xa8, 0xd9, 0x0e, 0x5a, 0x87,
+		0x54, 0x19, 0xeb, 0x3b, 0x8c, 0x6d, 0xfc, 0xf5, 0xb1, 0x4d, 0x12, 0x4e, 0x94, 0xeb, 0xb3, 0x80,
+		0x54, 0x3f, 0x9e, 0xb8, 0xda, 0xc4, 0x4e, 0x68, 0x36, 0x61, 0x1f, 0xe0, 0x4c, 0x6f, 0x62, 0x8c,
+		0xa8, 0x61, 0x50, 0xf6, 0x41, 0x00, 0xaf, 0x4e, 0x72, 0xc3, 0x50, 0x2d, 0x97, 0x2d, 0x6c, 0xdb,
+		0x74, 0x03, 0x30, 0xa5, 0x88, 0xcf, 0xdc, 0xc8, 0x1f, 0x7e, 0xf6, 0x91, 0xc1, 0x00, 0xc7, 0xfc,
+		0x00, 0xc0, 0x0d, 0x97, 0xf4, 0xe4, 0xc7, 0x24, 0x18, 0x69, 0xa8, 0x11, 0xc9, 0x30, 0x31, 0xb3,
+		0xb1, 0x7e, 0x65, 0x45, 0x59, 0x78, 0x7e, 0x86, 0x1c, 0xdb, 0x2f, 0xb2, 0x4b, 0x03, 0xcb, 0x6b,
+		0xab, 0x85, 0xd9, 0x85, 0xcb, 0x0b, 0x85, 0xb9, 0xf4, 0x3e, 0x34, 0x09, 0x87, 0x22, 0x70, 0xe6,
+		0x0a, 0x8b, 0x85, 0xf9, 0x99, 0x75, 0x72, 0x45, 0xe2, 0x3e, 0x38, 0x12, 0xc9, 0xc4, 0x45, 0x89,
+		0x35, 0x41, 0x51, 0x0a, 0x2e, 0x4a, 0x3c, 0x7f, 0xb9, 0x69, 0x2f, 0x7a, 0xb8, 0xa5, 0xff, 0xdc,
+		0x72, 0xbb, 0x4b, 0xb0, 0x3f, 0xfd, 0x6f, 0x09, 0xc6, 0x59, 0x68, 0xf5, 0x86, 0x0c, 0x55, 0xdf,
+		0x69, 0x76, 0x6f, 0xf4, 0x1c, 0xc4, 0x67, 0xf4, 0x1d, 0x34, 0xce, 0x32, 0xe7, 0x62, 0xdd, 0xaa,
+		0xf2, 0x68, 0xd3, 0x47, 0xbe, 0x37, 0xac, 0x2a, 0x89, 0x42, 0xe2, 0x52, 0x00, 0xd9, 0xa8, 0x67,
+		0x1f, 0xf9, 0xf7, 0x49, 0xdd, 0x0d, 0x91, 0xc9, 0x19, 0x7d, 0x87, 0x46, 0x97, 0x55, 0xe9, 0xf9,
+		0x87, 0xdb, 0x6e, 0xa0, 0x5e, 0xd7, 0x8d, 0x9b, 0x3a, 0x11, 0xdb, 0xdc, 0x14, 0x9b, 0xa7, 0x13,
+		0xe1, 0xcd, 0xd3, 0x67, 0x70, 0xb5, 0xfa, 0x14, 0xc1, 0x5b, 0x0f, 0xe8, 0xff, 0xc1, 0x18, 0x4c,
+		0x34, 0x0c, 0x99, 0x3c, 0xbb, 0x68, 0x66, 0x84, 0x1c, 0x24, 0xe7, 0x38, 0x0a, 0xf1, 0x35, 0x1b,
+		0x97, 0x0c, 0xbd, 0xcc, 0x7a, 0x79, 0x5c, 0x11, 0x9f, 0xc4, 0x10, 0xba, 0xaa, 0x1b, 0x36, 0x3f,
+		0xb1, 0xcf, 0x3e, 0xf2, 0x1f, 0xe9, 0xd2, 0x10, 0x83, 0xa2, 0x26, 0x61, 0x8d, 0xd3, 0x1d, 0x5a,
+		0x43, 0x28, 0x11, 0xd8, 0x52, 0xee, 0xd4, 0x2a, 0x3f, 0x1b, 0x83, 0xc9, 0xb0, 0x55, 0x48, 0xca,
+		0x66, 0x3b, 0x6a, 0xcd, 0x6c, 0x66, 0x96, 0x8b, 0x90, 0x5a, 0x17, 0x38, 0x5d, 0xdb, 0xe5, 0x76,
+		0x97, 0x76, 0x19, 0x72, 0xab, 0x12, 0x86, 0x39, 0xd3, 0xa1, 0x61, 0x5c, 0x3d, 0x76, 0x65, 0x99,
+		0xcf, 0x24, 0xe0, 0x08, 0xbd, 0xd2, 0x65, 0xd5, 0x34, 0xdd, 0x39, 0x55, 0xb2, 0x76, 0x4c, 0x87,
+		0x26, 0x6d, 0xc6, 0x16, 0xb7, 0xcb, 0x88, 0x57, 0x3c, 0xcd, 0x8a, 0x9b, 0xe4, 0x00, 0x5b, 0xd0,
+		0xb3, 0x4a, 0xe8, 0x88, 0x45, 0x1c, 0xc3, 0x51, 0xab, 0xdc, 0x52, 0xec, 0x83, 0x40, 0xd9, 0x35,
+		0xb0, 0x18, 0x83, 0x6a, 0xe2, 0x06, 0x58, 0x15, 0xab, 0x5b, 0xec, 0x34, 0x7d, 0x9c, 0x76, 0xb1,
+		0x24, 0x01, 0xd0, 0x83, 0xf3, 0x63, 0xd0, 0xa3, 0xd6, 0xd9, 0x31, 0x8e, 0x38, 0xe9, 0x7b, 0xf4,
+		0x43, 0x7e, 0x0a, 0xfa, 0xf8, 0x66, 0x32, 0x39, 0xc8, 0x70, 0x1d, 0xef, 0xd0, 0x7a, 0x06, 0x14,
+		0xf2, 0x2f, 0x9a, 0x86, 0x1e, 0x2a, 0x3c, 0x1f, 0x3c, 0x32, 0xd3, 0x0d, 0xd2, 0x4f, 0x53, 0x21,
+		0x15, 0x86, 0x26, 0x5f, 0x85, 0xe4, 0x9c, 0x51, 0xd3, 0x74, 0x23, 0xc8, 0x2d, 0xc5, 0xb8, 0x51,
+		0x99, 0xcd, 0x3a, 0xcf, 0x35, 0x14, 0xf6, 0x41, 0xce, 0x98, 0xb2, 0xdb, 0x15, 0xfc, 0x28, 0x0a,
+		0xff, 0x92, 0x67, 0xa1, 0x8f, 0xf2, 0x5e, 0x31, 0xc9, 0x35, 0x0e, 0xf7, 0x20, 0x6b, 0x8a, 0xdf,
+		0xb5, 0xe3, 0xec, 0x63, 0x9e, 0xb0, 0x08, 0x12, 0x65, 0xd5, 0x51, 0xb9, 0xde, 0xf4, 0x7f, 0xf9,
+		0x4d, 0x90, 0xe4, 0x4c, 0x6c, 0x74, 0x06, 0xe2, 0x86, 0x69, 0xf3, 0xc3, 0x24, 0xd9, 0x66, 0xaa,
+		0xac, 0x98, 0xf9, 0x04, 0xc9, 0x52, 0x14, 0x82, 0x9c, 0x57, 0x9a, 0x06, 0xd4, 0x27, 0x7c, 0x01,
+		0xd5, 0xd7, 0xe4, 0xbe, 0x7f, 0x59, 0x93, 0x36, 0xb8, 0x83, 0xeb, 0x2c, 0x9f, 0x88, 0xc1, 0x84,
+		0xaf, 0xf4, 0x06, 0xb6, 0x6c, 0xcd, 0xd0, 0xf9, 0x58, 0xce, 0xbc, 0x05, 0xf9, 0x84, 0xe4, 0xe5,
+		0x4d, 0xdc, 0xe5, 0x49, 0x88, 0xcf, 0x98, 0x26, 0xb9, 0x64, 0x48, 0xbf, 0x4b, 0x06, 0xf3, 0x97,
+		0x84, 0xe2, 0x7e, 0x93, 0x32, 0xdb, 0xd8, 0x72, 0x6e, 0xaa, 0x96, 0x7b, 0x01, 0x51, 0x7c, 0xcb,
+		0x17, 0x20, 0x35, 0x6b, 0xe8, 0x36, 0xd6, 0xed, 0x3a, 0xed, 0x83, 0x9b, 0x55, 0xa3, 0x74, 0x9d,
+		0x73, 0x60, 0x1f, 0xc4, 0xe0, 0xaa, 0x69, 0x52, 0xca, 0x84, 0x42, 0xfe, 0x65, 0x79, 0x61, 0x7e,
+		0xad, 0xa9, 0x89, 0x2e, 0x74, 0x6f, 0x22, 0xae, 0xa4, 0x7f, 0x00, 0x3a, 0xdc, 0xd8, 0xa1, 0xae,
+		0xe3, 0x1d, 0xbb, 0xdb, 0xfe, 0xf4, 0x2c, 0xa4, 0x56, 0xe9, 0x2b, 0x00, 0x4f, 0xe1, 0x1d, 0x94,
+		0x85, 0x3e, 0x5c, 0x3e, 0x73, 0xf6, 0xec, 0xe9, 0x0b, 0xcc, 0xdb, 0xaf, 0xec, 0x53, 0x04, 0x00,
+		0x4d, 0x40, 0xca, 0xc6, 0x25, 0xf3, 0xcc, 0xd9, 0x73, 0xd7, 0x4f, 0x33, 0xf7, 0x22, 0xd9, 0x8f,
+		0x0b, 0xca, 0x25, 0x89, 0xd6, 0xdf, 0xf8, 0xc4, 0xa4, 0x94, 0xef, 0x81, 0xb8, 0x5d, 0xaf, 0xdd,
+		0x53, 0x1f, 0x79, 0xb5, 0x07, 0xa6, 0xfc, 0x94, 0x34, 0x52, 0xb9, 0x19, 0x09, 0xb7, 0x41, 0xda,
+		0x67, 0x03, 0x8a, 0xd1, 0x24, 0x91, 0x6d, 0x69, 0x49, 0xf9, 0x37, 0x24, 0x18, 0x70, 0xd3, 0x24,
+		0xf2, 0xe0, 0xc3, 0x45, 0x7f, 0xee, 0xc3, 0xbb, 0xcd, 0xa1, 0xe9, 0x70, 0x5d, 0x5e, 0x3a, 0xa7,
+		0xf8, 0xd0, 0xd1, 0x79, 0xea, 0x88, 0xa6, 0x61, 0xf3, 0x4b, 0x69, 0x6d, 0x48, 0x5d, 0x64, 0x72,
+		0x44, 0x90, 0x46, 0xb8, 0xe2, 0x0d, 0xc3, 0x21, 0x67, 0x26, 0x4c, 0xe3, 0x26, 0xbf, 0xea, 0x1b,
+		0x57, 0xd2, 0xb4, 0xe4, 0x1a, 0x2d, 0x58, 0x25, 0x70, 0x22, 0x74, 0xca, 0xe5, 0x12, 0x4c, 0xed,
+		0x48, 0x10, 0x10, 0x9f, 0xe4, 0x26, 0x9c, 0x59, 0xdf, 0x2c, 0x8a, 0x88, 0x41, 0xee, 0x12, 0x46,
+		0xf4, 0x7f, 0xe1, 0x1f, 0x3c, 0x02, 0xf4, 0x9a, 0xf5, 0x4d, 0xe2, 0x2d, 0xf7, 0xc1, 0x40, 0x84,
+		0x30, 0xfd, 0x37, 0x3c, 0x39, 0xe8, 0xe3, 0x13, 0x5c, 0x83, 0xa2, 0x69, 0x69, 0x86, 0xa5, 0x39,
+		0x3b, 0x34, 0x77, 0x8d, 0x2b, 0x69, 0x51, 0xb0, 0xca, 0xe1, 0xf2, 0x75, 0x18, 0x5e, 0xa3, 0x73,
+		0x5b, 0x4f, 0xf2, 0xb3, 0x9e, 0x7c, 0x52, 0x7b, 0xf9, 0x9a, 0x4a, 0x16, 0x6b, 0x90, 0x2c, 0xff,
+		0x74, 0x53, 0xef, 0x3c, 0xdf, 0xbd, 0x77, 0x06, 0xb3, 0xc3, 0x3f, 0x1d, 0x87, 0xc3, 0xe1, 0xc2,
+		0x40, 0xf8, 0xea, 0xd4, 0x31, 0xdb, 0x65, 0x13, 0xd9, 0xd6, 0x83, 0x6a, 0xb6, 0x4d, 0x18, 0xcd,
+		0xb6, 0xed, 0x42, 0xf2, 0x05, 0x18, 0x24, 0x47, 0x3b, 0xd7, 0xb0, 0x73, 0x05, 0xab, 0x65, 0x6c,
+		0x05, 0x47, 0xdd, 0x41, 0x31, 0xea, 0x22, 0x48, 0xd0, 0xa1, 0x95, 0x8d, 0x3a, 0xf4, 0x7f, 0x79,
+		0x1b, 0x12, 0x84, 0xd4, 0x1b, 0x91, 0x39, 0x05, 0xfd, 0x20, 0xd0, 0xcd, 0x1d, 0x07, 0xdb, 0x22,
+		0xe1, 0xa5, 0x1f, 0xe8, 0x71, 0x31, 0xae, 0xc6, 0x5b, 0x8f, 0xab, 0xdc, 0x11, 0xf9, 0xe8, 0x5a,
+		0x85, 0xbe, 0x3c, 0x09, 0xc5, 0x0b, 0x73, 0xae, 0x20, 0x92, 0x27, 0x08, 0x5a, 0x82, 0x61, 0x53,
+		0xb5, 0x1c, 0x7a, 0xa1, 0x66, 0x9b, 0x6a, 0xc1, 0x7d, 0x7d, 0xb2, 0xb1, 0xe7, 0x05, 0x94, 0xe5,
+		0xb5, 0x0c, 0x9a, 0x7e, 0xa0, 0xfc, 0x9f, 0x13, 0xd0, 0xcb, 0x8d, 0xf1, 0x24, 0xf4, 0x71, 0xb3,
+		0x72, 0xef, 0x3c, 0x32, 0xdd, 0x38, 0x30, 0x4d, 0xbb, 0x03, 0x08, 0xe7, 0x27, 0x68, 0xd0, 0x83,
+		0x90, 0x2c, 0x6d, 0xab, 0x9a, 0x5e, 0xd4, 0xca, 0x62, 0x99, 0xe1, 0xab, 0x77, 0x26, 0xfb, 0x66,
+		0x09, 0x6c, 0x61, 0x4e, 0xe9, 0xa3, 0x85, 0x0b, 0x65, 0x92, 0x09, 0x6c, 0x63, 0xad, 0xb2, 0xed,
+		0xf0, 0x1e, 0xc6, 0xbf, 0xc8, 0xcb, 0x33, 0xc4, 0x21, 0xf8, 0x75, 0xcb, 0x6c, 0xc3, 0x62, 0x8f,
+		0x9b, 0xec, 0xe5, 0x93, 0xa4, 0xe2, 0xf7, 0xbf, 0x36, 0x29, 0x29, 0x94, 0x02, 0xcd, 0xc2, 0x60,
+		0x55, 0xb5, 0x9d, 0x22, 0x1d, 0xc1, 0x48, 0xf5, 0x3d, 0x7c, 0xae, 0xdd, 0x60, 0x10, 0x6e, 0x58,
+		0x2e, 0x7a, 0x3f, 0xa1, 0x62, 0xa0, 0x32, 0xb9, 0x0d, 0x46, 0x99, 0x90, 0x13, 0xad, 0x9a, 0xc3,
+		0x72, 0xab, 0x5e, 0x6a, 0xf7, 0x21, 0x02, 0x9f, 0xa5, 0x60, 0x9a, 0x61, 0x1d, 0x82, 0x14, 0xbd,
+		0xe0, 0x45, 0x51, 0xd8, 0x51, 0xe4, 0x24, 0x01, 0xd0, 0xc2, 0x63, 0x30, 0xec, 0xc5, 0x47, 0x86,
+		0x92, 0x64, 0x5c, 0x3c, 0x30, 0x45, 0x7c, 0x14, 0xc6, 0x74, 0x7c, 0xcb, 0x29, 0x7a, 0x60, 0x86,
+		0x9d, 0xa2, 0xd8, 0x88, 0x94, 0x5d, 0x0b, 0x52, 0x3c, 0x00, 0x43, 0x25, 0x61, 0x7c, 0x86, 0x0b,
+		0x14, 0x77, 0xd0, 0x85, 0x52, 0xb4, 0x71, 0x48, 0xaa, 0xa6, 0xc9, 0x10, 0xfa, 0x79, 0x7c, 0x34,
+		0x4d, 0x5a, 0x74, 0x12, 0x46, 0xa8, 0x8e, 0x16, 0xb6, 0xeb, 0x55, 0x87, 0x33, 0x19, 0xa0, 0x38,
+		0xc3, 0xa4, 0x40, 0x61, 0x70, 0x8a, 0x7b, 0x14, 0x06, 0xf1, 0x0d, 0xad, 0x8c, 0xf5, 0x12, 0x66,
+		0x78, 0x83, 0x14, 0x6f, 0x40, 0x00, 0x29, 0xd2, 0x09, 0x70, 0xe3, 0x5e, 0x51, 0xc4, 0xe4, 0x21,
+		0xc6, 0x4f, 0xc0, 0x67, 0x18, 0x58, 0xce, 0x40, 0x62, 0x4e, 0x75, 0x54, 0x92, 0x60, 0x38, 0xb7,
+		0xd8, 0x40, 0x33, 0xa0, 0x90, 0x7f, 0xe5, 0x6f, 0xc4, 0x20, 0x71, 0xcd, 0x70, 0x30, 0x7a, 0xcc,
+		0x97, 0x00, 0x0e, 0x45, 0xf9, 0xf3, 0x9a, 0x56, 0xd1, 0x71, 0x79, 0xc9, 0xae, 0xf8, 0x5e, 0x63,
+		0xf0, 0xdc, 0x29, 0x16, 0x70, 0xa7, 0x31, 0xe8, 0xb1, 0x8c, 0xba, 0x5e, 0x16, 0xa7, 0x78, 0xe9,
+		0x07, 0x2a, 0x40, 0xd2, 0xf5, 0x92, 0x44, 0x3b, 0x2f, 0x19, 0x26, 0x5e, 0x42, 0x7c, 0x98, 0x03,
+		0x94, 0xbe, 0x4d, 0xee, 0x2c, 0x79, 0x48, 0xb9, 0xc1, 0x2b, 0xd3, 0xd3, 0x85, 0xc3, 0x7a, 0x64,
+		0x64, 0x30, 0x71, 0xdb, 0xde, 0x35, 0x1e, 0xf3, 0xb8, 0xb4, 0x5b, 0xc0, 0xad, 0x17, 0x70, 0x2b,
+		0xfe, 0x32, 0x44, 0x1f, 0xd5, 0xcb, 0x73, 0x2b, 0xf6, 0x3a, 0xc4, 0x61, 0x72, 0x28, 0xab, 0xa2,
+		0xab, 0x4e, 0xdd, 0xc2, 0xdc, 0xf3, 0x3c, 0x00, 0xb9, 0xb3, 0xd3, 0xcb, 0x3c, 0xd9, 0x67, 0x37,
+		0x29, 0xda, 0x6e, 0xb1, 0x66, 0x76, 0x8b, 0xef, 0xde, 0x6e, 0x33, 0x00, 0xae, 0x30, 0x36, 0xbf,
+		0xb0, 0x1f, 0x91, 0x31, 0x30, 0x11, 0xd7, 0xb4, 0x0a, 0xef, 0xa8, 0x3e, 0x22, 0xf9, 0x3f, 0x48,
+		0x90, 0x72, 0xcb, 0xd1, 0x0c, 0x0c, 0x0a, 0xb9, 0x8a, 0x5b, 0x55, 0xb5, 0xc2, 0x7d, 0xe7, 0x48,
+		0x53, 0xe1, 0x2e, 0x57, 0xd5, 0x8a, 0xd2, 0xcf, 0xe5, 0x21, 0x1f, 0xd1, 0xed, 0x10, 0x6b, 0xd2,
+		0x0e, 0x81, 0x86, 0x8f, 0xef, 0xae, 0xe1, 0x03, 0x4d, 0x94, 0x08, 0x37, 0xd1, 0x6f, 0xc6, 0xe8,
+		0x64, 0xc6, 0x34, 0x6c, 0xb5, 0xfa, 0xbd, 0xe8, 0x11, 0x87, 0x20, 0x65, 0x1a, 0xd5, 0x22, 0x2b,
+		0x61, 0xa7, 0xdb, 0x93, 0xa6, 0x51, 0x55, 0x1a, 0x9a, 0xbd, 0x67, 0x8f, 0xba, 0x4b, 0xef, 0x1e,
+		0x58, 0xad, 0x2f, 0x6c, 0x35, 0x0b, 0x06, 0x98, 0x29, 0xf8, 0x58, 0xf6, 0x28, 0xb1, 0x01, 0xf9,
+		0x2f, 0x23, 0x35, 0x8e, 0xbd, 0x4c, 0x6c, 0x86, 0xa9, 0xf4, 0x6e, 0xbb, 0x14, 0x2c, 0xf4, 0x67,
+		0x62, 0xcd, 0x28, 0x98, 0xdb, 0x29, 0x1c, 0x4f, 0xfe, 0x19, 0x09, 0x60, 0x91, 0x58, 0x96, 0xea,
+		0x4b, 0x46, 0x21, 0x9b, 0x8a, 0x50, 0x0c, 0xd4, 0x3c, 0xd1, 0xac, 0xd1, 0x78, 0xfd, 0x03, 0xb6,
+		0x5f, 0xee, 0x59, 0x18, 0xf4, 0x9c, 0xd1, 0xc6, 0x42, 0x98, 0x89, 0x16, 0x59, 0xf5, 0x1a, 0x76,
+		0x94, 0x81, 0x1b, 0xbe, 0x2f, 0xf9, 0xf7, 0x24, 0x48, 0x51, 0x99, 0xc8, 0x75, 0xe3, 0x40, 0x1b,
+		0x4a, 0xbb, 0x6f, 0xc3, 0x23, 0x00, 0x8c, 0x0d, 0xd9, 0xa2, 0xe6, 0x9e, 0x95, 0xa2, 0x10, 0xb2,
+		0xf1, 0x8c, 0xce, 0xb9, 0x06, 0x8f, 0xb7, 0x36, 0xb8, 0xc8, 0xba, 0xb9, 0xd9, 0x0f, 0x42, 0x1f,
+		0x7d, 0xe0, 0xea, 0x96, 0xcd, 0x13, 0x69, 0xf2, 0xaa, 0xc5, 0xfa, 0x2d, 0x5b, 0x7e, 0x01, 0xfa,
+		0xd6, 0x6f, 0xb1, 0xb5, 0x91, 0x43, 0x90, 0xb2, 0x0c, 0x83, 0x8f, 0xc9, 0x2c, 0x17, 0x4a, 0x12,
+		0x00, 0x1d, 0x82, 0xc4, 0x7a, 0x40, 0xcc, 0x5b, 0x0f, 0xf0, 0x16, 0x34, 0xe2, 0x1d, 0x2d, 0x68,
+		0x9c, 0xfc, 0xb7, 0x12, 0xf4, 0xfb, 0xe2, 0x03, 0x3a, 0x0d, 0xfb, 0xf3, 0x8b, 0x2b, 0xb3, 0x4f,
+		0x15, 0x17, 0xe6, 0x8a, 0x97, 0x17, 0x67, 0xe6, 0xbd, 0xfb, 0x5b, 0xd9, 0x03, 0xaf, 0xdc, 0x9e,
+		0x42, 0x3e, 0xdc, 0x0d, 0x9d, 0xae, 0x28, 0xa1, 0x53, 0x30, 0x16, 0x24, 0x99, 0xc9, 0xaf, 0x91,
+		0xcb, 0x5c, 0x52, 0x76, 0xff, 0x2b, 0xb7, 0xa7, 0x46, 0x7c, 0x14, 0x33, 0x9b, 0x36, 0xd6, 0x9d,
+		0x46, 0x82, 0xd9, 0x95, 0xa5, 0xa5, 0x85, 0xf5, 0x74, 0xac, 0x81, 0x80, 0x07, 0xec, 0x13, 0x30,
+		0x12, 0x24, 0x58, 0x5e, 0x58, 0x4c, 0xc7, 0xb3, 0xe8, 0x95, 0xdb, 0x53, 0x43, 0x3e, 0xec, 0x65,
+		0xad, 0x9a, 0x4d, 0xbe, 0xfb, 0x93, 0x13, 0xfb, 0x3e, 0xfd, 0x8b, 0x13, 0x12, 0xd1, 0x6c, 0x30,
+		0x10, 0x23, 0xd0, 0xc3, 0x70, 0x70, 0x6d, 0x61, 0x7e, 0xb9, 0x30, 0x57, 0x5c, 0x5a, 0x9b, 0x17,
+		0xeb, 0xcf, 0x42, 0xbb, 0xe1, 0x57, 0x6e, 0x4f, 0xf5, 0x73, 0x95, 0x9a, 0x61, 0xaf, 0x2a, 0x85,
+		0x6b, 0x2b, 0x64, 0x35, 0x9b, 0x61, 0xaf, 0x5a, 0xf8, 0x86, 0xe1, 0xb0, 0x17, 0xf0, 0x1e, 0x85,
+		0xf1, 0x08, 0x6c, 0x57, 0xb1, 0x91, 0x57, 0x6e, 0x4f, 0x0d, 0xae, 0x5a, 0x98, 0xf5, 0x1f, 0x4a,
+		0x31, 0x0d, 0x99, 0x46, 0x8a, 0x95, 0xd5, 0x95, 0xb5, 0x99, 0xc5, 0xf4, 0x54, 0x36, 0xfd, 0xca,
+		0xed, 0xa9, 0x01, 0x11, 0x0c, 0xe9, 0x22, 0xbf, 0xab, 0xd9, 0xbd, 0x9c, 0xf1, 0xfc, 0xe1, 0x93,
+		0x70, 0x7f, 0x93, 0xfd, 0x25, 0xfe, 0xbd, 0xbb, 0x1d, 0xa6, 0xa6, 0x6b, 0xec, 0xd9, 0x36, 0xcb,
+		0xcf, 0xed, 0xa7, 0x4e, 0xbb, 0xdf, 0xbd, 0xca, 0xb6, 0x9c, 0xdc, 0xc9, 0xef, 0x91, 0x60, 0xe8,
+		0x8a, 0x66, 0x3b, 0x86, 0xa5, 0x95, 0xd4, 0x2a, 0xbd, 0xb5, 0x75, 0xae, 0xd3, 0xd8, 0x1a, 0xea,
+		0xea, 0x97, 0xa0, 0xf7, 0x86, 0x5a, 0x65, 0x41, 0x2d, 0x4e, 0x9f, 0xa9, 0x69, 0xb2, 0xdd, 0xe3,
+		0x86, 0x36, 0xc1, 0x80, 0x91, 0xc9, 0xbf, 0x1a, 0x83, 0x61, 0xda, 0x19, 0x6c, 0xf6, 0x80, 0x19,
+		0x99, 0x63, 0xe5, 0x21, 0x61, 0xa9, 0x0e, 0x5f, 0x34, 0xcc, 0x4f, 0xf3, 0x9d, 0xc7, 0x07, 0x3b,
+		0xd8, 0x47, 0x23, 0x9b, 0x93, 0x94, 0x16, 0xfd, 0x08, 0x24, 0xc9, 0x46, 0x1d, 0xe5, 0xc3, 0x66,
+		0x2e, 0x33, 0xdd, 0xf1, 0xb9, 0x7b, 0x67, 0x72, 0x78, 0x47, 0xad, 0x55, 0x73, 0xb2, 0xe0, 0x23,
+		0x2b, 0x7d, 0x35, 0xf5, 0x16, 0x11, 0x11, 0x99, 0x30, 0x4c, 0xa0, 0xa5, 0x6d, 0x55, 0xaf, 0x60,
+		0x56, 0x09, 0x5d, 0x02, 0xcd, 0x5f, 0xe9, 0xba, 0x92, 0x03, 0x5e, 0x25, 0x3e, 0x76, 0xb2, 0x32,
+		0x58, 0x53, 0x6f, 0xcd, 0x52, 0x00, 0xa9, 0x31, 0x97, 0xfc, 0xf0, 0xc7, 0x27, 0xf7, 0xd1, 0xdd,
+		0xdc, 0x2f, 0x4b, 0x00, 0x9e, 0xc5, 0xd0, 0x8f, 0x40, 0xba, 0xe4, 0x7e, 0x51, 0x5a, 0xb1, 0x2f,
+		0x79, 0xac, 0x59, 0x5b, 0x84, 0xec, 0xcd, 0xc6, 0xe6, 0x2f, 0xdd, 0x99, 0x94, 0x94, 0xe1, 0x52,
+		0xa8, 0x29, 0xde, 0x0c, 0xfd, 0x75, 0xb3, 0xac, 0x3a, 0xb8, 0x48, 0xe7, 0x71, 0xb1, 0xb6, 0xe3,
+		0xfc, 0x04, 0xe1, 0x75, 0xf7, 0xce, 0x24, 0x62, 0x6a, 0xf9, 0x88, 0x65, 0x3a, 0xfa, 0x03, 0x83,
+		0x10, 0x02, 0x9f, 0x4e, 0x5f, 0x90, 0xa0, 0x7f, 0xce, 0x77, 0x9e, 0x32, 0x03, 0x7d, 0x35, 0x43,
+		0xd7, 0xae, 0x73, 0x7f, 0x4c, 0x29, 0xe2, 0x93, 0x2c, 0x85, 0xb2, 0x8b, 0xac, 0xce, 0x8e, 0x58,
+		0x0a, 0x15, 0xdf, 0x84, 0xea, 0x26, 0xde, 0xb4, 0x35, 0xd1, 0x1a, 0x8a, 0xf8, 0x44, 0x97, 0xc9,
+		0x6b, 0x3c, 0xa5, 0x3a, 0x59, 0xc3, 0x29, 0x96, 0x0c, 0xdd, 0x51, 0x4b, 0x0e, 0xbb, 0x12, 0x99,
+		0x3f, 0x74, 0xf7, 0xce, 0xe4, 0x41, 0x26, 0x6b, 0x18, 0x43, 0x56, 0x86, 0x05, 0x68, 0x96, 0x41,
+		0x48, 0x0d, 0x65, 0xec, 0xa8, 0x5a, 0xd5, 0xce, 0xb0, 0x83, 0x09, 0xe2, 0xd3, 0xa7, 0xcb, 0x7f,
+		0x02, 0xff, 0xc2, 0xd6, 0x65, 0x48, 0x1b, 0x26, 0xb6, 0x02, 0x89, 0xa8, 0x14, 0xae, 0x39, 0x8c,
+		0x21, 0x2b, 0xc3, 0x02, 0x24, 0x92, 0x54, 0x07, 0xd2, 0xee, 0x94, 0xb0, 0x68, 0xd6, 0x37, 0xbd,
+		0xf5, 0xb0, 0xb1, 0x86, 0xd6, 0x98, 0xd1, 0x77, 0xf2, 0x8f, 0x79, 0xdc, 0xc3, 0x74, 0xf2, 0x17,
+		0x3f, 0xfb, 0xc8, 0x18, 0x77, 0x0d, 0x6f, 0x7d, 0x8a, 0x2c, 0x4e, 0x0d, 0xbb, 0xa8, 0xab, 0x14,
+		0x93, 0xa4, 0x9d, 0x2f, 0xa8, 0x5a, 0x55, 0x5c, 0xed, 0x57, 0xf8, 0x17, 0xca, 0x41, 0xaf, 0xed,
+		0xa8, 0x4e, 0xdd, 0xe6, 0xbb, 0xbc, 0x72, 0x33, 0x57, 0xcb, 0x1b, 0x7a, 0x79, 0x8d, 0x62, 0x2a,
+		0x9c, 0x02, 0x5d, 0x86, 0x5e, 0xbe, 0x7d, 0xde, 0xd3, 0x75, 0xff, 0xa6, 0xe7, 0x24, 0x18, 0x35,
+		0xb1, 0x48, 0x19, 0x57, 0x71, 0x85, 0xa5, 0x55, 0xdb, 0x2a, 0x99, 0x7d, 0xd0, 0x97, 0xfb, 0xf2,
+		0x0b, 0x5d, 0x77, 0x42, 0x6e, 0xa9, 0x30, 0x3f, 0x59, 0x19, 0x76, 0x41, 0x6b, 0x14, 0x82, 0x9e,
+		0x0a, 0x1c, 0xfc, 0xe5, 0xcf, 0x5b, 0x1e, 0x6d, 0xa6, 0xbe, 0xcf, 0xa7, 0xc5, 0xfa, 0x84, 0x8f,
+		0x9a, 0x38, 0x47, 0x5d, 0xdf, 0x34, 0x74, 0x7a, 0xff, 0x96, 0xe7, 0xf7, 0x64, 0x7e, 0x17, 0xf7,
+		0x3b, 0x47, 0x18, 0x43, 0x56, 0x86, 0x5d, 0xd0, 0x15, 0x0a, 0x41, 0x65, 0x18, 0xf2, 0xb0, 0x68,
+		0x47, 0x4d, 0xb5, 0xed, 0xa8, 0xf7, 0xf1, 0x8e, 0xba, 0x3f, 0x5c, 0x8b, 0xd7, 0x57, 0x07, 0x5d,
+		0x20, 0x21, 0x43, 0x57, 0x00, 0xbc, 0xf0, 0x40, 0xd7, 0x29, 0xfa, 0xcf, 0xc8, 0xed, 0x63, 0x8c,
+		0x98, 0xef, 0x79, 0xb4, 0xe8, 0xad, 0x30, 0x5a, 0xd3, 0xf4, 0xa2, 0x8d, 0xab, 0x5b, 0x45, 0x6e,
+		0x60, 0xc2, 0x92, 0x3e, 0xc0, 0x94, 0x5f, 0xec, 0xce, 0x1f, 0xee, 0xde, 0x99, 0xcc, 0xf2, 0x10,
+		0xda, 0xc8, 0x52, 0x56, 0x46, 0x6a, 0x9a, 0xbe, 0x86, 0xab, 0x5b, 0x73, 0x2e, 0x0c, 0xdd, 0x84,
+		0x43, 0x16, 0x56, 0xab, 0xf4, 0x62, 0x36, 0xbf, 0xee, 0x2c, 0xa2, 0x67, 0xbd, 0x8a, 0xe9, 0xda,
+		0x49, 0xff, 0x99, 0xd3, 0xcd, 0x14, 0x53, 0x3c, 0x52, 0x5f, 0x1c, 0xad, 0x57, 0x31, 0xd7, 0x73,
+		0xdc, 0x6a, 0x86, 0x80, 0x9e, 0x81, 0x03, 0x9a, 0x5e, 0x22, 0xc1, 0xea, 0x06, 0x2e, 0x3a, 0x58,
+		0xad, 0xb9, 0x11, 0x61, 0x90, 0x6a, 0x7e, 0xdf, 0xdd, 0x3b, 0x93, 0x47, 0x98, 0x2e, 0xd1, 0x78,
+		0xb2, 0x32, 0xe6, 0x16, 0xac, 0x63, 0xb5, 0x26, 0x82, 0xc3, 0x32, 0x80, 0xeb, 0xa7, 0x6c, 0xb1,
+		0xa6, 0xfb, 0x6e, 0xe5, 0xe3, 0x80, 0x3e, 0x24, 0xc1, 0x78, 0x48, 0x02, 0x0b, 0x97, 0x34, 0x53,
+		0xa3, 0xd7, 0xc2, 0x87, 0xf9, 0x83, 0xac, 0x4d, 0x0c, 0xb4, 0xe0, 0x97, 0x50, 0x11, 0x64, 0xf9,
+		0xe3, 0xdc, 0xdf, 0xa6, 0x22, 0x15, 0xf4, 0xd8, 0xcb, 0xca, 0x41, 0x2d, 0x92, 0x83, 0x9d, 0x1b,
+		0x78, 0xf7, 0xc7, 0x27, 0xf7, 0xf1, 0x38, 0xbb, 0x4f, 0x3e, 0x47, 0x37, 0x3d, 0xb8, 0x09, 0xb0,
+		0x4d, 0x26, 0x93, 0xaa, 0xf8, 0xe0, 0xe7, 0x43, 0x3c, 0x00, 0x8b, 0xcf, 0x2f, 0xff, 0xfb, 0x29,
+		0x49, 0xfe, 0x15, 0x09, 0x7a, 0xe7, 0xae, 0xad, 0xaa, 0x9a, 0x85, 0x16, 0x60, 0xc4, 0xeb, 0xf2,
+		0xc1, 0xe8, 0x7c, 0xf8, 0xee, 0x9d, 0xc9, 0x4c, 0x38, 0x2a, 0xb8, 0xcd, 0xe0, 0x45, 0x1e, 0xd1,
+		0x04, 0x0b, 0xcd, 0x56, 0x1c, 0x02, 0xac, 0x1a, 0x50, 0xe4, 0xc6, 0xf5, 0x88, 0x90, 0x9a, 0x05,
+		0xe8, 0x63, 0xd2, 0x92, 0xcb, 0xfa, 0x3d, 0x26, 0xf9, 0x87, 0xef, 0xe8, 0x4c, 0x34, 0x8d, 0x3a,
+		0x14, 0xdf, 0x5d, 0x81, 0x26, 0x24, 0xf2, 0x07, 0x62, 0x00, 0x73, 0xd7, 0xae, 0xad, 0x5b, 0x9a,
+		0x59, 0xc5, 0xce, 0x5e, 0x6a, 0xbe, 0x0e, 0xfb, 0x3d, 0xb5, 0x6c, 0xab, 0x14, 0xd2, 0x7e, 0xea,
+		0xee, 0x9d, 0xc9, 0xc3, 0x61, 0xed, 0x7d, 0x68, 0xb2, 0x32, 0xea, 0x4d, 0x74, 0xad, 0x52, 0x24,
+		0xd7, 0xb2, 0xed, 0xb8, 0x5c, 0xe3, 0xcd, 0xb9, 0xfa, 0xd0, 0xfc, 0x5c, 0xe7, 0x6c, 0x27, 0xda,
+		0xb4, 0x6b, 0xd0, 0xef, 0x99, 0x84, 0x3c, 0x72, 0x97, 0x74, 0xf8, 0xff, 0xdc, 0xc2, 0x72, 0x73,
+		0x0b, 0x0b, 0x32, 0x6e, 0x65, 0x97, 0x52, 0xfe, 0x02, 0x31, 0xb4, 0x17, 0x6c, 0xbe, 0x2f, 0x5d,
+		0x8c, 0x8c, 0xc1, 0x7c, 0xc4, 0x8c, 0xef, 0x2a, 0xc7, 0xe6, 0xd4, 0x68, 0x05, 0x46, 0xd9, 0xd4,
+		0x4f, 0x25, 0x93, 0x05, 0x57, 0x28, 0x96, 0x5a, 0x4d, 0x78, 0xa1, 0x39, 0x02, 0x49, 0x56, 0x90,
+		0x0f, 0x1a, 0xdd, 0x40, 0x3f, 0x19, 0x23, 0x0f, 0xa5, 0xf0, 0x31, 0xe8, 0xfb, 0xde, 0xa8, 0xab,
+		0xd0, 0x87, 0x75, 0xc7, 0xd2, 0xa8, 0x55, 0x89, 0xfb, 0x3c, 0xda, 0xcc, 0x7d, 0x22, 0x74, 0xa2,
+		0x8f, 0x91, 0x89, 0xed, 0x17, 0xce, 0x26, 0x64, 0x8d, 0x9f, 0x8a, 0x43, 0xa6, 0x19, 0x25, 0x9a,
+		0x85, 0xe1, 0x92, 0x85, 0x29, 0xa0, 0xe8, 0x5f, 0x03, 0xce, 0x67, 0xbd, 0x39, 0x46, 0x08, 0x41,
+		0x56, 0x86, 0x04, 0x84, 0xe7, 0x11, 0x15, 0x20, 0x13, 0x00, 0xe2, 0xc7, 0x04, 0xab, 0xc3, 0x8c,
+		0x5f, 0xe6, 0x81, 0x5d, 0x54, 0x12, 0x64, 0xc0, 0x32, 0x89, 0x21, 0x0f, 0x4a, 0x08, 0xd1, 0x8b,
+		0x30, 0xac, 0xe9, 0x9a, 0xa3, 0xa9, 0xd5, 0xe2, 0xa6, 0x5a, 0x55, 0xf5, 0xd2, 0x6e, 0xe6, 0x4f,
+		0x6c, 0xf0, 0x3f, 0x20, 0xc6, 0x93, 0x00, 0x3b, 0x59, 0x19, 0xe2, 0x90, 0x3c, 0x03, 0xa0, 0x2b,
+		0xd0, 0x27, 0xaa, 0x4a, 0xec, 0x6a, 0x80, 0x14, 0xe4, 0xbe, 0x54, 0xff, 0xbd, 0x71, 0x18, 0x51,
+		0x70, 0xf9, 0xff, 0x37, 0x45, 0x77, 0x4d, 0xb1, 0x04, 0xc0, 0xe2, 0x07, 0x89, 0xd8, 0x99, 0xc4,
+		0xae, 0x22, 0x50, 0x8a, 0x71, 0x98, 0xb3, 0x1d, 0x5f, 0x7b, 0xdc, 0x89, 0xc1, 0x80, 0xbf, 0x3d,
+		0xfe, 0x1f, 0x1d, 0xe6, 0xd0, 0x82, 0x17, 0x89, 0x12, 0xfc, 0x09, 0xe7, 0xa6, 0xd9, 0x6c, 0xb9,
+		0x9b, 0x10, 0xf4, 0xbe, 0x04, 0xf4, 0xae, 0xaa, 0x96, 0x5a, 0xb3, 0x51, 0xa9, 0x61, 0xce, 0x21,
+		0x16, 0xa2, 0x1b, 0x1e, 0xea, 0xe7, 0xeb, 0x5e, 0x6d, 0xa6, 0x1c, 0x1f, 0x8e, 0x98, 0x72, 0xfc,
+		0x30, 0x0c, 0x91, 0x85, 0x11, 0xdf, 0x61, 0x16, 0x62, 0xed, 0xc1, 0xfc, 0xb8, 0xc7, 0x25, 0x58,
+		0xce, 0xd6, 0x4d, 0xae, 0xf9, 0x4f, 0xb3, 0xf4, 0x13, 0x0c, 0x2f, 0x30, 0x13, 0xf2, 0x03, 0xde,
+		0x02, 0x85, 0xaf, 0x50, 0x56, 0xc8, 0xd9, 0xee, 0x02, 0xfb, 0x40, 0x8b, 0x80, 0xb6, 0xdd, 0x35,
+		0xb2, 0xa2, 0x67, 0x4e, 0x42, 0x7f, 0xe4, 0xee, 0x9d, 0xc9, 0x71, 0x46, 0xdf, 0x88, 0x23, 0x2b,
+		0x23, 0x1e, 0x50, 0x70, 0x7b, 0x1c, 0x80, 0xe8, 0x55, 0x64, 0x07, 0xf9, 0xd9, 0xc4, 0x77, 0xff,
+		0xdd, 0x3b, 0x93, 0x23, 0x8c, 0x8b, 0x57, 0x26, 0x2b, 0x29, 0xf2, 0x31, 0x47, 0xfe, 0x47, 0xcb,
+		0x30, 0x4a, 0xe4, 0xf3, 0x72, 0xe5, 0x32, 0x36, 0x1d, 0xb6, 0x85, 0x3d, 0xe8, 0x1f, 0x5e, 0x23,
+		0x90, 0xc8, 0xcc, 0x47, 0xbd, 0xe5, 0xe6, 0xe2, 0x73, 0x04, 0x46, 0xe6, 0x9b, 0x04, 0xd5, 0x1b,
+		0x77, 0x31, 0xfb, 0x81, 0x86, 0x41, 0xff, 0x7c, 0x33, 0x8c, 0x21, 0x2b, 0x64, 0xad, 0x4b, 0xf1,
+		0x41, 0x7c, 0x3d, 0xee, 0x93, 0x12, 0x20, 0x6f, 0x28, 0x52, 0xb0, 0x6d, 0x1a, 0xba, 0x4d, 0xa7,
+		0x8a, 0xbe, 0x79, 0x9d, 0xd4, 0x7a, 0xaa, 0xe8, 0xd1, 0x8b, 0xa9, 0xa2, 0xaf, 0x07, 0x5f, 0xf0,
+		0xc2, 0x76, 0xac, 0xdd, 0x69, 0x7b, 0xee, 0xba, 0xe1, 0x38, 0xbd, 0x4f, 0xfe, 0x67, 0x12, 0x8c,
+		0x37, 0x78, 0xba, 0x2b, 0xec, 0x8f, 0x01, 0xb2, 0x7c, 0x85, 0xfc, 0x9d, 0x50, 0x26, 0x74, 0xd7,
+		0x1d, 0x67, 0xc4, 0x0a, 0x17, 0xec, 0xe1, 0xc8, 0xc3, 0xae, 0x73, 0xfc, 0x23, 0x09, 0xc6, 0xfc,
+		0xd5, 0xbb, 0x8a, 0x2c, 0xc3, 0x80, 0xbf, 0x76, 0xae, 0xc2, 0xfd, 0x9d, 0xa8, 0xc0, 0xa5, 0x0f,
+		0xd0, 0xa3, 0xa7, 0xbd, 0x30, 0xc2, 0x56, 0x77, 0x4f, 0x77, 0x6c, 0x0d, 0x21, 0x53, 0x38, 0x9c,
+		0x24, 0x68, 0x7b, 0xfc, 0x1f, 0x09, 0x12, 0xab, 0x86, 0x51, 0x45, 0x06, 0x8c, 0xe8, 0x86, 0x53,
+		0x24, 0x1e, 0x8f, 0xcb, 0xfe, 0x5b, 0x15, 0xa9, 0xfc, 0x6c, 0x77, 0x46, 0xfa, 0xe6, 0x9d, 0xc9,
+		0x46, 0x56, 0xca, 0xb0, 0x6e, 0x38, 0x79, 0x0a, 0xe1, 0x17, 0x2b, 0xde, 0x0a, 0x83, 0xc1, 0xca,
+		0x58, 0xf4, 0x7e, 0xa6, 0xeb, 0xca, 0x82, 0x6c, 0xee, 0xde, 0x99, 0x1c, 0xf3, 0x7a, 0xb2, 0x0b,
+		0x96, 0x95, 0x81, 0x4d, 0x5f, 0xed, 0xec, 0x00, 0xe2, 0xb7, 0x49, 0x1b, 0xbe, 0x9b, 0xb6, 0xa1,
+		0x9b, 0xfe, 0xd2, 0x87, 0x89, 0xe9, 0xca, 0xf2, 0x83, 0x81, 0xb3, 0x48, 0xf9, 0xf4, 0xdd, 0x3b,
+		0x93, 0x03, 0x62, 0x58, 0x2d, 0xe3, 0x5b, 0xb2, 0x38, 0x9d, 0x24, 0xd6, 0xc8, 0x63, 0xbb, 0x5f,
+		0x23, 0xe7, 0xee, 0xf4, 0x61, 0x09, 0x0e, 0x44, 0xcf, 0xd9, 0xd1, 0xc3, 0xc1, 0x53, 0x79, 0xa9,
+		0x3c, 0xba, 0x7b, 0x67, 0x72, 0x88, 0x89, 0xe3, 0x8e, 0x40, 0x7d, 0xaa, 0x37, 0xa9, 0xb8, 0xe9,
+		0xed, 0x51, 0xef, 0x62, 0x52, 0xc1, 0xa8, 0x73, 0xc9, 0x77, 0x8b, 0xe8, 0xf2, 0xe3, 0xd0, 0xef,
+		0x33, 0x12, 0xd9, 0xec, 0x66, 0xaf, 0x4a, 0xf2, 0x73, 0x5a, 0xf4, 0xa3, 0xd9, 0x1c, 0x24, 0xb6,
+		0xeb, 0x39, 0x88, 0x17, 0x37, 0x3e, 0xd4, 0x47, 0xe2, 0x46, 0xb3, 0xe5, 0x9c, 0x0d, 0xf0, 0x0e,
+		0x6a, 0x14, 0x5f, 0xc7, 0x86, 0x85, 0xb7, 0x3b, 0x4c, 0x3d, 0xe0, 0xcd, 0x30, 0xe2, 0x13, 0xca,
+		0x2e, 0xbe, 0x8e, 0x66, 0x4e, 0xfb, 0x19, 0x51, 0xe6, 0xb3, 0x24, 0xdb, 0x0b, 0x8e, 0x26, 0x6c,
+		0x48, 0xcc, 0xfa, 0xf3, 0xb7, 0xd0, 0x48, 0x32, 0xa4, 0x05, 0x87, 0x91, 0x17, 0xe0, 0xa0, 0xdf,
+		0x98, 0xec, 0xb1, 0x6d, 0xb6, 0xf3, 0xc0, 0xd2, 0x8d, 0x87, 0x9b, 0xc7, 0x89, 0x46, 0x97, 0xe7,
+		0x21, 0x62, 0xbf, 0x15, 0x51, 0xd6, 0xb0, 0x01, 0xd1, 0xb3, 0x97, 0x1b, 0x10, 0xe8, 0xbd, 0x12,
+		0x8c, 0x07, 0xf2, 0x07, 0xaa, 0x05, 0xdf, 0x87, 0xe1, 0x8b, 0xc9, 0x4a, 0xd7, 0x8b, 0xc9, 0x53,
+		0x11, 0x89, 0x89, 0x9f, 0xb1, 0xac, 0x1c, 0xf0, 0xe7, 0x28, 0x44, 0x4f, 0xb6, 0xd1, 0x83, 0x7e,
+		0x46, 0x82, 0xc3, 0xc1, 0xe1, 0xd7, 0xb2, 0xfd, 0x94, 0xec, 0xc7, 0x65, 0xf2, 0x1b, 0x5d, 0x8b,
+		0x74, 0x34, 0x6a, 0x68, 0x0f, 0xf2, 0x96, 0x95, 0xf1, 0xc0, 0x30, 0x6f, 0xd9, 0x3e, 0xc1, 0x7e,
+		0x42, 0x82, 0xfd, 0x84, 0xd8, 0x6b, 0x6a, 0x21, 0x11, 0x7d, 0x90, 0x33, 0xbf, 0xdc, 0xb5, 0x44,
+		0x87, 0x3d, 0x89, 0x1a, 0x98, 0xca, 0x0a, 0x22, 0x9b, 0x5f, 0xc2, 0x09, 0x98, 0x0c, 0x5e, 0xd2,
+		0x71, 0xf2, 0x73, 0x12, 0x80, 0xb7, 0xb1, 0x40, 0xf6, 0xb3, 0xf3, 0x2b, 0xcb, 0x73, 0xc5, 0xb5,
+		0xf5, 0x99, 0xf5, 0x8d, 0xb5, 0xe0, 0xf5, 0x2d, 0xb1, 0xfb, 0x6d, 0x9b, 0xb8, 0x44, 0x1f, 0x9c,
+		0x46, 0x0f, 0xc2, 0x58, 0x10, 0x9b, 0x7c, 0x91, 0xe7, 0xd1, 0xb3, 0x03, 0xaf, 0xdc, 0x9e, 0x4a,
+		0xb2, 0x09, 0x36, 0x26, 0x67, 0x07, 0xf7, 0x37, 0xe2, 0x91, 0xa7, 0x9b, 0x63, 0xd9, 0xc1, 0x57,
+		0x6e, 0x4f, 0xa5, 0xdc, 0x99, 0x38, 0x92, 0x01, 0xf9, 0x31, 0x39, 0xbf, 0x78, 0x16, 0x5e, 0xb9,
+		0x3d, 0xd5, 0xcb, 0x46, 0x9f, 0x6c, 0x82, 0xec, 0x71, 0xef, 0xf9, 0x25, 0xaf, 0x3f, 0xeb, 0x6b,
+		0xba, 0xa9, 0x5d, 0xc1, 0x3a, 0xb6, 0x35, 0x7b, 0x57, 0x9b, 0xda, 0x1d, 0x6d, 0x94, 0xcb, 0xff,
+		0xaa, 0x07, 0x06, 0xe6, 0x59, 0x2d, 0xa4, 0x21, 0x30, 0xfa, 0x21, 0xf2, 0xe4, 0x38, 0x99, 0x1b,
+		0xb8, 0xa7, 0x64, 0x9a, 0x44, 0x01, 0x36, 0x83, 0x70, 0x8f, 0x6a, 0xd3, 0x2f, 0x64, 0xf3, 0xb3,
+		0x9a, 0xec, 0x08, 0xb9, 0x77, 0x28, 0x7a, 0x20, 0xbf, 0xd0, 0xf5, 0x44, 0x94, 0x67, 0xb2, 0x61,
+		0x7e, 0x32, 0x3b, 0xf6, 0xb9, 0x4e, 0x20, 0xec, 0xf0, 0xf7, 0x3b, 0x25, 0xd8, 0x4f, 0xb1, 0xbc,
+		0x8e, 0x4a, 0x31, 0xc5, 0x0a, 0xce, 0xc9, 0x66, 0x2a, 0x2c, 0xaa, 0xb6, 0x77, 0x94, 0x93, 0xf2,
+		0xca, 0xdf, 0xcf, 0x03, 0xcf, 0x61, 0x5f, 0xe5, 0x61, 0xb6, 0xb2, 0x32, 0x5a, 0x6d, 0xa0, 0xb4,
+		0xd1, 0x7c, 0xe0, 0xbc, 0x7e, 0xa2, 0xbb, 0x9d, 0x74, 0x1f, 0x29, 0xba, 0x0a, 0xfd, 0x5e, 0x22,
+		0x66, 0xf3, 0x1f, 0x85, 0xeb, 0x3c, 0xf1, 0xf6, 0x13, 0xa3, 0x77, 0x49, 0xb0, 0xdf, 0x9b, 0xa2,
+		0xf9, 0xd9, 0xb2, 0x1f, 0xcf, 0x7b, 0xa8, 0x8b, 0xd5, 0xad, 0xb0, 0x71, 0x22, 0xf9, 0xca, 0xca,
+		0x98, 0x0b, 0x9f, 0xf3, 0x09, 0xb2, 0x4a, 0x7e, 0xb6, 0xc7, 0x5f, 0xbf, 0x78, 0xdd, 0xb9, 0xf3,
+		0xbc, 0x36, 0xc8, 0x80, 0xfd, 0xa0, 0x97, 0x69, 0x58, 0x0e, 0x2e, 0x67, 0x92, 0xfc, 0xb9, 0x42,
+		0xfe, 0x2d, 0x2f, 0x03, 0x6a, 0x6c, 0xdc, 0xf0, 0xfd, 0x04, 0xef, 0xea, 0x29, 0x49, 0x4a, 0xfc,
+		0x27, 0xf8, 0xd9, 0x87, 0x9b, 0xc3, 0xec, 0x7d, 0x9f, 0x7f, 0x2d, 0x06, 0x27, 0xfd, 0xa7, 0x3f,
+		0x5e, 0xac, 0x63, 0x6b, 0xc7, 0xed, 0xa2, 0xa6, 0x5a, 0xd1, 0x74, 0xff, 0x25, 0xc7, 0x71, 0xff,
+		0x6c, 0x89, 0xe2, 0x0a, 0x3b, 0x91, 0x1c, 0xb4, 0x7f, 0x55, 0xad, 0x60, 0x05, 0xbf, 0x58, 0xc7,
+		0xb6, 0x13, 0x71, 0x89, 0x8c, 0x5c, 0xf0, 0xda, 0xda, 0x12, 0x47, 0xd6, 0x12, 0x0a, 0xff, 0xa2,
+		0x89, 0x98, 0x46, 0x8e, 0xd5, 0xc5, 0x29, 0x98, 0x7d, 0x90, 0x47, 0x78, 0x4b, 0x46, 0x5d, 0xe7,
+		0x5d, 0x2e, 0x93, 0x10, 0xcf, 0xa8, 0xd5, 0x75, 0xd6, 0xe5, 0x88, 0x11, 0x2d, 0x4c, 0x8e, 0x96,
+		0xb3, 0x71, 0x3c, 0xa9, 0x88, 0x4f, 0xf9, 0x12, 0x0c, 0x30, 0x49, 0xf8, 0x4c, 0x66, 0x1c, 0x92,
+		0xf4, 0x20, 0xb5, 0x27, 0x4f, 0x1f, 0xf9, 0x7e, 0x8a, 0x5d, 0x45, 0x63, 0xfc, 0x99, 0x48, 0xec,
+		0x23, 0x9f, 0x6f, 0x6a, 0xe5, 0xe3, 0xed, 0xa3, 0x06, 0xb3, 0xa1, 0x6b, 0xe1, 0xdf, 0xef, 0x81,
+		0xfd, 0x2c, 0xbf, 0x38, 0xa5, 0x9a, 0xda, 0xa9, 0x6d, 0xc7, 0x11, 0x57, 0x23, 0x81, 0x81, 0xa7,
+		0x55, 0x53, 0x93, 0x77, 0x20, 0x71, 0xc5, 0x71, 0x4c, 0x74, 0x12, 0x7a, 0xc8, 0xb6, 0xa1, 0xd8,
+		0x32, 0x70, 0x77, 0xe3, 0x55, 0x53, 0x9b, 0x26, 0x08, 0x24, 0x55, 0x54, 0x18, 0x0a, 0x2a, 0xc0,
+		0xe4, 0x56, 0xbd, 0x5a, 0xdd, 0x21, 0xbf, 0xae, 0x68, 0x94, 0x71, 0xd1, 0xfd, 0x35, 0x2a, 0x7c,
+		0xcb, 0x54, 0xc5, 0x8b, 0xd4, 0xc4, 0x30, 0x87, 0x29, 0xda, 0x1c, 0xc5, 0x12, 0xbf, 0x44, 0x55,
+		0x10, 0x38, 0xf2, 0x1f, 0xc7, 0x20, 0x29, 0x58, 0x13, 0x5f, 0xb6, 0x71, 0x15, 0x97, 0x1c, 0x43,
+		0x9c, 0x95, 0x70, 0xbf, 0x11, 0x82, 0x78, 0x85, 0x37, 0x5e, 0xea, 0xca, 0x3e, 0x85, 0x7c, 0x10,
+		0x98, 0x7b, 0x63, 0x8f, 0xc0, 0xc8, 0x45, 0xbe, 0x31, 0x48, 0x98, 0x86, 0x58, 0x8a, 0xbb, 0xb2,
+		0x4f, 0xa1, 0x5f, 0x28, 0x03, 0xbd, 0xa4, 0xd3, 0x38, 0xac, 0xb5, 0x08, 0x9c, 0x7f, 0xa3, 0x03,
+		0x64, 0x23, 0xca, 0x29, 0xb1, 0x95, 0x08, 0x52, 0xc0, 0x3e, 0xd1, 0x79, 0xe8, 0x65, 0x0f, 0xae,
+		0x84, 0x7f, 0xa8, 0x8e, 0x18, 0x83, 0xbd, 0x6c, 0x4b, 0xe4, 0x5e, 0x55, 0x1d, 0x07, 0x5b, 0x3a,
+		0x61, 0xc8, 0xd0, 0xc9, 0x81, 0xbf, 0x4d, 0xa3, 0xbc, 0xc3, 0x7f, 0x3c, 0x8f, 0xfe, 0xcf, 0x7f,
+		0xad, 0x8b, 0xfa, 0x43, 0x91, 0x16, 0xb2, 0xdf, 0x0c, 0x1d, 0x10, 0xc0, 0x3c, 0x41, 0x2a, 0xc0,
+		0xa8, 0x5a, 0x2e, 0x6b, 0xec, 0x77, 0xec, 0x8a, 0x9b, 0x1a, 0x0d, 0x1e, 0x76, 0xa6, 0xbf, 0x45,
+		0x5b, 0x20, 0x8f, 0x20, 0xcf, 0xf1, 0xf3, 0x29, 0xf2, 0xdb, 0xb5, 0x54, 0x28, 0xf9, 0x22, 0x8c,
+		0x34, 0x48, 0x4a, 0xe4, 0xbb, 0xae, 0xe9, 0x65, 0x71, 0x8d, 0x91, 0xfc, 0x4f, 0x60, 0xf4, 0x2d,
+		0x6a, 0x76, 0x0a, 0x85, 0xfe, 0x9f, 0x7f, 0x7b, 0xf3, 0xdb, 0xae, 0x43, 0xbe, 0xdb, 0xae, 0xaa,
+		0xa9, 0xe5, 0x53, 0x94, 0x3f, 0xbf, 0xe3, 0x3a, 0xd3, 0x78, 0xc7, 0xb5, 0x82, 0x75, 0x31, 0x30,
+		0x93, 0x22, 0xd5, 0xd4, 0x6c, 0xea, 0x8e, 0xde, 0xdb, 0xd8, 0xf6, 0x45, 0xdf, 0xff, 0xf4, 0xca,
+		0x6b, 0x62, 0x7e, 0x66, 0x75, 0xc1, 0xf5, 0xe3, 0xdf, 0x8d, 0xc1, 0x61, 0x9f, 0x1f, 0xfb, 0x90,
+		0x1b, 0xdd, 0x39, 0x1b, 0xed, 0xf1, 0x1d, 0x3c, 0x3b, 0xf2, 0x14, 0x24, 0x08, 0x3e, 0x6a, 0xf3,
+		0x5b, 0x5a, 0x99, 0x5f, 0xfb, 0xe2, 0x3f, 0x90, 0xa7, 0xa4, 0xa6, 0xad, 0x42, 0x99, 0xe4, 0xdf,
+		0xd5, 0xb9, 0xfd, 0xd2, 0xde, 0xb3, 0xe0, 0xf6, 0xde, 0x99, 0x31, 0x6c, 0xc3, 0x2f, 0xbc, 0xa9,
+		0xe9, 0xb3, 0x14, 0x2c, 0x98, 0xb6, 0xce, 0xaf, 0xba, 0x88, 0xd4, 0xcd, 0x6e, 0xfe, 0xb5, 0x6a,
+		0xc1, 0x0e, 0x33, 0xb5, 0x5b, 0x70, 0xe0, 0x69, 0x52, 0xb7, 0xb7, 0x2c, 0x2a, 0x42, 0xfe, 0x01,
+		0xf7, 0x1c, 0x8f, 0xc4, 0x7f, 0x90, 0x57, 0x9c, 0xd1, 0x01, 0x4f, 0x3e, 0xbe, 0xf0, 0xf6, 0xe0,
+		0x74, 0xd3, 0xa1, 0x64, 0xda, 0x37, 0x8c, 0x28, 0x3e, 0x4a, 0xf9, 0x97, 0x25, 0x38, 0xd8, 0x50,
+		0x35, 0x8f, 0xf1, 0xf3, 0x11, 0x97, 0x14, 0x77, 0x95, 0xf4, 0xcc, 0x47, 0x08, 0x7b, 0xac, 0xad,
+		0xb0, 0x4c, 0x8a, 0x80, 0xb4, 0x6f, 0x82, 0xfd, 0x41, 0x61, 0x85, 0x99, 0x1e, 0xf0, 0xcf, 0xf4,
+		0xc9, 0xc0, 0xcf, 0xcd, 0x35, 0x18, 0xd8, 0x03, 0x94, 0x8b, 0x61, 0x3b, 0xbb, 0xba, 0x16, 0x20,
+		0xe5, 0xa2, 0xf2, 0xec, 0xb8, 0x63, 0x55, 0x3d, 0x4a, 0xf9, 0x03, 0x12, 0x4c, 0x05, 0x6b, 0xf0,
+		0xe5, 0x49, 0xdd, 0x09, 0xbb, 0x67, 0x4d, 0xfc, 0x0d, 0x09, 0xee, 0x6b, 0x21, 0x13, 0x37, 0xc0,
+		0x4b, 0x30, 0xe6, 0x5b, 0x61, 0x15, 0x21, 0x5c, 0x34, 0xfb, 0xc9, 0xf6, 0x19, 0xaa, 0xbb, 0xa0,
+		0x78, 0x88, 0x18, 0xe5, 0x33, 0xaf, 0x4d, 0x8e, 0x36, 0x96, 0xd9, 0xca, 0x68, 0xe3, 0xaa, 0xe8,
+		0x1e, 0xfa, 0xc7, 0xab, 0x12, 0x9c, 0x08, 0xaa, 0x1a, 0x91, 0xea, 0xbe, 0x51, 0xed, 0xf0, 0xef,
+		0x24, 0x38, 0xd9, 0x89, 0x70, 0xbc, 0x41, 0x36, 0x61, 0xd4, 0x4b, 0xc2, 0xc3, 0xed, 0xd1, 0x55,
+		0x6a, 0xcf, 0xbc, 0x14, 0xb9, 0xdc, 0xee, 0x81, 0xe1, 0x4d, 0xde, 0xb1, 0xfc, 0x4d, 0xee, 0x1a,
+		0x39, 0xb8, 0x7b, 0x27, 0x8c, 0x1c, 0xd8, 0xbf, 0x8b, 0x68, 0x8b, 0x58, 0x44, 0x5b, 0x78, 0x59,
+		0xbb, 0x7c, 0x03, 0x0e, 0x36, 0xd4, 0xc8, 0x2d, 0xf7, 0x66, 0x18, 0x8d, 0x70, 0x65, 0xde, 0xab,
+		0xbb, 0xf0, 0x64, 0x05, 0x35, 0x3a, 0xab, 0xbc, 0x03, 0x93, 0xb4, 0xde, 0x08, 0x43, 0xdf, 0x6b,
+		0x95, 0x6b, 0x30, 0xd5, 0xbc, 0x6a, 0xae, 0xfb, 0x02, 0xf4, 0xb2, 0x76, 0xe6, 0xea, 0xee, 0xc2,
+		0x51, 0x38, 0x03, 0xf9, 0x23, 0x22, 0x96, 0xcd, 0x09, 0xb1, 0xa3, 0xfb, 0x50, 0x27, 0xba, 0xee,
+		0x51, 0x1f, 0xf2, 0x19, 0xe3, 0xcb, 0x22, 0xaa, 0x45, 0x4b, 0xc7, 0xcd, 0x51, 0xda, 0xb3, 0xa8,
+		0xc6, 0x6c, 0x73, 0x6f, 0xc3, 0xd7, 0x2f, 0x8a, 0xf0, 0xe5, 0xea, 0xd4, 0x26, 0x7c, 0xbd, 0x31,
+		0xa6, 0x77, 0x03, 0x59, 0x1b, 0x31, 0x7f, 0x10, 0x03, 0xd9, 0xb7, 0x25, 0x18, 0xa7, 0xba, 0xf9,
+		0xd7, 0x28, 0xba, 0x35, 0xf9, 0xc3, 0x80, 0xc8, 0xc1, 0x82, 0xc8, 0xde, 0x9d, 0xb6, 0xad, 0xd2,
+		0xb5, 0xc0, 0xf8, 0xf2, 0x30, 0xa0, 0xb2, 0xed, 0x84, 0xb1, 0xd9, 0xf9, 0xf8, 0x74, 0xd9, 0x76,
+		0x82, 0xd8, 0xc1, 0xe6, 0x4c, 0xec, 0x41, 0x73, 0x7e, 0x49, 0x82, 0x6c, 0x94, 0xca, 0xbc, 0xf9,
+		0x34, 0x38, 0x10, 0xd8, 0x7c, 0x0d, 0xb7, 0xe0, 0xc3, 0x9d, 0xac, 0xf2, 0x84, 0xba, 0xd1, 0x7e,
+		0x0b, 0xdf, 0xeb, 0x3c, 0x60, 0x32, 0xe8, 0xa1, 0x8d, 0x99, 0xf5, 0x1b, 0xd6, 0x7d, 0x3e, 0xdb,
+		0x10, 0x57, 0x7f, 0x20, 0x72, 0xef, 0x5b, 0x30, 0xd1, 0x44, 0xea, 0x7b, 0x3d, 0xee, 0x6d, 0x37,
+		0x6d, 0xcc, 0xbd, 0x4e, 0xdf, 0x1f, 0xe7, 0x3d, 0x21, 0x78, 0xf7, 0xca, 0x37, 0x17, 0x8b, 0xba,
+		0xbc, 0x2d, 0x3f, 0x07, 0x87, 0x22, 0xa9, 0xb8, 0x6c, 0x39, 0x48, 0x90, 0xe3, 0x26, 0x19, 0x29,
+		0xe8, 0x3b, 0x61, 0xb1, 0x42, 0xd4, 0x94, 0x46, 0x46, 0x90, 0xa6, 0xac, 0xc9, 0x5e, 0x3c, 0x17,
+		0x43, 0x7e, 0x0a, 0x46, 0x7c, 0x30, 0x5e, 0xc9, 0x39, 0xb2, 0x40, 0x64, 0x54, 0xdd, 0x17, 0x4e,
+		0x9a, 0x2d, 0xec, 0x1b, 0x46, 0x95, 0xab, 0x4d, 0xf1, 0xe5, 0x31, 0x40, 0x8c, 0x19, 0x5d, 0xe3,
+		0x17, 0x55, 0xac, 0xc1, 0x68, 0x00, 0xca, 0x2b, 0x79, 0x5d, 0xfb, 0x07, 0xf2, 0x0d, 0x38, 0xcc,
+		0xc3, 0x8c, 0xb7, 0x8d, 0x48, 0x5e, 0xa7, 0xb8, 0xd7, 0xee, 0xa3, 0xc3, 0x91, 0x26, 0xf5, 0x72,
+		0xb5, 0x96, 0xc8, 0xa9, 0x0c, 0xb7, 0x4c, 0xf4, 0xb6, 0xa3, 0x1d, 0x6c, 0x91, 0x7a, 0x87, 0x32,
+		0x3c, 0x72, 0xf9, 0x1f, 0x4a, 0x90, 0x09, 0x55, 0x88, 0xdd, 0xa8, 0x73, 0x02, 0xd2, 0xe1, 0x6d,
+		0x6e, 0xae, 0xe6, 0x70, 0x68, 0xa3, 0xbb, 0x43, 0x45, 0x43, 0x01, 0x2a, 0xbe, 0x07, 0x01, 0xea,
+		0x2b, 0xde, 0x18, 0xe8, 0x57, 0xe0, 0x2f, 0xd1, 0x44, 0xf1, 0xcc, 0x3f, 0x1e, 0x87, 0x1e, 0xaa,
+		0x22, 0x7a, 0x55, 0x0a, 0xbc, 0x60, 0xd9, 0xf4, 0xd2, 0x44, 0xf4, 0xfa, 0x4c, 0xf6, 0x54, 0xc7,
+		0xf8, 0x7c, 0xfe, 0xf0, 0xd0, 0xdb, 0xff, 0xe5, 0xd7, 0x3f, 0x18, 0x7b, 0x00, 0x1d, 0x3d, 0xa5,
+		0x95, 0xcc, 0xaa, 0xfa, 0x92, 0xda, 0xb0, 0x34, 0xe4, 0x0b, 0xde, 0x9f, 0x0e, 0x3c, 0xc1, 0xf4,
+		0x48, 0x67, 0x75, 0x09, 0xd1, 0xa6, 0x3b, 0x45, 0xe7, 0x92, 0xfd, 0x10, 0x95, 0xec, 0x1c, 0x7a,
+		0xbc, 0x03, 0xc9, 0x4e, 0xbd, 0x25, 0xe8, 0x9a, 0x6f, 0x43, 0xff, 0x5a, 0x82, 0xb1, 0xa8, 0x05,
+		0x06, 0xf4, 0x44, 0x67, 0x62, 0x34, 0x26, 0xb8, 0xd9, 0x0b, 0xbb, 0xa0, 0xe4, 0xba, 0x5c, 0xa1,
+		0xba, 0xe4, 0xd1, 0x0f, 0xef, 0x46, 0x97, 0x53, 0xfe, 0x9d, 0xa8, 0xff, 0x25, 0xc1, 0x91, 0x96,
+		0x13, 0x76, 0x34, 0xd3, 0x99, 0x98, 0x2d, 0x52, 0xf9, 0x6c, 0xfe, 0xf5, 0xb0, 0xe0, 0x2a, 0x2b,
+		0x54, 0xe5, 0x45, 0x74, 0x75, 0x57, 0x2a, 0x47, 0xee, 0xf7, 0xa1, 0xcf, 0x4b, 0x81, 0xab, 0x12,
+		0xad, 0x3d, 0xaa, 0x61, 0x22, 0x9c, 0x3d, 0xd5, 0x31, 0x3e, 0xd7, 0xe1, 0x39, 0xaa, 0xc3, 0x1a,
+		0x7a, 0xfa, 0xf5, 0x36, 0xdb, 0xa9, 0xb7, 0x04, 0x87, 0x92, 0xb7, 0xa1, 0xbf, 0x90, 0xa2, 0x6f,
+		0x2a, 0x9c, 0x6f, 0x29, 0x63, 0xf3, 0x59, 0x7e, 0xf6, 0x89, 0xee, 0x09, 0xb9, 0x96, 0x3a, 0xd5,
+		0x72, 0x1b, 0x6d, 0xed, 0xb9, 0x96, 0x91, 0xcd, 0x88, 0xbe, 0x28, 0xc1, 0x58, 0xd4, 0x2c, 0xb9,
+		0x4d, 0xd7, 0x6c, 0x31, 0xed, 0x6f, 0xd3, 0x35, 0x5b, 0x4d, 0xc9, 0xe5, 0x27, 0xa9, 0xf6, 0xe7,
+		0xd1, 0xd9, 0xa6, 0xda, 0xb7, 0x6c, 0x47, 0xd2, 0x1f, 0x5b, 0xce, 0x3b, 0xdb, 0xf4, 0xc7, 0x4e,
+		0xa6, 0xd6, 0x6d, 0xfa, 0x63, 0x47, 0xd3, 0xde, 0x0e, 0xfa, 0xa3, 0xab, 0x5a, 0x87, 0x0d, 0x69,
+		0xa3, 0xdf, 0x93, 0x60, 0x30, 0x30, 0x4b, 0x43, 0xa7, 0x5b, 0x4a, 0x1a, 0x35, 0x89, 0xcd, 0x9e,
+		0xe9, 0x86, 0x84, 0x2b, 0x73, 0x95, 0x2a, 0x33, 0x87, 0xf2, 0xbb, 0x52, 0x26, 0xb8, 0xb7, 0xff,
+		0x65, 0x09, 0x46, 0x23, 0xa6, 0x3e, 0x6d, 0x7a, 0x62, 0xf3, 0x99, 0x5c, 0xf6, 0x89, 0xee, 0x09,
+		0xb9, 0x5a, 0xf3, 0x54, 0xad, 0x19, 0x74, 0x69, 0x57, 0x6a, 0xf9, 0x06, 0xea, 0xd7, 0xbc, 0x53,
+		0xd6, 0xbe, 0x8a, 0xd0, 0xb9, 0x2e, 0x25, 0x13, 0x1a, 0x9d, 0xef, 0x9a, 0x8e, 0x2b, 0xf4, 0x2c,
+		0x55, 0x48, 0x41, 0xab, 0xaf, 0x53, 0xa1, 0xc6, 0xf1, 0xfd, 0xb3, 0x8d, 0x4f, 0x52, 0xb4, 0x76,
+		0xa4, 0xc8, 0x39, 0x54, 0xf6, 0xb1, 0xae, 0x68, 0xb8, 0x56, 0x17, 0xa8, 0x56, 0x8f, 0xa1, 0xd3,
+		0x4d, 0xb5, 0xf2, 0x1d, 0xf2, 0xd7, 0xf4, 0x2d, 0xe3, 0xd4, 0x5b, 0xd8, 0xd4, 0xec, 0x6d, 0xe8,
+		0xed, 0xe2, 0x20, 0xf3, 0xf1, 0x96, 0x15, 0xfb, 0xe6, 0x57, 0xd9, 0x13, 0x1d, 0x60, 0x72, 0xc1,
+		0x1e, 0xa0, 0x82, 0x4d, 0xa2, 0x23, 0x4d, 0x05, 0x23, 0x93, 0x2c, 0xf4, 0x8a, 0xe4, 0x5e, 0xca,
+		0x38, 0xd9, 0x9a, 0xb9, 0x7f, 0x16, 0x96, 0x7d, 0xa8, 0x23, 0x5c, 0x2e, 0xca, 0x31, 0x2a, 0xca,
+		0x7d, 0x68, 0xb2, 0xb9, 0x28, 0x4c, 0x82, 0x3f, 0x91, 0x20, 0x1d, 0x9e, 0x0a, 0xa1, 0xc7, 0xdb,
+		0xc4, 0x84, 0xc8, 0x19, 0x5b, 0xf6, 0x6c, 0x97, 0x54, 0x5c, 0xd4, 0x2d, 0x2a, 0xea, 0x5f, 0x41,
+		0x3f, 0xb6, 0xf7, 0xe3, 0x9f, 0x7f, 0x22, 0x86, 0xee, 0x48, 0xe4, 0xb2, 0x91, 0x37, 0x85, 0x41,
+		0x8f, 0x76, 0x28, 0xaf, 0x3b, 0x5d, 0xcb, 0x9e, 0xee, 0x82, 0x82, 0x6b, 0xa7, 0x51, 0xed, 0x4a,
+		0x48, 0xdd, 0x95, 0x76, 0x7e, 0x05, 0x4e, 0xbd, 0x25, 0x3c, 0x55, 0xf4, 0x97, 0x63, 0x7b, 0xcf,
+		0x4f, 0x2e, 0xfd, 0xd2, 0x83, 0x30, 0xd9, 0x64, 0xbf, 0xda, 0xb9, 0xd5, 0x66, 0x23, 0xbd, 0xc5,
+		0x3b, 0x3b, 0x6d, 0xdf, 0xd1, 0xd9, 0xeb, 0xdf, 0x86, 0xe8, 0x70, 0xd7, 0xfd, 0xe7, 0x7b, 0x01,
+		0x2d, 0xd9, 0x95, 0x59, 0x0b, 0xb3, 0xdf, 0xa9, 0xe7, 0x41, 0x3b, 0xf4, 0x80, 0x84, 0xf4, 0xba,
+		0x1e, 0x90, 0x58, 0x0a, 0x3c, 0xc9, 0x10, 0xeb, 0xee, 0xd9, 0x97, 0x8e, 0xdf, 0x65, 0x88, 0x7f,
+		0x6f, 0xde, 0x65, 0x88, 0xbc, 0xac, 0x97, 0xd8, 0xbb, 0x5b, 0xbd, 0x3d, 0xbb, 0xbd, 0x2a, 0xcd,
+		0x9f, 0x5b, 0xe9, 0x6d, 0xf1, 0xdc, 0x4a, 0xa6, 0xe9, 0x9b, 0x2a, 0x9c, 0x1a, 0x9d, 0x15, 0xbf,
+		0x9f, 0xd0, 0xd7, 0xd9, 0x35, 0x26, 0x86, 0xdd, 0xee, 0xb1, 0x8a, 0xe4, 0x1b, 0xf0, 0x58, 0x45,
+		0xea, 0x75, 0x3d, 0x56, 0xe1, 0x5b, 0x09, 0x3a, 0x0c, 0xd9, 0xc6, 0x0e, 0xe2, 0x46, 0xba, 0xff,
+		0x99, 0x80, 0xf4, 0x92, 0x5d, 0x29, 0x94, 0x35, 0xe7, 0x1e, 0xf5, 0x9e, 0x4b, 0xcd, 0xef, 0x7e,
+		0x47, 0x5d, 0x74, 0x69, 0xf4, 0x8d, 0x1a, 0x0c, 0x87, 0xde, 0x5e, 0xe2, 0x7d, 0x65, 0x6e, 0x37,
+		0x4f, 0x40, 0x85, 0x58, 0xc9, 0xca, 0x90, 0x07, 0xa1, 0x97, 0x37, 0x6e, 0x45, 0x77, 0x4f, 0xd6,
+		0x45, 0xae, 0xdc, 0xcb, 0xae, 0xb9, 0xd4, 0xd4, 0x19, 0x58, 0xa7, 0x3a, 0x78, 0xf7, 0xce, 0xe4,
+		0x28, 0x63, 0xd9, 0xc9, 0x7b, 0x25, 0xad, 0xdf, 0x17, 0xe9, 0x7d, 0xa3, 0xde, 0x17, 0xf1, 0x3c,
+		0x33, 0x0b, 0x99, 0xb0, 0xeb, 0xb9, 0x7e, 0xf9, 0x5b, 0x31, 0xe8, 0x5f, 0xb2, 0xc5, 0xa4, 0x0c,
+		0x7f, 0x9f, 0x3e, 0x46, 0x70, 0xde, 0xfd, 0x79, 0xa8, 0x78, 0x67, 0xf1, 0x86, 0xa3, 0xef, 0xfd,
+		0x93, 0x0e, 0x9e, 0x55, 0xf7, 0xc3, 0xa8, 0xcf, 0x70, 0xae, 0x41, 0xff, 0x20, 0x46, 0x07, 0xca,
+		0x3c, 0xae, 0x90, 0x2c, 0xae, 0x7c, 0x0f, 0xec, 0xfa, 0x83, 0x74, 0x77, 0xdb, 0x6b, 0xb8, 0x44,
+		0x57, 0x0d, 0xe7, 0xb3, 0xf3, 0x75, 0xc8, 0x36, 0xda, 0xd3, 0xb7, 0x1f, 0xd1, 0xf0, 0xb2, 0x80,
+		0xd4, 0xc5, 0xf3, 0xad, 0xa1, 0xf7, 0x03, 0xc8, 0xf9, 0xaf, 0xc1, 0x25, 0xbb, 0xb2, 0xa1, 0x97,
+		0xff, 0x72, 0x76, 0x08, 0x9f, 0x5d, 0xb7, 0x60, 0x7f, 0x40, 0xd3, 0x7b, 0x65, 0xd2, 0x8f, 0xc7,
+		0x60, 0xb2, 0x21, 0xfc, 0x84, 0x86, 0xe7, 0xc8, 0xb1, 0x4b, 0xea, 0x62, 0xec, 0x6a, 0xbc, 0xbd,
+		0x18, 0xbb, 0x67, 0xb7, 0x17, 0xe3, 0x7b, 0x73, 0x7b, 0xd1, 0xd7, 0x14, 0x27, 0xe0, 0x58, 0x1b,
+		0x0b, 0xb9, 0xe1, 0xe5, 0x76, 0x94, 0x35, 0x3d, 0xce, 0x7b, 0x63, 0xcd, 0x88, 0x7b, 0x95, 0xb1,
+		0xbd, 0xbc, 0x57, 0x19, 0xdf, 0xe3, 0x7b, 0x95, 0x6d, 0x4c, 0x19, 0x34, 0x8f, 0x6b, 0xca, 0xf7,
+		0xc5, 0xe0, 0xd0, 0x92, 0x5d, 0x59, 0xc3, 0x8e, 0x7f, 0xdd, 0xd9, 0xc5, 0xfd, 0x3e, 0xed, 0xf9,
+		0x4d, 0x46, 0xb4, 0xf8, 0x1e, 0x8c, 0x68, 0x0f, 0xc0, 0xd1, 0x16, 0xf6, 0x10, 0x76, 0x3b, 0xf3,
+		0x5a, 0x1f, 0xc4, 0x97, 0xec, 0x0a, 0x79, 0x6b, 0x25, 0x3c, 0x1d, 0x6c, 0xba, 0x62, 0xd3, 0x98,
+		0x19, 0x67, 0xcf, 0x74, 0x8e, 0xeb, 0x86, 0xa6, 0xeb, 0x30, 0x18, 0xcc, 0xa0, 0x8f, 0xb7, 0x60,
+		0x12, 0xc0, 0xcc, 0x3e, 0xda, 0x29, 0xa6, 0x5b, 0xd9, 0x8f, 0x40, 0x92, 0x1b, 0x02, 0xa3, 0xa3,
+		0x2d, 0xa8, 0x05, 0x52, 0xf6, 0xa1, 0x0e, 0x90, 0x5c, 0xee, 0x2f, 0xc2, 0x70, 0x38, 0x47, 0x68,
+		0x65, 0xbd, 0x10, 0x6e, 0xf6, 0x4c, 0xe7, 0xb8, 0xbe, 0xc3, 0x65, 0xe0, 0x1b, 0xd8, 0x1e, 0x68,
+		0xc1, 0xc1, 0x43, 0xcb, 0x3e, 0xd2, 0x11, 0x9a, 0x5b, 0xc7, 0x87, 0x25, 0x38, 0xdc, 0x32, 0xd4,
+		0x9f, 0xef, 0xb8, 0x1d, 0x82, 0x84, 0xd9, 0x4b, 0xbb, 0x24, 0x6c, 0x21, 0x5a, 0x28, 0x6e, 0x76,
+		0x2e, 0x5a, 0x90, 0x30, 0x7b, 0x69, 0x97, 0x84, 0xae, 0x68, 0x7f, 0x5d, 0x82, 0x4c, 0xd3, 0x38,
+		0xf4, 0x58, 0x0b, 0xee, 0xcd, 0x88, 0xb2, 0x17, 0x77, 0x41, 0xe4, 0x1e, 0x36, 0xd8, 0xe3, 0xb5,
+		0xb2, 0xff, 0x3b, 0x00, 0x78, 0xca, 0x68, 0xfc, 0xfe, 0xab, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2260,6 +2422,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MaxIncentiveDepth != that1.MaxIncentiveDepth {
 		return false
 	}
+	if this.MaxRecommandees != that1.MaxRecommandees {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2343,6 +2508,33 @@ func (this *RecommanderClassRate) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *IncentiveTeamRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IncentiveTeamRecipient)
+	if !ok {
+		that2, ok := that.(IncentiveTeamRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (this *ReallocatedCommissionRule) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2382,6 +2574,15 @@ func (this *ReallocatedCommissionRule) Equal(that interface{}) bool {
 	if !this.UpdateTime.Equal(that1.UpdateTime) {
 		return false
 	}
+	if !this.MaxValidatorRateChange.Equal(that1.MaxValidatorRateChange) {
+		return false
+	}
+	if !this.MaxRecommandersRateChange.Equal(that1.MaxRecommandersRateChange) {
+		return false
+	}
+	if !this.MaxClassRateChange.Equal(that1.MaxClassRateChange) {
+		return false
+	}
 	return true
 }
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
@@ -2603,6 +2804,20 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IncentiveTeamRecipients) > 0 {
+		for iNdEx := len(m.IncentiveTeamRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveTeamRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	{
 		size := m.Delegators.Size()
 		i -= size
@@ -3212,6 +3427,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRecommandees != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxRecommandees))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.MaxIncentiveDepth != 0 {
 		i = encodeVarintStaking(dAtA, i, uint64(m.MaxIncentiveDepth))
 		i--
@@ -3464,6 +3684,81 @@ func (m *RecommanderClassRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IncentiveTeamRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveTeamRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveTeamRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recommander) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recommander) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recommander) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecommanderAddress) > 0 {
+		i -= len(m.RecommanderAddress)
+		copy(dAtA[i:], m.RecommanderAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.RecommanderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Level != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ReallocatedCommissionRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3484,6 +3779,36 @@ func (m *ReallocatedCommissionRule) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxClassRateChange.Size()
+		i -= size
+		if _, err := m.MaxClassRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxRecommandersRateChange.Size()
+		i -= size
+		if _, err := m.MaxRecommandersRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxValidatorRateChange.Size()
+		i -= size
+		if _, err := m.MaxValidatorRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
 	if err16 != nil {
 		return 0, err16
@@ -3662,6 +3987,12 @@ func (m *Validator) Size() (n int) {
 	}
 	l = m.Delegators.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if len(m.IncentiveTeamRecipients) > 0 {
+		for _, e := range m.IncentiveTeamRecipients {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3881,6 +4212,9 @@ func (m *Params) Size() (n int) {
 	if m.MaxIncentiveDepth != 0 {
 		n += 1 + sovStaking(uint64(m.MaxIncentiveDepth))
 	}
+	if m.MaxRecommandees != 0 {
+		n += 1 + sovStaking(uint64(m.MaxRecommandees))
+	}
 	return n
 }
 
@@ -3954,6 +4288,37 @@ func (m *RecommanderClassRate) Size() (n int) {
 	return n
 }
 
+func (m *IncentiveTeamRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *Recommander) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovStaking(uint64(m.Level))
+	}
+	l = len(m.RecommanderAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func (m *ReallocatedCommissionRule) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3975,6 +4340,12 @@ func (m *ReallocatedCommissionRule) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxValidatorRateChange.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxRecommandersRateChange.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxClassRateChange.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -5052,6 +5423,40 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveTeamRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveTeamRecipients = append(m.IncentiveTeamRecipients, IncentiveTeamRecipient{})
+			if err := m.IncentiveTeamRecipients[len(m.IncentiveTeamRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6601,11 +7006,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecommandees", wireType)
+			}
+			m.MaxRecommandees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecommandees |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthStaking
@@ -7193,6 +7617,223 @@ func (m *RecommanderClassRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IncentiveTeamRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveTeamRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveTeamRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recommander) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recommander: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recommander: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommanderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommanderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ReallocatedCommissionRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7376,6 +8017,108 @@ func (m *ReallocatedCommissionRule) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecommandersRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRecommandersRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClassRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxClassRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
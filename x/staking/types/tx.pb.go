@@ -130,6 +130,9 @@ type MsgEditValidator struct {
 	CommissionRate       *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate,omitempty" yaml:"commission_rate"`
 	MinSelfDelegation    *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation,omitempty" yaml:"min_self_delegation"`
 	IncentiveTeamAddress string                                  `protobuf:"bytes,5,opt,name=incentive_team_address,json=incentiveTeamAddress,proto3" json:"incentive_team_address,omitempty" yaml:"team_address"`
+	// incentive_team_recipients replaces the team recipients of the validator
+	// when not empty, it cannot be set along with incentive_team_address.
+	IncentiveTeamRecipients []IncentiveTeamRecipient `protobuf:"bytes,6,rep,name=incentive_team_recipients,json=incentiveTeamRecipients,proto3" json:"incentive_team_recipients" yaml:"incentive_team_recipients"`
 }
 
 func (m *MsgEditValidator) Reset()         { *m = MsgEditValidator{} }
@@ -614,6 +617,84 @@ func (m *MsgEditValidatorRecommanderRuleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditValidatorRecommanderRuleResponse proto.InternalMessageInfo
 
+// MsgSetDelegationRecommander defines a SDK message for changing the recommander
+// of an existing delegation. An empty recommander clears it.
+type MsgSetDelegationRecommander struct {
+	DelegatorAddress   string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress   string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	RecommanderAddress string `protobuf:"bytes,3,opt,name=recommander_address,json=recommanderAddress,proto3" json:"recommander_address,omitempty" yaml:"recommander_address"`
+}
+
+func (m *MsgSetDelegationRecommander) Reset()         { *m = MsgSetDelegationRecommander{} }
+func (m *MsgSetDelegationRecommander) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegationRecommander) ProtoMessage()    {}
+func (*MsgSetDelegationRecommander) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{14}
+}
+func (m *MsgSetDelegationRecommander) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDelegationRecommander) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDelegationRecommander.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDelegationRecommander) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegationRecommander.Merge(m, src)
+}
+func (m *MsgSetDelegationRecommander) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDelegationRecommander) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegationRecommander.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDelegationRecommander proto.InternalMessageInfo
+
+// MsgSetDelegationRecommanderResponse defines the Msg/SetDelegationRecommander response type.
+type MsgSetDelegationRecommanderResponse struct {
+}
+
+func (m *MsgSetDelegationRecommanderResponse) Reset()         { *m = MsgSetDelegationRecommanderResponse{} }
+func (m *MsgSetDelegationRecommanderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDelegationRecommanderResponse) ProtoMessage()    {}
+func (*MsgSetDelegationRecommanderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{15}
+}
+func (m *MsgSetDelegationRecommanderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDelegationRecommanderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDelegationRecommanderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDelegationRecommanderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDelegationRecommanderResponse.Merge(m, src)
+}
+func (m *MsgSetDelegationRecommanderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDelegationRecommanderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDelegationRecommanderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDelegationRecommanderResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -629,84 +710,92 @@ func init() {
 	proto.RegisterType((*MsgEditValidatorRCommissionRuleResponse)(nil), "cosmos.staking.v1beta1.MsgEditValidatorRCommissionRuleResponse")
 	proto.RegisterType((*MsgEditValidatorRecommanderRule)(nil), "cosmos.staking.v1beta1.MsgEditValidatorRecommanderRule")
 	proto.RegisterType((*MsgEditValidatorRecommanderRuleResponse)(nil), "cosmos.staking.v1beta1.MsgEditValidatorRecommanderRuleResponse")
+	proto.RegisterType((*MsgSetDelegationRecommander)(nil), "cosmos.staking.v1beta1.MsgSetDelegationRecommander")
+	proto.RegisterType((*MsgSetDelegationRecommanderResponse)(nil), "cosmos.staking.v1beta1.MsgSetDelegationRecommanderResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0xa9, 0x49, 0x27, 0xca, 0xd7, 0x3a, 0x49, 0x9d, 0x25, 0x78, 0xc3, 0x16, 0x68,
-	0x80, 0x66, 0x4d, 0x82, 0x50, 0xa5, 0x5e, 0xaa, 0x3a, 0xa6, 0xa2, 0x2a, 0x16, 0x68, 0x9b, 0x82,
-	0x04, 0x48, 0xd6, 0x78, 0x77, 0xb2, 0x5d, 0xbc, 0x1f, 0xee, 0xce, 0x38, 0xc4, 0x12, 0x3f, 0x80,
-	0x63, 0x8f, 0x5c, 0x10, 0xf9, 0x11, 0x48, 0xfc, 0x85, 0x8a, 0x03, 0xca, 0x11, 0x71, 0x30, 0x28,
-	0xb9, 0xf4, 0xec, 0x5f, 0x80, 0x76, 0x76, 0x76, 0x76, 0xbd, 0x5e, 0xbb, 0x76, 0x95, 0x5c, 0x38,
-	0xd9, 0x9a, 0x79, 0xde, 0x67, 0xde, 0x79, 0xde, 0xaf, 0x59, 0x20, 0xeb, 0x1e, 0x76, 0x3c, 0x5c,
-	0xc5, 0x04, 0xb6, 0x2d, 0xd7, 0xac, 0x1e, 0xef, 0xb5, 0x10, 0x81, 0x7b, 0x55, 0x72, 0xa2, 0x76,
-	0x7c, 0x8f, 0x78, 0xe2, 0x46, 0x08, 0x50, 0x19, 0x40, 0x65, 0x00, 0x69, 0xd3, 0xf4, 0x3c, 0xd3,
-	0x46, 0x55, 0x8a, 0x6a, 0x75, 0x8f, 0xaa, 0xd0, 0xed, 0x85, 0x26, 0x92, 0x9c, 0xde, 0x22, 0x96,
-	0x83, 0x30, 0x81, 0x4e, 0x87, 0x01, 0xd6, 0x4c, 0xcf, 0xf4, 0xe8, 0xdf, 0x6a, 0xf0, 0x8f, 0xad,
-	0x6e, 0x86, 0x27, 0x35, 0xc3, 0x0d, 0x76, 0x6c, 0xb8, 0x55, 0x61, 0x5e, 0xb6, 0x20, 0x46, 0xdc,
-	0x45, 0xdd, 0xb3, 0x5c, 0xb6, 0xff, 0xce, 0x98, 0x5b, 0x44, 0x4e, 0x53, 0x94, 0xf2, 0x6b, 0x11,
-	0x88, 0x0d, 0x6c, 0x1e, 0xf8, 0x08, 0x12, 0xf4, 0x15, 0xb4, 0x2d, 0x03, 0x12, 0xcf, 0x17, 0x1f,
-	0x81, 0x05, 0x03, 0x61, 0xdd, 0xb7, 0x3a, 0xc4, 0xf2, 0xdc, 0xb2, 0xb0, 0x2d, 0xec, 0x2c, 0xec,
-	0xdf, 0x54, 0xb3, 0xef, 0xad, 0xd6, 0x63, 0x68, 0x6d, 0xee, 0x45, 0x5f, 0xce, 0x69, 0x49, 0x6b,
-	0xb1, 0x01, 0x80, 0xee, 0x39, 0x8e, 0x85, 0x71, 0xc0, 0x95, 0xa7, 0x5c, 0xb7, 0xc6, 0x71, 0x1d,
-	0x70, 0xa4, 0x06, 0x09, 0xc2, 0x8c, 0x2f, 0x41, 0x20, 0xfe, 0x08, 0x4a, 0x8e, 0xe5, 0x36, 0x31,
-	0xb2, 0x8f, 0x9a, 0x06, 0xb2, 0x91, 0x09, 0xa9, 0x8f, 0x85, 0x6d, 0x61, 0xe7, 0x7a, 0xed, 0xf3,
-	0x00, 0xfe, 0x77, 0x5f, 0x7e, 0xcf, 0xb4, 0xc8, 0xd3, 0x6e, 0x4b, 0xd5, 0x3d, 0x87, 0xc9, 0xc6,
-	0x7e, 0x76, 0xb1, 0xd1, 0xae, 0x92, 0x5e, 0x07, 0x61, 0xf5, 0xa1, 0x4b, 0x06, 0x7d, 0x59, 0xea,
-	0x41, 0xc7, 0xbe, 0xab, 0x64, 0x50, 0x2a, 0xda, 0xaa, 0x63, 0xb9, 0x8f, 0x91, 0x7d, 0x54, 0xe7,
-	0x6b, 0xe2, 0x43, 0xb0, 0xca, 0x10, 0x9e, 0xdf, 0x84, 0x86, 0xe1, 0x23, 0x8c, 0xcb, 0x73, 0xf4,
-	0xec, 0xad, 0x41, 0x5f, 0x2e, 0x87, 0x6c, 0x23, 0x10, 0x45, 0x5b, 0xe1, 0x6b, 0xf7, 0xc3, 0xa5,
-	0x80, 0xea, 0x38, 0x52, 0x9c, 0x53, 0x5d, 0x4b, 0x53, 0x8d, 0x40, 0x14, 0x6d, 0x85, 0xaf, 0x45,
-	0x54, 0x0f, 0x40, 0xb1, 0xd3, 0x6d, 0xb5, 0x51, 0xaf, 0x5c, 0xa4, 0xf2, 0xae, 0xa9, 0x61, 0xbe,
-	0xa9, 0x51, 0xbe, 0xa9, 0xf7, 0xdd, 0x5e, 0xad, 0xfc, 0xc7, 0x6f, 0xbb, 0x6b, 0x4c, 0x77, 0xdd,
-	0xef, 0x75, 0x88, 0xa7, 0x7e, 0xd9, 0x6d, 0x3d, 0x42, 0x3d, 0x8d, 0x59, 0x8b, 0x9f, 0x80, 0x6b,
-	0xc7, 0xd0, 0xee, 0xa2, 0xf2, 0x1b, 0x94, 0x66, 0x33, 0x8a, 0x52, 0x90, 0x64, 0x89, 0x10, 0x59,
-	0x51, 0x9c, 0x43, 0xb4, 0xf8, 0x03, 0x78, 0xd3, 0x47, 0xd0, 0xb6, 0x3d, 0x1d, 0x12, 0x64, 0x34,
-	0xe3, 0x60, 0x35, 0xfd, 0xae, 0x8d, 0xca, 0xf3, 0x94, 0x6c, 0x6f, 0x5c, 0xc8, 0xb5, 0xd8, 0x34,
-	0x11, 0xfd, 0xae, 0x8d, 0xd8, 0x21, 0x9b, 0xfe, 0x38, 0x80, 0xf8, 0x35, 0xd8, 0xb0, 0x5c, 0x1d,
-	0xb9, 0xc4, 0x3a, 0x46, 0x4d, 0x82, 0xa0, 0xc3, 0x75, 0xbc, 0x4e, 0x75, 0x7c, 0x7b, 0xd0, 0x97,
-	0xdf, 0x0a, 0x75, 0xcc, 0xc6, 0x29, 0xda, 0x1a, 0xdf, 0x38, 0x44, 0xd0, 0x61, 0x82, 0xde, 0x9d,
-	0xff, 0xe9, 0x54, 0xce, 0xbd, 0x3c, 0x95, 0x73, 0xca, 0x16, 0x90, 0x46, 0x0b, 0x44, 0x43, 0xb8,
-	0xe3, 0xb9, 0x18, 0x29, 0xe7, 0x05, 0xb0, 0xd2, 0xc0, 0xe6, 0xa7, 0x86, 0x45, 0xae, 0xa8, 0x7a,
-	0xee, 0x65, 0x65, 0x49, 0x9e, 0xde, 0x4e, 0x1c, 0xf4, 0xe5, 0xa5, 0xf0, 0x76, 0x13, 0x72, 0xc3,
-	0x01, 0xcb, 0xc9, 0x80, 0x40, 0x82, 0x58, 0xad, 0xd4, 0xa7, 0xac, 0x93, 0x3a, 0xd2, 0x07, 0x7d,
-	0x79, 0x23, 0x3c, 0x28, 0x45, 0xa5, 0x68, 0x4b, 0xfa, 0x50, 0xc5, 0x8a, 0x27, 0xd9, 0xe5, 0x19,
-	0x96, 0xc8, 0x67, 0x57, 0x59, 0x9a, 0x8d, 0xb1, 0xc9, 0x10, 0x16, 0xd5, 0x8d, 0x41, 0x5f, 0x2e,
-	0x85, 0x94, 0xb3, 0xa5, 0x80, 0x04, 0xca, 0xe9, 0x18, 0xf3, 0x04, 0xf8, 0x3d, 0x0f, 0x16, 0x1a,
-	0xd8, 0x64, 0x6e, 0xa0, 0xec, 0xfe, 0x20, 0x5c, 0x5e, 0x7f, 0xc8, 0xbf, 0x56, 0x7f, 0xb8, 0x03,
-	0x8a, 0xd0, 0xf1, 0xba, 0x2e, 0xa1, 0xa1, 0x9f, 0xa2, 0xb0, 0x19, 0x5c, 0xfc, 0x02, 0x94, 0x7c,
-	0x14, 0x44, 0x18, 0xba, 0x06, 0x4a, 0x37, 0xbc, 0x4a, 0x1c, 0xa3, 0x0c, 0x90, 0xa2, 0x89, 0x89,
-	0xd5, 0x51, 0x55, 0xd7, 0x41, 0x29, 0x21, 0x1c, 0x17, 0xf4, 0xcf, 0x3c, 0x9d, 0x48, 0x35, 0x64,
-	0x5a, 0xae, 0x86, 0x8c, 0x2b, 0xd0, 0xf5, 0x10, 0xac, 0xc7, 0xa2, 0x61, 0x5f, 0x4f, 0x69, 0xbb,
-	0x3d, 0xe8, 0xcb, 0x5b, 0x69, 0x6d, 0x13, 0x30, 0x45, 0x2b, 0xf1, 0xf5, 0xc7, 0xbe, 0x9e, 0xc9,
-	0x6a, 0x60, 0xc2, 0x59, 0x0b, 0xe3, 0x59, 0x13, 0xb0, 0x24, 0x6b, 0x1d, 0x93, 0xd1, 0xc0, 0xcd,
-	0xcd, 0x14, 0xb8, 0x84, 0xce, 0x6d, 0xda, 0xc0, 0x52, 0x7a, 0x46, 0x72, 0x8b, 0x0d, 0xda, 0x1d,
-	0x3a, 0x36, 0x0a, 0x4a, 0xa8, 0x19, 0xbc, 0x4a, 0x58, 0xbf, 0x92, 0x46, 0x46, 0xc8, 0x61, 0xf4,
-	0x64, 0xa9, 0xcd, 0x07, 0x47, 0x3d, 0xff, 0x47, 0x16, 0x68, 0xf5, 0x33, 0xe3, 0x60, 0x5b, 0x79,
-	0x29, 0x80, 0xc5, 0x06, 0x36, 0x9f, 0xb8, 0xc6, 0xff, 0xb3, 0x20, 0x12, 0xba, 0x1e, 0x81, 0xf5,
-	0xa1, 0x9b, 0x5e, 0x95, 0xa4, 0xa7, 0x79, 0x20, 0x8f, 0xb4, 0x9f, 0xd4, 0x1c, 0xcc, 0x1c, 0x12,
-	0xc2, 0x0c, 0x43, 0xe2, 0x09, 0x58, 0x8a, 0x09, 0xe8, 0x8c, 0x08, 0x75, 0x55, 0x67, 0x78, 0x4f,
-	0xd5, 0x91, 0xae, 0x2d, 0x72, 0x16, 0x3a, 0x0c, 0xbe, 0x05, 0xab, 0x89, 0x1e, 0x80, 0x93, 0xd3,
-	0x67, 0x56, 0xe6, 0x95, 0x24, 0x51, 0x40, 0x9e, 0x08, 0xc5, 0xfb, 0xe0, 0xd6, 0x2b, 0x14, 0xe2,
-	0xed, 0xe5, 0x97, 0x2c, 0x35, 0x63, 0xe6, 0xcb, 0x51, 0xf3, 0x00, 0x2c, 0xc7, 0x93, 0xc8, 0x40,
-	0x1d, 0xf2, 0x94, 0xca, 0xb9, 0x58, 0x93, 0xe2, 0x41, 0x9a, 0x02, 0x28, 0xda, 0x12, 0x5f, 0xa9,
-	0x07, 0x0b, 0xe2, 0xf7, 0xe0, 0x46, 0xb2, 0xab, 0xea, 0x36, 0xc4, 0xa1, 0x80, 0x41, 0x4b, 0x29,
-	0xec, 0x2c, 0xec, 0xdf, 0x1e, 0xff, 0xa0, 0xe2, 0x66, 0x07, 0x81, 0x55, 0xa0, 0x16, 0x4b, 0xe3,
-	0x75, 0x3f, 0x63, 0x0f, 0xbf, 0x42, 0xca, 0x61, 0x79, 0x22, 0x29, 0xf7, 0xcf, 0x8a, 0xa0, 0xd0,
-	0xc0, 0xa6, 0xf8, 0x0c, 0x2c, 0xa7, 0xbf, 0x1f, 0x3e, 0x18, 0xe7, 0xda, 0xe8, 0x53, 0x4a, 0xda,
-	0x9f, 0x1e, 0xcb, 0x4b, 0xac, 0x0d, 0x16, 0x87, 0x9f, 0x5c, 0x3b, 0x13, 0x48, 0x86, 0x90, 0xd2,
-	0x47, 0xd3, 0x22, 0xf9, 0x61, 0xdf, 0x81, 0x79, 0x3e, 0xde, 0x6f, 0x4e, 0xb0, 0x8e, 0x40, 0xd2,
-	0x87, 0x53, 0x80, 0x38, 0xfb, 0x33, 0xb0, 0x9c, 0x9e, 0x75, 0x93, 0xd4, 0x4b, 0x61, 0x27, 0xaa,
-	0x37, 0xae, 0xe7, 0xb7, 0x00, 0x48, 0x34, 0xe8, 0x77, 0x27, 0x30, 0xc4, 0x30, 0x69, 0x77, 0x2a,
-	0x18, 0x3f, 0xe3, 0x67, 0x01, 0x6c, 0x4d, 0x6c, 0x59, 0x77, 0xa6, 0x8e, 0xc3, 0xb0, 0xa1, 0x74,
-	0xef, 0x35, 0x0d, 0x27, 0xb8, 0x96, 0xaa, 0xff, 0xe9, 0x5d, 0x1b, 0x36, 0x9c, 0xc1, 0xb5, 0xec,
-	0x92, 0xaa, 0x3d, 0x78, 0x71, 0x5e, 0x11, 0xce, 0xce, 0x2b, 0xc2, 0xbf, 0xe7, 0x15, 0xe1, 0xf9,
-	0x45, 0x25, 0x77, 0x76, 0x51, 0xc9, 0xfd, 0x75, 0x51, 0xc9, 0x7d, 0x73, 0x7b, 0x62, 0x9b, 0x3c,
-	0xe1, 0x9f, 0xf9, 0xb4, 0x61, 0xb6, 0x8a, 0x74, 0xc2, 0x7c, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xd6, 0x9b, 0x7b, 0x0b, 0xcb, 0x10, 0x00, 0x00,
+	// 1244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1f, 0xf6, 0xda, 0xa9, 0x9b, 0x4e, 0x94, 0xb7, 0xcd, 0x9b, 0xb3, 0xcd, 0xdf, 0x9b, 0xff, 0x86,
+	0xd2, 0x00, 0xcd, 0x9a, 0xa4, 0x42, 0x95, 0xca, 0x21, 0xaa, 0x63, 0x2a, 0xa2, 0x62, 0x81, 0x36,
+	0x29, 0x48, 0x80, 0x64, 0x8d, 0x77, 0x27, 0xdb, 0x25, 0xfb, 0xe2, 0xee, 0x8c, 0x43, 0x2c, 0xf1,
+	0x01, 0x38, 0x20, 0xd4, 0x03, 0x07, 0x2e, 0x88, 0x7c, 0x08, 0x24, 0xbe, 0x42, 0xc5, 0x01, 0xf5,
+	0x88, 0x38, 0xb8, 0x28, 0xb9, 0xf4, 0xec, 0x1b, 0x37, 0xb4, 0x6f, 0xb3, 0xe3, 0xf5, 0xae, 0xeb,
+	0x44, 0x89, 0x84, 0x38, 0x65, 0x35, 0xf3, 0xfc, 0x9e, 0x99, 0x79, 0x7e, 0xaf, 0x31, 0x10, 0x55,
+	0x07, 0x5b, 0x0e, 0xae, 0x60, 0x02, 0x0f, 0x0d, 0x5b, 0xaf, 0x1c, 0x6d, 0x36, 0x11, 0x81, 0x9b,
+	0x15, 0x72, 0x2c, 0xb7, 0x5c, 0x87, 0x38, 0xfc, 0x62, 0x00, 0x90, 0x43, 0x80, 0x1c, 0x02, 0x84,
+	0x65, 0xdd, 0x71, 0x74, 0x13, 0x55, 0x7c, 0x54, 0xb3, 0x7d, 0x50, 0x81, 0x76, 0x27, 0x30, 0x11,
+	0xc4, 0xe4, 0x16, 0x31, 0x2c, 0x84, 0x09, 0xb4, 0x5a, 0x21, 0x60, 0x5e, 0x77, 0x74, 0xc7, 0xff,
+	0xac, 0x78, 0x5f, 0xe1, 0xea, 0x72, 0x70, 0x52, 0x23, 0xd8, 0x08, 0x8f, 0x0d, 0xb6, 0xca, 0xe1,
+	0x2d, 0x9b, 0x10, 0x23, 0x7a, 0x45, 0xd5, 0x31, 0xec, 0x70, 0xff, 0x8d, 0x8c, 0x57, 0x44, 0x97,
+	0xf6, 0x51, 0xd2, 0xcf, 0x45, 0xc0, 0xd7, 0xb1, 0xbe, 0xe3, 0x22, 0x48, 0xd0, 0xa7, 0xd0, 0x34,
+	0x34, 0x48, 0x1c, 0x97, 0x7f, 0x04, 0x26, 0x34, 0x84, 0x55, 0xd7, 0x68, 0x11, 0xc3, 0xb1, 0x4b,
+	0xdc, 0x2a, 0xb7, 0x3e, 0xb1, 0xb5, 0x26, 0xa7, 0xbf, 0x5b, 0xae, 0xc5, 0xd0, 0xea, 0xd8, 0xf3,
+	0xae, 0x98, 0x53, 0x58, 0x6b, 0xbe, 0x0e, 0x80, 0xea, 0x58, 0x96, 0x81, 0xb1, 0xc7, 0x95, 0xf7,
+	0xb9, 0x6e, 0x67, 0x71, 0xed, 0x50, 0xa4, 0x02, 0x09, 0xc2, 0x21, 0x1f, 0x43, 0xc0, 0x7f, 0x03,
+	0xe6, 0x2c, 0xc3, 0x6e, 0x60, 0x64, 0x1e, 0x34, 0x34, 0x64, 0x22, 0x1d, 0xfa, 0x77, 0x2c, 0xac,
+	0x72, 0xeb, 0x37, 0xaa, 0x1f, 0x79, 0xf0, 0x3f, 0xbb, 0xe2, 0x9b, 0xba, 0x41, 0x9e, 0xb4, 0x9b,
+	0xb2, 0xea, 0x58, 0xa1, 0x6c, 0xe1, 0x9f, 0x0d, 0xac, 0x1d, 0x56, 0x48, 0xa7, 0x85, 0xb0, 0xbc,
+	0x6b, 0x93, 0x5e, 0x57, 0x14, 0x3a, 0xd0, 0x32, 0xef, 0x4b, 0x29, 0x94, 0x92, 0x32, 0x6b, 0x19,
+	0xf6, 0x1e, 0x32, 0x0f, 0x6a, 0x74, 0x8d, 0xdf, 0x05, 0xb3, 0x21, 0xc2, 0x71, 0x1b, 0x50, 0xd3,
+	0x5c, 0x84, 0x71, 0x69, 0xcc, 0x3f, 0x7b, 0xa5, 0xd7, 0x15, 0x4b, 0x01, 0xdb, 0x00, 0x44, 0x52,
+	0x66, 0xe8, 0xda, 0x83, 0x60, 0xc9, 0xa3, 0x3a, 0x8a, 0x14, 0xa7, 0x54, 0xd7, 0x92, 0x54, 0x03,
+	0x10, 0x49, 0x99, 0xa1, 0x6b, 0x11, 0xd5, 0x43, 0x50, 0x6c, 0xb5, 0x9b, 0x87, 0xa8, 0x53, 0x2a,
+	0xfa, 0xf2, 0xce, 0xcb, 0x41, 0xbc, 0xc9, 0x51, 0xbc, 0xc9, 0x0f, 0xec, 0x4e, 0xb5, 0xf4, 0xdb,
+	0x2f, 0x1b, 0xf3, 0xa1, 0xee, 0xaa, 0xdb, 0x69, 0x11, 0x47, 0xfe, 0xa4, 0xdd, 0x7c, 0x84, 0x3a,
+	0x4a, 0x68, 0xcd, 0xbf, 0x07, 0xae, 0x1d, 0x41, 0xb3, 0x8d, 0x4a, 0xd7, 0x7d, 0x9a, 0xe5, 0xc8,
+	0x4b, 0x5e, 0x90, 0x31, 0x2e, 0x32, 0x22, 0x3f, 0x07, 0x68, 0xfe, 0x6b, 0x70, 0xd3, 0x45, 0xd0,
+	0x34, 0x1d, 0x15, 0x12, 0xa4, 0x35, 0x62, 0x67, 0x35, 0xdc, 0xb6, 0x89, 0x4a, 0xe3, 0x3e, 0xd9,
+	0x66, 0x96, 0xcb, 0x95, 0xd8, 0x94, 0xf1, 0x7e, 0xdb, 0x44, 0xe1, 0x21, 0xcb, 0x6e, 0x16, 0x80,
+	0xff, 0x0c, 0x2c, 0x1a, 0xb6, 0x8a, 0x6c, 0x62, 0x1c, 0xa1, 0x06, 0x41, 0xd0, 0xa2, 0x3a, 0xde,
+	0xf0, 0x75, 0xfc, 0x7f, 0xaf, 0x2b, 0xfe, 0x2f, 0xd0, 0x31, 0x1d, 0x27, 0x29, 0xf3, 0x74, 0x63,
+	0x1f, 0x41, 0x2b, 0x14, 0xf4, 0xfe, 0xf8, 0xb7, 0x27, 0x62, 0xee, 0xd5, 0x89, 0x98, 0x93, 0x56,
+	0x80, 0x30, 0x98, 0x20, 0x0a, 0xc2, 0x2d, 0xc7, 0xc6, 0x48, 0xfa, 0x7b, 0x0c, 0xcc, 0xd4, 0xb1,
+	0xfe, 0x81, 0x66, 0x90, 0x2b, 0xca, 0x9e, 0xed, 0xb4, 0x28, 0xc9, 0xfb, 0xaf, 0xe3, 0x7b, 0x5d,
+	0x71, 0x2a, 0x78, 0xdd, 0x90, 0xd8, 0xb0, 0xc0, 0x34, 0xeb, 0x10, 0x48, 0x50, 0x98, 0x2b, 0xb5,
+	0x11, 0xf3, 0xa4, 0x86, 0xd4, 0x5e, 0x57, 0x5c, 0x0c, 0x0e, 0x4a, 0x50, 0x49, 0xca, 0x94, 0xda,
+	0x97, 0xb1, 0xfc, 0x71, 0x7a, 0x7a, 0x06, 0x29, 0xf2, 0xe1, 0x55, 0xa6, 0x66, 0x3d, 0x33, 0x18,
+	0x82, 0xa4, 0x5a, 0xea, 0x75, 0xc5, 0xb9, 0x80, 0x72, 0x84, 0x10, 0xe0, 0x7f, 0xe0, 0xc0, 0x72,
+	0x82, 0xcf, 0x45, 0xaa, 0xd1, 0x32, 0x90, 0x4d, 0x70, 0xa9, 0xb8, 0x5a, 0x58, 0x9f, 0xd8, 0x92,
+	0xb3, 0x9c, 0xba, 0xcb, 0x32, 0x2a, 0x91, 0x59, 0x75, 0xdd, 0xf3, 0x6f, 0xaf, 0x2b, 0xae, 0xa6,
+	0xc6, 0x64, 0x4c, 0x2f, 0x29, 0x4b, 0x46, 0x2a, 0x03, 0x1b, 0x99, 0x02, 0x28, 0x25, 0x43, 0x8f,
+	0xc6, 0xe5, 0xaf, 0x79, 0x30, 0x51, 0xc7, 0x7a, 0xa8, 0x0e, 0x4a, 0x2f, 0x5b, 0xdc, 0xe5, 0x95,
+	0xad, 0xfc, 0x85, 0xca, 0xd6, 0x3d, 0x50, 0x84, 0x96, 0xd3, 0xb6, 0x49, 0xa9, 0x30, 0x5a, 0xbd,
+	0x09, 0xe1, 0xfc, 0xc7, 0x60, 0xce, 0x45, 0x5e, 0xe0, 0x41, 0x5b, 0x43, 0xc9, 0x3a, 0x5c, 0x8e,
+	0x43, 0x27, 0x05, 0x24, 0x29, 0x3c, 0xb3, 0x3a, 0x98, 0xef, 0x0b, 0x60, 0x8e, 0x11, 0x8e, 0x0a,
+	0xfa, 0x7b, 0xde, 0x6f, 0x94, 0x55, 0xa4, 0x1b, 0xb6, 0x82, 0xb4, 0x2b, 0xd0, 0x75, 0x1f, 0x2c,
+	0xc4, 0xa2, 0x61, 0x57, 0x4d, 0x68, 0xbb, 0xda, 0xeb, 0x8a, 0x2b, 0x49, 0x6d, 0x19, 0x98, 0xa4,
+	0xcc, 0xd1, 0xf5, 0x3d, 0x57, 0x4d, 0x65, 0xd5, 0x30, 0xa1, 0xac, 0x85, 0x6c, 0x56, 0x06, 0xc6,
+	0xb2, 0xd6, 0x30, 0x19, 0x74, 0xdc, 0xd8, 0xb9, 0x1c, 0xc7, 0xe8, 0x7c, 0x08, 0x84, 0x41, 0x3d,
+	0x23, 0xb9, 0xf9, 0xba, 0x5f, 0xb4, 0x5a, 0x26, 0xf2, 0x32, 0xbb, 0xe1, 0x0d, 0x4b, 0x61, 0x19,
+	0x15, 0x06, 0x3a, 0xdb, 0x7e, 0x34, 0x49, 0x55, 0xc7, 0xbd, 0xa3, 0x9e, 0xbd, 0x14, 0x39, 0x65,
+	0x2a, 0x36, 0xf6, 0xb6, 0xa5, 0x57, 0x1c, 0x98, 0xac, 0x63, 0xfd, 0xb1, 0xad, 0xfd, 0x37, 0x13,
+	0x82, 0xd1, 0xf5, 0x00, 0x2c, 0xf4, 0xbd, 0xf4, 0xaa, 0x24, 0x3d, 0xc9, 0x03, 0x71, 0xa0, 0xfc,
+	0x24, 0xda, 0x73, 0x6a, 0xef, 0xe2, 0xce, 0xd1, 0xbb, 0x1e, 0x83, 0xa9, 0x98, 0xc0, 0x6f, 0x5d,
+	0x81, 0xae, 0xf2, 0x39, 0xc6, 0xbc, 0x1a, 0x52, 0x95, 0x49, 0xca, 0xe2, 0xf7, 0xa8, 0x2f, 0xc0,
+	0x2c, 0x53, 0x03, 0x30, 0xdb, 0x14, 0xcf, 0xcb, 0x3c, 0xc3, 0x12, 0x79, 0xe4, 0x8c, 0x2b, 0xde,
+	0x02, 0xb7, 0x5f, 0xa3, 0x10, 0x2d, 0x2f, 0x3f, 0xa5, 0xa9, 0x19, 0x33, 0x5f, 0x8e, 0x9a, 0x3b,
+	0x60, 0x3a, 0xee, 0x38, 0x1a, 0x6a, 0x91, 0x27, 0xbe, 0x9c, 0x93, 0x55, 0x21, 0xee, 0xef, 0x09,
+	0x80, 0xa4, 0x4c, 0xd1, 0x95, 0x9a, 0xb7, 0xc0, 0x7f, 0x05, 0x96, 0xd8, 0xaa, 0xaa, 0x9a, 0x10,
+	0x07, 0x02, 0x7a, 0x25, 0xc5, 0xeb, 0x89, 0x77, 0xb2, 0xe7, 0x3c, 0x6a, 0xb6, 0xe3, 0x59, 0x79,
+	0x6a, 0x85, 0x61, 0xbc, 0xe0, 0xa6, 0xec, 0xe1, 0xd7, 0x48, 0xd9, 0x2f, 0x0f, 0x95, 0xf2, 0xfb,
+	0x3c, 0xb8, 0x59, 0xc7, 0xfa, 0x1e, 0x22, 0xf1, 0x6c, 0xc0, 0x60, 0xff, 0xa5, 0x99, 0x9f, 0xd1,
+	0xd1, 0x0a, 0x97, 0xd0, 0xd1, 0x6e, 0x81, 0xb5, 0x21, 0x7a, 0x44, 0xba, 0x6d, 0xbd, 0xbc, 0x0e,
+	0x0a, 0x75, 0xac, 0xf3, 0x4f, 0xc1, 0x74, 0xf2, 0xdf, 0xc1, 0xb7, 0xb3, 0x5c, 0x3a, 0x38, 0x19,
+	0x0b, 0x5b, 0xa3, 0x63, 0x69, 0x69, 0x3a, 0x04, 0x93, 0xfd, 0x13, 0xf4, 0xfa, 0x10, 0x92, 0x3e,
+	0xa4, 0xf0, 0xee, 0xa8, 0x48, 0x7a, 0xd8, 0x97, 0x60, 0x9c, 0x8e, 0x45, 0x6b, 0x43, 0xac, 0x23,
+	0x90, 0xf0, 0xce, 0x08, 0x20, 0xca, 0xfe, 0x14, 0x4c, 0x27, 0x67, 0x84, 0x61, 0xea, 0x25, 0xb0,
+	0xc2, 0xd6, 0xe8, 0x58, 0x7a, 0x64, 0x13, 0x00, 0xa6, 0xb1, 0xdd, 0x1a, 0xc2, 0x10, 0xc3, 0x84,
+	0x8d, 0x91, 0x60, 0xf4, 0x8c, 0x1f, 0x39, 0xb0, 0x32, 0xb4, 0xd4, 0xdf, 0x1b, 0xd9, 0x0f, 0xfd,
+	0x86, 0xc2, 0xf6, 0x05, 0x0d, 0x87, 0x5c, 0x2d, 0x51, 0x37, 0x47, 0xbf, 0x5a, 0xbf, 0xa1, 0xb0,
+	0x7d, 0x41, 0x43, 0x7a, 0xb5, 0xef, 0x38, 0x50, 0xca, 0xac, 0x43, 0x77, 0x87, 0xb0, 0x67, 0x19,
+	0x09, 0xef, 0x5f, 0xc0, 0x28, 0xba, 0x4e, 0xf5, 0xe1, 0xf3, 0xd3, 0x32, 0xf7, 0xe2, 0xb4, 0xcc,
+	0xfd, 0x75, 0x5a, 0xe6, 0x9e, 0x9d, 0x95, 0x73, 0x2f, 0xce, 0xca, 0xb9, 0x3f, 0xce, 0xca, 0xb9,
+	0xcf, 0xef, 0x0c, 0xed, 0x76, 0xc7, 0xf4, 0x47, 0x24, 0xbf, 0xef, 0x35, 0x8b, 0xfe, 0xa0, 0x70,
+	0xf7, 0x9f, 0x01, 0x00, 0x1c, 0xe6, 0xcd, 0xd2, 0x29, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -740,6 +829,9 @@ type MsgClient interface {
 	// EditValidatorRecommanderRule defines a method for performing to edit recommander rule
 	// from a validator
 	EditValidatorRecommanderRule(ctx context.Context, in *MsgEditValidatorRecommanderRule, opts ...grpc.CallOption) (*MsgEditValidatorRecommanderRuleResponse, error)
+	// SetDelegationRecommander defines a method for changing the recommander
+	// of an existing delegation.
+	SetDelegationRecommander(ctx context.Context, in *MsgSetDelegationRecommander, opts ...grpc.CallOption) (*MsgSetDelegationRecommanderResponse, error)
 }
 
 type msgClient struct {
@@ -813,6 +905,15 @@ func (c *msgClient) EditValidatorRecommanderRule(ctx context.Context, in *MsgEdi
 	return out, nil
 }
 
+func (c *msgClient) SetDelegationRecommander(ctx context.Context, in *MsgSetDelegationRecommander, opts ...grpc.CallOption) (*MsgSetDelegationRecommanderResponse, error) {
+	out := new(MsgSetDelegationRecommanderResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/SetDelegationRecommander", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -834,6 +935,9 @@ type MsgServer interface {
 	// EditValidatorRecommanderRule defines a method for performing to edit recommander rule
 	// from a validator
 	EditValidatorRecommanderRule(context.Context, *MsgEditValidatorRecommanderRule) (*MsgEditValidatorRecommanderRuleResponse, error)
+	// SetDelegationRecommander defines a method for changing the recommander
+	// of an existing delegation.
+	SetDelegationRecommander(context.Context, *MsgSetDelegationRecommander) (*MsgSetDelegationRecommanderResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -861,6 +965,9 @@ func (*UnimplementedMsgServer) EditValidatorRCommissionRule(ctx context.Context,
 func (*UnimplementedMsgServer) EditValidatorRecommanderRule(ctx context.Context, req *MsgEditValidatorRecommanderRule) (*MsgEditValidatorRecommanderRuleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditValidatorRecommanderRule not implemented")
 }
+func (*UnimplementedMsgServer) SetDelegationRecommander(ctx context.Context, req *MsgSetDelegationRecommander) (*MsgSetDelegationRecommanderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDelegationRecommander not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -992,6 +1099,24 @@ func _Msg_EditValidatorRecommanderRule_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDelegationRecommander_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDelegationRecommander)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDelegationRecommander(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/SetDelegationRecommander",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDelegationRecommander(ctx, req.(*MsgSetDelegationRecommander))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1024,6 +1149,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditValidatorRecommanderRule",
 			Handler:    _Msg_EditValidatorRecommanderRule_Handler,
 		},
+		{
+			MethodName: "SetDelegationRecommander",
+			Handler:    _Msg_SetDelegationRecommander_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1178,6 +1307,20 @@ func (m *MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IncentiveTeamRecipients) > 0 {
+		for iNdEx := len(m.IncentiveTeamRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveTeamRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IncentiveTeamAddress) > 0 {
 		i -= len(m.IncentiveTeamAddress)
 		copy(dAtA[i:], m.IncentiveTeamAddress)
@@ -1637,6 +1780,73 @@ func (m *MsgEditValidatorRecommanderRuleResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetDelegationRecommander) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegationRecommander) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegationRecommander) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecommanderAddress) > 0 {
+		i -= len(m.RecommanderAddress)
+		copy(dAtA[i:], m.RecommanderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecommanderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDelegationRecommanderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDelegationRecommanderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDelegationRecommanderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1716,6 +1926,12 @@ func (m *MsgEditValidator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.IncentiveTeamRecipients) > 0 {
+		for _, e := range m.IncentiveTeamRecipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1881,6 +2097,36 @@ func (m *MsgEditValidatorRecommanderRuleResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetDelegationRecommander) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecommanderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDelegationRecommanderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2483,6 +2729,40 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 			}
 			m.IncentiveTeamAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveTeamRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveTeamRecipients = append(m.IncentiveTeamRecipients, IncentiveTeamRecipient{})
+			if err := m.IncentiveTeamRecipients[len(m.IncentiveTeamRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3660,6 +3940,202 @@ func (m *MsgEditValidatorRecommanderRuleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetDelegationRecommander) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDelegationRecommander: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDelegationRecommander: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommanderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecommanderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDelegationRecommanderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDelegationRecommanderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDelegationRecommanderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
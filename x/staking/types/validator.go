@@ -57,9 +57,9 @@ func NewValidator(operator sdk.ValAddress, pubKey cryptotypes.PubKey, descriptio
 		UnbondingTime:     time.Unix(0, 0).UTC(),
 		Commission:        NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
 		MinSelfDelegation: sdk.OneInt(),
-		ReallocatedCommissionRule: NewReallocatedCommissionRule(sdk.OneDec(), sdk.ZeroDec(), uint32(0), []RecommanderClassRate{}),
-		IncentiveTeamAddress: sdk.AccAddress(operator).String(),
+		ReallocatedCommissionRule: NewReallocatedCommissionRule(sdk.OneDec(), sdk.ZeroDec(), uint32(0), nil),
 		Delegators: sdk.ZeroInt(),
+		IncentiveTeamRecipients: NewSingleIncentiveTeam(sdk.AccAddress(operator)),
 	}, nil
 }
 
@@ -183,16 +183,34 @@ func (v Validator) IsUnbonding() bool {
 // constant used in flags to indicate that description field should not be updated
 const DoNotModifyDesc = "[do-not-modify]"
 
-// UpdateIncentiveTeamAddress updates the fields of a given incentive team address. An error is
-// returned if the resulting incentive team address contains an invalid 
-func (v Validator) UpdateIncentiveTeamAddress(t2 string) (string, error) {
-	if t2 == DoNotModifyDesc {
-		t2 = v.IncentiveTeamAddress
-	}else if _, err := sdk.AccAddressFromBech32(t2); err != nil {
-		return t2, err
+// UpdateIncentiveTeam returns the incentive team recipients of the validator
+// updated either with a single address, which receives the whole team
+// commission, or with a list of recipients. The recipients are kept when the
+// address is not modified and the list is empty. An error is returned if the
+// resulting recipients are invalid.
+func (v Validator) UpdateIncentiveTeam(address string, recipients []IncentiveTeamRecipient) ([]IncentiveTeamRecipient, error) {
+	switch {
+	case len(recipients) > 0:
+		if address != DoNotModifyDesc && address != "" {
+			return nil, sdkerrors.Wrap(ErrInvalidIncentiveTeamRecipients, "both incentive team address and recipients provided")
+		}
+
+	case address == DoNotModifyDesc || address == "":
+		return v.GetIncentiveTeamRecipients(), nil
+
+	default:
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		recipients = NewSingleIncentiveTeam(addr)
+	}
+
+	if err := IncentiveTeamRecipients(recipients).Validate(); err != nil {
+		return nil, err
 	}
 
-	return t2, nil
+	return recipients, nil
 }
 
 func NewDescription(moniker, identity, website, securityContact, details string) Description {
@@ -321,7 +339,8 @@ func (v Validator) SetInitialReallocatedCommissionRule(reallocatedCommissionRule
 }
 
 func (v Validator) SetInitialIncentiveTeam(incentiveTeamAddr sdk.AccAddress) (Validator, error) {
-	v.IncentiveTeamAddress = incentiveTeamAddr.String()
+	v.IncentiveTeamAddress = ""
+	v.IncentiveTeamRecipients = NewSingleIncentiveTeam(incentiveTeamAddr)
 	return v, nil
 }
 
@@ -547,12 +566,16 @@ func (v Validator) GetReallocatedCommissionRule() (sdk.Dec, sdk.Dec, uint32, []R
 	return v.ReallocatedCommissionRule.ValidatorRate, v.ReallocatedCommissionRule.RecommandersRate, 
 		v.ReallocatedCommissionRule.IncentiveDepth, v.ReallocatedCommissionRule.RecommanderClassRates
 }
-func (v Validator) GetIncentiveTeam() sdk.AccAddress { 
-	addr, err := sdk.AccAddressFromBech32(v.IncentiveTeamAddress)
-	if err != nil {
-		panic(err)
+func (v Validator) GetIncentiveTeamRecipients() []IncentiveTeamRecipient {
+	// validators which are not migrated yet only have the deprecated address
+	if len(v.IncentiveTeamRecipients) == 0 && v.IncentiveTeamAddress != "" {
+		addr, err := sdk.AccAddressFromBech32(v.IncentiveTeamAddress)
+		if err != nil {
+			panic(err)
+		}
+		return NewSingleIncentiveTeam(addr)
 	}
-	return addr
+	return v.IncentiveTeamRecipients
 }
 func (v Validator) GetMinSelfDelegation() sdk.Int { return v.MinSelfDelegation }
 func (v Validator) GetDelegatorShares() sdk.Dec   { return v.DelegatorShares }